@@ -0,0 +1,305 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package GasOracle
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// GasOracleMetaData contains all meta data concerning the GasOracle contract.
+var GasOracleMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"name\":\"l1BaseFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"l1BlobBaseFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"l1BlockNumber\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"dataSize\",\"type\":\"uint256\"}],\"name\":\"l1Cost\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// GasOracleABI is the input ABI used to generate the binding from.
+// Deprecated: Use GasOracleMetaData.ABI instead.
+var GasOracleABI = GasOracleMetaData.ABI
+
+// GasOracle is an auto generated Go binding around an Ethereum contract.
+type GasOracle struct {
+	GasOracleCaller     // Read-only binding to the contract
+	GasOracleTransactor // Write-only binding to the contract
+	GasOracleFilterer   // Log filterer for contract events
+}
+
+// GasOracleCaller is an auto generated read-only Go binding around an Ethereum contract.
+type GasOracleCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GasOracleTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type GasOracleTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GasOracleFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type GasOracleFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GasOracleSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type GasOracleSession struct {
+	Contract     *GasOracle        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// GasOracleCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type GasOracleCallerSession struct {
+	Contract *GasOracleCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// GasOracleTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type GasOracleTransactorSession struct {
+	Contract     *GasOracleTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// GasOracleRaw is an auto generated low-level Go binding around an Ethereum contract.
+type GasOracleRaw struct {
+	Contract *GasOracle // Generic contract binding to access the raw methods on
+}
+
+// GasOracleCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type GasOracleCallerRaw struct {
+	Contract *GasOracleCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// GasOracleTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type GasOracleTransactorRaw struct {
+	Contract *GasOracleTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewGasOracle creates a new instance of GasOracle, bound to a specific deployed contract.
+func NewGasOracle(address common.Address, backend bind.ContractBackend) (*GasOracle, error) {
+	contract, err := bindGasOracle(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &GasOracle{GasOracleCaller: GasOracleCaller{contract: contract}, GasOracleTransactor: GasOracleTransactor{contract: contract}, GasOracleFilterer: GasOracleFilterer{contract: contract}}, nil
+}
+
+// NewGasOracleCaller creates a new read-only instance of GasOracle, bound to a specific deployed contract.
+func NewGasOracleCaller(address common.Address, caller bind.ContractCaller) (*GasOracleCaller, error) {
+	contract, err := bindGasOracle(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GasOracleCaller{contract: contract}, nil
+}
+
+// NewGasOracleTransactor creates a new write-only instance of GasOracle, bound to a specific deployed contract.
+func NewGasOracleTransactor(address common.Address, transactor bind.ContractTransactor) (*GasOracleTransactor, error) {
+	contract, err := bindGasOracle(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GasOracleTransactor{contract: contract}, nil
+}
+
+// NewGasOracleFilterer creates a new log filterer instance of GasOracle, bound to a specific deployed contract.
+func NewGasOracleFilterer(address common.Address, filterer bind.ContractFilterer) (*GasOracleFilterer, error) {
+	contract, err := bindGasOracle(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &GasOracleFilterer{contract: contract}, nil
+}
+
+// bindGasOracle binds a generic wrapper to an already deployed contract.
+func bindGasOracle(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := GasOracleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_GasOracle *GasOracleRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _GasOracle.Contract.GasOracleCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_GasOracle *GasOracleRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _GasOracle.Contract.GasOracleTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_GasOracle *GasOracleRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _GasOracle.Contract.GasOracleTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_GasOracle *GasOracleCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _GasOracle.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_GasOracle *GasOracleTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _GasOracle.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_GasOracle *GasOracleTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _GasOracle.Contract.contract.Transact(opts, method, params...)
+}
+
+// L1BaseFee is a free data retrieval call binding the contract method 0x519b4bd3.
+//
+// Solidity: function l1BaseFee() view returns(uint256)
+func (_GasOracle *GasOracleCaller) L1BaseFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GasOracle.contract.Call(opts, &out, "l1BaseFee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// L1BaseFee is a free data retrieval call binding the contract method 0x519b4bd3.
+//
+// Solidity: function l1BaseFee() view returns(uint256)
+func (_GasOracle *GasOracleSession) L1BaseFee() (*big.Int, error) {
+	return _GasOracle.Contract.L1BaseFee(&_GasOracle.CallOpts)
+}
+
+// L1BaseFee is a free data retrieval call binding the contract method 0x519b4bd3.
+//
+// Solidity: function l1BaseFee() view returns(uint256)
+func (_GasOracle *GasOracleCallerSession) L1BaseFee() (*big.Int, error) {
+	return _GasOracle.Contract.L1BaseFee(&_GasOracle.CallOpts)
+}
+
+// L1BlobBaseFee is a free data retrieval call binding the contract method 0x84189161.
+//
+// Solidity: function l1BlobBaseFee() view returns(uint256)
+func (_GasOracle *GasOracleCaller) L1BlobBaseFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GasOracle.contract.Call(opts, &out, "l1BlobBaseFee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// L1BlobBaseFee is a free data retrieval call binding the contract method 0x84189161.
+//
+// Solidity: function l1BlobBaseFee() view returns(uint256)
+func (_GasOracle *GasOracleSession) L1BlobBaseFee() (*big.Int, error) {
+	return _GasOracle.Contract.L1BlobBaseFee(&_GasOracle.CallOpts)
+}
+
+// L1BlobBaseFee is a free data retrieval call binding the contract method 0x84189161.
+//
+// Solidity: function l1BlobBaseFee() view returns(uint256)
+func (_GasOracle *GasOracleCallerSession) L1BlobBaseFee() (*big.Int, error) {
+	return _GasOracle.Contract.L1BlobBaseFee(&_GasOracle.CallOpts)
+}
+
+// L1BlockNumber is a free data retrieval call binding the contract method 0x298c9005.
+//
+// Solidity: function l1BlockNumber() view returns(uint256)
+func (_GasOracle *GasOracleCaller) L1BlockNumber(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GasOracle.contract.Call(opts, &out, "l1BlockNumber")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// L1BlockNumber is a free data retrieval call binding the contract method 0x298c9005.
+//
+// Solidity: function l1BlockNumber() view returns(uint256)
+func (_GasOracle *GasOracleSession) L1BlockNumber() (*big.Int, error) {
+	return _GasOracle.Contract.L1BlockNumber(&_GasOracle.CallOpts)
+}
+
+// L1BlockNumber is a free data retrieval call binding the contract method 0x298c9005.
+//
+// Solidity: function l1BlockNumber() view returns(uint256)
+func (_GasOracle *GasOracleCallerSession) L1BlockNumber() (*big.Int, error) {
+	return _GasOracle.Contract.L1BlockNumber(&_GasOracle.CallOpts)
+}
+
+// L1Cost is a free data retrieval call binding the contract method 0x61ed0a95.
+//
+// Solidity: function l1Cost(uint256 dataSize) view returns(uint256)
+func (_GasOracle *GasOracleCaller) L1Cost(opts *bind.CallOpts, dataSize *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _GasOracle.contract.Call(opts, &out, "l1Cost", dataSize)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// L1Cost is a free data retrieval call binding the contract method 0x61ed0a95.
+//
+// Solidity: function l1Cost(uint256 dataSize) view returns(uint256)
+func (_GasOracle *GasOracleSession) L1Cost(dataSize *big.Int) (*big.Int, error) {
+	return _GasOracle.Contract.L1Cost(&_GasOracle.CallOpts, dataSize)
+}
+
+// L1Cost is a free data retrieval call binding the contract method 0x61ed0a95.
+//
+// Solidity: function l1Cost(uint256 dataSize) view returns(uint256)
+func (_GasOracle *GasOracleCallerSession) L1Cost(dataSize *big.Int) (*big.Int, error) {
+	return _GasOracle.Contract.L1Cost(&_GasOracle.CallOpts, dataSize)
+}
@@ -61,7 +61,7 @@ type StructsValueTransferMessage struct {
 
 // ManagementContractMetaData contains all meta data concerning the ManagementContract contract.
 var ManagementContractMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"ECDSAInvalidSignature\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"length\",\"type\":\"uint256\"}],\"name\":\"ECDSAInvalidSignatureLength\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"ECDSAInvalidSignatureS\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAddress\",\"type\":\"address\"}],\"name\":\"ImportantContractAddressUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"messageBusAddress\",\"type\":\"address\"}],\"name\":\"LogManagementContractCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"requester\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"requestReport\",\"type\":\"string\"}],\"name\":\"NetworkSecretRequested\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"requester\",\"type\":\"address\"}],\"name\":\"NetworkSecretResponded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"rollupHash\",\"type\":\"bytes32\"}],\"name\":\"RollupAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"enclaveID\",\"type\":\"address\"}],\"name\":\"SequencerEnclaveGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"enclaveID\",\"type\":\"address\"}],\"name\":\"SequencerEnclaveRevoked\",\"type\":\"event\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"r\",\"type\":\"tuple\"},{\"components\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"nonce\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"topic\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"},{\"internalType\":\"uint8\",\"name\":\"consistencyLevel\",\"type\":\"uint8\"}],\"internalType\":\"structStructs.CrossChainMessage[]\",\"name\":\"messages\",\"type\":\"tuple[]\"}],\"internalType\":\"structStructs.HeaderCrossChainData\",\"name\":\"\",\"type\":\"tuple\"}],\"name\":\"AddRollup\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"Attested\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"}],\"internalType\":\"structStructs.ValueTransferMessage\",\"name\":\"_msg\",\"type\":\"tuple\"},{\"internalType\":\"bytes32[]\",\"name\":\"proof\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32\",\"name\":\"root\",\"type\":\"bytes32\"}],\"name\":\"ExtractNativeValue\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GetImportantContractKeys\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"rollupHash\",\"type\":\"bytes32\"}],\"name\":\"GetRollupByHash\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"number\",\"type\":\"uint256\"}],\"name\":\"GetRollupByNumber\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"number\",\"type\":\"uint256\"}],\"name\":\"GetUniqueForkID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"GrantSequencerEnclave\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_enclaveID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_initSecret\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_genesisAttestation\",\"type\":\"string\"}],\"name\":\"InitializeNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"IsSequencerEnclave\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"IsWithdrawalAvailable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"requestReport\",\"type\":\"string\"}],\"name\":\"RequestNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"attesterID\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"requesterID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"attesterSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"responseSecret\",\"type\":\"bytes\"},{\"internalType\":\"bool\",\"name\":\"verifyAttester\",\"type\":\"bool\"}],\"name\":\"RespondNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"RetrieveAllBridgeFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"RevokeSequencerEnclave\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"newAddress\",\"type\":\"address\"}],\"name\":\"SetImportantContractAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_lastBatchHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"blockHash\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"blockNum\",\"type\":\"uint256\"},{\"internalType\":\"bytes[]\",\"name\":\"crossChainHashes\",\"type\":\"bytes[]\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"rollupNumber\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"forkID\",\"type\":\"bytes32\"}],\"name\":\"addCrossChainMessagesRoot\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"name\":\"importantContractAddresses\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"importantContractKeys\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes[]\",\"name\":\"crossChainHashes\",\"type\":\"bytes[]\"}],\"name\":\"isBundleAvailable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"isBundleSaved\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"isWithdrawalSpent\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastBatchHash\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastBatchSeqNo\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"merkleMessageBus\",\"outputs\":[{\"internalType\":\"contractIMerkleTreeMessageBus\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"messageBus\",\"outputs\":[{\"internalType\":\"contractIMessageBus\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[],\"name\":\"ECDSAInvalidSignature\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"length\",\"type\":\"uint256\"}],\"name\":\"ECDSAInvalidSignatureLength\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"s\",\"type\":\"bytes32\"}],\"name\":\"ECDSAInvalidSignatureS\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"InvalidInitialization\",\"type\":\"error\"},{\"inputs\":[],\"name\":\"NotInitializing\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"OwnableInvalidOwner\",\"type\":\"error\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"OwnableUnauthorizedAccount\",\"type\":\"error\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"encryptedTx\",\"type\":\"bytes\"}],\"name\":\"ForcedTransactionSubmitted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sequencer\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"batchSeqNo\",\"type\":\"uint64\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"batchHash\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"expectedStateRoot\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"receivedStateRoot\",\"type\":\"bytes32\"}],\"name\":\"FraudReported\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAddress\",\"type\":\"address\"}],\"name\":\"ImportantContractAddressUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint64\",\"name\":\"version\",\"type\":\"uint64\"}],\"name\":\"Initialized\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"messageBusAddress\",\"type\":\"address\"}],\"name\":\"LogManagementContractCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"requester\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"requestReport\",\"type\":\"string\"}],\"name\":\"NetworkSecretRequested\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"requester\",\"type\":\"address\"}],\"name\":\"NetworkSecretResponded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"epoch\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"attester\",\"type\":\"address\"}],\"name\":\"NetworkSecretRotated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"epoch\",\"type\":\"uint256\"}],\"name\":\"NetworkSecretRotationRequested\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"rollupHash\",\"type\":\"bytes32\"}],\"name\":\"RollupAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"enclaveID\",\"type\":\"address\"}],\"name\":\"SequencerEnclaveGranted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"enclaveID\",\"type\":\"address\"}],\"name\":\"SequencerEnclaveRevoked\",\"type\":\"event\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"r\",\"type\":\"tuple\"},{\"components\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"nonce\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"topic\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"},{\"internalType\":\"uint8\",\"name\":\"consistencyLevel\",\"type\":\"uint8\"}],\"internalType\":\"structStructs.CrossChainMessage[]\",\"name\":\"messages\",\"type\":\"tuple[]\"}],\"internalType\":\"structStructs.HeaderCrossChainData\",\"name\":\"\",\"type\":\"tuple\"},{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"name\":\"AddCalldataRollup\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"r\",\"type\":\"tuple\"},{\"components\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"},{\"internalType\":\"uint32\",\"name\":\"nonce\",\"type\":\"uint32\"},{\"internalType\":\"uint32\",\"name\":\"topic\",\"type\":\"uint32\"},{\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"},{\"internalType\":\"uint8\",\"name\":\"consistencyLevel\",\"type\":\"uint8\"}],\"internalType\":\"structStructs.CrossChainMessage[]\",\"name\":\"messages\",\"type\":\"tuple[]\"}],\"internalType\":\"structStructs.HeaderCrossChainData\",\"name\":\"\",\"type\":\"tuple\"}],\"name\":\"AddRollup\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"Attested\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"epoch\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"attesterID\",\"type\":\"address\"},{\"internalType\":\"address[]\",\"name\":\"enclaveIDs\",\"type\":\"address[]\"},{\"internalType\":\"bytes[]\",\"name\":\"encryptedSecrets\",\"type\":\"bytes[]\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"name\":\"DistributeNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"receiver\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"uint64\",\"name\":\"sequence\",\"type\":\"uint64\"}],\"internalType\":\"structStructs.ValueTransferMessage\",\"name\":\"_msg\",\"type\":\"tuple\"},{\"internalType\":\"bytes32[]\",\"name\":\"proof\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32\",\"name\":\"root\",\"type\":\"bytes32\"}],\"name\":\"ExtractNativeValue\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"GetImportantContractKeys\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"rollupHash\",\"type\":\"bytes32\"}],\"name\":\"GetRollupByHash\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"number\",\"type\":\"uint256\"}],\"name\":\"GetRollupByNumber\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"number\",\"type\":\"uint256\"}],\"name\":\"GetUniqueForkID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"Hash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes\",\"name\":\"Signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"LastSequenceNumber\",\"type\":\"uint256\"}],\"internalType\":\"structStructs.MetaRollup\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"GrantSequencerEnclave\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_enclaveID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_initSecret\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_genesisAttestation\",\"type\":\"string\"}],\"name\":\"InitializeNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"IsSequencerEnclave\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"IsWithdrawalAvailable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MAX_FORCED_TX_SIZE\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"batchSeqNo\",\"type\":\"uint64\"},{\"internalType\":\"bytes32\",\"name\":\"batchHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"expectedStateRoot\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"receivedStateRoot\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"sequencer\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"}],\"name\":\"ReportFraud\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"requestReport\",\"type\":\"string\"}],\"name\":\"RequestNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"attesterID\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"requesterID\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"attesterSig\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"responseSecret\",\"type\":\"bytes\"},{\"internalType\":\"bool\",\"name\":\"verifyAttester\",\"type\":\"bool\"}],\"name\":\"RespondNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"RetrieveAllBridgeFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"RevokeSequencerEnclave\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"RotateNetworkSecret\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"key\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"newAddress\",\"type\":\"address\"}],\"name\":\"SetImportantContractAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"encryptedTx\",\"type\":\"bytes\"}],\"name\":\"SubmitForcedTransaction\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_lastBatchHash\",\"type\":\"bytes32\"},{\"internalType\":\"bytes32\",\"name\":\"blockHash\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"blockNum\",\"type\":\"uint256\"},{\"internalType\":\"bytes[]\",\"name\":\"crossChainHashes\",\"type\":\"bytes[]\"},{\"internalType\":\"bytes\",\"name\":\"signature\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"rollupNumber\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"forkID\",\"type\":\"bytes32\"}],\"name\":\"addCrossChainMessagesRoot\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"name\":\"importantContractAddresses\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"importantContractKeys\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes[]\",\"name\":\"crossChainHashes\",\"type\":\"bytes[]\"}],\"name\":\"isBundleAvailable\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"isBundleSaved\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"isFraudReported\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"isWithdrawalSpent\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastBatchHash\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastBatchSeqNo\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"merkleMessageBus\",\"outputs\":[{\"internalType\":\"contractIMerkleTreeMessageBus\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"messageBus\",\"outputs\":[{\"internalType\":\"contractIMessageBus\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"networkSecretEpoch\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"requestedSecretEpoch\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 	Bin: "0x6080604052348015600f57600080fd5b50601733601b565b608c565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c19930080546001600160a01b031981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3505050565b61531d8061009b6000396000f3fe608060405234801561001057600080fd5b50600436106101d95760003560e01c80638129fc1c11610104578063a25eb31c116100a2578063db5d91b111610071578063db5d91b114610475578063e34fbfc8146104a1578063e874eb20146104b4578063f2fde38b146104c757600080fd5b8063a25eb31c1461042c578063a4ab2faa1461043f578063a52f433c14610452578063d4fab8871461046257600080fd5b806387059edb116100de57806387059edb146103a95780638da5cb5b146103bc57806398077e86146103ec578063a1a227fa1461040c57600080fd5b80638129fc1c1461035d5780638236a7ba14610365578063841548261461038657600080fd5b8063476657381161017c5780636a30d26c1161014b5780636a30d26c146103255780636b9707d61461033a578063715018a61461034d578063728109961461035557600080fd5b806347665738146102cb5780635371a216146102de578063568699c8146102f157806368e103831461031257600080fd5b80632f0cb9e3116101b85780632f0cb9e3146102255780633e60a22f1461025557806343348b2f14610296578063440c953b146102c257600080fd5b80620ddd27146101de57806303e72e48146101fd578063073b6ef314610212575b600080fd5b6101e7600e5481565b6040516101f49190611a56565b60405180910390f35b61021061020b366004611b8c565b6104da565b005b610210610220366004611d01565b6105e2565b610248610233366004611dcf565b600c6020526000908152604090205460ff1681565b6040516101f49190611df6565b610289610263366004611e04565b80516020818301810180516003825292820191909301209152546001600160a01b031681565b6040516101f49190611e50565b6102486102a4366004611e5e565b6001600160a01b031660009081526020819052604090205460ff1690565b6101e760055481565b6102106102d9366004611e5e565b6107d9565b6102106102ec366004611ee3565b610879565b6103046102ff366004611dcf565b610a1e565b6040516101f4929190611fe5565b610210610320366004612005565b610a74565b61032d610b18565b6040516101f49190612108565b610210610348366004611e5e565b610bf1565b610210610c81565b610210610c95565b610210610d1a565b610378610373366004611dcf565b610ef5565b6040516101f4929190612119565b610248610394366004611dcf565b600d6020526000908152604090205460ff1681565b6103786103b7366004611dcf565b610fdd565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b0316610289565b6103ff6103fa366004611dcf565b611054565b6040516101f49190612127565b600a5461041f906001600160a01b031681565b6040516101f4919061217a565b61021061043a3660046121b2565b611100565b61024861044d366004612224565b611208565b600454610100900460ff16610248565b610210610470366004612272565b611286565b610248610483366004611e5e565b6001600160a01b031660009081526001602052604090205460ff1690565b6102106104af366004612319565b61138e565b600b5461041f906001600160a01b031681565b6102106104d5366004611e5e565b6113d5565b6104e261142c565b60006001600160a01b03166003836040516104fd9190612383565b908152604051908190036020019020546001600160a01b03160361055957600280546001810182556000919091527f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace01610557838261245f565b505b8060038360405161056a9190612383565b90815260405190819003602001812080546001600160a01b039390931673ffffffffffffffffffffffffffffffffffffffff19909316929092179091557f17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5906105d6908490849061251f565b60405180910390a15050565b60008281526008602052604090205481146106185760405162461bcd60e51b815260040161060f90612571565b60405180910390fd5b60006106868989898960405160200161063494939291906125d9565b6040516020818303038152906040528051906020012086868080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506114a092505050565b6001600160a01b03811660009081526020819052604090205490915060ff166106c15760405162461bcd60e51b815260040161060f9061264f565b600e8990556000805b87518110156107b457600b5488516001600160a01b039091169063b6aed0cb908a90849081106106fc576106fc61265f565b602002602001015161070d9061267f565b426040518363ffffffff1660e01b815260040161072b9291906126b5565b600060405180830381600087803b15801561074557600080fd5b505af1158015610759573d6000803e3d6000fd5b50505050818882815181106107705761077061265f565b60200260200101516107819061267f565b6040516020016107929291906126b5565b60408051601f19818403018152919052805160209091012091506001016106ca565b506000908152600d60205260409020805460ff19166001179055505050505050505050565b6107e161142c565b6001600160a01b03811660009081526020819052604090205460ff166108195760405162461bcd60e51b815260040161060f9061264f565b6001600160a01b038116600090815260016020819052604091829020805460ff19169091179055517ffe64c7181f0fc60e300dc02cca368cdfa94d7ca45902de3b9a9d80070e7609369061086e908390611e50565b60405180910390a150565b600b546040517fb201246f0000000000000000000000000000000000000000000000000000000081526001600160a01b039091169063b201246f906108c89087908790879087906004016127eb565b60006040518083038186803b1580156108e057600080fd5b505afa1580156108f4573d6000803e3d6000fd5b5050505060008460405160200161090b9190612824565b60408051601f1981840301815291815281516020928301206000818152600c90935291205490915060ff16156109535760405162461bcd60e51b815260040161060f90612864565b6001600c60008760405160200161096a9190612824565b60408051808303601f190181529181528151602092830120835282820193909352908201600020805460ff191693151593909317909255600a546001600160a01b0316916399a3ad21916109c391908901908901611e5e565b87604001356040518363ffffffff1660e01b81526004016109e5929190612874565b600060405180830381600087803b1580156109ff57600080fd5b505af1158015610a13573d6000803e3d6000fd5b505050505050505050565b604080516060808201835260008083526020830191909152918101829052600080610a4885610fdd565b9150915081610a5d5760009590945092505050565b600094855260086020526040909420549492505050565b60045460ff1615610a975760405162461bcd60e51b815260040161060f906128dc565b60048054600160ff1991821681179092556001600160a01b0387166000908152602081815260408083208054851686179055908490529081902080549092169092179055517ffe64c7181f0fc60e300dc02cca368cdfa94d7ca45902de3b9a9d80070e76093690610b09908790611e50565b60405180910390a15050505050565b60606002805480602002602001604051908101604052809291908181526020016000905b82821015610be8578382906000526020600020018054610b5b906123a3565b80601f0160208091040260200160405190810160405280929190818152602001828054610b87906123a3565b8015610bd45780601f10610ba957610100808354040283529160200191610bd4565b820191906000526020600020905b815481529060010190602001808311610bb757829003601f168201915b505050505081526020019060010190610b3c565b50505050905090565b610bf961142c565b6001600160a01b03811660009081526001602052604090205460ff16610c315760405162461bcd60e51b815260040161060f9061291e565b6001600160a01b03811660009081526001602052604090819020805460ff19169055517f0f279980343c7ca542fde9fa5396555068efb5cd560d9cf9c191aa2911079b479061086e908390611e50565b610c8961142c565b610c9360006114cc565b565b610c9d61142c565b600a546040517f36d2da900000000000000000000000000000000000000000000000000000000081526001600160a01b03909116906336d2da9090610ce6903390600401611e50565b600060405180830381600087803b158015610d0057600080fd5b505af1158015610d14573d6000803e3d6000fd5b50505050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805468010000000000000000810460ff16159067ffffffffffffffff16600081158015610d655750825b905060008267ffffffffffffffff166001148015610d825750303b155b905081158015610d90575080155b15610dc7576040517ff92ee8a900000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b845467ffffffffffffffff191660011785558315610dfb57845468ff00000000000000001916680100000000000000001785555b610e043361154a565b60006005556001600955604051610e1a90611a41565b604051809103906000f080158015610e36573d6000803e3d6000fd5b50600b80546001600160a01b039290921673ffffffffffffffffffffffffffffffffffffffff199283168117909155600a805490921681179091556040517fbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf91610e9f91611e50565b60405180910390a18315610eee57845468ff0000000000000000191685556040517fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d290610b0990600190612949565b5050505050565b604080516060808201835260008083526020808401839052838501829052858252600681528482208551938401909552845483526001850180549295869493909284019190610f43906123a3565b80601f0160208091040260200160405190810160405280929190818152602001828054610f6f906123a3565b8015610fbc5780601f10610f9157610100808354040283529160200191610fbc565b820191906000526020600020905b815481529060010190602001808311610f9f57829003601f168201915b50505091835250506002919091015460209091015280519094149492505050565b6040805160608082018352600080835260208301919091529181018290526000838152600760205260408120549081900361104157505060408051606081018252600080825282516020818101855282825283015291810182905290939092509050565b61104a81610ef5565b9250925050915091565b6002818154811061106457600080fd5b90600052602060002001600091509050805461107f906123a3565b80601f01602080910402602001604051908101604052809291908181526020018280546110ab906123a3565b80156110f85780601f106110cd576101008083540402835291602001916110f8565b820191906000526020600020905b8154815290600101906020018083116110db57829003601f168201915b505050505081565b600061114e83356111146020860186612957565b8080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506114a092505050565b6001600160a01b03811660009081526020819052604090205490915060ff166111895760405162461bcd60e51b815260040161060f9061264f565b6001600160a01b03811660009081526001602052604090205460ff166111c15760405162461bcd60e51b815260040161060f9061291e565b6111ca8361155b565b6040517fd6555bff8670bd3008dc064c30bb56d6ac7cb14ae801e36146fe4e7c6a504a58906111fb90853590611a56565b60405180910390a1505050565b600080805b835181101561126d57818482815181106112295761122961265f565b602002602001015161123a9061267f565b60405160200161124b9291906126b5565b60408051601f198184030181529190528051602090910120915060010161120d565b506000908152600d602052604090205460ff1692915050565b6001600160a01b03851660009081526020819052604090205460ff16806112bf5760405162461bcd60e51b815260040161060f90612a04565b81156113375760006112f38787866040516020016112df93929190612a3c565b6040516020818303038152906040526115ff565b9050600061130182876114a0565b9050876001600160a01b0316816001600160a01b0316146113345760405162461bcd60e51b815260040161060f90612ab8565b50505b6001600160a01b03808616600081815260208190526040808220805460ff191660011790555191928916917fb869e23ebc7c717d76e345eee8ec282612603e45c44f7ae5494b197c8d9d1be19190a3505050505050565b336001600160a01b03167f0b0ecdedd12079aa2d6c5e0186026c711cb0c8d04f1b724ba5880fb6328d430183836040516113c9929190612ae8565b60405180910390a25050565b6113dd61142c565b6001600160a01b0381166114205760006040517f1e4fbdf700000000000000000000000000000000000000000000000000000000815260040161060f9190611e50565b611429816114cc565b50565b3361145e7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b031690565b6001600160a01b031614610c9357336040517f118cdaa700000000000000000000000000000000000000000000000000000000815260040161060f9190611e50565b6000806000806114b0868661163a565b9250925092506114c08282611687565b50909150505b92915050565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300805473ffffffffffffffffffffffffffffffffffffffff1981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3505050565b61155261178d565b611429816117f4565b8035600090815260066020526040902081906115778282612c5d565b5050600954600090815260076020526040902081359081905561159b600143612c7d565b406040516020016115ad9291906126b5565b60408051601f1981840301815291815281516020928301206009805460009081526008909452918320558054916115e383612c90565b9190505550600554816040013511156114295760400135600555565b600061160b82516117fc565b8260405160200161161d929190612ca9565b604051602081830303815290604052805190602001209050919050565b600080600083516041036116745760208401516040850151606086015160001a6116668882858561189d565b955095509550505050611680565b50508151600091506002905b9250925092565b600082600381111561169b5761169b612ce5565b036116a4575050565b60018260038111156116b8576116b8612ce5565b036116ef576040517ff645eedf00000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b600282600381111561170357611703612ce5565b0361173c576040517ffce698f700000000000000000000000000000000000000000000000000000000815261060f908290600401611a56565b600382600381111561175057611750612ce5565b0361178957806040517fd78bce0c00000000000000000000000000000000000000000000000000000000815260040161060f9190611a56565b5050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a005468010000000000000000900460ff16610c93576040517fd7e6bcf800000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b6113dd61178d565b606060006118098361195f565b600101905060008167ffffffffffffffff81111561182957611829611a64565b6040519080825280601f01601f191660200182016040528015611853576020820181803683370190505b5090508181016020015b600019017f3031323334353637383961626364656600000000000000000000000000000000600a86061a8153600a850494508461185d575b509392505050565b600080807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a08411156118d85750600091506003905082611955565b6000600188888888604051600081526020016040526040516118fd9493929190612d04565b6020604051602081039080840390855afa15801561191f573d6000803e3d6000fd5b5050604051601f1901519150506001600160a01b03811661194b57506000925060019150829050611955565b9250600091508190505b9450945094915050565b6000807a184f03e93ff9f4daa797ed6e38ed64bf6a1f01000000000000000083106119a8577a184f03e93ff9f4daa797ed6e38ed64bf6a1f010000000000000000830492506040015b6d04ee2d6d415b85acef810000000083106119d4576d04ee2d6d415b85acef8100000000830492506020015b662386f26fc1000083106119f257662386f26fc10000830492506010015b6305f5e1008310611a0a576305f5e100830492506008015b6127108310611a1e57612710830492506004015b60648310611a30576064830492506002015b600a83106114c65760010192915050565b6125ae80612d3a83390190565b805b82525050565b602081016114c68284611a4e565b634e487b7160e01b600052604160045260246000fd5b601f19601f830116810181811067ffffffffffffffff82111715611aa057611aa0611a64565b6040525050565b6000611ab260405190565b9050611abe8282611a7a565b919050565b600067ffffffffffffffff821115611add57611add611a64565b601f19601f83011660200192915050565b82818337506000910152565b6000611b0d611b0884611ac3565b611aa7565b9050828152838383011115611b2457611b24600080fd5b611b32836020830184611aee565b9392505050565b600082601f830112611b4d57611b4d600080fd5b611b3283833560208501611afa565b60006001600160a01b0382166114c6565b611b7681611b5c565b811461142957600080fd5b80356114c681611b6d565b60008060408385031215611ba257611ba2600080fd5b823567ffffffffffffffff811115611bbc57611bbc600080fd5b611bc885828601611b39565b925050611bd88460208501611b81565b90509250929050565b80611b76565b80356114c681611be1565b600067ffffffffffffffff821115611c0c57611c0c611a64565b5060209081020190565b6000611c24611b0884611bf2565b83815290506020808201908402830185811115611c4357611c43600080fd5b835b81811015611c8257803567ffffffffffffffff811115611c6757611c67600080fd5b611c7388828801611b39565b84525060209283019201611c45565b5050509392505050565b600082601f830112611ca057611ca0600080fd5b611b3283833560208501611c16565b60008083601f840112611cc457611cc4600080fd5b50813567ffffffffffffffff811115611cdf57611cdf600080fd5b602083019150836001820283011115611cfa57611cfa600080fd5b9250929050565b60008060008060008060008060e0898b031215611d2057611d20600080fd5b611d2a8a8a611be7565b9750611d398a60208b01611be7565b9650611d488a60408b01611be7565b9550606089013567ffffffffffffffff811115611d6757611d67600080fd5b611d738b828c01611c8c565b955050608089013567ffffffffffffffff811115611d9357611d93600080fd5b611d9f8b828c01611caf565b9450945050611db18a60a08b01611be7565b9150611dc08a60c08b01611be7565b90509295985092959890939650565b600060208284031215611de457611de4600080fd5b611b328383611be7565b801515611a50565b602081016114c68284611dee565b600060208284031215611e1957611e19600080fd5b813567ffffffffffffffff811115611e3357611e33600080fd5b611e3f84828501611b39565b949350505050565b611a5081611b5c565b602081016114c68284611e47565b600060208284031215611e7357611e73600080fd5b611b328383611b81565b600060808284031215611e9257611e92600080fd5b50919050565b60008083601f840112611ead57611ead600080fd5b50813567ffffffffffffffff811115611ec857611ec8600080fd5b602083019150836020820283011115611cfa57611cfa600080fd5b60008060008060c08587031215611efc57611efc600080fd5b611f068686611e7d565b9350608085013567ffffffffffffffff811115611f2557611f25600080fd5b611f3187828801611e98565b9350935050611f438660a08701611be7565b905092959194509250565b60005b83811015611f69578181015183820152602001611f51565b50506000910152565b6000611f7c825190565b808452602084019350611f93818560208601611f4e565b601f01601f19169290920192915050565b80516000906060840190611fb88582611a4e565b5060208301518482036020860152611fd08282611f72565b91505060408301516118956040860182611a4e565b60408101611ff38285611a4e565b8181036020830152611e3f8184611fa4565b60008060008060006060868803121561202057612020600080fd5b61202a8787611b81565b9450602086013567ffffffffffffffff81111561204957612049600080fd5b61205588828901611caf565b9450945050604086013567ffffffffffffffff81111561207757612077600080fd5b61208388828901611caf565b92509250509295509295909350565b6000611b328383611f72565b60200190565b60006120ae825190565b808452602084019350836020820285016120c88560200190565b60005b848110156120fc57838303885281516120e48482612092565b935050602082016020989098019791506001016120cb565b50909695505050505050565b60208082528101611b3281846120a4565b60408101611ff38285611dee565b60208082528101611b328184611f72565b60006114c66001600160a01b03831661214f565b90565b6001600160a01b031690565b60006114c682612138565b60006114c68261215b565b611a5081612166565b602081016114c68284612171565b600060608284031215611e9257611e92600080fd5b600060208284031215611e9257611e92600080fd5b600080604083850312156121c8576121c8600080fd5b823567ffffffffffffffff8111156121e2576121e2600080fd5b6121ee85828601612188565b925050602083013567ffffffffffffffff81111561220e5761220e600080fd5b61221a8582860161219d565b9150509250929050565b60006020828403121561223957612239600080fd5b813567ffffffffffffffff81111561225357612253600080fd5b611e3f84828501611c8c565b801515611b76565b80356114c68161225f565b600080600080600060a0868803121561228d5761228d600080fd5b6122978787611b81565b94506122a68760208801611b81565b9350604086013567ffffffffffffffff8111156122c5576122c5600080fd5b6122d188828901611b39565b935050606086013567ffffffffffffffff8111156122f1576122f1600080fd5b6122fd88828901611b39565b92505061230d8760808801612267565b90509295509295909350565b6000806020838503121561232f5761232f600080fd5b823567ffffffffffffffff81111561234957612349600080fd5b61235585828601611caf565b92509250509250929050565b600061236b825190565b612379818560208601611f4e565b9290920192915050565b6114c68183612361565b634e487b7160e01b600052602260045260246000fd5b6002810460018216806123b757607f821691505b602082108103611e9257611e9261238d565b60006114c661214c8381565b6123de836123c9565b815460001960089490940293841b1916921b91909117905550565b60006124068184846123d5565b505050565b818110156117895761241e6000826123f9565b60010161240b565b601f821115612406576000818152602090206020601f8501048101602085101561244d5750805b610eee6020601f86010483018261240b565b815167ffffffffffffffff81111561247957612479611a64565b61248382546123a3565b61248e828285612426565b506020601f8211600181146124c357600083156124ab5750848201515b600019600885021c1981166002850217855550610eee565b600084815260208120601f198516915b828110156124f357878501518255602094850194600190920191016124d3565b50848210156125105783870151600019601f87166008021c191681555b50505050600202600101905550565b604080825281016125308185611f72565b9050611b326020830184611e47565b600e8152602081017f496e76616c696420666f726b49440000000000000000000000000000000000008152905061209e565b602080825281016114c68161253f565b600061258b825190565b808452602084019350836020820285016125a58560200190565b60005b848110156120fc57838303885281516125c18482612092565b935050602082016020989098019791506001016125a8565b608081016125e78287611a4e565b6125f46020830186611a4e565b6126016040830185611a4e565b81810360608301526126138184612581565b9695505050505050565b60168152602081017f656e636c6176654944206e6f74206174746573746564000000000000000000008152905061209e565b602080825281016114c68161261d565b634e487b7160e01b600052603260045260246000fd5b60006114c6825190565b6000612689825190565b6020830161269681612675565b9250506020811015611e92576000196020919091036008021b16919050565b604081016126c38285611a4e565b611b326020830184611a4e565b5060006114c66020830183611b81565b5060006114c66020830183611be7565b67ffffffffffffffff8116611b76565b80356114c6816126f0565b5060006114c66020830183612700565b67ffffffffffffffff8116611a50565b61273581806126d0565b61273f8382611e47565b5061274d60208201826126d0565b61275a6020840182611e47565b5061276860408201826126e0565b6127756040840182611a4e565b50612783606082018261270b565b612406606084018261271b565b82818337505050565b81835260208301925060007f07ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8311156127d4576127d4600080fd5b6020830292506127e5838584612790565b50500190565b60c081016127f9828761272b565b818103608083015261280c818587612799565b905061281b60a0830184611a4e565b95945050505050565b608081016114c6828461272b565b60188152602081017f7769746864726177616c20616c7265616479207370656e7400000000000000008152905061209e565b602080825281016114c681612832565b604081016126c38285611e47565b60228152602081017f6e6574776f726b2073656372657420616c726561647920696e697469616c697a81527f6564000000000000000000000000000000000000000000000000000000000000602082015290505b60400190565b602080825281016114c681612882565b60198152602081017f656e636c6176654944206e6f7420612073657175656e636572000000000000008152905061209e565b602080825281016114c6816128ec565b600067ffffffffffffffff82166114c6565b611a508161292e565b602081016114c68284612940565b6000808335601e193685900301811261297257612972600080fd5b8301915050803567ffffffffffffffff81111561299157612991600080fd5b602082019150600181023603821315611cfa57611cfa600080fd5b60238152602081017f726573706f6e64696e67206174746573746572206973206e6f7420617474657381527f7465640000000000000000000000000000000000000000000000000000000000602082015290506128d6565b602080825281016114c6816129ac565b60006114c68260601b90565b60006114c682612a14565b611a50612a3782611b5c565b612a20565b612a468185612a2b565b601401612a538184612a2b565b601401611e3f8183612361565b602c8152602081017f63616c63756c61746564206164647265737320616e642061747465737465724981527f4420646f6e74206d617463680000000000000000000000000000000000000000602082015290506128d6565b602080825281016114c681612a60565b818352602083019250612adc828483611aee565b50601f01601f19160190565b60208082528101611e3f818486612ac8565b600081356114c681611be1565b6000816114c6565b612b1882612b07565b612b2461214c82612b07565b8255505050565b8267ffffffffffffffff811115612b4457612b44611a64565b612b4e82546123a3565b612b59828285612426565b506000601f821160018114612b8e5760008315612b765750848201355b600019600885021c1981166002850217855550612be8565b600084815260209020601f19841690835b82811015612bbf5787850135825560209485019460019092019101612b9f565b5084821015612bdc576000196008601f8716021c19878501351681555b50506001600284020184555b505050505050565b612406838383612b2b565b612c04826123c9565b80612b24565b8180612c1581612afa565b9050612c218184612b0f565b5050612c306020830183612957565b612c3e818360018601612bf0565b50506040820180612c4e82612afa565b9050610d148160028501612bfb565b6117898282612c0a565b634e487b7160e01b600052601160045260246000fd5b818103818111156114c6576114c6612c67565b600060018201612ca257612ca2612c67565b5060010190565b7f19457468657265756d205369676e6564204d6573736167653a0a0000000000008152601a01612cd98184612361565b9050611b328183612361565b634e487b7160e01b600052602160045260246000fd5b60ff8116611a50565b60808101612d128287611a4e565b612d1f6020830186612cfb565b612d2c6040830185611a4e565b61281b6060830184611a4e56fe608060405234801561001057600080fd5b5061001a33610027565b610022610098565b61014a565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c19930080546001600160a01b031981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3505050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805468010000000000000000900460ff16156100e85760405163f92ee8a960e01b815260040160405180910390fd5b80546001600160401b03908116146101475780546001600160401b0319166001600160401b0390811782556040519081527fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29060200160405180910390a15b50565b612455806101596000396000f3fe6080604052600436106101115760003560e01c80638da5cb5b116100a5578063b1454caa11610074578063b6aed0cb11610059578063b6aed0cb1461038b578063e138a8d2146103ab578063f2fde38b146103cb57610185565b8063b1454caa1461034b578063b201246f1461036b57610185565b80638da5cb5b146102a65780639730886d146102eb57806399a3ad211461030b578063ab53bddc1461032b57610185565b8063346633fb116100e1578063346633fb1461023e57806336d2da9014610251578063485cc95514610271578063715018a61461029157610185565b8062a1b815146101a65780630fcfbd11146101d15780630fe9188e146101f157806333a88c721461021157610185565b36610185576040517f346633fb000000000000000000000000000000000000000000000000000000008152309063346633fb90349061015690339083906004016111ea565b6000604051808303818588803b15801561016f57600080fd5b505af1158015610183573d6000803e3d6000fd5b005b60405162461bcd60e51b815260040161019d90611239565b60405180910390fd5b3480156101b257600080fd5b506101bb6103eb565b6040516101c89190611249565b60405180910390f35b3480156101dd57600080fd5b506101bb6101ec366004611272565b610477565b3480156101fd57600080fd5b5061018361020c3660046112c5565b6104d6565b34801561021d57600080fd5b5061023161022c366004611272565b61051c565b6040516101c891906112ec565b61018361024c36600461130e565b61056e565b34801561025d57600080fd5b5061018361026c366004611346565b6106bd565b34801561027d57600080fd5b5061018361028c366004611365565b61073c565b34801561029d57600080fd5b506101836108a7565b3480156102b257600080fd5b507f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b03166040516101c89190611394565b3480156102f757600080fd5b506101836103063660046113a2565b6108bb565b34801561031757600080fd5b5061018361032636600461130e565b610a27565b34801561033757600080fd5b5061018361034636600461130e565b610aa7565b61035e61035936600461146b565b610b70565b6040516101c891906114f8565b34801561037757600080fd5b50610183610386366004611566565b610c7d565b34801561039757600080fd5b506101836103a63660046115d1565b610d7e565b3480156103b757600080fd5b506101836103c63660046115f1565b610dc4565b3480156103d757600080fd5b506101836103e6366004611346565b610f0f565b600354604080517f1a90a21900000000000000000000000000000000000000000000000000000000815290516000926001600160a01b031691631a90a2199160048083019260209291908290030181865afa15801561044e573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906104729190611679565b905090565b6000808260405160200161048b9190611837565b60408051601f198184030181529181528151602092830120600081815292839052912054909150806104cf5760405162461bcd60e51b815260040161019d90611886565b9392505050565b6104de610f66565b600081815260046020526040812054900361050b5760405162461bcd60e51b815260040161019d906118c8565b600090815260046020526040812055565b600080826040516020016105309190611837565b60408051601f19818403018152918152815160209283012060008181529283905291205490915080158015906105665750428111155b949350505050565b60003411801561057d57508034145b6105995760405162461bcd60e51b815260040161019d90611930565b60035434906001600160a01b03161561065d5760006105b66103eb565b9050803410156105d85760405162461bcd60e51b815260040161019d90611970565b6105e28134611996565b6003546040519193506000916001600160a01b039091169083908381818185875af1925050503d8060008114610634576040519150601f19603f3d011682016040523d82523d6000602084013e610639565b606091505b505090508061065a5760405162461bcd60e51b815260040161019d90611a01565b50505b600061066833610fda565b9050836001600160a01b0316336001600160a01b03167f50c536ac33a920f00755865b831d17bf4cff0b2e0345f65b16d52bfc004068b684846040516106af929190611a11565b60405180910390a350505050565b6106c5610f66565b6000816001600160a01b03164760405160006040518083038185875af1925050503d8060008114610712576040519150601f19603f3d011682016040523d82523d6000602084013e610717565b606091505b50509050806107385760405162461bcd60e51b815260040161019d90611a5e565b5050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a00805468010000000000000000810460ff16159067ffffffffffffffff166000811580156107875750825b905060008267ffffffffffffffff1660011480156107a45750303b155b9050811580156107b2575080155b156107e9576040517ff92ee8a900000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b845467ffffffffffffffff19166001178555831561081d57845468ff00000000000000001916680100000000000000001785555b61082687611038565b6003805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038816179055831561089e57845468ff0000000000000000191685556040517fc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d29061089590600190611a92565b60405180910390a15b50505050505050565b6108af610f66565b6108b96000611049565b565b60006108c8600130611aa0565b90506108fb7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b031690565b6001600160a01b0316336001600160a01b031614806109225750336001600160a01b038216145b61093e5760405162461bcd60e51b815260040161019d90611af5565b600061094a8342611b05565b905060008460405160200161095f9190611837565b60408051601f198184030181529181528151602092830120600081815292839052912054909150156109a35760405162461bcd60e51b815260040161019d90611b70565b6000818152602081815260408220849055600191906109c490880188611346565b6001600160a01b0316815260208101919091526040016000908120906109f06080880160608901611b80565b63ffffffff1681526020808201929092526040016000908120805460018101825590825291902086916004020161089e8282611fbe565b610a2f610f66565b6000826001600160a01b03168260405160006040518083038185875af1925050503d8060008114610a7c576040519150601f19603f3d011682016040523d82523d6000602084013e610a81565b606091505b5050905080610aa25760405162461bcd60e51b815260040161019d90611a5e565b505050565b6000610ab4600130611aa0565b9050610ae77f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b031690565b6001600160a01b0316336001600160a01b03161480610b0e5750336001600160a01b038216145b610b2a5760405162461bcd60e51b815260040161019d90611af5565b826001600160a01b03167fcd9850463422a7449c406a036e35e5edb6fbe35a64c9f12a2354be98a750c0d383604051610b639190611249565b60405180910390a2505050565b6003546000906001600160a01b031615610c26576000610b8e6103eb565b905080341015610bb05760405162461bcd60e51b815260040161019d90612020565b6003546040516000916001600160a01b03169083908381818185875af1925050503d8060008114610bfd576040519150601f19603f3d011682016040523d82523d6000602084013e610c02565b606091505b5050905080610c235760405162461bcd60e51b815260040161019d90611a01565b50505b610c2f33610fda565b90507fb93c37389233beb85a3a726c3f15c2d15533ee74cb602f20f490dfffef77593733828888888888604051610c6c9796959493929190612030565b60405180910390a195945050505050565b6000818152600460205260408120549003610caa5760405162461bcd60e51b815260040161019d906120eb565b600081815260046020526040902054421015610cd85760405162461bcd60e51b815260040161019d90612137565b600084604051602001610ceb91906121bc565b60405160208183030381529060405280519060200120604051602001610d1191906121fc565b604051602081830303815290604052805190602001209050610d5b84848484604051602001610d40919061221b565b604051602081830303815290604052805190602001206110c7565b610d775760405162461bcd60e51b815260040161019d90612285565b5050505050565b610d86610f66565b60008281526004602052604090205415610db25760405162461bcd60e51b815260040161019d906122ed565b60009182526004602052604090912055565b6000818152600460205260408120549003610df15760405162461bcd60e51b815260040161019d906120eb565b600081815260046020526040902054421015610e1f5760405162461bcd60e51b815260040161019d90612137565b6000610e2e6020860186611346565b610e3e60408701602088016122fd565b610e4e6060880160408901611b80565b610e5e6080890160608a01611b80565b610e6b60808a018a611cd7565b610e7b60c08c0160a08d0161231c565b604051602001610e919796959493929190612030565b604051602081830303815290604052805190602001209050600081604051602001610ebc919061236d565b604051602081830303815290604052805190602001209050610eeb85858584604051602001610d40919061221b565b610f075760405162461bcd60e51b815260040161019d906123d5565b505050505050565b610f17610f66565b6001600160a01b038116610f5a5760006040517f1e4fbdf700000000000000000000000000000000000000000000000000000000815260040161019d9190611394565b610f6381611049565b50565b33610f987f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300546001600160a01b031690565b6001600160a01b0316146108b957336040517f118cdaa700000000000000000000000000000000000000000000000000000000815260040161019d9190611394565b6001600160a01b0381166000908152600260205260408120805467ffffffffffffffff16916001919061100d83856123e5565b92506101000a81548167ffffffffffffffff021916908367ffffffffffffffff160217905550919050565b6110406110df565b610f6381611146565b7f9016d09d72d40fdae2fd8ceac6b6234c7706214fd39c1cd1e609a0528c199300805473ffffffffffffffffffffffffffffffffffffffff1981166001600160a01b03848116918217845560405192169182907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a3505050565b6000826110d586868561114e565b1495945050505050565b7ff0c57e16840df040f15088dc2f81fe391c3923bec73e23a9662efc9c229c6a005468010000000000000000900460ff166108b9576040517fd7e6bcf800000000000000000000000000000000000000000000000000000000815260040160405180910390fd5b610f176110df565b600081815b848110156111875761117d8287878481811061117157611171612409565b90506020020135611190565b9150600101611153565b50949350505050565b60008183106111ac5760008281526020849052604090206111bb565b60008381526020839052604090205b90505b92915050565b60006001600160a01b0382166111be565b6111de816111c4565b82525050565b806111de565b604081016111f882856111d5565b6104cf60208301846111e4565b600b8152602081017f756e737570706f72746564000000000000000000000000000000000000000000815290505b60200190565b602080825281016111be81611205565b602081016111be82846111e4565b600060c0828403121561126c5761126c600080fd5b50919050565b60006020828403121561128757611287600080fd5b813567ffffffffffffffff8111156112a1576112a1600080fd5b61056684828501611257565b805b8114610f6357600080fd5b80356111be816112ad565b6000602082840312156112da576112da600080fd5b6111bb83836112ba565b8015156111de565b602081016111be82846112e4565b6112af816111c4565b80356111be816112fa565b6000806040838503121561132457611324600080fd5b61132e8484611303565b915061133d84602085016112ba565b90509250929050565b60006020828403121561135b5761135b600080fd5b6111bb8383611303565b6000806040838503121561137b5761137b600080fd5b6113858484611303565b915061133d8460208501611303565b602081016111be82846111d5565b600080604083850312156113b8576113b8600080fd5b823567ffffffffffffffff8111156113d2576113d2600080fd5b6113de85828601611257565b92505061133d84602085016112ba565b63ffffffff81166112af565b80356111be816113ee565b60008083601f84011261141a5761141a600080fd5b50813567ffffffffffffffff81111561143557611435600080fd5b60208301915083600182028301111561145057611450600080fd5b9250929050565b60ff81166112af565b80356111be81611457565b60008060008060006080868803121561148657611486600080fd5b61149087876113fa565b945061149f87602088016113fa565b9350604086013567ffffffffffffffff8111156114be576114be600080fd5b6114ca88828901611405565b93509350506114dc8760608801611460565b90509295509295909350565b67ffffffffffffffff81166111de565b602081016111be82846114e8565b60006080828403121561126c5761126c600080fd5b60008083601f84011261153057611530600080fd5b50813567ffffffffffffffff81111561154b5761154b600080fd5b60208301915083602082028301111561145057611450600080fd5b60008060008060c0858703121561157f5761157f600080fd5b6115898686611506565b9350608085013567ffffffffffffffff8111156115a8576115a8600080fd5b6115b48782880161151b565b93509350506115c68660a087016112ba565b905092959194509250565b600080604083850312156115e7576115e7600080fd5b61132e84846112ba565b6000806000806060858703121561160a5761160a600080fd5b843567ffffffffffffffff81111561162457611624600080fd5b61163087828801611257565b945050602085013567ffffffffffffffff81111561165057611650600080fd5b61165c8782880161151b565b93509350506115c686604087016112ba565b80516111be816112ad565b60006020828403121561168e5761168e600080fd5b6111bb838361166e565b5060006111be6020830183611303565b67ffffffffffffffff81166112af565b80356111be816116a8565b5060006111be60208301836116b8565b5060006111be60208301836113fa565b63ffffffff81166111de565b6000808335601e193685900301811261170a5761170a600080fd5b830160208101925035905067ffffffffffffffff81111561172d5761172d600080fd5b3681900382131561145057611450600080fd5b82818337506000910152565b818352602083019250611760828483611740565b50601f01601f19160190565b5060006111be6020830183611460565b60ff81166111de565b600060c083016117958380611698565b61179f85826111d5565b506117ad60208401846116c3565b6117ba60208601826114e8565b506117c860408401846116d3565b6117d560408601826116e3565b506117e360608401846116d3565b6117f060608601826116e3565b506117fe60808401846116ef565b858303608087015261181183828461174c565b9250505061182260a084018461176c565b61182f60a086018261177c565b509392505050565b602080825281016111bb8184611785565b60218152602081017f54686973206d65737361676520776173206e65766572207375626d69747465648152601760f91b602082015290505b60400190565b602080825281016111be81611848565b601a8152602081017f537461746520726f6f7420646f6573206e6f742065786973742e00000000000081529050611233565b602080825281016111be81611896565b60308152602081017f417474656d7074696e6720746f2073656e642076616c756520776974686f757481527f2070726f766964696e672045746865720000000000000000000000000000000060208201529050611880565b602080825281016111be816118d8565b60208082527f496e73756666696369656e742066756e647320746f2073656e642076616c75659101908152611233565b602080825281016111be81611940565b634e487b7160e01b600052601160045260246000fd5b818103818111156111be576111be611980565b60248152602081017f4661696c656420746f2073656e64206665657320746f206665657320636f6e7481527f726163740000000000000000000000000000000000000000000000000000000060208201529050611880565b602080825281016111be816119a9565b60408101611a1f82856111e4565b6104cf60208301846114e8565b60148152602081017f6661696c65642073656e64696e672076616c756500000000000000000000000081529050611233565b602080825281016111be81611a2c565b60006111be82611a7c565b90565b67ffffffffffffffff1690565b6111de81611a6e565b602081016111be8284611a89565b6001600160a01b039182169190811690828203908111156111be576111be611980565b60118152602081017f4e6f74206f776e6572206f722073656c6600000000000000000000000000000081529050611233565b602080825281016111be81611ac3565b808201808211156111be576111be611980565b60218152602081017f4d657373616765207375626d6974746564206d6f7265207468616e206f6e636581527f210000000000000000000000000000000000000000000000000000000000000060208201529050611880565b602080825281016111be81611b18565b600060208284031215611b9557611b95600080fd5b6111bb83836113fa565b600081356111be816112fa565b60006001600160a01b03835b81169019929092169190911792915050565b60006111be826111c4565b60006111be82611bca565b611be982611bd5565b611bf4818354611bac565b8255505050565b600081356111be816116a8565b60007bffffffffffffffff0000000000000000000000000000000000000000611bb88460a01b90565b60006111be67ffffffffffffffff8316611a7c565b611c4f82611c31565b611bf4818354611c08565b600081356111be816113ee565b60007fffffffff00000000000000000000000000000000000000000000000000000000611bb88460e01b90565b600063ffffffff82166111be565b611cab82611c94565b611bf4818354611c67565b600063ffffffff83611bb8565b611ccc82611c94565b611bf4818354611cb6565b6000808335601e1936859003018112611cf257611cf2600080fd5b8301915050803567ffffffffffffffff811115611d1157611d11600080fd5b60208201915060018102360382131561145057611450600080fd5b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052602260045260246000fd5b600281046001821680611d6c57607f821691505b60208210810361126c5761126c611d42565b60006111be611a798381565b611d9383611d7e565b815460001960089490940293841b1916921b91909117905550565b6000610aa2818484611d8a565b8181101561073857611dce600082611dae565b600101611dbb565b601f821115610aa2576000818152602090206020601f85010481016020851015611dfd5750805b610d776020601f860104830182611dbb565b8267ffffffffffffffff811115611e2857611e28611d2c565b611e328254611d58565b611e3d828285611dd6565b506000601f821160018114611e725760008315611e5a5750848201355b600019600885021c1981166002850217855550610f07565b600084815260209020601f19841690835b82811015611ea35787850135825560209485019460019092019101611e83565b5084821015611ec0576000196008601f8716021c19878501351681555b5050505060020260010190555050565b610aa2838383611e0f565b600081356111be81611457565b600060ff82166111be565b611efc82611ee8565b815460ff191660ff821617611bf4565b808280611f1881611b9f565b9050611f248184611be0565b50506020830180611f3482611bfb565b9050611f408184611c46565b50506040830180611f5082611c5a565b9050611f5c8184611ca2565b5050506060820180611f6d82611c5a565b9050611f7c8160018501611cc3565b5050611f8b6080830183611cd7565b611f99818360028601611ed0565b505060a0820180611fa982611edb565b9050611fb88160038501611ef3565b50505050565b6107388282611f0c565b60258152602081017f496e73756666696369656e742066756e647320746f207075626c697368206d6581527f737361676500000000000000000000000000000000000000000000000000000060208201529050611880565b602080825281016111be81611fc8565b60c0810161203e828a6111d5565b61204b60208301896114e8565b61205860408301886116e3565b61206560608301876116e3565b818103608083015261207881858761174c565b905061208760a083018461177c565b98975050505050505050565b602a8152602081017f526f6f74206973206e6f74207075626c6973686564206f6e2074686973206d6581527f7373616765206275732e0000000000000000000000000000000000000000000060208201529050611880565b602080825281016111be81612093565b60218152602081017f526f6f74206973206e6f7420636f6e736964657265642066696e616c207965748152601760f91b60208201529050611880565b602080825281016111be816120fb565b5060006111be60208301836112ba565b6121618180611698565b61216b83826111d5565b506121796020820182611698565b61218660208401826111d5565b506121946040820182612147565b6121a160408401826111e4565b506121af60608201826116c3565b610aa260608401826114e8565b608081016111be8284612157565b60018152602081017f760000000000000000000000000000000000000000000000000000000000000081529050611233565b6040808252810161220c816121ca565b90506111be60208301846111e4565b61222581836111e4565b602001919050565b60338152602081017f496e76616c696420696e636c7573696f6e2070726f6f6620666f722076616c7581527f65207472616e73666572206d6573736167652e0000000000000000000000000060208201529050611880565b602080825281016111be8161222d565b60258152602081017f526f6f7420616c726561647920616464656420746f20746865206d657373616781527f652062757300000000000000000000000000000000000000000000000000000060208201529050611880565b602080825281016111be81612295565b60006020828403121561231257612312600080fd5b6111bb83836116b8565b60006020828403121561233157612331600080fd5b6111bb8383611460565b60018152602081017f6d0000000000000000000000000000000000000000000000000000000000000081529050611233565b6040808252810161220c8161233b565b60308152602081017f496e76616c696420696e636c7573696f6e2070726f6f6620666f722063726f7381527f7320636861696e206d6573736167652e0000000000000000000000000000000060208201529050611880565b602080825281016111be8161237d565b67ffffffffffffffff9182169190811690828201908111156111be576111be611980565b634e487b7160e01b600052603260045260246000fdfea26469706673582212209046d260e6ecf02a9b545045265133ebd2a8a091d8cfe42d8fe9829451348f2664736f6c634300081c0033a26469706673582212204fc49cf676f6209e494e1c7c64ce47f23c42b2af6d8db63f5317b2767b5e28b164736f6c634300081c0033",
 }
 
@@ -452,6 +452,37 @@ func (_ManagementContract *ManagementContractCallerSession) IsWithdrawalAvailabl
 	return _ManagementContract.Contract.IsWithdrawalAvailable(&_ManagementContract.CallOpts)
 }
 
+// MAXFORCEDTXSIZE is a free data retrieval call binding the contract method 0x4ab08a67.
+//
+// Solidity: function MAX_FORCED_TX_SIZE() view returns(uint256)
+func (_ManagementContract *ManagementContractCaller) MAXFORCEDTXSIZE(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ManagementContract.contract.Call(opts, &out, "MAX_FORCED_TX_SIZE")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MAXFORCEDTXSIZE is a free data retrieval call binding the contract method 0x4ab08a67.
+//
+// Solidity: function MAX_FORCED_TX_SIZE() view returns(uint256)
+func (_ManagementContract *ManagementContractSession) MAXFORCEDTXSIZE() (*big.Int, error) {
+	return _ManagementContract.Contract.MAXFORCEDTXSIZE(&_ManagementContract.CallOpts)
+}
+
+// MAXFORCEDTXSIZE is a free data retrieval call binding the contract method 0x4ab08a67.
+//
+// Solidity: function MAX_FORCED_TX_SIZE() view returns(uint256)
+func (_ManagementContract *ManagementContractCallerSession) MAXFORCEDTXSIZE() (*big.Int, error) {
+	return _ManagementContract.Contract.MAXFORCEDTXSIZE(&_ManagementContract.CallOpts)
+}
+
 // ImportantContractAddresses is a free data retrieval call binding the contract method 0x3e60a22f.
 //
 // Solidity: function importantContractAddresses(string ) view returns(address)
@@ -576,6 +607,37 @@ func (_ManagementContract *ManagementContractCallerSession) IsBundleSaved(arg0 [
 	return _ManagementContract.Contract.IsBundleSaved(&_ManagementContract.CallOpts, arg0)
 }
 
+// IsFraudReported is a free data retrieval call binding the contract method 0x1e7124c9.
+//
+// Solidity: function isFraudReported(bytes32 ) view returns(bool)
+func (_ManagementContract *ManagementContractCaller) IsFraudReported(opts *bind.CallOpts, arg0 [32]byte) (bool, error) {
+	var out []interface{}
+	err := _ManagementContract.contract.Call(opts, &out, "isFraudReported", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsFraudReported is a free data retrieval call binding the contract method 0x1e7124c9.
+//
+// Solidity: function isFraudReported(bytes32 ) view returns(bool)
+func (_ManagementContract *ManagementContractSession) IsFraudReported(arg0 [32]byte) (bool, error) {
+	return _ManagementContract.Contract.IsFraudReported(&_ManagementContract.CallOpts, arg0)
+}
+
+// IsFraudReported is a free data retrieval call binding the contract method 0x1e7124c9.
+//
+// Solidity: function isFraudReported(bytes32 ) view returns(bool)
+func (_ManagementContract *ManagementContractCallerSession) IsFraudReported(arg0 [32]byte) (bool, error) {
+	return _ManagementContract.Contract.IsFraudReported(&_ManagementContract.CallOpts, arg0)
+}
+
 // IsWithdrawalSpent is a free data retrieval call binding the contract method 0x2f0cb9e3.
 //
 // Solidity: function isWithdrawalSpent(bytes32 ) view returns(bool)
@@ -731,6 +793,37 @@ func (_ManagementContract *ManagementContractCallerSession) MessageBus() (common
 	return _ManagementContract.Contract.MessageBus(&_ManagementContract.CallOpts)
 }
 
+// NetworkSecretEpoch is a free data retrieval call binding the contract method 0x888e487e.
+//
+// Solidity: function networkSecretEpoch() view returns(uint256)
+func (_ManagementContract *ManagementContractCaller) NetworkSecretEpoch(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ManagementContract.contract.Call(opts, &out, "networkSecretEpoch")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NetworkSecretEpoch is a free data retrieval call binding the contract method 0x888e487e.
+//
+// Solidity: function networkSecretEpoch() view returns(uint256)
+func (_ManagementContract *ManagementContractSession) NetworkSecretEpoch() (*big.Int, error) {
+	return _ManagementContract.Contract.NetworkSecretEpoch(&_ManagementContract.CallOpts)
+}
+
+// NetworkSecretEpoch is a free data retrieval call binding the contract method 0x888e487e.
+//
+// Solidity: function networkSecretEpoch() view returns(uint256)
+func (_ManagementContract *ManagementContractCallerSession) NetworkSecretEpoch() (*big.Int, error) {
+	return _ManagementContract.Contract.NetworkSecretEpoch(&_ManagementContract.CallOpts)
+}
+
 // Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
 //
 // Solidity: function owner() view returns(address)
@@ -762,6 +855,58 @@ func (_ManagementContract *ManagementContractCallerSession) Owner() (common.Addr
 	return _ManagementContract.Contract.Owner(&_ManagementContract.CallOpts)
 }
 
+// RequestedSecretEpoch is a free data retrieval call binding the contract method 0xc75537e0.
+//
+// Solidity: function requestedSecretEpoch() view returns(uint256)
+func (_ManagementContract *ManagementContractCaller) RequestedSecretEpoch(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ManagementContract.contract.Call(opts, &out, "requestedSecretEpoch")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// RequestedSecretEpoch is a free data retrieval call binding the contract method 0xc75537e0.
+//
+// Solidity: function requestedSecretEpoch() view returns(uint256)
+func (_ManagementContract *ManagementContractSession) RequestedSecretEpoch() (*big.Int, error) {
+	return _ManagementContract.Contract.RequestedSecretEpoch(&_ManagementContract.CallOpts)
+}
+
+// RequestedSecretEpoch is a free data retrieval call binding the contract method 0xc75537e0.
+//
+// Solidity: function requestedSecretEpoch() view returns(uint256)
+func (_ManagementContract *ManagementContractCallerSession) RequestedSecretEpoch() (*big.Int, error) {
+	return _ManagementContract.Contract.RequestedSecretEpoch(&_ManagementContract.CallOpts)
+}
+
+// AddCalldataRollup is a paid mutator transaction binding the contract method 0x4ee63dbf.
+//
+// Solidity: function AddCalldataRollup((bytes32,bytes,uint256) r, ((address,uint64,uint32,uint32,bytes,uint8)[]) , bytes ) returns()
+func (_ManagementContract *ManagementContractTransactor) AddCalldataRollup(opts *bind.TransactOpts, r StructsMetaRollup, arg1 StructsHeaderCrossChainData, arg2 []byte) (*types.Transaction, error) {
+	return _ManagementContract.contract.Transact(opts, "AddCalldataRollup", r, arg1, arg2)
+}
+
+// AddCalldataRollup is a paid mutator transaction binding the contract method 0x4ee63dbf.
+//
+// Solidity: function AddCalldataRollup((bytes32,bytes,uint256) r, ((address,uint64,uint32,uint32,bytes,uint8)[]) , bytes ) returns()
+func (_ManagementContract *ManagementContractSession) AddCalldataRollup(r StructsMetaRollup, arg1 StructsHeaderCrossChainData, arg2 []byte) (*types.Transaction, error) {
+	return _ManagementContract.Contract.AddCalldataRollup(&_ManagementContract.TransactOpts, r, arg1, arg2)
+}
+
+// AddCalldataRollup is a paid mutator transaction binding the contract method 0x4ee63dbf.
+//
+// Solidity: function AddCalldataRollup((bytes32,bytes,uint256) r, ((address,uint64,uint32,uint32,bytes,uint8)[]) , bytes ) returns()
+func (_ManagementContract *ManagementContractTransactorSession) AddCalldataRollup(r StructsMetaRollup, arg1 StructsHeaderCrossChainData, arg2 []byte) (*types.Transaction, error) {
+	return _ManagementContract.Contract.AddCalldataRollup(&_ManagementContract.TransactOpts, r, arg1, arg2)
+}
+
 // AddRollup is a paid mutator transaction binding the contract method 0xa25eb31c.
 //
 // Solidity: function AddRollup((bytes32,bytes,uint256) r, ((address,uint64,uint32,uint32,bytes,uint8)[]) ) returns()
@@ -783,6 +928,27 @@ func (_ManagementContract *ManagementContractTransactorSession) AddRollup(r Stru
 	return _ManagementContract.Contract.AddRollup(&_ManagementContract.TransactOpts, r, arg1)
 }
 
+// DistributeNetworkSecret is a paid mutator transaction binding the contract method 0x5ec10d06.
+//
+// Solidity: function DistributeNetworkSecret(uint256 epoch, address attesterID, address[] enclaveIDs, bytes[] encryptedSecrets, bytes signature) returns()
+func (_ManagementContract *ManagementContractTransactor) DistributeNetworkSecret(opts *bind.TransactOpts, epoch *big.Int, attesterID common.Address, enclaveIDs []common.Address, encryptedSecrets [][]byte, signature []byte) (*types.Transaction, error) {
+	return _ManagementContract.contract.Transact(opts, "DistributeNetworkSecret", epoch, attesterID, enclaveIDs, encryptedSecrets, signature)
+}
+
+// DistributeNetworkSecret is a paid mutator transaction binding the contract method 0x5ec10d06.
+//
+// Solidity: function DistributeNetworkSecret(uint256 epoch, address attesterID, address[] enclaveIDs, bytes[] encryptedSecrets, bytes signature) returns()
+func (_ManagementContract *ManagementContractSession) DistributeNetworkSecret(epoch *big.Int, attesterID common.Address, enclaveIDs []common.Address, encryptedSecrets [][]byte, signature []byte) (*types.Transaction, error) {
+	return _ManagementContract.Contract.DistributeNetworkSecret(&_ManagementContract.TransactOpts, epoch, attesterID, enclaveIDs, encryptedSecrets, signature)
+}
+
+// DistributeNetworkSecret is a paid mutator transaction binding the contract method 0x5ec10d06.
+//
+// Solidity: function DistributeNetworkSecret(uint256 epoch, address attesterID, address[] enclaveIDs, bytes[] encryptedSecrets, bytes signature) returns()
+func (_ManagementContract *ManagementContractTransactorSession) DistributeNetworkSecret(epoch *big.Int, attesterID common.Address, enclaveIDs []common.Address, encryptedSecrets [][]byte, signature []byte) (*types.Transaction, error) {
+	return _ManagementContract.Contract.DistributeNetworkSecret(&_ManagementContract.TransactOpts, epoch, attesterID, enclaveIDs, encryptedSecrets, signature)
+}
+
 // ExtractNativeValue is a paid mutator transaction binding the contract method 0x5371a216.
 //
 // Solidity: function ExtractNativeValue((address,address,uint256,uint64) _msg, bytes32[] proof, bytes32 root) returns()
@@ -846,6 +1012,27 @@ func (_ManagementContract *ManagementContractTransactorSession) InitializeNetwor
 	return _ManagementContract.Contract.InitializeNetworkSecret(&_ManagementContract.TransactOpts, _enclaveID, _initSecret, _genesisAttestation)
 }
 
+// ReportFraud is a paid mutator transaction binding the contract method 0x0b23cf35.
+//
+// Solidity: function ReportFraud(uint64 batchSeqNo, bytes32 batchHash, bytes32 expectedStateRoot, bytes32 receivedStateRoot, address sequencer, address validator, bytes signature) returns()
+func (_ManagementContract *ManagementContractTransactor) ReportFraud(opts *bind.TransactOpts, batchSeqNo uint64, batchHash [32]byte, expectedStateRoot [32]byte, receivedStateRoot [32]byte, sequencer common.Address, validator common.Address, signature []byte) (*types.Transaction, error) {
+	return _ManagementContract.contract.Transact(opts, "ReportFraud", batchSeqNo, batchHash, expectedStateRoot, receivedStateRoot, sequencer, validator, signature)
+}
+
+// ReportFraud is a paid mutator transaction binding the contract method 0x0b23cf35.
+//
+// Solidity: function ReportFraud(uint64 batchSeqNo, bytes32 batchHash, bytes32 expectedStateRoot, bytes32 receivedStateRoot, address sequencer, address validator, bytes signature) returns()
+func (_ManagementContract *ManagementContractSession) ReportFraud(batchSeqNo uint64, batchHash [32]byte, expectedStateRoot [32]byte, receivedStateRoot [32]byte, sequencer common.Address, validator common.Address, signature []byte) (*types.Transaction, error) {
+	return _ManagementContract.Contract.ReportFraud(&_ManagementContract.TransactOpts, batchSeqNo, batchHash, expectedStateRoot, receivedStateRoot, sequencer, validator, signature)
+}
+
+// ReportFraud is a paid mutator transaction binding the contract method 0x0b23cf35.
+//
+// Solidity: function ReportFraud(uint64 batchSeqNo, bytes32 batchHash, bytes32 expectedStateRoot, bytes32 receivedStateRoot, address sequencer, address validator, bytes signature) returns()
+func (_ManagementContract *ManagementContractTransactorSession) ReportFraud(batchSeqNo uint64, batchHash [32]byte, expectedStateRoot [32]byte, receivedStateRoot [32]byte, sequencer common.Address, validator common.Address, signature []byte) (*types.Transaction, error) {
+	return _ManagementContract.Contract.ReportFraud(&_ManagementContract.TransactOpts, batchSeqNo, batchHash, expectedStateRoot, receivedStateRoot, sequencer, validator, signature)
+}
+
 // RequestNetworkSecret is a paid mutator transaction binding the contract method 0xe34fbfc8.
 //
 // Solidity: function RequestNetworkSecret(string requestReport) returns()
@@ -930,6 +1117,27 @@ func (_ManagementContract *ManagementContractTransactorSession) RevokeSequencerE
 	return _ManagementContract.Contract.RevokeSequencerEnclave(&_ManagementContract.TransactOpts, _addr)
 }
 
+// RotateNetworkSecret is a paid mutator transaction binding the contract method 0xbea26307.
+//
+// Solidity: function RotateNetworkSecret() returns()
+func (_ManagementContract *ManagementContractTransactor) RotateNetworkSecret(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ManagementContract.contract.Transact(opts, "RotateNetworkSecret")
+}
+
+// RotateNetworkSecret is a paid mutator transaction binding the contract method 0xbea26307.
+//
+// Solidity: function RotateNetworkSecret() returns()
+func (_ManagementContract *ManagementContractSession) RotateNetworkSecret() (*types.Transaction, error) {
+	return _ManagementContract.Contract.RotateNetworkSecret(&_ManagementContract.TransactOpts)
+}
+
+// RotateNetworkSecret is a paid mutator transaction binding the contract method 0xbea26307.
+//
+// Solidity: function RotateNetworkSecret() returns()
+func (_ManagementContract *ManagementContractTransactorSession) RotateNetworkSecret() (*types.Transaction, error) {
+	return _ManagementContract.Contract.RotateNetworkSecret(&_ManagementContract.TransactOpts)
+}
+
 // SetImportantContractAddress is a paid mutator transaction binding the contract method 0x03e72e48.
 //
 // Solidity: function SetImportantContractAddress(string key, address newAddress) returns()
@@ -951,6 +1159,27 @@ func (_ManagementContract *ManagementContractTransactorSession) SetImportantCont
 	return _ManagementContract.Contract.SetImportantContractAddress(&_ManagementContract.TransactOpts, key, newAddress)
 }
 
+// SubmitForcedTransaction is a paid mutator transaction binding the contract method 0x9d8e0811.
+//
+// Solidity: function SubmitForcedTransaction(bytes encryptedTx) returns()
+func (_ManagementContract *ManagementContractTransactor) SubmitForcedTransaction(opts *bind.TransactOpts, encryptedTx []byte) (*types.Transaction, error) {
+	return _ManagementContract.contract.Transact(opts, "SubmitForcedTransaction", encryptedTx)
+}
+
+// SubmitForcedTransaction is a paid mutator transaction binding the contract method 0x9d8e0811.
+//
+// Solidity: function SubmitForcedTransaction(bytes encryptedTx) returns()
+func (_ManagementContract *ManagementContractSession) SubmitForcedTransaction(encryptedTx []byte) (*types.Transaction, error) {
+	return _ManagementContract.Contract.SubmitForcedTransaction(&_ManagementContract.TransactOpts, encryptedTx)
+}
+
+// SubmitForcedTransaction is a paid mutator transaction binding the contract method 0x9d8e0811.
+//
+// Solidity: function SubmitForcedTransaction(bytes encryptedTx) returns()
+func (_ManagementContract *ManagementContractTransactorSession) SubmitForcedTransaction(encryptedTx []byte) (*types.Transaction, error) {
+	return _ManagementContract.Contract.SubmitForcedTransaction(&_ManagementContract.TransactOpts, encryptedTx)
+}
+
 // AddCrossChainMessagesRoot is a paid mutator transaction binding the contract method 0x073b6ef3.
 //
 // Solidity: function addCrossChainMessagesRoot(bytes32 _lastBatchHash, bytes32 blockHash, uint256 blockNum, bytes[] crossChainHashes, bytes signature, uint256 rollupNumber, bytes32 forkID) returns()
@@ -1035,9 +1264,9 @@ func (_ManagementContract *ManagementContractTransactorSession) TransferOwnershi
 	return _ManagementContract.Contract.TransferOwnership(&_ManagementContract.TransactOpts, newOwner)
 }
 
-// ManagementContractImportantContractAddressUpdatedIterator is returned from FilterImportantContractAddressUpdated and is used to iterate over the raw logs and unpacked data for ImportantContractAddressUpdated events raised by the ManagementContract contract.
-type ManagementContractImportantContractAddressUpdatedIterator struct {
-	Event *ManagementContractImportantContractAddressUpdated // Event containing the contract specifics and raw log
+// ManagementContractForcedTransactionSubmittedIterator is returned from FilterForcedTransactionSubmitted and is used to iterate over the raw logs and unpacked data for ForcedTransactionSubmitted events raised by the ManagementContract contract.
+type ManagementContractForcedTransactionSubmittedIterator struct {
+	Event *ManagementContractForcedTransactionSubmitted // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1051,7 +1280,7 @@ type ManagementContractImportantContractAddressUpdatedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ManagementContractImportantContractAddressUpdatedIterator) Next() bool {
+func (it *ManagementContractForcedTransactionSubmittedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1060,7 +1289,7 @@ func (it *ManagementContractImportantContractAddressUpdatedIterator) Next() bool
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ManagementContractImportantContractAddressUpdated)
+			it.Event = new(ManagementContractForcedTransactionSubmitted)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1075,7 +1304,7 @@ func (it *ManagementContractImportantContractAddressUpdatedIterator) Next() bool
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ManagementContractImportantContractAddressUpdated)
+		it.Event = new(ManagementContractForcedTransactionSubmitted)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1091,42 +1320,52 @@ func (it *ManagementContractImportantContractAddressUpdatedIterator) Next() bool
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ManagementContractImportantContractAddressUpdatedIterator) Error() error {
+func (it *ManagementContractForcedTransactionSubmittedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ManagementContractImportantContractAddressUpdatedIterator) Close() error {
+func (it *ManagementContractForcedTransactionSubmittedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ManagementContractImportantContractAddressUpdated represents a ImportantContractAddressUpdated event raised by the ManagementContract contract.
-type ManagementContractImportantContractAddressUpdated struct {
-	Key        string
-	NewAddress common.Address
-	Raw        types.Log // Blockchain specific contextual infos
+// ManagementContractForcedTransactionSubmitted represents a ForcedTransactionSubmitted event raised by the ManagementContract contract.
+type ManagementContractForcedTransactionSubmitted struct {
+	Sender      common.Address
+	EncryptedTx []byte
+	Raw         types.Log // Blockchain specific contextual infos
 }
 
-// FilterImportantContractAddressUpdated is a free log retrieval operation binding the contract event 0x17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5.
+// FilterForcedTransactionSubmitted is a free log retrieval operation binding the contract event 0xf854b322df5c47e5666fd281aa8679c6d3e977719abd54770e6ff0cfd6093efd.
 //
-// Solidity: event ImportantContractAddressUpdated(string key, address newAddress)
-func (_ManagementContract *ManagementContractFilterer) FilterImportantContractAddressUpdated(opts *bind.FilterOpts) (*ManagementContractImportantContractAddressUpdatedIterator, error) {
+// Solidity: event ForcedTransactionSubmitted(address indexed sender, bytes encryptedTx)
+func (_ManagementContract *ManagementContractFilterer) FilterForcedTransactionSubmitted(opts *bind.FilterOpts, sender []common.Address) (*ManagementContractForcedTransactionSubmittedIterator, error) {
 
-	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "ImportantContractAddressUpdated")
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "ForcedTransactionSubmitted", senderRule)
 	if err != nil {
 		return nil, err
 	}
-	return &ManagementContractImportantContractAddressUpdatedIterator{contract: _ManagementContract.contract, event: "ImportantContractAddressUpdated", logs: logs, sub: sub}, nil
+	return &ManagementContractForcedTransactionSubmittedIterator{contract: _ManagementContract.contract, event: "ForcedTransactionSubmitted", logs: logs, sub: sub}, nil
 }
 
-// WatchImportantContractAddressUpdated is a free log subscription operation binding the contract event 0x17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5.
+// WatchForcedTransactionSubmitted is a free log subscription operation binding the contract event 0xf854b322df5c47e5666fd281aa8679c6d3e977719abd54770e6ff0cfd6093efd.
 //
-// Solidity: event ImportantContractAddressUpdated(string key, address newAddress)
-func (_ManagementContract *ManagementContractFilterer) WatchImportantContractAddressUpdated(opts *bind.WatchOpts, sink chan<- *ManagementContractImportantContractAddressUpdated) (event.Subscription, error) {
+// Solidity: event ForcedTransactionSubmitted(address indexed sender, bytes encryptedTx)
+func (_ManagementContract *ManagementContractFilterer) WatchForcedTransactionSubmitted(opts *bind.WatchOpts, sink chan<- *ManagementContractForcedTransactionSubmitted, sender []common.Address) (event.Subscription, error) {
 
-	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "ImportantContractAddressUpdated")
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "ForcedTransactionSubmitted", senderRule)
 	if err != nil {
 		return nil, err
 	}
@@ -1136,8 +1375,8 @@ func (_ManagementContract *ManagementContractFilterer) WatchImportantContractAdd
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ManagementContractImportantContractAddressUpdated)
-				if err := _ManagementContract.contract.UnpackLog(event, "ImportantContractAddressUpdated", log); err != nil {
+				event := new(ManagementContractForcedTransactionSubmitted)
+				if err := _ManagementContract.contract.UnpackLog(event, "ForcedTransactionSubmitted", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1158,21 +1397,21 @@ func (_ManagementContract *ManagementContractFilterer) WatchImportantContractAdd
 	}), nil
 }
 
-// ParseImportantContractAddressUpdated is a log parse operation binding the contract event 0x17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5.
+// ParseForcedTransactionSubmitted is a log parse operation binding the contract event 0xf854b322df5c47e5666fd281aa8679c6d3e977719abd54770e6ff0cfd6093efd.
 //
-// Solidity: event ImportantContractAddressUpdated(string key, address newAddress)
-func (_ManagementContract *ManagementContractFilterer) ParseImportantContractAddressUpdated(log types.Log) (*ManagementContractImportantContractAddressUpdated, error) {
-	event := new(ManagementContractImportantContractAddressUpdated)
-	if err := _ManagementContract.contract.UnpackLog(event, "ImportantContractAddressUpdated", log); err != nil {
+// Solidity: event ForcedTransactionSubmitted(address indexed sender, bytes encryptedTx)
+func (_ManagementContract *ManagementContractFilterer) ParseForcedTransactionSubmitted(log types.Log) (*ManagementContractForcedTransactionSubmitted, error) {
+	event := new(ManagementContractForcedTransactionSubmitted)
+	if err := _ManagementContract.contract.UnpackLog(event, "ForcedTransactionSubmitted", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// ManagementContractInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the ManagementContract contract.
-type ManagementContractInitializedIterator struct {
-	Event *ManagementContractInitialized // Event containing the contract specifics and raw log
+// ManagementContractFraudReportedIterator is returned from FilterFraudReported and is used to iterate over the raw logs and unpacked data for FraudReported events raised by the ManagementContract contract.
+type ManagementContractFraudReportedIterator struct {
+	Event *ManagementContractFraudReported // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1186,7 +1425,7 @@ type ManagementContractInitializedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ManagementContractInitializedIterator) Next() bool {
+func (it *ManagementContractFraudReportedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1195,7 +1434,7 @@ func (it *ManagementContractInitializedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ManagementContractInitialized)
+			it.Event = new(ManagementContractFraudReported)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1210,7 +1449,7 @@ func (it *ManagementContractInitializedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ManagementContractInitialized)
+		it.Event = new(ManagementContractFraudReported)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1226,41 +1465,64 @@ func (it *ManagementContractInitializedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ManagementContractInitializedIterator) Error() error {
+func (it *ManagementContractFraudReportedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ManagementContractInitializedIterator) Close() error {
+func (it *ManagementContractFraudReportedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ManagementContractInitialized represents a Initialized event raised by the ManagementContract contract.
-type ManagementContractInitialized struct {
-	Version uint64
-	Raw     types.Log // Blockchain specific contextual infos
+// ManagementContractFraudReported represents a FraudReported event raised by the ManagementContract contract.
+type ManagementContractFraudReported struct {
+	Sequencer         common.Address
+	Validator         common.Address
+	BatchSeqNo        uint64
+	BatchHash         [32]byte
+	ExpectedStateRoot [32]byte
+	ReceivedStateRoot [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
 }
 
-// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+// FilterFraudReported is a free log retrieval operation binding the contract event 0xffc80593e90c3cdb347fc7a5ff6aeb337a1e2982421898747bcd1b568c277b0d.
 //
-// Solidity: event Initialized(uint64 version)
-func (_ManagementContract *ManagementContractFilterer) FilterInitialized(opts *bind.FilterOpts) (*ManagementContractInitializedIterator, error) {
+// Solidity: event FraudReported(address indexed sequencer, address indexed validator, uint64 batchSeqNo, bytes32 batchHash, bytes32 expectedStateRoot, bytes32 receivedStateRoot)
+func (_ManagementContract *ManagementContractFilterer) FilterFraudReported(opts *bind.FilterOpts, sequencer []common.Address, validator []common.Address) (*ManagementContractFraudReportedIterator, error) {
 
-	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "Initialized")
+	var sequencerRule []interface{}
+	for _, sequencerItem := range sequencer {
+		sequencerRule = append(sequencerRule, sequencerItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "FraudReported", sequencerRule, validatorRule)
 	if err != nil {
 		return nil, err
 	}
-	return &ManagementContractInitializedIterator{contract: _ManagementContract.contract, event: "Initialized", logs: logs, sub: sub}, nil
+	return &ManagementContractFraudReportedIterator{contract: _ManagementContract.contract, event: "FraudReported", logs: logs, sub: sub}, nil
 }
 
-// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+// WatchFraudReported is a free log subscription operation binding the contract event 0xffc80593e90c3cdb347fc7a5ff6aeb337a1e2982421898747bcd1b568c277b0d.
 //
-// Solidity: event Initialized(uint64 version)
-func (_ManagementContract *ManagementContractFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *ManagementContractInitialized) (event.Subscription, error) {
+// Solidity: event FraudReported(address indexed sequencer, address indexed validator, uint64 batchSeqNo, bytes32 batchHash, bytes32 expectedStateRoot, bytes32 receivedStateRoot)
+func (_ManagementContract *ManagementContractFilterer) WatchFraudReported(opts *bind.WatchOpts, sink chan<- *ManagementContractFraudReported, sequencer []common.Address, validator []common.Address) (event.Subscription, error) {
 
-	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "Initialized")
+	var sequencerRule []interface{}
+	for _, sequencerItem := range sequencer {
+		sequencerRule = append(sequencerRule, sequencerItem)
+	}
+	var validatorRule []interface{}
+	for _, validatorItem := range validator {
+		validatorRule = append(validatorRule, validatorItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "FraudReported", sequencerRule, validatorRule)
 	if err != nil {
 		return nil, err
 	}
@@ -1270,8 +1532,8 @@ func (_ManagementContract *ManagementContractFilterer) WatchInitialized(opts *bi
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ManagementContractInitialized)
-				if err := _ManagementContract.contract.UnpackLog(event, "Initialized", log); err != nil {
+				event := new(ManagementContractFraudReported)
+				if err := _ManagementContract.contract.UnpackLog(event, "FraudReported", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1292,21 +1554,21 @@ func (_ManagementContract *ManagementContractFilterer) WatchInitialized(opts *bi
 	}), nil
 }
 
-// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+// ParseFraudReported is a log parse operation binding the contract event 0xffc80593e90c3cdb347fc7a5ff6aeb337a1e2982421898747bcd1b568c277b0d.
 //
-// Solidity: event Initialized(uint64 version)
-func (_ManagementContract *ManagementContractFilterer) ParseInitialized(log types.Log) (*ManagementContractInitialized, error) {
-	event := new(ManagementContractInitialized)
-	if err := _ManagementContract.contract.UnpackLog(event, "Initialized", log); err != nil {
+// Solidity: event FraudReported(address indexed sequencer, address indexed validator, uint64 batchSeqNo, bytes32 batchHash, bytes32 expectedStateRoot, bytes32 receivedStateRoot)
+func (_ManagementContract *ManagementContractFilterer) ParseFraudReported(log types.Log) (*ManagementContractFraudReported, error) {
+	event := new(ManagementContractFraudReported)
+	if err := _ManagementContract.contract.UnpackLog(event, "FraudReported", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// ManagementContractLogManagementContractCreatedIterator is returned from FilterLogManagementContractCreated and is used to iterate over the raw logs and unpacked data for LogManagementContractCreated events raised by the ManagementContract contract.
-type ManagementContractLogManagementContractCreatedIterator struct {
-	Event *ManagementContractLogManagementContractCreated // Event containing the contract specifics and raw log
+// ManagementContractImportantContractAddressUpdatedIterator is returned from FilterImportantContractAddressUpdated and is used to iterate over the raw logs and unpacked data for ImportantContractAddressUpdated events raised by the ManagementContract contract.
+type ManagementContractImportantContractAddressUpdatedIterator struct {
+	Event *ManagementContractImportantContractAddressUpdated // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1320,7 +1582,7 @@ type ManagementContractLogManagementContractCreatedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ManagementContractLogManagementContractCreatedIterator) Next() bool {
+func (it *ManagementContractImportantContractAddressUpdatedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1329,7 +1591,7 @@ func (it *ManagementContractLogManagementContractCreatedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ManagementContractLogManagementContractCreated)
+			it.Event = new(ManagementContractImportantContractAddressUpdated)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1344,7 +1606,7 @@ func (it *ManagementContractLogManagementContractCreatedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ManagementContractLogManagementContractCreated)
+		it.Event = new(ManagementContractImportantContractAddressUpdated)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1360,41 +1622,42 @@ func (it *ManagementContractLogManagementContractCreatedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ManagementContractLogManagementContractCreatedIterator) Error() error {
+func (it *ManagementContractImportantContractAddressUpdatedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ManagementContractLogManagementContractCreatedIterator) Close() error {
+func (it *ManagementContractImportantContractAddressUpdatedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ManagementContractLogManagementContractCreated represents a LogManagementContractCreated event raised by the ManagementContract contract.
-type ManagementContractLogManagementContractCreated struct {
-	MessageBusAddress common.Address
-	Raw               types.Log // Blockchain specific contextual infos
+// ManagementContractImportantContractAddressUpdated represents a ImportantContractAddressUpdated event raised by the ManagementContract contract.
+type ManagementContractImportantContractAddressUpdated struct {
+	Key        string
+	NewAddress common.Address
+	Raw        types.Log // Blockchain specific contextual infos
 }
 
-// FilterLogManagementContractCreated is a free log retrieval operation binding the contract event 0xbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf.
+// FilterImportantContractAddressUpdated is a free log retrieval operation binding the contract event 0x17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5.
 //
-// Solidity: event LogManagementContractCreated(address messageBusAddress)
-func (_ManagementContract *ManagementContractFilterer) FilterLogManagementContractCreated(opts *bind.FilterOpts) (*ManagementContractLogManagementContractCreatedIterator, error) {
+// Solidity: event ImportantContractAddressUpdated(string key, address newAddress)
+func (_ManagementContract *ManagementContractFilterer) FilterImportantContractAddressUpdated(opts *bind.FilterOpts) (*ManagementContractImportantContractAddressUpdatedIterator, error) {
 
-	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "LogManagementContractCreated")
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "ImportantContractAddressUpdated")
 	if err != nil {
 		return nil, err
 	}
-	return &ManagementContractLogManagementContractCreatedIterator{contract: _ManagementContract.contract, event: "LogManagementContractCreated", logs: logs, sub: sub}, nil
+	return &ManagementContractImportantContractAddressUpdatedIterator{contract: _ManagementContract.contract, event: "ImportantContractAddressUpdated", logs: logs, sub: sub}, nil
 }
 
-// WatchLogManagementContractCreated is a free log subscription operation binding the contract event 0xbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf.
+// WatchImportantContractAddressUpdated is a free log subscription operation binding the contract event 0x17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5.
 //
-// Solidity: event LogManagementContractCreated(address messageBusAddress)
-func (_ManagementContract *ManagementContractFilterer) WatchLogManagementContractCreated(opts *bind.WatchOpts, sink chan<- *ManagementContractLogManagementContractCreated) (event.Subscription, error) {
+// Solidity: event ImportantContractAddressUpdated(string key, address newAddress)
+func (_ManagementContract *ManagementContractFilterer) WatchImportantContractAddressUpdated(opts *bind.WatchOpts, sink chan<- *ManagementContractImportantContractAddressUpdated) (event.Subscription, error) {
 
-	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "LogManagementContractCreated")
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "ImportantContractAddressUpdated")
 	if err != nil {
 		return nil, err
 	}
@@ -1404,8 +1667,8 @@ func (_ManagementContract *ManagementContractFilterer) WatchLogManagementContrac
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(ManagementContractLogManagementContractCreated)
-				if err := _ManagementContract.contract.UnpackLog(event, "LogManagementContractCreated", log); err != nil {
+				event := new(ManagementContractImportantContractAddressUpdated)
+				if err := _ManagementContract.contract.UnpackLog(event, "ImportantContractAddressUpdated", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1426,21 +1689,21 @@ func (_ManagementContract *ManagementContractFilterer) WatchLogManagementContrac
 	}), nil
 }
 
-// ParseLogManagementContractCreated is a log parse operation binding the contract event 0xbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf.
+// ParseImportantContractAddressUpdated is a log parse operation binding the contract event 0x17b2f9f5748931099ffee882b5b64f4a560b5c55da9b4f4e396dae3bb9f98cb5.
 //
-// Solidity: event LogManagementContractCreated(address messageBusAddress)
-func (_ManagementContract *ManagementContractFilterer) ParseLogManagementContractCreated(log types.Log) (*ManagementContractLogManagementContractCreated, error) {
-	event := new(ManagementContractLogManagementContractCreated)
-	if err := _ManagementContract.contract.UnpackLog(event, "LogManagementContractCreated", log); err != nil {
+// Solidity: event ImportantContractAddressUpdated(string key, address newAddress)
+func (_ManagementContract *ManagementContractFilterer) ParseImportantContractAddressUpdated(log types.Log) (*ManagementContractImportantContractAddressUpdated, error) {
+	event := new(ManagementContractImportantContractAddressUpdated)
+	if err := _ManagementContract.contract.UnpackLog(event, "ImportantContractAddressUpdated", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// ManagementContractNetworkSecretRequestedIterator is returned from FilterNetworkSecretRequested and is used to iterate over the raw logs and unpacked data for NetworkSecretRequested events raised by the ManagementContract contract.
-type ManagementContractNetworkSecretRequestedIterator struct {
-	Event *ManagementContractNetworkSecretRequested // Event containing the contract specifics and raw log
+// ManagementContractInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the ManagementContract contract.
+type ManagementContractInitializedIterator struct {
+	Event *ManagementContractInitialized // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1454,7 +1717,7 @@ type ManagementContractNetworkSecretRequestedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ManagementContractNetworkSecretRequestedIterator) Next() bool {
+func (it *ManagementContractInitializedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1463,7 +1726,275 @@ func (it *ManagementContractNetworkSecretRequestedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ManagementContractNetworkSecretRequested)
+			it.Event = new(ManagementContractInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractInitialized represents a Initialized event raised by the ManagementContract contract.
+type ManagementContractInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_ManagementContract *ManagementContractFilterer) FilterInitialized(opts *bind.FilterOpts) (*ManagementContractInitializedIterator, error) {
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractInitializedIterator{contract: _ManagementContract.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_ManagementContract *ManagementContractFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *ManagementContractInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractInitialized)
+				if err := _ManagementContract.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_ManagementContract *ManagementContractFilterer) ParseInitialized(log types.Log) (*ManagementContractInitialized, error) {
+	event := new(ManagementContractInitialized)
+	if err := _ManagementContract.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagementContractLogManagementContractCreatedIterator is returned from FilterLogManagementContractCreated and is used to iterate over the raw logs and unpacked data for LogManagementContractCreated events raised by the ManagementContract contract.
+type ManagementContractLogManagementContractCreatedIterator struct {
+	Event *ManagementContractLogManagementContractCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractLogManagementContractCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractLogManagementContractCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractLogManagementContractCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractLogManagementContractCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractLogManagementContractCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractLogManagementContractCreated represents a LogManagementContractCreated event raised by the ManagementContract contract.
+type ManagementContractLogManagementContractCreated struct {
+	MessageBusAddress common.Address
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterLogManagementContractCreated is a free log retrieval operation binding the contract event 0xbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf.
+//
+// Solidity: event LogManagementContractCreated(address messageBusAddress)
+func (_ManagementContract *ManagementContractFilterer) FilterLogManagementContractCreated(opts *bind.FilterOpts) (*ManagementContractLogManagementContractCreatedIterator, error) {
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "LogManagementContractCreated")
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractLogManagementContractCreatedIterator{contract: _ManagementContract.contract, event: "LogManagementContractCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchLogManagementContractCreated is a free log subscription operation binding the contract event 0xbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf.
+//
+// Solidity: event LogManagementContractCreated(address messageBusAddress)
+func (_ManagementContract *ManagementContractFilterer) WatchLogManagementContractCreated(opts *bind.WatchOpts, sink chan<- *ManagementContractLogManagementContractCreated) (event.Subscription, error) {
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "LogManagementContractCreated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractLogManagementContractCreated)
+				if err := _ManagementContract.contract.UnpackLog(event, "LogManagementContractCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLogManagementContractCreated is a log parse operation binding the contract event 0xbd726cf82ac9c3260b1495107182e336e0654b25c10915648c0cc15b2bb72cbf.
+//
+// Solidity: event LogManagementContractCreated(address messageBusAddress)
+func (_ManagementContract *ManagementContractFilterer) ParseLogManagementContractCreated(log types.Log) (*ManagementContractLogManagementContractCreated, error) {
+	event := new(ManagementContractLogManagementContractCreated)
+	if err := _ManagementContract.contract.UnpackLog(event, "LogManagementContractCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagementContractNetworkSecretRequestedIterator is returned from FilterNetworkSecretRequested and is used to iterate over the raw logs and unpacked data for NetworkSecretRequested events raised by the ManagementContract contract.
+type ManagementContractNetworkSecretRequestedIterator struct {
+	Event *ManagementContractNetworkSecretRequested // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractNetworkSecretRequestedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractNetworkSecretRequested)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1736,6 +2267,303 @@ func (_ManagementContract *ManagementContractFilterer) ParseNetworkSecretRespond
 	return event, nil
 }
 
+// ManagementContractNetworkSecretRotatedIterator is returned from FilterNetworkSecretRotated and is used to iterate over the raw logs and unpacked data for NetworkSecretRotated events raised by the ManagementContract contract.
+type ManagementContractNetworkSecretRotatedIterator struct {
+	Event *ManagementContractNetworkSecretRotated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractNetworkSecretRotatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractNetworkSecretRotated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractNetworkSecretRotated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractNetworkSecretRotatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractNetworkSecretRotatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractNetworkSecretRotated represents a NetworkSecretRotated event raised by the ManagementContract contract.
+type ManagementContractNetworkSecretRotated struct {
+	Epoch    *big.Int
+	Attester common.Address
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterNetworkSecretRotated is a free log retrieval operation binding the contract event 0xe13b059f92bc1806d8cb98a7fc7f98d549a4bfc8ac8fc80f393e4d31f6b6112b.
+//
+// Solidity: event NetworkSecretRotated(uint256 indexed epoch, address indexed attester)
+func (_ManagementContract *ManagementContractFilterer) FilterNetworkSecretRotated(opts *bind.FilterOpts, epoch []*big.Int, attester []common.Address) (*ManagementContractNetworkSecretRotatedIterator, error) {
+
+	var epochRule []interface{}
+	for _, epochItem := range epoch {
+		epochRule = append(epochRule, epochItem)
+	}
+	var attesterRule []interface{}
+	for _, attesterItem := range attester {
+		attesterRule = append(attesterRule, attesterItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "NetworkSecretRotated", epochRule, attesterRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractNetworkSecretRotatedIterator{contract: _ManagementContract.contract, event: "NetworkSecretRotated", logs: logs, sub: sub}, nil
+}
+
+// WatchNetworkSecretRotated is a free log subscription operation binding the contract event 0xe13b059f92bc1806d8cb98a7fc7f98d549a4bfc8ac8fc80f393e4d31f6b6112b.
+//
+// Solidity: event NetworkSecretRotated(uint256 indexed epoch, address indexed attester)
+func (_ManagementContract *ManagementContractFilterer) WatchNetworkSecretRotated(opts *bind.WatchOpts, sink chan<- *ManagementContractNetworkSecretRotated, epoch []*big.Int, attester []common.Address) (event.Subscription, error) {
+
+	var epochRule []interface{}
+	for _, epochItem := range epoch {
+		epochRule = append(epochRule, epochItem)
+	}
+	var attesterRule []interface{}
+	for _, attesterItem := range attester {
+		attesterRule = append(attesterRule, attesterItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "NetworkSecretRotated", epochRule, attesterRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractNetworkSecretRotated)
+				if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretRotated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNetworkSecretRotated is a log parse operation binding the contract event 0xe13b059f92bc1806d8cb98a7fc7f98d549a4bfc8ac8fc80f393e4d31f6b6112b.
+//
+// Solidity: event NetworkSecretRotated(uint256 indexed epoch, address indexed attester)
+func (_ManagementContract *ManagementContractFilterer) ParseNetworkSecretRotated(log types.Log) (*ManagementContractNetworkSecretRotated, error) {
+	event := new(ManagementContractNetworkSecretRotated)
+	if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretRotated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ManagementContractNetworkSecretRotationRequestedIterator is returned from FilterNetworkSecretRotationRequested and is used to iterate over the raw logs and unpacked data for NetworkSecretRotationRequested events raised by the ManagementContract contract.
+type ManagementContractNetworkSecretRotationRequestedIterator struct {
+	Event *ManagementContractNetworkSecretRotationRequested // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ManagementContractNetworkSecretRotationRequestedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ManagementContractNetworkSecretRotationRequested)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ManagementContractNetworkSecretRotationRequested)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ManagementContractNetworkSecretRotationRequestedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ManagementContractNetworkSecretRotationRequestedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ManagementContractNetworkSecretRotationRequested represents a NetworkSecretRotationRequested event raised by the ManagementContract contract.
+type ManagementContractNetworkSecretRotationRequested struct {
+	Epoch *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterNetworkSecretRotationRequested is a free log retrieval operation binding the contract event 0xe96ea23102d73f3569bfbe9efd3075a479ef5fd53e5c11591d72423b285ee16e.
+//
+// Solidity: event NetworkSecretRotationRequested(uint256 indexed epoch)
+func (_ManagementContract *ManagementContractFilterer) FilterNetworkSecretRotationRequested(opts *bind.FilterOpts, epoch []*big.Int) (*ManagementContractNetworkSecretRotationRequestedIterator, error) {
+
+	var epochRule []interface{}
+	for _, epochItem := range epoch {
+		epochRule = append(epochRule, epochItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.FilterLogs(opts, "NetworkSecretRotationRequested", epochRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ManagementContractNetworkSecretRotationRequestedIterator{contract: _ManagementContract.contract, event: "NetworkSecretRotationRequested", logs: logs, sub: sub}, nil
+}
+
+// WatchNetworkSecretRotationRequested is a free log subscription operation binding the contract event 0xe96ea23102d73f3569bfbe9efd3075a479ef5fd53e5c11591d72423b285ee16e.
+//
+// Solidity: event NetworkSecretRotationRequested(uint256 indexed epoch)
+func (_ManagementContract *ManagementContractFilterer) WatchNetworkSecretRotationRequested(opts *bind.WatchOpts, sink chan<- *ManagementContractNetworkSecretRotationRequested, epoch []*big.Int) (event.Subscription, error) {
+
+	var epochRule []interface{}
+	for _, epochItem := range epoch {
+		epochRule = append(epochRule, epochItem)
+	}
+
+	logs, sub, err := _ManagementContract.contract.WatchLogs(opts, "NetworkSecretRotationRequested", epochRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ManagementContractNetworkSecretRotationRequested)
+				if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretRotationRequested", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNetworkSecretRotationRequested is a log parse operation binding the contract event 0xe96ea23102d73f3569bfbe9efd3075a479ef5fd53e5c11591d72423b285ee16e.
+//
+// Solidity: event NetworkSecretRotationRequested(uint256 indexed epoch)
+func (_ManagementContract *ManagementContractFilterer) ParseNetworkSecretRotationRequested(log types.Log) (*ManagementContractNetworkSecretRotationRequested, error) {
+	event := new(ManagementContractNetworkSecretRotationRequested)
+	if err := _ManagementContract.contract.UnpackLog(event, "NetworkSecretRotationRequested", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // ManagementContractOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the ManagementContract contract.
 type ManagementContractOwnershipTransferredIterator struct {
 	Event *ManagementContractOwnershipTransferred // Event containing the contract specifics and raw log
@@ -8,7 +8,8 @@ interface ContractTransparencyConfig {
     enum Field{
         TOPIC1, TOPIC2, TOPIC3, // if any of these fields is in the relevantTo array, then the address in that topic will be able to query for that event
         SENDER, // the tx.origin will be able to query for the event
-        EVERYONE // the event is public - visible to everyone
+        EVERYONE, // the event is public - visible to everyone
+        CONTRACT // the contract decides who can view the event at query time - it must implement ContractEventVisibility
     }
 
     enum ContractCfg{
@@ -31,3 +32,22 @@ interface ContractTransparencyConfig {
     // max gas: 1 Million
     function visibilityRules() external pure returns (VisibilityConfig memory);
 }
+
+// implement this interface if any of the events is visible to the CONTRACT field
+// the TEN platform calls it when an account queries for the event, and caches the result until the next batch
+interface ContractEventVisibility {
+    // returns true if the requester is allowed to view the event with the given signature and topics
+    // max gas: 100k
+    function canView(address requester, bytes32 eventSig, bytes32[] calldata topics) external view returns (bool);
+}
+
+// implement this interface, together with ContractTransparencyConfig, if a PRIVATE contract wants to make some of its
+// internal state readable by everyone via eth_getStorageAt (e.g. the total supply of a token)
+// the TEN platform reads this information once, when the contract is deployed
+interface ContractStorageDisclosure {
+    // slots - the storage slots of the public state variables
+    // mappingSlots - the base slots of the public mappings. An entry is read by providing the storage slot of the mapping and the key
+    // keep the logic independent of the environment
+    // max gas: 30k
+    function publicStorage() external pure returns (bytes32[] memory slots, bytes32[] memory mappingSlots);
+}
@@ -28,6 +28,13 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
     event RollupAdded(bytes32 rollupHash);
     event NetworkSecretRequested(address indexed requester, string requestReport);
     event NetworkSecretResponded(address indexed attester, address indexed requester);
+    event NetworkSecretRotationRequested(uint256 indexed epoch);
+    event NetworkSecretRotated(uint256 indexed epoch, address indexed attester);
+    event ForcedTransactionSubmitted(address indexed sender, bytes encryptedTx);
+    event FraudReported(address indexed sequencer, address indexed validator, uint64 batchSeqNo, bytes32 batchHash, bytes32 expectedStateRoot, bytes32 receivedStateRoot);
+
+    // the maximum size of an encrypted L2 transaction submitted through the escape hatch
+    uint256 public constant MAX_FORCED_TX_SIZE = 128 * 1024;
 
     // mapping of enclaveID to whether it is attested
     mapping(address => bool) private attested;
@@ -60,6 +67,15 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
 
     bytes32 public lastBatchHash;
 
+    // the epoch of the network secret. It starts at 0 and is incremented each time the secret of a new epoch is distributed.
+    uint256 public networkSecretEpoch;
+
+    // the epoch requested by the owner. The rotation is pending until a sequencer enclave distributes its secret.
+    uint256 public requestedSecretEpoch;
+
+    // the hashes of the fraud reports that were already published
+    mapping(bytes32 => bool) public isFraudReported;
+
     function initialize() public initializer {
         __Ownable_init(msg.sender);
         lastBatchSeqNo = 0;
@@ -162,6 +178,13 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
         emit RollupAdded(r.Hash);
     }
 
+    // AddCalldataRollup - the rollup is published in the calldata instead of blobs, when it is cheaper to do so.
+    // The rollup data is only used by the nodes reading the L1 transactions.
+    // solc-ignore-next-line unused-param
+    function AddCalldataRollup(Structs.MetaRollup calldata r, Structs.HeaderCrossChainData calldata crossChainData, bytes calldata) external {
+        AddRollup(r, crossChainData);
+    }
+
     // InitializeNetworkSecret kickstarts the network secret, can only be called once
     // solc-ignore-next-line unused-param
     function InitializeNetworkSecret(address _enclaveID, bytes calldata  _initSecret, string calldata _genesisAttestation) public {
@@ -220,6 +243,53 @@ contract ManagementContract is Initializable, OwnableUpgradeable {
     }
 
 
+    // Function to request the rotation of the network secret - contract owner only
+    // The secret of the new epoch is generated by a sequencer enclave and distributed with DistributeNetworkSecret.
+    function RotateNetworkSecret() public onlyOwner {
+        require(networkSecretInitialized, "network secret not initialized");
+        require(requestedSecretEpoch == networkSecretEpoch, "a rotation is already pending");
+        requestedSecretEpoch = networkSecretEpoch + 1;
+        emit NetworkSecretRotationRequested(requestedSecretEpoch);
+    }
+
+    // A sequencer enclave generates the secret of the requested epoch from fresh entropy, and publishes it encrypted
+    // with the key of each attested enclave. The enclaves switch to the new epoch when they process this transaction,
+    // and keep the secrets of the previous epochs to decrypt the historical data.
+    function DistributeNetworkSecret(uint256 epoch, address attesterID, address[] calldata enclaveIDs, bytes[] calldata encryptedSecrets, bytes calldata signature) public {
+        require(epoch == requestedSecretEpoch && epoch == networkSecretEpoch + 1, "no rotation pending for this epoch");
+        require(sequencerEnclave[attesterID], "attester is not a sequencer enclave");
+        require(enclaveIDs.length == encryptedSecrets.length, "one secret is required per enclave");
+
+        bytes32 distributionHash = keccak256(abi.encodePacked("TEN secret distribution", epoch, attesterID));
+        for (uint256 i = 0; i < enclaveIDs.length; i++) {
+            distributionHash = keccak256(abi.encodePacked(distributionHash, enclaveIDs[i], keccak256(encryptedSecrets[i])));
+        }
+        require(ECDSA.recover(distributionHash, signature) == attesterID, "invalid attester signature");
+
+        networkSecretEpoch = epoch;
+        emit NetworkSecretRotated(epoch, attesterID);
+    }
+
+    // Escape hatch for users whose transactions are censored by the sequencer, or who can't reach it.
+    // The L2 transaction must be signed and encrypted with the RPC key of the network, so that it stays private.
+    // The enclaves include it in a batch within a fixed number of L1 blocks, otherwise the batches are invalid.
+    function SubmitForcedTransaction(bytes calldata encryptedTx) public {
+        require(encryptedTx.length > 0, "empty transaction");
+        require(encryptedTx.length <= MAX_FORCED_TX_SIZE, "transaction too large");
+        emit ForcedTransactionSubmitted(msg.sender, encryptedTx);
+    }
+
+    // Published by a validator when the batch it computes diverges from the batch signed by the sequencer.
+    // The report must be signed by an attested enclave, so it can't be forged by the operator of the validator.
+    function ReportFraud(uint64 batchSeqNo, bytes32 batchHash, bytes32 expectedStateRoot, bytes32 receivedStateRoot, address sequencer, address validator, bytes calldata signature) public {
+        require(attested[validator], "validator enclave not attested");
+        bytes32 reportHash = keccak256(abi.encodePacked("TEN fraud report", batchSeqNo, batchHash, expectedStateRoot, receivedStateRoot, sequencer, validator));
+        require(!isFraudReported[reportHash], "fraud already reported");
+        require(ECDSA.recover(reportHash, signature) == validator, "invalid validator signature");
+        isFraudReported[reportHash] = true;
+        emit FraudReported(sequencer, validator, batchSeqNo, batchHash, expectedStateRoot, receivedStateRoot);
+    }
+
     // Accessor to check if the contract is locked or not
     function IsWithdrawalAvailable() view public returns (bool) {
         return isWithdrawalAvailable;
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.28;
+
+interface IGasOracle {
+    function l1BlockNumber() external view returns (uint256);
+    function l1BaseFee() external view returns (uint256);
+    function l1BlobBaseFee() external view returns (uint256);
+    function l1Cost(uint256 dataSize) external view returns (uint256);
+}
+
+// Exposes the prices of the L1 block the current batch is built on, so contracts can quote the L1 cost of their data.
+// The enclave writes the prices directly into the storage of the contract at the start of every batch, and charges the
+// L1 cost of the transactions using the same prices.
+// The contract is a predeploy: it is not deployed by the SystemDeployer, instead the enclave installs the equivalent
+// runtime code at a fixed address in the system contracts genesis batch (go/enclave/system/gas_oracle.go). Any change
+// to this contract must be mirrored there, and the storage layout must not change. TestGasOracleCodeMatchesTheSolidity
+// compiles this contract and checks that both behave the same for every function and storage slot.
+contract GasOracle is IGasOracle {
+
+    uint256 public l1BlockNumber; // slot 0
+    uint256 public l1BaseFee;     // slot 1
+    uint256 public l1BlobBaseFee; // slot 2
+    // whether the blobs are considered, from the activation height of the blob cost of the enclave
+    bool private blobCostActive;  // slot 3
+
+    uint256 private constant BLOB_CAPACITY = 4096 * 31;
+    uint256 private constant BLOB_GAS_PER_BLOB = 131072;
+
+    // The L1 cost of publishing `dataSize` bytes. Matches the calculation of the enclave (gas.L1Prices), which charges
+    // for 90% of the size to account for the compression of the rollups, and charges the cheapest of publishing the data
+    // in the calldata (16 gas per byte) or in blobs (BLOB_CAPACITY bytes for BLOB_GAS_PER_BLOB blob gas). The blobs are
+    // shared by the transactions of a rollup, so the data is charged pro-rata for the blob gas it uses. Like the enclave,
+    // only the calldata is considered before the activation height of the blob cost.
+    // Sizes that don't fit in 64 bits revert.
+    function l1Cost(uint256 dataSize) external view returns (uint256) {
+        require(dataSize <= type(uint64).max);
+        uint256 reducedSize = dataSize * 90 / 100;
+        uint256 calldataCost = reducedSize * 16 * l1BaseFee;
+        // the blob base fee is only zero when the L1 does not support blobs
+        if (l1BlobBaseFee == 0 || !blobCostActive) {
+            return calldataCost;
+        }
+        uint256 blobGas = reducedSize * BLOB_GAS_PER_BLOB / BLOB_CAPACITY;
+        uint256 blobCost = blobGas * l1BlobBaseFee;
+        return blobCost < calldataCost ? blobCost : calldataCost;
+    }
+}
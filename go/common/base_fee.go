@@ -0,0 +1,56 @@
+package common
+
+import (
+	"math/big"
+)
+
+// BaseFeeChangeDenominator - bounds the change of the base fee between consecutive batches to 1/8, as in EIP-1559
+const BaseFeeChangeDenominator = 8
+
+// CalcNextBaseFee - returns the base fee of the batch extending `parent`, following EIP-1559.
+// The base fee increases when the parent batch used more gas than the target, and decreases when it used less, but
+// never below `minBaseFee`. A target of 0 disables the adjustment, so all the batches have the static `minBaseFee`.
+// The batches below `activationHeight` were created with the static `minBaseFee`, which is kept so that they can be replayed.
+func CalcNextBaseFee(parent *BatchHeader, targetGas uint64, minBaseFee *big.Int, activationHeight uint64) *big.Int {
+	if parent.BaseFee == nil || !IsDynamicBaseFee(new(big.Int).Add(parent.Number, big.NewInt(1)).Uint64(), targetGas, activationHeight) {
+		if minBaseFee == nil {
+			return new(big.Int)
+		}
+		return new(big.Int).Set(minBaseFee)
+	}
+	if parent.GasUsed == targetGas {
+		return new(big.Int).Set(parent.BaseFee)
+	}
+
+	target := new(big.Int).SetUint64(targetGas)
+	denominator := big.NewInt(BaseFeeChangeDenominator)
+	if parent.GasUsed > targetGas {
+		// parentBaseFee * (gasUsed - target) / target / denominator, increasing by at least 1 wei
+		delta := new(big.Int).SetUint64(parent.GasUsed - targetGas)
+		delta.Mul(delta, parent.BaseFee)
+		delta.Div(delta, target)
+		delta.Div(delta, denominator)
+		if delta.Sign() == 0 {
+			delta.SetInt64(1)
+		}
+		return delta.Add(delta, parent.BaseFee)
+	}
+
+	// parentBaseFee * (target - gasUsed) / target / denominator
+	delta := new(big.Int).SetUint64(targetGas - parent.GasUsed)
+	delta.Mul(delta, parent.BaseFee)
+	delta.Div(delta, target)
+	delta.Div(delta, denominator)
+	baseFee := new(big.Int).Sub(parent.BaseFee, delta)
+	if minBaseFee != nil && baseFee.Cmp(minBaseFee) < 0 {
+		return new(big.Int).Set(minBaseFee)
+	}
+	return baseFee
+}
+
+// IsDynamicBaseFee - whether the base fee of the batch at `height` is derived from the gas used by its parent, and
+// whether the batch records the gas it used. The batches of the networks without a target keep the static base fee and
+// the format of their header
+func IsDynamicBaseFee(height uint64, targetGas uint64, activationHeight uint64) bool {
+	return targetGas > 0 && height >= activationHeight
+}
@@ -0,0 +1,45 @@
+package common
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalcNextBaseFee(t *testing.T) {
+	minBaseFee := big.NewInt(1_000)
+	target := uint64(15_000_000)
+
+	tests := []struct {
+		name       string
+		baseFee    *big.Int
+		gasUsed    uint64
+		target     uint64
+		activation uint64
+		expBaseFee int64
+	}{
+		{name: "at target", baseFee: big.NewInt(10_000), gasUsed: target, target: target, expBaseFee: 10_000},
+		{name: "full batch", baseFee: big.NewInt(10_000), gasUsed: 2 * target, target: target, expBaseFee: 11_250},
+		{name: "above target rounds up to 1 wei", baseFee: big.NewInt(1_000), gasUsed: target + 1, target: target, expBaseFee: 1_001},
+		{name: "empty batch", baseFee: big.NewInt(10_000), gasUsed: 0, target: target, expBaseFee: 8_750},
+		{name: "empty batch at min base fee", baseFee: big.NewInt(1_050), gasUsed: 0, target: target, expBaseFee: 1_000},
+		{name: "static base fee", baseFee: big.NewInt(10_000), gasUsed: 2 * target, target: 0, expBaseFee: 1_000},
+		{name: "no parent base fee", baseFee: nil, gasUsed: 0, target: target, expBaseFee: 1_000},
+		{name: "before the activation height", baseFee: big.NewInt(10_000), gasUsed: 2 * target, target: target, activation: 12, expBaseFee: 1_000},
+		{name: "at the activation height", baseFee: big.NewInt(10_000), gasUsed: 2 * target, target: target, activation: 11, expBaseFee: 11_250},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parent := &BatchHeader{Number: big.NewInt(10), BaseFee: tt.baseFee, GasUsed: tt.gasUsed}
+			require.Equal(t, big.NewInt(tt.expBaseFee), CalcNextBaseFee(parent, tt.target, minBaseFee, tt.activation))
+		})
+	}
+}
+
+func TestIsDynamicBaseFee(t *testing.T) {
+	// the batches of the networks upgraded with the default config keep the static base fee and don't record their gas
+	require.False(t, IsDynamicBaseFee(10, 0, 0))
+	require.False(t, IsDynamicBaseFee(10, 15_000_000, 11))
+	require.True(t, IsDynamicBaseFee(11, 15_000_000, 11))
+}
@@ -50,3 +50,31 @@ type BatchRequest struct {
 	Requester string   // The address of the requester, used to direct the response
 	FromSeqNo *big.Int // The requester's view of the current head seq no, or nil if they haven't stored any batches.
 }
+
+// MaxFeeHistoryBatches is the maximum number of batches covered by a fee history request (same as geth)
+const MaxFeeHistoryBatches = 1024
+
+// ValidateRewardPercentiles - the reward percentiles of a fee history request must be increasing values between 0 and
+// 100 (same rules as geth)
+func ValidateRewardPercentiles(percentiles []float64) error {
+	for i, p := range percentiles {
+		if p < 0 || p > 100 {
+			return fmt.Errorf("invalid reward percentile: %f", p)
+		}
+		if i > 0 && p <= percentiles[i-1] {
+			return fmt.Errorf("invalid reward percentile: #%d:%f >= #%d:%f", i-1, percentiles[i-1], i, p)
+		}
+	}
+	return nil
+}
+
+// BatchTxFees holds the fee data of a canonical batch that is used to answer the fee history requests. The gas used
+// only counts the paid transactions. The rewards are the tips paid at the requested percentiles, weighted by the gas
+// used by each transaction, and are nil when the batch has no paid transactions.
+type BatchTxFees struct {
+	Height   uint64
+	BaseFee  *big.Int
+	GasLimit uint64
+	GasUsed  uint64
+	Rewards  []*big.Int
+}
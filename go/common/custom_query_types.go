@@ -24,6 +24,8 @@ const (
 	ActivateSessionKeyCQMethod      = "0x0000000000000000000000000000000000000004"
 	DeactivateSessionKeyCQMethod    = "0x0000000000000000000000000000000000000005"
 	DeleteSessionKeyCQMethod        = "0x0000000000000000000000000000000000000006"
+	ListSessionKeysCQMethod         = "0x0000000000000000000000000000000000000007"
+	RevokeSessionKeyCQMethod        = "0x0000000000000000000000000000000000000008"
 )
 
 type ListPrivateTransactionsQueryParams struct {
@@ -2,7 +2,6 @@ package common
 
 import (
 	"context"
-	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
@@ -11,7 +10,6 @@ import (
 
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 
-	"github.com/ten-protocol/go-ten/go/common/tracers"
 	"github.com/ten-protocol/go-ten/go/responses"
 	"github.com/ten-protocol/go-ten/lib/gethfork/rpc"
 
@@ -60,6 +58,11 @@ type EnclaveInit interface {
 
 	// RPCEncryptionKey - returns the key used
 	RPCEncryptionKey(context.Context) ([]byte, SystemError)
+
+	// EndorseP2PKey - signs the public key and the public address used by the host on the P2P network with the enclave key.
+	// Other hosts use the endorsement to check that the peer is backed by an enclave registered in the management contract,
+	// and to send the responses to the address of the authenticated peer.
+	EndorseP2PKey(ctx context.Context, p2pPubKey []byte, p2pAddress string) ([]byte, SystemError)
 }
 
 // EnclaveAdmin provides administrative functions for managing an enclave.
@@ -93,6 +96,10 @@ type EnclaveAdmin interface {
 	// GetRollupData - retrieve the first batch sequence and start time for a given rollup.
 	GetRollupData(ctx context.Context, hash L2RollupHash) (*PublicRollupMetadata, SystemError)
 
+	// RevealDAKeys - returns the keys used to encrypt the rollups of the revelation period, or no keys if the revelation
+	// delay of the period has not elapsed yet according to both the L1 head seen by the host and the enclave's L1 view.
+	RevealDAKeys(ctx context.Context, period uint64, hostL1Head L1BlockHash) ([]RevealedDAKey, SystemError)
+
 	// CreateBatch - creates a new head batch extending the previous one for the latest known L1 head if the node is
 	// a sequencer. Will panic otherwise.
 	CreateBatch(ctx context.Context, skipIfEmpty bool) SystemError
@@ -107,6 +114,20 @@ type EnclaveAdmin interface {
 
 	ExportCrossChainData(context.Context, uint64, uint64) (*ExtCrossChainBundle, SystemError)
 
+	// GetFraudReport - returns the report signed by the validator enclave for the last batch signed by the sequencer
+	// that diverged from the batch computed by the enclave, or nil if there was no divergence
+	GetFraudReport(ctx context.Context) (*FraudReport, SystemError)
+
+	// ExportStateSnapshot - returns an encrypted and attested snapshot of the state at the canonical batch with the
+	// sequence number, which can be imported by a new validator enclave of the network.
+	// The chunks of the snapshot are passed to `onChunk` in order, before the snapshot is returned.
+	ExportStateSnapshot(ctx context.Context, seqNo uint64, onChunk func(chunk []byte) error) (*StateSnapshot, SystemError)
+
+	// ImportStateSnapshot - verifies the snapshot exported by another enclave, stores it and makes the snapshot batch
+	// the head batch, so the enclave doesn't have to replay the batches since genesis.
+	// `nextChunk` returns the chunks of the snapshot in order, and io.EOF after the last one.
+	ImportStateSnapshot(ctx context.Context, snapshot *StateSnapshot, nextChunk func() ([]byte, error)) SystemError
+
 	// Stop gracefully stops the enclave
 	Stop() SystemError
 	// StopClient stops the enclave client if one exists - only implemented by the RPC layer
@@ -126,14 +147,19 @@ type EnclaveClientRPC interface {
 	// the given ID, nothing is deleted.
 	Unsubscribe(id rpc.ID) SystemError
 
-	// DebugTraceTransaction returns the trace of a transaction
-	DebugTraceTransaction(ctx context.Context, hash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, SystemError)
-
 	GetCode(ctx context.Context, address gethcommon.Address, blockNrOrHash rpc.BlockNumberOrHash) ([]byte, SystemError)
 
 	// GetTotalContractCount returns the total number of contracts that have been deployed
 	GetTotalContractCount(context.Context) (*big.Int, SystemError)
 
+	// GetPublicStorageAt returns the value of a storage slot of a contract at the head batch, or nil if the slot is not
+	// public. When the mapping key is set, the slot is the base slot of a mapping, and the value of the entry is returned.
+	GetPublicStorageAt(ctx context.Context, address gethcommon.Address, slot gethcommon.Hash, mappingKey *gethcommon.Hash) (*PublicStorageValue, SystemError)
+
+	// GetBatchTxFees returns the fee data of the canonical batches with heights in the inclusive range, with the rewards
+	// at the requested percentiles
+	GetBatchTxFees(ctx context.Context, fromHeight uint64, toHeight uint64, rewardPercentiles []float64) ([]BatchTxFees, SystemError)
+
 	// EnclavePublicConfig returns network data that is known to the enclave but can be shared publicly
 	EnclavePublicConfig(context.Context) (*EnclavePublicConfig, SystemError)
 }
@@ -148,6 +174,7 @@ type Enclave interface {
 // BlockSubmissionResponse is the response sent from the enclave back to the node after ingesting a block
 type BlockSubmissionResponse struct {
 	ProducedSecretResponses []*ProducedSecretResponse // The responses to any secret requests in the ingested L1 block.
+	SecretDistribution      *SecretDistribution       // The secret of the new epoch, if the ingested L1 block requested a rotation.
 	RejectError             *errutil.BlockRejectError // If block was rejected, contains information about what block to submit next.
 }
 
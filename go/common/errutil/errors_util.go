@@ -28,6 +28,7 @@ var (
 	ErrNoNextRollup                = errors.New("no next rollup")
 	ErrRollupForkMismatch          = errors.New("rollup fork mismatch")
 	ErrNoBundleToPublish           = errors.New("no bundle to publish")
+	ErrStatePruned                 = errors.New("historic state was pruned")
 )
 
 // BlockRejectError is used as a standard format for error response from enclave for block submission errors
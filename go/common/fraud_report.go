@@ -0,0 +1,40 @@
+package common
+
+import (
+	"encoding/binary"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// fraudReportPrefix - makes sure a report can't be confused with a signature over a batch or rollup. The management
+// contract computes the same hash to verify the signature of the validator
+const fraudReportPrefix = "TEN fraud report"
+
+// FraudReport - produced by a validator enclave when the batch it computes from the transactions of a batch signed by
+// the sequencer does not match that batch. It is signed by the validator enclave and published to the management
+// contract, so that anyone can learn about the divergence without trusting the validator operator.
+type FraudReport struct {
+	BatchSeqNo        uint64
+	BatchHash         L2BatchHash // the hash of the batch signed by the sequencer
+	ExpectedStateRoot StateRoot   // the state root computed by the validator
+	ReceivedStateRoot StateRoot   // the state root of the batch signed by the sequencer
+	Sequencer         EnclaveID   // the enclave that signed the batch
+	EnclaveID         EnclaveID   // the validator enclave that produced the report
+	Signature         []byte
+}
+
+// Hash - the payload signed by the validator enclave. Matches `abi.encodePacked` of the same fields in the contract
+func (r *FraudReport) Hash() gethcommon.Hash {
+	seqNo := make([]byte, 8)
+	binary.BigEndian.PutUint64(seqNo, r.BatchSeqNo)
+	return crypto.Keccak256Hash(
+		[]byte(fraudReportPrefix),
+		seqNo,
+		r.BatchHash.Bytes(),
+		r.ExpectedStateRoot.Bytes(),
+		r.ReceivedStateRoot.Bytes(),
+		r.Sequencer.Bytes(),
+		r.EnclaveID.Bytes(),
+	)
+}
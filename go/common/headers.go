@@ -182,6 +182,10 @@ type RollupHeader struct {
 	Signature   []byte      // The signature of the sequencer enclave on the payload hash
 
 	LastBatchSeqNo uint64
+	// the epoch of the shared secret keys and the revelation period of the key used to encrypt the rollup. They are
+	// optional so that the rollups published before they were added can still be decoded, with both set to zero.
+	SecretEpoch      uint64 `rlp:"optional"`
+	RevelationPeriod uint64 `rlp:"optional"`
 }
 
 // CalldataRollupHeader contains all information necessary to reconstruct the batches included in the rollup.
@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/contracts/generated/MessageBus"
 )
 
 func TestBatchHeader_MarshalJSON(t *testing.T) {
@@ -63,3 +65,29 @@ func randomHash() gethcommon.Hash {
 
 	return gethcommon.BytesToHash(byteArr)
 }
+
+func TestLegacyRollupHeaderIsDecoded(t *testing.T) {
+	// the layout of the rollup headers published before the secret epochs and the revelation periods were added
+	type legacyRollupHeader struct {
+		CompressionL1Head  L1BlockHash
+		CrossChainMessages []MessageBus.StructsCrossChainMessage
+		PayloadHash        gethcommon.Hash
+		Signature          []byte
+		LastBatchSeqNo     uint64
+	}
+	legacy := legacyRollupHeader{CompressionL1Head: randomHash(), PayloadHash: randomHash(), Signature: []byte{1}, LastBatchSeqNo: 12}
+	encoded, err := rlp.EncodeToBytes(legacy)
+	require.NoError(t, err)
+
+	header := new(RollupHeader)
+	require.NoError(t, rlp.DecodeBytes(encoded, header))
+	require.Equal(t, legacy.PayloadHash, header.PayloadHash)
+	require.Equal(t, uint64(12), header.LastBatchSeqNo)
+	require.Zero(t, header.SecretEpoch)
+	require.Zero(t, header.RevelationPeriod)
+	// the hash signed by the sequencer is unchanged
+	legacy.Signature = nil
+	legacyHash, err := rlpHash(legacy)
+	require.NoError(t, err)
+	require.Equal(t, legacyHash, header.Hash())
+}
@@ -81,6 +81,8 @@ type L1DataService interface {
 	Subscribe(handler L1BlockHandler) func()
 
 	FetchBlockByHeight(height *big.Int) (*types.Block, error)
+	// FetchFinalizedBlockNumber returns the height of the latest finalized L1 block
+	FetchFinalizedBlockNumber() (uint64, error)
 	// FetchNextBlock returns the next canonical block after a given block hash
 	// It returns the new block, a bool which is true if the block is the current L1 head and a bool if the block is on a different fork to prevBlock
 	FetchNextBlock(prevBlock gethcommon.Hash) (*types.Block, bool, error)
@@ -102,12 +104,19 @@ type L1Publisher interface {
 	RequestSecret(report *common.AttestationReport) (gethcommon.Hash, error)
 	// FindSecretResponseTx will return the secret response tx from an L1 block
 	FindSecretResponseTx(responseTxs []*common.L1TxData) []*common.L1RespondSecretTx
+	// FindCalldataRollupTxs will return the rollups published in the calldata of the rollup txs of an L1 block
+	FindCalldataRollupTxs(rollupTxs []*common.L1TxData) []*common.L1RollupTx
 	// PublishRollup will create and publish a rollup tx to the management contract - fire and forget we don't wait for receipt
 	// todo (#1624) - With a single sequencer, it is problematic if rollup publication fails; handle this case better
 	PublishRollup(producedRollup *common.ExtRollup)
 	// PublishSecretResponse will create and publish a secret response tx to the management contract - fire and forget we don't wait for receipt
 	PublishSecretResponse(secretResponse *common.ProducedSecretResponse) error
 
+	// PublishFraudReport will create and publish a fraud report tx to the management contract - fire and forget we don't wait for receipt
+	PublishFraudReport(report *common.FraudReport) error
+	// PublishSecretDistribution will create and publish the secret of a new epoch to the management contract - fire and forget we don't wait for receipt
+	PublishSecretDistribution(distribution *common.SecretDistribution) error
+
 	// PublishCrossChainBundle will create and publish a cross-chain bundle tx to the management contract
 	PublishCrossChainBundle(*common.ExtCrossChainBundle, *big.Int, gethcommon.Hash) error
 
@@ -118,6 +127,11 @@ type L1Publisher interface {
 	// ResyncImportantContracts will fetch the latest important contracts from the management contract, update the cache
 	ResyncImportantContracts() error
 
+	// IsAttestedEnclave returns true if the enclave was attested by the management contract
+	IsAttestedEnclave(enclaveID gethcommon.Address) (bool, error)
+	// IsSequencerEnclave returns true if the enclave is permissioned as a sequencer by the management contract
+	IsSequencerEnclave(enclaveID gethcommon.Address) (bool, error)
+
 	// GetBundleRangeFromManagementContract returns the range of batches for which to build a bundle
 	GetBundleRangeFromManagementContract(lastRollupNumber *big.Int, lastRollupUID gethcommon.Hash) (*gethcommon.Hash, *big.Int, *big.Int, error)
 }
@@ -164,6 +178,16 @@ type EnclaveService interface {
 	//	 the service that it should failover if possible
 	NotifyUnavailable(enclaveID *common.EnclaveID)
 
+	// HaltSequencer stores the fraud report of an enclave of this host, which re-executed the batch independently, and
+	// stops the production, acceptance and gossip of the batches signed by the reported sequencer. It returns false if
+	// the report was already known or the sequencer was already halted.
+	HaltSequencer(report *common.FraudReport) (bool, error)
+	// AddFraudReport stores a fraud report published to the L1, and halts the reported sequencer once a quorum of
+	// distinct validators reported it. It returns whether the report halted the sequencer.
+	AddFraudReport(report *common.FraudReport) (bool, error)
+	// IsSequencerHalted returns whether the sequencer was reported for fraud
+	IsSequencerHalted(sequencer common.EnclaveID) bool
+
 	// SubmitAndBroadcastTx submits an encrypted transaction to the enclave, and broadcasts it to other hosts on the network (in particular, to the sequencer)
 	SubmitAndBroadcastTx(ctx context.Context, encryptedParams common.EncryptedRequest) (*responses.RawTx, error)
 
@@ -65,6 +65,10 @@ const (
 	SequencerAddedTx
 	SequencerRevokedTx
 	SetImportantContractsTx
+	SecretRotationTx
+	ForcedTransactionTx
+	SecretRotationRequestTx
+	FraudReportTx
 )
 
 // ProcessedL1Data is submitted to the enclave by the guardian
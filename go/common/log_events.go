@@ -55,6 +55,10 @@ type LogSubscription struct {
 
 	// A subscriber-defined filter to apply to the stream of logs.
 	Filter *FilterCriteriaJSON
+
+	// PendingTransactions - the subscriber receives the transactions of the account which become executable in the
+	// mempool, instead of logs
+	PendingTransactions bool `json:",omitempty"`
 }
 
 func CreateAuthenticatedLogSubscriptionPayload(args []interface{}, vk *viewingkey.ViewingKey) (*LogSubscription, error) {
@@ -63,6 +67,7 @@ func CreateAuthenticatedLogSubscriptionPayload(args []interface{}, vk *viewingke
 			PublicKey:               vk.PublicKey,
 			SignatureWithAccountKey: vk.SignatureWithAccountKey,
 			SignatureType:           vk.SignatureType,
+			Delegation:              vk.Delegation,
 		},
 	}
 
@@ -0,0 +1,44 @@
+package privacy
+
+import (
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// StorageDisclosure - the storage slots that a private contract declared as public
+type StorageDisclosure struct {
+	Slots        map[gethcommon.Hash]bool
+	MappingSlots map[gethcommon.Hash]bool // the base slots of the mappings whose entries are public
+}
+
+func NewStorageDisclosure(slots []gethcommon.Hash, mappingSlots []gethcommon.Hash) *StorageDisclosure {
+	sd := &StorageDisclosure{
+		Slots:        make(map[gethcommon.Hash]bool, len(slots)),
+		MappingSlots: make(map[gethcommon.Hash]bool, len(mappingSlots)),
+	}
+	for _, slot := range slots {
+		sd.Slots[slot] = true
+	}
+	for _, slot := range mappingSlots {
+		sd.MappingSlots[slot] = true
+	}
+	return sd
+}
+
+// DisclosedSlot - returns the slot that can be read, and false if the requested value is private.
+// When a mapping key is provided, the slot is the base slot of the mapping, and the returned slot is the one of the entry.
+func (sd *StorageDisclosure) DisclosedSlot(slot gethcommon.Hash, mappingKey *gethcommon.Hash) (gethcommon.Hash, bool) {
+	if mappingKey == nil {
+		return slot, sd.Slots[slot]
+	}
+	if !sd.MappingSlots[slot] {
+		return gethcommon.Hash{}, false
+	}
+	return MappingEntrySlot(slot, *mappingKey), true
+}
+
+// MappingEntrySlot - the storage slot of the value stored under `key` by a solidity mapping with the `baseSlot`.
+// The key must be a value type (address, uint, bytes32, etc.) padded to 32 bytes.
+func MappingEntrySlot(baseSlot gethcommon.Hash, key gethcommon.Hash) gethcommon.Hash {
+	return crypto.Keccak256Hash(key.Bytes(), baseSlot.Bytes())
+}
@@ -0,0 +1,39 @@
+package privacy
+
+import (
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+func TestMappingEntrySlot(t *testing.T) {
+	// keccak256(abi.encode(uint256(0), uint256(0))) - the slot of the entry with key 0 of the mapping at slot 0
+	expected := gethcommon.HexToHash("0xad3228b676f7d3cd4284a5443f17f1962b36e491b30a40b2405849e597ba5fb5")
+	if slot := MappingEntrySlot(gethcommon.Hash{}, gethcommon.Hash{}); slot != expected {
+		t.Fatalf("unexpected mapping entry slot %s", slot)
+	}
+}
+
+func TestDisclosedSlot(t *testing.T) {
+	publicSlot := gethcommon.BigToHash(gethcommon.Big1)
+	mappingSlot := gethcommon.BigToHash(gethcommon.Big2)
+	privateSlot := gethcommon.BigToHash(gethcommon.Big3)
+	key := gethcommon.BytesToHash(gethcommon.HexToAddress("0x1234").Bytes())
+	sd := NewStorageDisclosure([]gethcommon.Hash{publicSlot}, []gethcommon.Hash{mappingSlot})
+
+	if slot, ok := sd.DisclosedSlot(publicSlot, nil); !ok || slot != publicSlot {
+		t.Fatal("public slot should be disclosed")
+	}
+	if _, ok := sd.DisclosedSlot(privateSlot, nil); ok {
+		t.Fatal("private slot should not be disclosed")
+	}
+	if _, ok := sd.DisclosedSlot(mappingSlot, nil); ok {
+		t.Fatal("the base slot of a mapping should not be disclosed")
+	}
+	if slot, ok := sd.DisclosedSlot(mappingSlot, &key); !ok || slot != MappingEntrySlot(mappingSlot, key) {
+		t.Fatal("mapping entry should be disclosed")
+	}
+	if _, ok := sd.DisclosedSlot(publicSlot, &key); ok {
+		t.Fatal("a public slot is not a public mapping")
+	}
+}
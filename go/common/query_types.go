@@ -77,6 +77,12 @@ type PublicBlock struct {
 	RollupHash  common.Hash  `json:"rollupHash"`
 }
 
+// PublicStorageValue - the value of a storage slot that the contract made public
+type PublicStorageValue struct {
+	Slot  common.Hash `json:"slot"` // for mapping entries, this is the slot of the entry
+	Value common.Hash `json:"value"`
+}
+
 type FinalityType string
 
 const (
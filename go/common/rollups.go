@@ -2,6 +2,8 @@ package common
 
 import (
 	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 // ExtRollup is an encrypted form of rollup used when passing the rollup around outside an enclave.
@@ -22,3 +24,11 @@ func (r *ExtRollup) Hash() L2RollupHash {
 	r.hash.Store(v)
 	return v
 }
+
+// RevealedDAKey is the key used to encrypt the rollups of a revelation period during a secret epoch, published once the
+// revelation delay of the period has elapsed.
+type RevealedDAKey struct {
+	Epoch  uint64
+	Period uint64
+	Key    hexutil.Bytes
+}
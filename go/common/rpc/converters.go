@@ -9,6 +9,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/rpc/generated"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 // Functions to convert classes that need to be sent between the host and the enclave to and from their equivalent
@@ -35,6 +36,13 @@ func ToBlockSubmissionResponseMsg(response *common.BlockSubmissionResponse) (*ge
 	msg := &generated.BlockSubmissionResponseMsg{
 		ProducedSecretResponses: ToSecretRespMsg(response.ProducedSecretResponses),
 	}
+	if response.SecretDistribution != nil {
+		encoded, err := rlp.EncodeToBytes(response.SecretDistribution)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode the secret distribution. Cause: %w", err)
+		}
+		msg.SecretDistribution = encoded
+	}
 
 	return msg, nil
 }
@@ -71,9 +79,16 @@ func FromSecretRespMsg(secretResponses []*generated.SecretResponseMsg) []*common
 }
 
 func FromBlockSubmissionResponseMsg(msg *generated.BlockSubmissionResponseMsg) (*common.BlockSubmissionResponse, error) {
-	return &common.BlockSubmissionResponse{
+	response := &common.BlockSubmissionResponse{
 		ProducedSecretResponses: FromSecretRespMsg(msg.ProducedSecretResponses),
-	}, nil
+	}
+	if len(msg.SecretDistribution) > 0 {
+		response.SecretDistribution = &common.SecretDistribution{}
+		if err := rlp.DecodeBytes(msg.SecretDistribution, response.SecretDistribution); err != nil {
+			return nil, fmt.Errorf("could not decode the secret distribution. Cause: %w", err)
+		}
+	}
+	return response, nil
 }
 
 func ToCrossChainMsgs(messages []MessageBus.StructsCrossChainMessage) []*generated.CrossChainMsg {
@@ -224,6 +239,8 @@ func ToRollupHeaderMsg(header *common.RollupHeader) *generated.RollupHeaderMsg {
 		Signature:          header.Signature,
 		CrossChainMessages: ToCrossChainMsgs(header.CrossChainMessages),
 		LastBatchSeqNo:     header.LastBatchSeqNo,
+		SecretEpoch:        header.SecretEpoch,
+		RevelationPeriod:   header.RevelationPeriod,
 	}
 
 	return &headerMsg
@@ -253,6 +270,8 @@ func FromRollupHeaderMsg(header *generated.RollupHeaderMsg) *common.RollupHeader
 		Signature:          header.Signature,
 		CrossChainMessages: FromCrossChainMsgs(header.CrossChainMessages),
 		LastBatchSeqNo:     header.LastBatchSeqNo,
+		SecretEpoch:        header.SecretEpoch,
+		RevelationPeriod:   header.RevelationPeriod,
 	}
 }
 
@@ -281,3 +300,111 @@ func FromRollupDataMsg(msg *generated.PublicRollupDataMsg) (*common.PublicRollup
 		StartTime:          msg.Timestamp,
 	}, nil
 }
+
+func ToRevealedDAKeyMsgs(keys []common.RevealedDAKey) []*generated.RevealedDAKeyMsg {
+	msgs := make([]*generated.RevealedDAKeyMsg, len(keys))
+	for i, key := range keys {
+		msgs[i] = &generated.RevealedDAKeyMsg{Epoch: key.Epoch, Period: key.Period, Key: key.Key}
+	}
+	return msgs
+}
+
+func FromRevealedDAKeyMsgs(msgs []*generated.RevealedDAKeyMsg) []common.RevealedDAKey {
+	keys := make([]common.RevealedDAKey, len(msgs))
+	for i, msg := range msgs {
+		keys[i] = common.RevealedDAKey{Epoch: msg.Epoch, Period: msg.Period, Key: msg.Key}
+	}
+	return keys
+}
+
+func ToBatchTxFeesMsgs(batches []common.BatchTxFees) []*generated.BatchTxFeesMsg {
+	msgs := make([]*generated.BatchTxFeesMsg, len(batches))
+	for i, batch := range batches {
+		var rewards [][]byte
+		for _, reward := range batch.Rewards {
+			rewards = append(rewards, reward.Bytes())
+		}
+		var baseFee []byte
+		if batch.BaseFee != nil {
+			baseFee = batch.BaseFee.Bytes()
+		}
+		msgs[i] = &generated.BatchTxFeesMsg{Height: batch.Height, BaseFee: baseFee, GasLimit: batch.GasLimit, GasUsed: batch.GasUsed, Rewards: rewards}
+	}
+	return msgs
+}
+
+func FromBatchTxFeesMsgs(msgs []*generated.BatchTxFeesMsg) []common.BatchTxFees {
+	batches := make([]common.BatchTxFees, len(msgs))
+	for i, msg := range msgs {
+		var rewards []*big.Int
+		for _, reward := range msg.Rewards {
+			rewards = append(rewards, new(big.Int).SetBytes(reward))
+		}
+		batches[i] = common.BatchTxFees{
+			Height:   msg.Height,
+			BaseFee:  new(big.Int).SetBytes(msg.BaseFee),
+			GasLimit: msg.GasLimit,
+			GasUsed:  msg.GasUsed,
+			Rewards:  rewards,
+		}
+	}
+	return batches
+}
+
+func ToFraudReportMsg(report *common.FraudReport) *generated.FraudReportMsg {
+	if report == nil {
+		return nil
+	}
+	return &generated.FraudReportMsg{
+		BatchSeqNo:        report.BatchSeqNo,
+		BatchHash:         report.BatchHash.Bytes(),
+		ExpectedStateRoot: report.ExpectedStateRoot.Bytes(),
+		ReceivedStateRoot: report.ReceivedStateRoot.Bytes(),
+		Sequencer:         report.Sequencer.Bytes(),
+		EnclaveID:         report.EnclaveID.Bytes(),
+		Signature:         report.Signature,
+	}
+}
+
+func FromFraudReportMsg(msg *generated.FraudReportMsg) *common.FraudReport {
+	if msg == nil {
+		return nil
+	}
+	return &common.FraudReport{
+		BatchSeqNo:        msg.BatchSeqNo,
+		BatchHash:         gethcommon.BytesToHash(msg.BatchHash),
+		ExpectedStateRoot: gethcommon.BytesToHash(msg.ExpectedStateRoot),
+		ReceivedStateRoot: gethcommon.BytesToHash(msg.ReceivedStateRoot),
+		Sequencer:         gethcommon.BytesToAddress(msg.Sequencer),
+		EnclaveID:         gethcommon.BytesToAddress(msg.EnclaveID),
+		Signature:         msg.Signature,
+	}
+}
+
+func ToStateSnapshotMsg(snapshot *common.StateSnapshot) *generated.StateSnapshotMsg {
+	attestation := ToAttestationReportMsg(snapshot.Attestation)
+	return &generated.StateSnapshotMsg{
+		BatchSeqNo:  snapshot.BatchSeqNo,
+		BatchHash:   snapshot.BatchHash.Bytes(),
+		StateRoot:   snapshot.StateRoot.Bytes(),
+		Attestation: &attestation,
+		Chunks:      snapshot.Chunks,
+		ChunksHash:  snapshot.ChunksHash.Bytes(),
+		Signature:   snapshot.Signature,
+	}
+}
+
+func FromStateSnapshotMsg(msg *generated.StateSnapshotMsg) *common.StateSnapshot {
+	snapshot := &common.StateSnapshot{
+		BatchSeqNo: msg.BatchSeqNo,
+		BatchHash:  gethcommon.BytesToHash(msg.BatchHash),
+		StateRoot:  gethcommon.BytesToHash(msg.StateRoot),
+		Chunks:     msg.Chunks,
+		ChunksHash: gethcommon.BytesToHash(msg.ChunksHash),
+		Signature:  msg.Signature,
+	}
+	if msg.Attestation != nil {
+		snapshot.Attestation = FromAttestationReportMsg(msg.Attestation)
+	}
+	return snapshot
+}
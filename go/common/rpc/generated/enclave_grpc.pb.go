@@ -25,6 +25,7 @@ const (
 	EnclaveProto_InitEnclave_FullMethodName           = "/generated.EnclaveProto/InitEnclave"
 	EnclaveProto_EnclaveID_FullMethodName             = "/generated.EnclaveProto/EnclaveID"
 	EnclaveProto_RPCEncryptionKey_FullMethodName      = "/generated.EnclaveProto/RPCEncryptionKey"
+	EnclaveProto_EndorseP2PKey_FullMethodName         = "/generated.EnclaveProto/EndorseP2PKey"
 	EnclaveProto_SubmitL1Block_FullMethodName         = "/generated.EnclaveProto/SubmitL1Block"
 	EnclaveProto_EncryptedRPC_FullMethodName          = "/generated.EnclaveProto/EncryptedRPC"
 	EnclaveProto_SubmitBatch_FullMethodName           = "/generated.EnclaveProto/SubmitBatch"
@@ -36,12 +37,17 @@ const (
 	EnclaveProto_GetBatch_FullMethodName              = "/generated.EnclaveProto/GetBatch"
 	EnclaveProto_GetBatchBySeqNo_FullMethodName       = "/generated.EnclaveProto/GetBatchBySeqNo"
 	EnclaveProto_GetRollupData_FullMethodName         = "/generated.EnclaveProto/GetRollupData"
+	EnclaveProto_RevealDAKeys_FullMethodName          = "/generated.EnclaveProto/RevealDAKeys"
 	EnclaveProto_CreateBatch_FullMethodName           = "/generated.EnclaveProto/CreateBatch"
 	EnclaveProto_CreateRollup_FullMethodName          = "/generated.EnclaveProto/CreateRollup"
 	EnclaveProto_ExportCrossChainData_FullMethodName  = "/generated.EnclaveProto/ExportCrossChainData"
-	EnclaveProto_DebugTraceTransaction_FullMethodName = "/generated.EnclaveProto/DebugTraceTransaction"
+	EnclaveProto_GetFraudReport_FullMethodName        = "/generated.EnclaveProto/GetFraudReport"
+	EnclaveProto_ExportStateSnapshot_FullMethodName   = "/generated.EnclaveProto/ExportStateSnapshot"
+	EnclaveProto_ImportStateSnapshot_FullMethodName   = "/generated.EnclaveProto/ImportStateSnapshot"
 	EnclaveProto_StreamL2Updates_FullMethodName       = "/generated.EnclaveProto/StreamL2Updates"
 	EnclaveProto_GetTotalContractCount_FullMethodName = "/generated.EnclaveProto/GetTotalContractCount"
+	EnclaveProto_GetBatchTxFees_FullMethodName        = "/generated.EnclaveProto/GetBatchTxFees"
+	EnclaveProto_GetPublicStorageAt_FullMethodName    = "/generated.EnclaveProto/GetPublicStorageAt"
 	EnclaveProto_EnclavePublicConfig_FullMethodName   = "/generated.EnclaveProto/EnclavePublicConfig"
 	EnclaveProto_MakeActive_FullMethodName            = "/generated.EnclaveProto/MakeActive"
 	EnclaveProto_AddSequencer_FullMethodName          = "/generated.EnclaveProto/AddSequencer"
@@ -57,6 +63,7 @@ type EnclaveProtoClient interface {
 	InitEnclave(ctx context.Context, in *InitEnclaveRequest, opts ...grpc.CallOption) (*InitEnclaveResponse, error)
 	EnclaveID(ctx context.Context, in *EnclaveIDRequest, opts ...grpc.CallOption) (*EnclaveIDResponse, error)
 	RPCEncryptionKey(ctx context.Context, in *RPCEncryptionKeyRequest, opts ...grpc.CallOption) (*RPCEncryptionKeyResponse, error)
+	EndorseP2PKey(ctx context.Context, in *EndorseP2PKeyRequest, opts ...grpc.CallOption) (*EndorseP2PKeyResponse, error)
 	SubmitL1Block(ctx context.Context, in *SubmitBlockRequest, opts ...grpc.CallOption) (*SubmitBlockResponse, error)
 	EncryptedRPC(ctx context.Context, in *EncCallRequest, opts ...grpc.CallOption) (*EncCallResponse, error)
 	SubmitBatch(ctx context.Context, in *SubmitBatchRequest, opts ...grpc.CallOption) (*SubmitBatchResponse, error)
@@ -68,12 +75,17 @@ type EnclaveProtoClient interface {
 	GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (*GetBatchResponse, error)
 	GetBatchBySeqNo(ctx context.Context, in *GetBatchBySeqNoRequest, opts ...grpc.CallOption) (*GetBatchResponse, error)
 	GetRollupData(ctx context.Context, in *GetRollupDataRequest, opts ...grpc.CallOption) (*GetRollupDataResponse, error)
+	RevealDAKeys(ctx context.Context, in *RevealDAKeysRequest, opts ...grpc.CallOption) (*RevealDAKeysResponse, error)
 	CreateBatch(ctx context.Context, in *CreateBatchRequest, opts ...grpc.CallOption) (*CreateBatchResponse, error)
 	CreateRollup(ctx context.Context, in *CreateRollupRequest, opts ...grpc.CallOption) (*CreateRollupResponse, error)
 	ExportCrossChainData(ctx context.Context, in *ExportCrossChainDataRequest, opts ...grpc.CallOption) (*ExportCrossChainDataResponse, error)
-	DebugTraceTransaction(ctx context.Context, in *DebugTraceTransactionRequest, opts ...grpc.CallOption) (*DebugTraceTransactionResponse, error)
+	GetFraudReport(ctx context.Context, in *GetFraudReportRequest, opts ...grpc.CallOption) (*GetFraudReportResponse, error)
+	ExportStateSnapshot(ctx context.Context, in *ExportStateSnapshotRequest, opts ...grpc.CallOption) (EnclaveProto_ExportStateSnapshotClient, error)
+	ImportStateSnapshot(ctx context.Context, opts ...grpc.CallOption) (EnclaveProto_ImportStateSnapshotClient, error)
 	StreamL2Updates(ctx context.Context, in *StreamL2UpdatesRequest, opts ...grpc.CallOption) (EnclaveProto_StreamL2UpdatesClient, error)
 	GetTotalContractCount(ctx context.Context, in *GetTotalContractCountRequest, opts ...grpc.CallOption) (*GetTotalContractCountResponse, error)
+	GetBatchTxFees(ctx context.Context, in *GetBatchTxFeesRequest, opts ...grpc.CallOption) (*GetBatchTxFeesResponse, error)
+	GetPublicStorageAt(ctx context.Context, in *GetPublicStorageAtRequest, opts ...grpc.CallOption) (*GetPublicStorageAtResponse, error)
 	EnclavePublicConfig(ctx context.Context, in *EnclavePublicConfigRequest, opts ...grpc.CallOption) (*EnclavePublicConfigResponse, error)
 	MakeActive(ctx context.Context, in *MakeActiveRequest, opts ...grpc.CallOption) (*MakeActiveResponse, error)
 	AddSequencer(ctx context.Context, in *AddSequencerRequest, opts ...grpc.CallOption) (*AddSequencerResponse, error)
@@ -141,6 +153,15 @@ func (c *enclaveProtoClient) RPCEncryptionKey(ctx context.Context, in *RPCEncryp
 	return out, nil
 }
 
+func (c *enclaveProtoClient) EndorseP2PKey(ctx context.Context, in *EndorseP2PKeyRequest, opts ...grpc.CallOption) (*EndorseP2PKeyResponse, error) {
+	out := new(EndorseP2PKeyResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_EndorseP2PKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) SubmitL1Block(ctx context.Context, in *SubmitBlockRequest, opts ...grpc.CallOption) (*SubmitBlockResponse, error) {
 	out := new(SubmitBlockResponse)
 	err := c.cc.Invoke(ctx, EnclaveProto_SubmitL1Block_FullMethodName, in, out, opts...)
@@ -240,6 +261,15 @@ func (c *enclaveProtoClient) GetRollupData(ctx context.Context, in *GetRollupDat
 	return out, nil
 }
 
+func (c *enclaveProtoClient) RevealDAKeys(ctx context.Context, in *RevealDAKeysRequest, opts ...grpc.CallOption) (*RevealDAKeysResponse, error) {
+	out := new(RevealDAKeysResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_RevealDAKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) CreateBatch(ctx context.Context, in *CreateBatchRequest, opts ...grpc.CallOption) (*CreateBatchResponse, error) {
 	out := new(CreateBatchResponse)
 	err := c.cc.Invoke(ctx, EnclaveProto_CreateBatch_FullMethodName, in, out, opts...)
@@ -267,17 +297,83 @@ func (c *enclaveProtoClient) ExportCrossChainData(ctx context.Context, in *Expor
 	return out, nil
 }
 
-func (c *enclaveProtoClient) DebugTraceTransaction(ctx context.Context, in *DebugTraceTransactionRequest, opts ...grpc.CallOption) (*DebugTraceTransactionResponse, error) {
-	out := new(DebugTraceTransactionResponse)
-	err := c.cc.Invoke(ctx, EnclaveProto_DebugTraceTransaction_FullMethodName, in, out, opts...)
+func (c *enclaveProtoClient) GetFraudReport(ctx context.Context, in *GetFraudReportRequest, opts ...grpc.CallOption) (*GetFraudReportResponse, error) {
+	out := new(GetFraudReportResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_GetFraudReport_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
+func (c *enclaveProtoClient) ExportStateSnapshot(ctx context.Context, in *ExportStateSnapshotRequest, opts ...grpc.CallOption) (EnclaveProto_ExportStateSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EnclaveProto_ServiceDesc.Streams[0], EnclaveProto_ExportStateSnapshot_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &enclaveProtoExportStateSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EnclaveProto_ExportStateSnapshotClient interface {
+	Recv() (*ExportStateSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type enclaveProtoExportStateSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *enclaveProtoExportStateSnapshotClient) Recv() (*ExportStateSnapshotResponse, error) {
+	m := new(ExportStateSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *enclaveProtoClient) ImportStateSnapshot(ctx context.Context, opts ...grpc.CallOption) (EnclaveProto_ImportStateSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EnclaveProto_ServiceDesc.Streams[1], EnclaveProto_ImportStateSnapshot_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &enclaveProtoImportStateSnapshotClient{stream}
+	return x, nil
+}
+
+type EnclaveProto_ImportStateSnapshotClient interface {
+	Send(*ImportStateSnapshotRequest) error
+	CloseAndRecv() (*ImportStateSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type enclaveProtoImportStateSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *enclaveProtoImportStateSnapshotClient) Send(m *ImportStateSnapshotRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *enclaveProtoImportStateSnapshotClient) CloseAndRecv() (*ImportStateSnapshotResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportStateSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *enclaveProtoClient) StreamL2Updates(ctx context.Context, in *StreamL2UpdatesRequest, opts ...grpc.CallOption) (EnclaveProto_StreamL2UpdatesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &EnclaveProto_ServiceDesc.Streams[0], EnclaveProto_StreamL2Updates_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &EnclaveProto_ServiceDesc.Streams[2], EnclaveProto_StreamL2Updates_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -317,6 +413,24 @@ func (c *enclaveProtoClient) GetTotalContractCount(ctx context.Context, in *GetT
 	return out, nil
 }
 
+func (c *enclaveProtoClient) GetBatchTxFees(ctx context.Context, in *GetBatchTxFeesRequest, opts ...grpc.CallOption) (*GetBatchTxFeesResponse, error) {
+	out := new(GetBatchTxFeesResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_GetBatchTxFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enclaveProtoClient) GetPublicStorageAt(ctx context.Context, in *GetPublicStorageAtRequest, opts ...grpc.CallOption) (*GetPublicStorageAtResponse, error) {
+	out := new(GetPublicStorageAtResponse)
+	err := c.cc.Invoke(ctx, EnclaveProto_GetPublicStorageAt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *enclaveProtoClient) EnclavePublicConfig(ctx context.Context, in *EnclavePublicConfigRequest, opts ...grpc.CallOption) (*EnclavePublicConfigResponse, error) {
 	out := new(EnclavePublicConfigResponse)
 	err := c.cc.Invoke(ctx, EnclaveProto_EnclavePublicConfig_FullMethodName, in, out, opts...)
@@ -354,6 +468,7 @@ type EnclaveProtoServer interface {
 	InitEnclave(context.Context, *InitEnclaveRequest) (*InitEnclaveResponse, error)
 	EnclaveID(context.Context, *EnclaveIDRequest) (*EnclaveIDResponse, error)
 	RPCEncryptionKey(context.Context, *RPCEncryptionKeyRequest) (*RPCEncryptionKeyResponse, error)
+	EndorseP2PKey(context.Context, *EndorseP2PKeyRequest) (*EndorseP2PKeyResponse, error)
 	SubmitL1Block(context.Context, *SubmitBlockRequest) (*SubmitBlockResponse, error)
 	EncryptedRPC(context.Context, *EncCallRequest) (*EncCallResponse, error)
 	SubmitBatch(context.Context, *SubmitBatchRequest) (*SubmitBatchResponse, error)
@@ -365,12 +480,17 @@ type EnclaveProtoServer interface {
 	GetBatch(context.Context, *GetBatchRequest) (*GetBatchResponse, error)
 	GetBatchBySeqNo(context.Context, *GetBatchBySeqNoRequest) (*GetBatchResponse, error)
 	GetRollupData(context.Context, *GetRollupDataRequest) (*GetRollupDataResponse, error)
+	RevealDAKeys(context.Context, *RevealDAKeysRequest) (*RevealDAKeysResponse, error)
 	CreateBatch(context.Context, *CreateBatchRequest) (*CreateBatchResponse, error)
 	CreateRollup(context.Context, *CreateRollupRequest) (*CreateRollupResponse, error)
 	ExportCrossChainData(context.Context, *ExportCrossChainDataRequest) (*ExportCrossChainDataResponse, error)
-	DebugTraceTransaction(context.Context, *DebugTraceTransactionRequest) (*DebugTraceTransactionResponse, error)
+	GetFraudReport(context.Context, *GetFraudReportRequest) (*GetFraudReportResponse, error)
+	ExportStateSnapshot(*ExportStateSnapshotRequest, EnclaveProto_ExportStateSnapshotServer) error
+	ImportStateSnapshot(EnclaveProto_ImportStateSnapshotServer) error
 	StreamL2Updates(*StreamL2UpdatesRequest, EnclaveProto_StreamL2UpdatesServer) error
 	GetTotalContractCount(context.Context, *GetTotalContractCountRequest) (*GetTotalContractCountResponse, error)
+	GetBatchTxFees(context.Context, *GetBatchTxFeesRequest) (*GetBatchTxFeesResponse, error)
+	GetPublicStorageAt(context.Context, *GetPublicStorageAtRequest) (*GetPublicStorageAtResponse, error)
 	EnclavePublicConfig(context.Context, *EnclavePublicConfigRequest) (*EnclavePublicConfigResponse, error)
 	MakeActive(context.Context, *MakeActiveRequest) (*MakeActiveResponse, error)
 	AddSequencer(context.Context, *AddSequencerRequest) (*AddSequencerResponse, error)
@@ -399,6 +519,9 @@ func (UnimplementedEnclaveProtoServer) EnclaveID(context.Context, *EnclaveIDRequ
 func (UnimplementedEnclaveProtoServer) RPCEncryptionKey(context.Context, *RPCEncryptionKeyRequest) (*RPCEncryptionKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RPCEncryptionKey not implemented")
 }
+func (UnimplementedEnclaveProtoServer) EndorseP2PKey(context.Context, *EndorseP2PKeyRequest) (*EndorseP2PKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndorseP2PKey not implemented")
+}
 func (UnimplementedEnclaveProtoServer) SubmitL1Block(context.Context, *SubmitBlockRequest) (*SubmitBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitL1Block not implemented")
 }
@@ -432,6 +555,9 @@ func (UnimplementedEnclaveProtoServer) GetBatchBySeqNo(context.Context, *GetBatc
 func (UnimplementedEnclaveProtoServer) GetRollupData(context.Context, *GetRollupDataRequest) (*GetRollupDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRollupData not implemented")
 }
+func (UnimplementedEnclaveProtoServer) RevealDAKeys(context.Context, *RevealDAKeysRequest) (*RevealDAKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealDAKeys not implemented")
+}
 func (UnimplementedEnclaveProtoServer) CreateBatch(context.Context, *CreateBatchRequest) (*CreateBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBatch not implemented")
 }
@@ -441,8 +567,14 @@ func (UnimplementedEnclaveProtoServer) CreateRollup(context.Context, *CreateRoll
 func (UnimplementedEnclaveProtoServer) ExportCrossChainData(context.Context, *ExportCrossChainDataRequest) (*ExportCrossChainDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportCrossChainData not implemented")
 }
-func (UnimplementedEnclaveProtoServer) DebugTraceTransaction(context.Context, *DebugTraceTransactionRequest) (*DebugTraceTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DebugTraceTransaction not implemented")
+func (UnimplementedEnclaveProtoServer) GetFraudReport(context.Context, *GetFraudReportRequest) (*GetFraudReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFraudReport not implemented")
+}
+func (UnimplementedEnclaveProtoServer) ExportStateSnapshot(*ExportStateSnapshotRequest, EnclaveProto_ExportStateSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportStateSnapshot not implemented")
+}
+func (UnimplementedEnclaveProtoServer) ImportStateSnapshot(EnclaveProto_ImportStateSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportStateSnapshot not implemented")
 }
 func (UnimplementedEnclaveProtoServer) StreamL2Updates(*StreamL2UpdatesRequest, EnclaveProto_StreamL2UpdatesServer) error {
 	return status.Errorf(codes.Unimplemented, "method StreamL2Updates not implemented")
@@ -450,6 +582,12 @@ func (UnimplementedEnclaveProtoServer) StreamL2Updates(*StreamL2UpdatesRequest,
 func (UnimplementedEnclaveProtoServer) GetTotalContractCount(context.Context, *GetTotalContractCountRequest) (*GetTotalContractCountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTotalContractCount not implemented")
 }
+func (UnimplementedEnclaveProtoServer) GetBatchTxFees(context.Context, *GetBatchTxFeesRequest) (*GetBatchTxFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchTxFees not implemented")
+}
+func (UnimplementedEnclaveProtoServer) GetPublicStorageAt(context.Context, *GetPublicStorageAtRequest) (*GetPublicStorageAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicStorageAt not implemented")
+}
 func (UnimplementedEnclaveProtoServer) EnclavePublicConfig(context.Context, *EnclavePublicConfigRequest) (*EnclavePublicConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnclavePublicConfig not implemented")
 }
@@ -580,6 +718,24 @@ func _EnclaveProto_RPCEncryptionKey_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_EndorseP2PKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndorseP2PKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).EndorseP2PKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnclaveProto_EndorseP2PKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).EndorseP2PKey(ctx, req.(*EndorseP2PKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_SubmitL1Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitBlockRequest)
 	if err := dec(in); err != nil {
@@ -778,6 +934,24 @@ func _EnclaveProto_GetRollupData_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_RevealDAKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealDAKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).RevealDAKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnclaveProto_RevealDAKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).RevealDAKeys(ctx, req.(*RevealDAKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_CreateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateBatchRequest)
 	if err := dec(in); err != nil {
@@ -832,24 +1006,71 @@ func _EnclaveProto_ExportCrossChainData_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-func _EnclaveProto_DebugTraceTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DebugTraceTransactionRequest)
+func _EnclaveProto_GetFraudReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFraudReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(EnclaveProtoServer).DebugTraceTransaction(ctx, in)
+		return srv.(EnclaveProtoServer).GetFraudReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: EnclaveProto_DebugTraceTransaction_FullMethodName,
+		FullMethod: EnclaveProto_GetFraudReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EnclaveProtoServer).DebugTraceTransaction(ctx, req.(*DebugTraceTransactionRequest))
+		return srv.(EnclaveProtoServer).GetFraudReport(ctx, req.(*GetFraudReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_ExportStateSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportStateSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EnclaveProtoServer).ExportStateSnapshot(m, &enclaveProtoExportStateSnapshotServer{stream})
+}
+
+type EnclaveProto_ExportStateSnapshotServer interface {
+	Send(*ExportStateSnapshotResponse) error
+	grpc.ServerStream
+}
+
+type enclaveProtoExportStateSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *enclaveProtoExportStateSnapshotServer) Send(m *ExportStateSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EnclaveProto_ImportStateSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EnclaveProtoServer).ImportStateSnapshot(&enclaveProtoImportStateSnapshotServer{stream})
+}
+
+type EnclaveProto_ImportStateSnapshotServer interface {
+	SendAndClose(*ImportStateSnapshotResponse) error
+	Recv() (*ImportStateSnapshotRequest, error)
+	grpc.ServerStream
+}
+
+type enclaveProtoImportStateSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *enclaveProtoImportStateSnapshotServer) SendAndClose(m *ImportStateSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *enclaveProtoImportStateSnapshotServer) Recv() (*ImportStateSnapshotRequest, error) {
+	m := new(ImportStateSnapshotRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _EnclaveProto_StreamL2Updates_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamL2UpdatesRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -889,6 +1110,42 @@ func _EnclaveProto_GetTotalContractCount_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnclaveProto_GetBatchTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchTxFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetBatchTxFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnclaveProto_GetBatchTxFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetBatchTxFees(ctx, req.(*GetBatchTxFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnclaveProto_GetPublicStorageAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicStorageAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnclaveProtoServer).GetPublicStorageAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnclaveProto_GetPublicStorageAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnclaveProtoServer).GetPublicStorageAt(ctx, req.(*GetPublicStorageAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EnclaveProto_EnclavePublicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EnclavePublicConfigRequest)
 	if err := dec(in); err != nil {
@@ -974,6 +1231,10 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RPCEncryptionKey",
 			Handler:    _EnclaveProto_RPCEncryptionKey_Handler,
 		},
+		{
+			MethodName: "EndorseP2PKey",
+			Handler:    _EnclaveProto_EndorseP2PKey_Handler,
+		},
 		{
 			MethodName: "SubmitL1Block",
 			Handler:    _EnclaveProto_SubmitL1Block_Handler,
@@ -1018,6 +1279,10 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRollupData",
 			Handler:    _EnclaveProto_GetRollupData_Handler,
 		},
+		{
+			MethodName: "RevealDAKeys",
+			Handler:    _EnclaveProto_RevealDAKeys_Handler,
+		},
 		{
 			MethodName: "CreateBatch",
 			Handler:    _EnclaveProto_CreateBatch_Handler,
@@ -1031,13 +1296,21 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _EnclaveProto_ExportCrossChainData_Handler,
 		},
 		{
-			MethodName: "DebugTraceTransaction",
-			Handler:    _EnclaveProto_DebugTraceTransaction_Handler,
+			MethodName: "GetFraudReport",
+			Handler:    _EnclaveProto_GetFraudReport_Handler,
 		},
 		{
 			MethodName: "GetTotalContractCount",
 			Handler:    _EnclaveProto_GetTotalContractCount_Handler,
 		},
+		{
+			MethodName: "GetBatchTxFees",
+			Handler:    _EnclaveProto_GetBatchTxFees_Handler,
+		},
+		{
+			MethodName: "GetPublicStorageAt",
+			Handler:    _EnclaveProto_GetPublicStorageAt_Handler,
+		},
 		{
 			MethodName: "EnclavePublicConfig",
 			Handler:    _EnclaveProto_EnclavePublicConfig_Handler,
@@ -1052,6 +1325,16 @@ var EnclaveProto_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportStateSnapshot",
+			Handler:       _EnclaveProto_ExportStateSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportStateSnapshot",
+			Handler:       _EnclaveProto_ImportStateSnapshot_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "StreamL2Updates",
 			Handler:       _EnclaveProto_StreamL2Updates_Handler,
@@ -21,7 +21,13 @@ const (
 	ERPCGetLogs                 = "ten_getLogs"
 	ERPCGetStorageAt            = "ten_getStorageAt"
 	ERPCDebugLogs               = "debug_eventLogRelevancy"
+	ERPCDebugTraceTransaction   = "debug_traceTransaction"
+	ERPCDebugTraceCall          = "debug_traceCall"
+	ERPCDebugTraceBatchByNumber = "debug_traceBatchByNumber"
 	ERPCGetPersonalTransactions = "scan_getPersonalTransactions"
+	ERPCGetPoolTransactions     = "ten_getPoolTransactions"
+	ERPCRevokeDelegation        = "ten_revokeDelegation"
+	ERPCGetSoftFinalityReceipt  = "ten_getSoftFinalityReceipt"
 )
 
 var encryptedMethods = []string{
@@ -37,7 +43,13 @@ var encryptedMethods = []string{
 	ERPCGetLogs,
 	ERPCGetStorageAt,
 	ERPCDebugLogs,
+	ERPCDebugTraceTransaction,
+	ERPCDebugTraceCall,
+	ERPCDebugTraceBatchByNumber,
 	ERPCGetPersonalTransactions,
+	ERPCGetPoolTransactions,
+	ERPCRevokeDelegation,
+	ERPCGetSoftFinalityReceipt,
 }
 
 // IsEncryptedMethod indicates whether the RPC method's requests and responses should be encrypted.
@@ -0,0 +1,48 @@
+package common
+
+import (
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// secretDistributionPrefix - makes sure a distribution can't be confused with a signature over a batch or rollup. The
+// management contract computes the same hash to verify the signature of the sequencer enclave
+const secretDistributionPrefix = "TEN secret distribution"
+
+// SecretDistribution - the secret of a new epoch, generated by a sequencer enclave from fresh entropy and encrypted with
+// the key of each attested enclave. It is published to the management contract, and the enclaves switch to the new
+// epoch when they process it.
+type SecretDistribution struct {
+	Epoch      uint64
+	AttesterID EnclaveID   // the sequencer enclave that generated the secret
+	EnclaveIDs []EnclaveID // the recipients
+	Secrets    [][]byte    // the secret, encrypted with the key of the recipient at the same index
+	Signature  []byte
+}
+
+// Hash - the payload signed by the sequencer enclave. Matches the hash chained over the recipients in the contract
+func (d *SecretDistribution) Hash() gethcommon.Hash {
+	epoch := make([]byte, 32)
+	new(big.Int).SetUint64(d.Epoch).FillBytes(epoch)
+	hash := crypto.Keccak256Hash([]byte(secretDistributionPrefix), epoch, d.AttesterID.Bytes())
+	for i, enclaveID := range d.EnclaveIDs {
+		var secret []byte
+		if i < len(d.Secrets) {
+			secret = d.Secrets[i]
+		}
+		hash = crypto.Keccak256Hash(hash.Bytes(), enclaveID.Bytes(), crypto.Keccak256(secret))
+	}
+	return hash
+}
+
+// SecretFor - the secret encrypted for the enclave, if it is one of the recipients
+func (d *SecretDistribution) SecretFor(enclaveID EnclaveID) ([]byte, bool) {
+	for i, id := range d.EnclaveIDs {
+		if id == enclaveID && i < len(d.Secrets) {
+			return d.Secrets[i], true
+		}
+	}
+	return nil, false
+}
@@ -20,6 +20,9 @@ import (
 const (
 	SigLength         = 65
 	SigRecoveryOffset = 27
+
+	// p2pEndorsementPrefix - makes sure an endorsement can't be confused with a signature over a batch or rollup
+	p2pEndorsementPrefix = "TEN P2P identity endorsement"
 )
 
 func VerifySignature(pubKey *ecdsa.PublicKey, hash, signature []byte) error {
@@ -75,3 +78,9 @@ func Sign(messageHash []byte, key *ecdsa.PrivateKey) ([]byte, error) {
 	sig[64] += SigRecoveryOffset
 	return sig, nil
 }
+
+// P2PEndorsementHash - the hash an enclave signs to endorse the public key and the public address its host uses on the
+// P2P network
+func P2PEndorsementHash(p2pPubKey []byte, p2pAddress string) gethcommon.Hash {
+	return crypto.Keccak256Hash([]byte(p2pEndorsementPrefix), crypto.Keccak256(p2pPubKey), []byte(p2pAddress))
+}
@@ -0,0 +1,30 @@
+package common
+
+import (
+	"encoding/binary"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// softFinalityReceiptPrefix - makes sure a receipt can't be confused with a signature over a batch or rollup
+const softFinalityReceiptPrefix = "TEN soft-finality receipt"
+
+// SoftFinalityReceipt - signed by the sequencer enclave for a transaction that is included in the batch with the given
+// sequence number, or that is executable in the mempool of the sequencer. For a pending transaction, the batch is the
+// one by which the sequencer aims to include it. This is best-effort: nothing forces the sequencer to include the
+// transaction by that batch, e.g. if the batches are full. The receipt only proves that the attested sequencer enclave
+// accepted the transaction as executable.
+type SoftFinalityReceipt struct {
+	TxHash     L2TxHash
+	BatchSeqNo uint64
+	EnclaveID  EnclaveID
+	Signature  []byte
+}
+
+// Hash - the payload signed by the sequencer enclave
+func (r *SoftFinalityReceipt) Hash() gethcommon.Hash {
+	seqNo := make([]byte, 8)
+	binary.BigEndian.PutUint64(seqNo, r.BatchSeqNo)
+	return crypto.Keccak256Hash([]byte(softFinalityReceiptPrefix), r.TxHash.Bytes(), seqNo, r.EnclaveID.Bytes())
+}
@@ -0,0 +1,50 @@
+package common
+
+import (
+	"encoding/binary"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// stateSnapshotPrefix - makes sure the signature over a snapshot can't be confused with any other enclave signature
+const stateSnapshotPrefix = "TEN state snapshot"
+
+// StateSnapshot - the state of the network at a canonical batch, exported by an enclave so that a new validator enclave
+// can start from it instead of replaying all the rollups since genesis.
+// The content of the snapshot is too large for a single message, so it is transferred separately in chunks, which
+// contain the trie nodes of the state, the canonical batches since genesis with their receipts and the system contract
+// addresses.
+// The chunks are encrypted with a key derived from the shared secret, so they can only be read by the enclaves of the
+// network, and the snapshot commits to them through `ChunksHash`.
+type StateSnapshot struct {
+	BatchSeqNo  uint64
+	BatchHash   L2BatchHash
+	StateRoot   StateRoot
+	Attestation *AttestationReport // the attestation of the enclave that exported the snapshot
+	Chunks      uint64
+	ChunksHash  gethcommon.Hash // see NextStateSnapshotChunksHash
+	Signature   []byte          // signed by the exporting enclave with the key of the attestation
+}
+
+// NextStateSnapshotChunksHash - returns the hash of the chunks of a snapshot up to `chunk`, given the hash of the
+// previous chunks. The hash of the first chunk is computed from the empty hash.
+func NextStateSnapshotChunksHash(prev gethcommon.Hash, chunk []byte) gethcommon.Hash {
+	return crypto.Keccak256Hash(prev.Bytes(), crypto.Keccak256(chunk))
+}
+
+// Hash - the payload signed by the exporting enclave
+func (s *StateSnapshot) Hash() gethcommon.Hash {
+	seqNo := make([]byte, 8)
+	binary.BigEndian.PutUint64(seqNo, s.BatchSeqNo)
+	chunks := make([]byte, 8)
+	binary.BigEndian.PutUint64(chunks, s.Chunks)
+	return crypto.Keccak256Hash(
+		[]byte(stateSnapshotPrefix),
+		seqNo,
+		s.BatchHash.Bytes(),
+		s.StateRoot.Bytes(),
+		chunks,
+		s.ChunksHash.Bytes(),
+	)
+}
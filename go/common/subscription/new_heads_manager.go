@@ -25,6 +25,7 @@ type NewHeadsService struct {
 	convertToEthHeader bool
 	notifiersMutex     *sync.RWMutex
 	newHeadNotifiers   map[rpc.ID]*rpc.Notifier
+	listeners          []func(*common.BatchHeader)
 	onMessage          func(*common.BatchHeader) error
 	stopped            *atomic.Bool
 	logger             gethlog.Logger
@@ -94,6 +95,10 @@ func (nhs *NewHeadsService) onNewBatch(head *common.BatchHeader) error {
 	nhs.notifiersMutex.Lock()
 	defer nhs.notifiersMutex.Unlock()
 
+	for _, listener := range nhs.listeners {
+		listener(head)
+	}
+
 	// for each new head, notify all registered subscriptions
 	for id, notifier := range nhs.newHeadNotifiers {
 		if nhs.stopped.Load() {
@@ -121,6 +126,14 @@ func (nhs *NewHeadsService) RegisterNotifier(notifier *rpc.Notifier, subscriptio
 	})
 }
 
+// AddListener - registers a callback for the new heads, for consumers that are not rpc subscriptions
+// The callback must not block
+func (nhs *NewHeadsService) AddListener(listener func(*common.BatchHeader)) {
+	nhs.notifiersMutex.Lock()
+	defer nhs.notifiersMutex.Unlock()
+	nhs.listeners = append(nhs.listeners, listener)
+}
+
 func (nhs *NewHeadsService) Stop() error {
 	nhs.stopped.Store(true)
 	return nil
@@ -1,87 +1,80 @@
-// // Package native: This file was copied/adapted from geth - go-ethereum/eth/tracers
-// //
-// //
-// // Copyright 2021 The go-ethereum Authors
-// // This file is part of the go-ethereum library.
-// //
-// // The go-ethereum library is free software: you can redistribute it and/or modify
-// // it under the terms of the GNU Lesser General Public License as published by
-// // the Free Software Foundation, either version 3 of the License, or
-// // (at your option) any later version.
-// //
-// // The go-ethereum library is distributed in the hope that it will be useful,
-// // but WITHOUT ANY WARRANTY; without even the implied warranty of
-// // MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
-// // GNU Lesser General Public License for more details.
-// //
-// // You should have received a copy of the GNU Lesser General Public License
-// // along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+// Package native: This file was copied/adapted from geth - go-ethereum/eth/tracers
 //
-// /*
-// Package native is a collection of tracers written in go.
 //
-// In order to add a native tracer and have it compiled into the binary, a new
-// file needs to be added to this folder, containing an implementation of the
-// `eth.tracers.Tracer` interface.
+// Copyright 2021 The go-ethereum Authors
+// This file is part of the go-ethereum library.
 //
-// Aside from implementing the tracer, it also needs to register itself, using the
-// `register` method -- and this needs to be done in the package initialization.
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
 //
-// Example:
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
 //
-// ```golang
-//
-//	func init() {
-//		register("noopTracerNative", newNoopTracer)
-//	}
-//
-// ```
-// */
-//
-// //nolint:gochecknoinits
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+/*
+Package native is a collection of tracers written in go.
+
+In order to add a native tracer and have it compiled into the binary, a new
+file needs to be added to this folder, containing an implementation of the
+`tracers.Tracer` hooks.
+
+Aside from implementing the tracer, it also needs to register itself in the
+`tracers.DefaultDirectory` during the package initialization.
+
+Example:
+
+```golang
+
+	func init() {
+		tracers.DefaultDirectory.Register("noopTracer", newNoopTracer)
+	}
+
+```
+*/
 package native
 
-//
-//import (
-//	"errors"
-//
-//	"github.com/ten-protocol/go-ten/go/common/tracers"
-//)
-//
-//// init registers itself this packages as a lookup for tracers.
-//func init() {
-//	tracers.RegisterLookup(false, lookup)
-//}
-//
-///*
-//ctors is a map of package-local tracer constructors.
-//
-//We cannot be certain about the order of init-functions within a package,
-//The go spec (https://golang.org/ref/spec#Package_initialization) says
-//
-//> To ensure reproducible initialization behavior, build systems
-//> are encouraged to present multiple files belonging to the same
-//> package in lexical file name order to a compiler.
-//
-//Hence, we cannot make the map in init, but must make it upon first use.
-//*/
-//var ctors map[string]func() tracers.Tracer
-//
-//// register is used by native tracers to register their presence.
-//func register(name string, ctor func() tracers.Tracer) {
-//	if ctors == nil {
-//		ctors = make(map[string]func() tracers.Tracer)
-//	}
-//	ctors[name] = ctor
-//}
-//
-//// lookup returns a tracer, if one can be matched to the given name.
-//func lookup(name string, _ *tracers.Context) (tracers.Tracer, error) {
-//	if ctors == nil {
-//		ctors = make(map[string]func() tracers.Tracer)
-//	}
-//	if ctor, ok := ctors[name]; ok {
-//		return ctor(), nil
-//	}
-//	return nil, errors.New("no tracer found")
-//}
+import (
+	"errors"
+	"fmt"
+)
+
+const memoryPadLimit = 1024 * 1024
+
+// getMemoryCopyPadded returns offset + size as a new slice.
+// It zero-pads the slice if it extends beyond memory bounds.
+func getMemoryCopyPadded(m []byte, offset, size int64) ([]byte, error) {
+	if offset < 0 || size < 0 {
+		return nil, errors.New("offset or size must not be negative")
+	}
+	length := int64(len(m))
+	if offset+size < length { // slice fully inside memory
+		return memoryCopy(m, offset, size), nil
+	}
+	paddingNeeded := offset + size - length
+	if paddingNeeded > memoryPadLimit {
+		return nil, fmt.Errorf("reached limit for padding memory slice: %d", paddingNeeded)
+	}
+	cpy := make([]byte, size)
+	if overlap := length - offset; overlap > 0 {
+		copy(cpy, m[offset:offset+overlap])
+	}
+	return cpy, nil
+}
+
+func memoryCopy(m []byte, offset, size int64) []byte {
+	if size == 0 {
+		return nil
+	}
+	if len(m) > int(offset) {
+		cpy := make([]byte, size)
+		copy(cpy, m[offset:offset+size])
+		return cpy
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package native
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+)
+
+// the native tracers must be available by name in the default directory
+func TestNativeTracersAreRegistered(t *testing.T) {
+	for _, name := range []string{"callTracer", "prestateTracer", "4byteTracer", "noopTracer"} {
+		tracer, err := tracers.DefaultDirectory.New(name, &tracers.Context{}, nil)
+		require.NoError(t, err, name)
+		require.NotNil(t, tracer.Hooks, name)
+	}
+
+	_, err := tracers.DefaultDirectory.New("function() { return 1 }", &tracers.Context{}, nil)
+	require.Error(t, err)
+}
+
+// the call tracer must produce a call frame from the evm hooks
+func TestCallTracerResult(t *testing.T) {
+	tracer, err := tracers.DefaultDirectory.New("callTracer", &tracers.Context{}, nil)
+	require.NoError(t, err)
+
+	from := common.HexToAddress("0x1")
+	to := common.HexToAddress("0x2")
+	tx := types.NewTx(&types.LegacyTx{To: &to, Gas: 21000, GasPrice: big.NewInt(1)})
+
+	tracer.OnTxStart(&tracing.VMContext{BlockNumber: big.NewInt(1)}, tx, from)
+	tracer.OnEnter(0, byte(vm.CALL), from, to, nil, 21000, big.NewInt(0))
+	tracer.OnExit(0, nil, 21000, nil, false)
+	tracer.OnTxEnd(&types.Receipt{GasUsed: 21000}, nil)
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	require.Contains(t, string(res), `"to":"0x0000000000000000000000000000000000000002"`)
+	require.Contains(t, string(res), `"type":"CALL"`)
+}
@@ -7,9 +7,12 @@ package tracers
 import (
 	"encoding/json"
 	"errors"
+	"math/big"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
 	gethlogger "github.com/ethereum/go-ethereum/eth/tracers/logger"
+	"github.com/ten-protocol/go-ten/go/common/gethapi"
 )
 
 // TraceConfig holds extra parameters to trace functions.
@@ -23,46 +26,70 @@ type TraceConfig struct {
 	TracerConfig json.RawMessage
 }
 
+// TraceCallConfig is the config for traceCall API. It holds one more
+// field to override the state for tracing.
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *gethapi.StateOverride
+}
+
+// TxTraceResult is the result of a single transaction trace.
+type TxTraceResult struct {
+	TxHash gethcommon.Hash `json:"txHash"`           // transaction hash
+	Result json.RawMessage `json:"result,omitempty"` // Trace results produced by the tracer
+	Error  string          `json:"error,omitempty"`  // Trace failure produced by the tracer
+}
+
 // Context contains some contextual infos for a transaction execution that is not
 // available from within the EVM object.
 type Context struct {
-	BlockHash gethcommon.Hash // Hash of the block the tx is contained within (zero if dangling tx or call)
-	TxIndex   int             // Index of the transaction within a block (zero if dangling tx or call)
-	TxHash    gethcommon.Hash // Hash of the transaction being traced (zero if dangling call)
+	BlockHash   gethcommon.Hash // Hash of the block the tx is contained within (zero if dangling tx or call)
+	BlockNumber *big.Int        // Number of the block the tx is contained within (zero if dangling tx or call)
+	TxIndex     int             // Index of the transaction within a block (zero if dangling tx or call)
+	TxHash      gethcommon.Hash // Hash of the transaction being traced (zero if dangling call)
 }
 
-// Tracer interface extends vm.EVMLogger and additionally
-// allows collecting the tracing result.
-type Tracer interface {
-	// vm.EVMLogger
-	GetResult() (json.RawMessage, error)
+// Tracer is the set of hooks and methods that must be exposed by a tracer
+// for it to be available through the RPC interface.
+type Tracer struct {
+	*tracing.Hooks
+	GetResult func() (json.RawMessage, error)
 	// Stop terminates execution of the tracer at the first opportune moment.
-	Stop(err error)
+	Stop func(err error)
 }
 
-type lookupFunc func(string, *Context) (Tracer, error)
+type ctorFn func(*Context, json.RawMessage) (*Tracer, error)
 
-var lookups []lookupFunc
+// DefaultDirectory is the collection of tracers bundled by default.
+// Unlike geth, TEN does not support JS tracers, because they would allow arbitrary code to run inside the enclave.
+var DefaultDirectory = directory{elems: make(map[string]ctorFn)}
 
-// RegisterLookup registers a method as a lookup for tracers, meaning that
-// users can invoke a named tracer through that lookup. If 'wildcard' is true,
-// then the lookup will be placed last. This is typically meant for interpreted
-// engines (js) which can evaluate dynamic user-supplied code.
-func RegisterLookup(wildcard bool, lookup lookupFunc) {
-	if wildcard {
-		lookups = append(lookups, lookup)
-	} else {
-		lookups = append([]lookupFunc{lookup}, lookups...)
-	}
+// directory provides functionality to lookup a tracer by name
+// and a function to instantiate it.
+type directory struct {
+	elems map[string]ctorFn
 }
 
-// New returns a new instance of a tracer, by iterating through the
-// registered lookups.
-func New(code string, ctx *Context) (Tracer, error) {
-	for _, lookup := range lookups {
-		if tracer, err := lookup(code, ctx); err == nil {
-			return tracer, nil
-		}
+// Register registers a method as a lookup for tracers, meaning that
+// users can invoke a named tracer through that lookup.
+func (d *directory) Register(name string, f ctorFn) {
+	d.elems[name] = f
+}
+
+// New returns a new instance of a tracer, by looking up the registered native tracers.
+func (d *directory) New(name string, ctx *Context, cfg json.RawMessage) (*Tracer, error) {
+	if ctor, ok := d.elems[name]; ok {
+		return ctor(ctx, cfg)
 	}
 	return nil, errors.New("tracer not found")
 }
+
+// NewStructLogger returns the default opcode level tracer, used when no named tracer is requested.
+func NewStructLogger(cfg *gethlogger.Config) *Tracer {
+	logger := gethlogger.NewStructLogger(cfg)
+	return &Tracer{
+		Hooks:     logger.Hooks(),
+		GetResult: logger.GetResult,
+		Stop:      logger.Stop,
+	}
+}
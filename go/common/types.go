@@ -103,6 +103,13 @@ const (
 	L2GenesisSeqNo            = uint64(1)
 	L2SysContractGenesisSeqNo = uint64(2)
 
+	// ForcedInclusionDelay - the number of L1 blocks after which an L2 transaction submitted through the management
+	// contract must be included in a batch. Batches that don't include a due transaction which can be executed are invalid.
+	ForcedInclusionDelay = uint64(32)
+	// ForcedInclusionExpiry - the number of L1 blocks after their deadline during which the forced transactions that were
+	// not included (e.g. because the batch was full or they could not be executed) remain due. They are dropped afterwards.
+	ForcedInclusionExpiry = uint64(32)
+
 	SyntheticTxGasLimit = params.MaxGasLimit
 )
 
@@ -19,6 +19,7 @@ type ViewingKey struct {
 	PublicKey               []byte              // ViewingKey public key in decrypt data from the enclave
 	SignatureWithAccountKey []byte              // ViewingKey public key signed by the Accounts Private key - Allows to retrieve the Account address
 	SignatureType           SignatureType       // Type of signature used to sign the public key
+	Delegation              *Delegation         // Optional - allows the viewing key to read the private data of another account
 }
 
 // RPCSignedViewingKey - used for transporting a minimalist viewing key via
@@ -29,6 +30,7 @@ type RPCSignedViewingKey struct {
 	PublicKey               []byte
 	SignatureWithAccountKey []byte
 	SignatureType           SignatureType
+	Delegation              *Delegation `json:",omitempty"`
 }
 
 const (
@@ -43,6 +45,9 @@ func (vk RPCSignedViewingKey) Validate() error {
 	if len(vk.SignatureWithAccountKey) != sigLen {
 		return fmt.Errorf("invalid viewing key signature")
 	}
+	if vk.Delegation != nil {
+		return vk.Delegation.Validate()
+	}
 	return nil
 }
 
@@ -0,0 +1,125 @@
+package viewingkey
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/status-im/keycard-go/hexutils"
+	"github.com/ten-protocol/go-ten/go/wallet"
+)
+
+const (
+	EIP712DelegationType      = "Delegation"
+	EIP712DelegatedViewingKey = "Viewing Key"
+	EIP712DelegationNotBefore = "Not Before"
+	EIP712DelegationNotAfter  = "Not After"
+)
+
+// Delegation - signed by an account to let the holder of another viewing key (e.g. an auditor) read the private data of
+// the account: receipts, logs and the results of calls made on its behalf.
+// The delegation is only valid during the [NotBefore, NotAfter] window, and it doesn't allow the delegate to transact.
+type Delegation struct {
+	Delegate  []byte // the user id of the viewing key that is granted access (see CalculateUserID)
+	NotBefore uint64 // unix timestamp in seconds
+	NotAfter  uint64 // unix timestamp in seconds
+	Signature []byte // EIP712 signature with the key of the delegating account
+}
+
+func (d *Delegation) Validate() error {
+	if len(d.Delegate) != UserIDLength {
+		return fmt.Errorf("invalid delegate")
+	}
+	if len(d.Signature) != sigLen {
+		return fmt.Errorf("invalid delegation signature")
+	}
+	if d.NotBefore > d.NotAfter {
+		return fmt.Errorf("invalid delegation window")
+	}
+	return nil
+}
+
+// IsActive - true if the time is within the delegation window
+func (d *Delegation) IsActive(t time.Time) bool {
+	now := uint64(t.Unix())
+	return d.NotBefore <= now && now <= d.NotAfter
+}
+
+// GenerateDelegationMessage - the EIP712 message signed by an account to delegate read access to the viewing key
+// with the `delegate` user id. It uses the same domain as the viewing key messages from GenerateMessage.
+func GenerateDelegationMessage(delegate []byte, notBefore uint64, notAfter uint64, chainID int64) ([]byte, error) {
+	if len(delegate) != UserIDLength {
+		return nil, fmt.Errorf("delegate must be %d bytes, received %d", UserIDLength, len(delegate))
+	}
+	message := map[string]interface{}{
+		EIP712DelegatedViewingKey: hexutils.BytesToHex(delegate),
+		EIP712DelegationNotBefore: strconv.FormatUint(notBefore, 10),
+		EIP712DelegationNotAfter:  strconv.FormatUint(notAfter, 10),
+	}
+
+	types := apitypes.Types{
+		EIP712Domain: eip712DomainTypes,
+		EIP712DelegationType: {
+			{Name: EIP712DelegatedViewingKey, Type: "address"},
+			{Name: EIP712DelegationNotBefore, Type: "uint256"},
+			{Name: EIP712DelegationNotAfter, Type: "uint256"},
+		},
+	}
+
+	return json.Marshal(apitypes.TypedData{
+		Types:       types,
+		PrimaryType: EIP712DelegationType,
+		Domain:      eip712Domain(chainID),
+		Message:     message,
+	})
+}
+
+// CheckDelegationSignature - checks the signature of the delegation and returns the address of the delegating account
+func CheckDelegationSignature(d *Delegation, chainID int64) (*gethcommon.Address, error) {
+	msg, err := GenerateDelegationMessage(d.Delegate, d.NotBefore, d.NotAfter, chainID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate delegation message. Cause %w", err)
+	}
+	msgHash, err := GetMessageHash(msg, EIP712Signature)
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate delegation message hash. Cause %w", err)
+	}
+
+	// wallets produce a V of 27/28, which has to be transformed to 0/1 to recover the address
+	signature := gethcommon.CopyBytes(d.Signature)
+	if signature[64] == 27 || signature[64] == 28 {
+		signature[64] -= 27
+	}
+
+	address, err := CheckSignatureAndReturnAccountAddress(msgHash, signature)
+	if err != nil {
+		return nil, fmt.Errorf("delegation signature verification failed. Cause %w", err)
+	}
+	return address, nil
+}
+
+// GenerateDelegation - the account of the wallet delegates read access to the viewing key during the time window
+func GenerateDelegation(wal wallet.Wallet, vk *ViewingKey, notBefore time.Time, notAfter time.Time) (*Delegation, error) {
+	d := &Delegation{
+		Delegate:  CalculateUserID(vk.PublicKey),
+		NotBefore: uint64(notBefore.Unix()),
+		NotAfter:  uint64(notAfter.Unix()),
+	}
+	msg, err := GenerateDelegationMessage(d.Delegate, d.NotBefore, d.NotAfter, wal.ChainID().Int64())
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate delegation message: %w", err)
+	}
+	msgHash, err := GetMessageHash(msg, EIP712Signature)
+	if err != nil {
+		return nil, err
+	}
+	d.Signature, err = crypto.Sign(msgHash, wal.PrivateKey())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign delegation: %w", err)
+	}
+	return d, nil
+}
@@ -144,22 +144,12 @@ func getBytesFromTypedData(typedData apitypes.TypedData) ([]byte, error) {
 func createTypedDataForEIP712Message(encryptionToken []byte, chainID int64) apitypes.TypedData {
 	hexToken := hexutils.BytesToHex(encryptionToken)
 
-	domain := apitypes.TypedDataDomain{
-		Name:    EIP712DomainNameValue,
-		Version: EIP712DomainVersionValue,
-		ChainId: (*math.HexOrDecimal256)(big.NewInt(chainID)),
-	}
-
 	message := map[string]interface{}{
 		EIP712EncryptionToken: hexToken,
 	}
 
 	types := apitypes.Types{
-		EIP712Domain: {
-			{Name: EIP712DomainName, Type: "string"},
-			{Name: EIP712DomainVersion, Type: "string"},
-			{Name: EIP712DomainChainID, Type: "uint256"},
-		},
+		EIP712Domain: eip712DomainTypes,
 		EIP712Type: {
 			{Name: EIP712EncryptionToken, Type: "address"},
 		},
@@ -168,12 +158,27 @@ func createTypedDataForEIP712Message(encryptionToken []byte, chainID int64) apit
 	typedData := apitypes.TypedData{
 		Types:       types,
 		PrimaryType: EIP712Type,
-		Domain:      domain,
+		Domain:      eip712Domain(chainID),
 		Message:     message,
 	}
 	return typedData
 }
 
+var eip712DomainTypes = []apitypes.Type{
+	{Name: EIP712DomainName, Type: "string"},
+	{Name: EIP712DomainVersion, Type: "string"},
+	{Name: EIP712DomainChainID, Type: "uint256"},
+}
+
+// eip712Domain - the domain shared by all the EIP712 messages signed by TEN users
+func eip712Domain(chainID int64) apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
+		Name:    EIP712DomainNameValue,
+		Version: EIP712DomainVersionValue,
+		ChainId: (*math.HexOrDecimal256)(big.NewInt(chainID)),
+	}
+}
+
 // CalculateUserIDHex CalculateUserID calculates userID from a public key
 // (we truncate it, because we want it to have length 20) and encode to hex strings
 func CalculateUserIDHex(publicKeyBytes []byte) string {
@@ -28,6 +28,11 @@ type EnclaveDB struct {
 	// SqliteDBPath is the filepath for the sqlite DB persistence file (can be empty if a throwaway file in /tmp/ is acceptable or
 	// if using InMemory DB or if attestation is enabled).
 	SqlitePath string `mapstructure:"sqlitePath"`
+	// StateRetention is either `archive`, to keep the state of every batch, or `pruned`, to keep only the state of the
+	// recent batches and of the batches that end a rollup.
+	StateRetention string `mapstructure:"stateRetention"`
+	// RetainedBatches is the number of recent batches whose state is kept when the state is pruned.
+	RetainedBatches uint64 `mapstructure:"retainedBatches"`
 }
 
 // EnclaveDebug contains the configuration for the enclave debug.
@@ -9,6 +9,7 @@ type HostConfig struct {
 	DB      *HostDB      `mapstructure:"db"`
 	Debug   *HostDebug   `mapstructure:"debug"`
 	Enclave *HostEnclave `mapstructure:"enclave"`
+	Fraud   *HostFraud   `mapstructure:"fraud"`
 	L1      *HostL1      `mapstructure:"l1"`
 	Log     *HostLog     `mapstructure:"log"`
 	P2P     *HostP2P     `mapstructure:"p2p"`
@@ -45,6 +46,9 @@ type HostRPC struct {
 	HTTPPort   uint64 `mapstructure:"httpPort"`
 	EnableWS   bool   `mapstructure:"enableWS"`
 	WSPort     uint64 `mapstructure:"wsPort"`
+	// EnableBlobArchive serves the blobs archived by the host on the HTTP port, through the beacon API blob sidecars
+	// endpoint, so that other nodes can use this node as their blob archive
+	EnableBlobArchive bool `mapstructure:"enableBlobArchive"`
 }
 
 // HostP2P contains the configuration for the host P2P server.
@@ -81,6 +85,16 @@ type HostEnclave struct {
 	RPCTimeout   time.Duration `mapstructure:"rpcTimeout"`
 }
 
+// HostFraud contains the configuration for the handling of the fraud reports by the host.
+//
+//	yaml: `host.fraud`
+type HostFraud struct {
+	// ResumeSequencers are the sequencer enclave IDs whose halt is lifted when the host starts, once the operator has
+	// established that the fraud reports were wrong (e.g. caused by a validator bug). The reports seen so far no longer
+	// count towards the quorum.
+	ResumeSequencers []string `mapstructure:"resumeSequencers"`
+}
+
 // HostDebug contains the configuration for the host's debug settings.
 //
 //	yaml: `host.debug`
@@ -47,6 +47,16 @@ type GasConfig struct {
 	PaymentAddress      gethcommon.Address `mapstructure:"paymentAddress"`
 	BatchExecutionLimit uint64             `mapstructure:"batchExecutionLimit"`
 	LocalExecutionCap   uint64             `mapstructure:"localExecutionCap"`
+	// BatchTarget is the gas used by a batch above which the base fee of the next batch increases (0 for a static base fee)
+	BatchTarget uint64 `mapstructure:"batchTarget"`
+	// BaseFeeActivationHeight is the batch height from which the base fee is adjusted, the previous batches have the static base fee
+	BaseFeeActivationHeight uint64 `mapstructure:"baseFeeActivationHeight"`
+	// OracleActivationHeight is the batch height from which the GasOracle system contract is installed and receives the
+	// L1 prices of every batch. The batches below this height are executed without it
+	OracleActivationHeight uint64 `mapstructure:"oracleActivationHeight"`
+	// BlobCostActivationHeight is the batch height from which the transactions are charged the cheapest of the calldata
+	// and blob costs of publishing them to the L1. The previous batches were charged the calldata cost
+	BlobCostActivationHeight uint64 `mapstructure:"blobCostActivationHeight"`
 }
 
 // L1Config contains config about the L1 network that the Ten network is rolling up to
@@ -82,6 +92,11 @@ type RollupConfig struct {
 	// a protocol limit, but a miner imposed limit and it might be hard to find someone
 	// to include a transaction if it goes above it
 	MaxSize uint64 `mapstructure:"maxSize"`
+	// RevelationPeriod is the number of L1 blocks in a revelation period. The rollup data of each period is encrypted
+	// with its own key, which is revealed once the period is over. Zero disables the revelation of the keys.
+	RevelationPeriod uint64 `mapstructure:"revelationPeriod"`
+	// RevelationDelay is the number of L1 blocks after the end of a revelation period before its key is revealed
+	RevelationDelay uint64 `mapstructure:"revelationDelay"`
 }
 
 // Sequencer contains the configuration for how the L2 sequencer will operate for the Ten network
@@ -91,6 +106,9 @@ type Sequencer struct {
 	// P2PAddress is the address that the sequencer will listen on for incoming P2P connections
 	P2PAddress              string             `mapstructure:"p2pAddress"`
 	SystemContractsUpgrader gethcommon.Address `mapstructure:"systemContractsUpgrader"`
+	// FraudReportQuorum is the number of distinct validator enclaves whose fraud reports, published to the L1, halt a
+	// sequencer. A host whose own enclave detects the divergence halts the sequencer regardless
+	FraudReportQuorum uint64 `mapstructure:"fraudReportQuorum"`
 }
 
 // CrossChainConfig contains the configuration for the cross chain processing on the Ten network
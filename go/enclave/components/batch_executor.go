@@ -48,6 +48,17 @@ import (
 
 var ErrNoTransactionsToProcess = fmt.Errorf("no transactions to process")
 
+// BatchDivergenceError - returned when the batch computed by a validator from the transactions of a batch signed by
+// the sequencer does not match that batch
+type BatchDivergenceError struct {
+	Incoming *common.BatchHeader
+	Computed *common.BatchHeader
+}
+
+func (e *BatchDivergenceError) Error() string {
+	return fmt.Sprintf("batch is in invalid state. Incoming hash: %s  Computed hash: %s", e.Incoming.Hash(), e.Computed.Hash())
+}
+
 // batchExecutor - the component responsible for executing batches
 type batchExecutor struct {
 	storage                storage.Storage
@@ -55,6 +66,7 @@ type batchExecutor struct {
 	config                 enclaveconfig.EnclaveConfig
 	gethEncodingService    gethencoding.EncodingService
 	crossChainProcessors   *crosschain.Processors
+	forcedInclusion        *ForcedInclusion
 	dataCompressionService compression.DataCompressionService
 	genesis                *genesis.Genesis
 	logger                 gethlog.Logger
@@ -76,6 +88,7 @@ func NewBatchExecutor(
 	config enclaveconfig.EnclaveConfig,
 	gethEncodingService gethencoding.EncodingService,
 	cc *crosschain.Processors,
+	forcedInclusion *ForcedInclusion,
 	genesis *genesis.Genesis,
 	gasOracle gas.Oracle,
 	chainConfig *params.ChainConfig,
@@ -91,6 +104,7 @@ func NewBatchExecutor(
 		config:                 config,
 		gethEncodingService:    gethEncodingService,
 		crossChainProcessors:   cc,
+		forcedInclusion:        forcedInclusion,
 		genesis:                genesis,
 		chainConfig:            chainConfig,
 		logger:                 logger,
@@ -127,7 +141,10 @@ func (executor *batchExecutor) ComputeBatch(ctx context.Context, ec *BatchExecut
 		return executor.execResult(ec)
 	}
 
-	// Step 1: execute the transactions included in the batch or pending in the mempool
+	// the prices of the L1 block are published before any transaction is executed, so all of them can read them
+	executor.systemContracts.UpdateGasOracle(ec.stateDB, ec.currentBatch.NumberU64(), executor.gasOracle.L1Prices(ec.l1block, ec.currentBatch.NumberU64()))
+
+	// Step 1: execute the due forced transactions, followed by the transactions included in the batch or pending in the mempool
 	if err := executor.execBatchTransactions(ec); err != nil {
 		return nil, err
 	}
@@ -185,6 +202,7 @@ func (executor *batchExecutor) verifyContext(ec *BatchExecutionContext) error {
 		return fmt.Errorf("failed to retrieve parent batch %s. Cause: %w", ec.ParentPtr, err)
 	}
 	ec.parentBatch = parentBatch
+	ec.baseFee = common.CalcNextBaseFee(parentBatch, executor.config.GasBatchTarget, executor.config.BaseFee, executor.config.GasBaseFeeActivationHeight)
 
 	parentBlock := block
 	if parentBatch.L1Proof != block.Hash() {
@@ -203,7 +221,7 @@ func (executor *batchExecutor) verifyContext(ec *BatchExecutionContext) error {
 func (executor *batchExecutor) prepareState(ec *BatchExecutionContext) error {
 	var err error
 	// Create a new batch based on the provided context
-	ec.currentBatch = core.DeterministicEmptyBatch(ec.parentBatch, ec.l1block, ec.AtTime, ec.SequencerNo, ec.BaseFee, ec.Creator)
+	ec.currentBatch = core.DeterministicEmptyBatch(ec.parentBatch, ec.l1block, ec.AtTime, ec.SequencerNo, ec.baseFee, ec.Creator)
 	ec.stateDB, err = executor.batchRegistry.GetBatchState(ec.ctx, rpc.BlockNumberOrHash{BlockHash: &ec.currentBatch.Header.ParentHash})
 	if err != nil {
 		return fmt.Errorf("could not create stateDB. Cause: %w", err)
@@ -246,6 +264,9 @@ func (executor *batchExecutor) handleSysContractGenesis(ec *BatchExecutionContex
 	if err = executor.verifySyntheticTransactionsSuccess(transactions, sysCtrGenesisResult); err != nil {
 		return fmt.Errorf("batch computation failed due to system deployer reverting. Cause: %w", err)
 	}
+	if system.IsGasOracleActive(ec.currentBatch.NumberU64(), executor.config.GasOracleActivationHeight) {
+		system.DeployGasOracle(ec.stateDB)
+	}
 
 	ec.genesisSysCtrResult = sysCtrGenesisResult
 	ec.genesisSysCtrResult.MarkSynthetic(true)
@@ -266,7 +287,7 @@ func (executor *batchExecutor) toPricedTx(ec *BatchExecutionContext, tx *common.
 	}
 	accBalance := ec.stateDB.GetBalance(*sender)
 
-	cost, err := executor.gasOracle.EstimateL1StorageGasCost(tx, block)
+	cost, err := executor.gasOracle.EstimateL1StorageGasCost(tx, block, ec.currentBatch.NumberU64())
 	if err != nil {
 		executor.logger.Error("Unable to get gas cost for tx. Should not happen at this point.", log.TxKey, tx.Hash(), log.ErrKey, err)
 		return nil, fmt.Errorf("unable to get gas cost for tx. Cause: %w", err)
@@ -284,22 +305,84 @@ func (executor *batchExecutor) toPricedTx(ec *BatchExecutionContext, tx *common.
 }
 
 func (executor *batchExecutor) execBatchTransactions(ec *BatchExecutionContext) error {
+	sizeLimiter := limiters.NewBatchSizeLimiter(executor.config.MaxBatchSize, executor.dataCompressionService)
+	if err := executor.execForcedTransactions(ec, sizeLimiter); err != nil {
+		return err
+	}
 	if ec.UseMempool {
-		return executor.execMempoolTransactions(ec)
+		return executor.execMempoolTransactions(ec, sizeLimiter)
 	}
 	return executor.executeExistingBatch(ec)
 }
 
-func (executor *batchExecutor) execMempoolTransactions(ec *BatchExecutionContext) error {
-	sizeLimiter := limiters.NewBatchSizeLimiter(executor.config.MaxBatchSize, executor.dataCompressionService)
-	pendingTransactions := executor.mempool.PendingTransactions()
+// execForcedTransactions - the transactions submitted through the management contract which are due in this batch are
+// executed at the start of the batch. They are subject to the same size limit as the other transactions, and a
+// transaction that doesn't fit or can't be executed (e.g. because of its nonce or the balance of the sender) is skipped
+// until it expires.
+// When validating a batch, the batch must start with the due transactions that fit and can be executed, otherwise it
+// is invalid.
+func (executor *batchExecutor) execForcedTransactions(ec *BatchExecutionContext, sizeLimiter limiters.BatchSizeLimiter) error {
+	dueTxs, err := executor.forcedInclusion.DueTransactions(ec.ctx, ec.parentBatch, ec.parentL1Block, ec.l1block)
+	if err != nil {
+		return err
+	}
+
+	results := make(core.TxExecResults, 0, len(dueTxs))
+	for _, tx := range dueTxs {
+		included := !ec.UseMempool && len(ec.Transactions) > len(results) && ec.Transactions[len(results)].Hash() == tx.Hash()
+
+		if err := sizeLimiter.AcceptTransaction(tx); err != nil {
+			if !errors.Is(err, limiters.ErrInsufficientSpace) {
+				return fmt.Errorf("failed to apply the batch limiter. Cause: %w", err)
+			}
+			if included {
+				return fmt.Errorf("batch includes forced transaction %s which exceeds the batch size", tx.Hash())
+			}
+			executor.logger.Info("Deferring forced transaction which doesn't fit in the batch", log.TxKey, tx.Hash())
+			continue
+		}
+
+		snap := ec.stateDB.Snapshot()
+		var txResult *core.TxExecResult
+		pTx, err := executor.toPricedTx(ec, tx)
+		switch {
+		case err == nil:
+			txResult, err = executor.executeTx(ec, pTx, len(results), false)
+			if err != nil {
+				return fmt.Errorf("could not process forced transaction. Cause: %w", err)
+			}
+		case !errors.Is(err, ErrLowBalance):
+			return fmt.Errorf("unable to transform forced transaction to priced tx. Cause: %w", err)
+		}
+		executable := txResult != nil && txResult.Err == nil
+
+		switch {
+		case executable && (ec.UseMempool || included):
+			results = append(results, txResult)
+		case executable:
+			return fmt.Errorf("%w: %s", ErrForcedTxNotIncluded, tx.Hash())
+		case included:
+			return fmt.Errorf("batch includes forced transaction %s which can't be executed", tx.Hash())
+		default:
+			ec.stateDB.RevertToSnapshot(snap)
+			executor.logger.Info("Skipping forced transaction which can't be executed", log.TxKey, tx.Hash())
+		}
+	}
+
+	ec.batchTxResults = results
+	return nil
+}
+
+// execMempoolTransactions - fills the space left by the forced transactions with the pending transactions of the mempool
+func (executor *batchExecutor) execMempoolTransactions(ec *BatchExecutionContext, sizeLimiter limiters.BatchSizeLimiter) error {
+	pendingTransactions := executor.mempool.PendingTransactions(ec.currentBatch.Header.BaseFee)
 
 	nrPending, nrQueued := executor.mempool.Stats()
 	executor.logger.Debug(fmt.Sprintf("Mempool pending txs: %d. Queued: %d", nrPending, nrQueued))
 
 	mempoolTxs := newTransactionsByPriceAndNonce(nil, pendingTransactions, ec.currentBatch.Header.BaseFee)
 
-	results := make(core.TxExecResults, 0)
+	results := ec.batchTxResults
 
 	for {
 		// If we don't have enough gas for any further transactions then we're done.
@@ -374,19 +457,21 @@ func (executor *batchExecutor) execMempoolTransactions(ec *BatchExecutionContext
 }
 
 func (executor *batchExecutor) executeExistingBatch(ec *BatchExecutionContext) error {
-	transactionsToProcess := make(common.L2PricedTransactions, len(ec.Transactions))
+	// the forced transactions at the start of the batch were already executed
+	remainingTxs := ec.Transactions[len(ec.batchTxResults):]
+	transactionsToProcess := make(common.L2PricedTransactions, len(remainingTxs))
 	var err error
-	for i, tx := range ec.Transactions {
+	for i, tx := range remainingTxs {
 		transactionsToProcess[i], err = executor.toPricedTx(ec, tx)
 		if err != nil {
 			return fmt.Errorf("unable to transform to priced tx. Cause: %w", err)
 		}
 	}
-	txResults, err := executor.executeTxs(ec, 0, transactionsToProcess, false)
+	txResults, err := executor.executeTxs(ec, len(ec.batchTxResults), transactionsToProcess, false)
 	if err != nil {
 		return fmt.Errorf("could not process transactions. Cause: %w", err)
 	}
-	ec.batchTxResults = txResults
+	ec.batchTxResults = append(ec.batchTxResults, txResults...)
 	ec.stateDB.Finalise(true)
 	return nil
 }
@@ -517,8 +602,7 @@ func (executor *batchExecutor) execResult(ec *BatchExecutionContext) (*ComputedB
 		if err != nil {
 			return gethcommon.Hash{}, fmt.Errorf("commit failure for batch %d. Cause: %w", ec.currentBatch.SeqNo(), err)
 		}
-		trieDB := executor.storage.TrieDB()
-		err = trieDB.Commit(h, false)
+		err = executor.storage.CommitBatchState(ec.currentBatch.SeqNo().Uint64(), h)
 
 		// When system contract deployment genesis batch is committed, initialize executor's addresses for the hooks.
 		// Further restarts will call into Load() which will take the receipts for batch number 2 (which should never be deleted)
@@ -545,6 +629,12 @@ func (executor *batchExecutor) createBatch(ec *BatchExecutionContext) (*core.Bat
 	batch := *ec.currentBatch
 	batch.Header.Root = ec.stateDB.IntermediateRoot(false)
 	batch.Transactions = ec.batchTxResults.BatchTransactions()
+	// the execution gas used by the transactions of the batch determines the base fee of the next batch. The gas charged
+	// for publishing the transactions on the L1 and the synthetic transactions don't consume the batch gas limit, so
+	// they are not included
+	if common.IsDynamicBaseFee(batch.NumberU64(), executor.config.GasBatchTarget, executor.config.GasBaseFeeActivationHeight) {
+		batch.Header.GasUsed = ec.txsGasUsed
+	}
 	batch.ResetHash()
 
 	txReceipts := ec.batchTxResults.Receipts()
@@ -593,16 +683,14 @@ func (executor *batchExecutor) ExecuteBatch(ctx context.Context, batch *core.Bat
 		ChainConfig:  executor.chainConfig,
 		SequencerNo:  batch.Header.SequencerOrderNo,
 		Creator:      batch.Header.Coinbase,
-		BaseFee:      batch.Header.BaseFee,
 	}, false) // this execution is not used when first producing a batch, we never want to fail for empty batches
 	if err != nil {
 		return nil, fmt.Errorf("failed computing batch %s. Cause: %w", batch.Hash(), err)
 	}
 
 	if cb.Batch.Hash() != batch.Hash() {
-		// todo @stefan - generate a validator challenge here and return it
 		executor.logger.Error(fmt.Sprintf("Error validating batch. Calculated: %+v    Incoming: %+v", cb.Batch.Header, batch.Header))
-		return nil, fmt.Errorf("batch is in invalid state. Incoming hash: %s  Computed hash: %s", batch.Hash(), cb.Batch.Hash())
+		return nil, &BatchDivergenceError{Incoming: batch.Header, Computed: cb.Batch.Header}
 	}
 
 	if _, err := cb.Commit(true); err != nil {
@@ -727,6 +815,7 @@ func (executor *batchExecutor) executeTx(ec *BatchExecutionContext, tx *common.L
 	before := ethHeader.MixDigest
 	ethHeader.MixDigest = executor.entropyService.TxEntropy(before.Bytes(), offset)
 
+	gasBefore := *ec.usedGas
 	// if the tx fails, it handles the revert
 	txResult := evm.ExecuteTransaction(
 		tx,
@@ -740,6 +829,10 @@ func (executor *batchExecutor) executeTx(ec *BatchExecutionContext, tx *common.L
 		offset,
 		executor.logger,
 	)
+	if !noBaseFee {
+		// the synthetic transactions are executed without a base fee
+		ec.txsGasUsed += *ec.usedGas - gasBefore
+	}
 
 	if txResult.Err == nil {
 		// populate the derived fields in the receipt
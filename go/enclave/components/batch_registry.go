@@ -240,7 +240,8 @@ func (br *batchRegistry) GetBatchAtHeight(ctx context.Context, height gethrpc.Bl
 			return nil, fmt.Errorf("could not retrieve genesis rollup. Cause: %w", err)
 		}
 		batch = genesisBatch
-	// note: our API currently treats all these block statuses the same for obscuro batches
+	// note: the enclave does not track the L1 finality of the rollups, so the safe and finalized tags are resolved to
+	// batch numbers by the host (see the ten gateway) and are treated as the head here
 	case gethrpc.SafeBlockNumber, gethrpc.FinalizedBlockNumber, gethrpc.LatestBlockNumber, gethrpc.PendingBlockNumber:
 		headBatch, err := br.storage.FetchBatchBySeqNo(ctx, br.HeadBatchSeq().Uint64())
 		if err != nil {
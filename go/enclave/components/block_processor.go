@@ -28,6 +28,7 @@ type l1BlockProcessor struct {
 	gasOracle            gas.Oracle
 	logger               gethlog.Logger
 	crossChainProcessors *crosschain.Processors
+	forcedInclusion      *ForcedInclusion
 
 	// we store the l1 head to avoid expensive db access
 	// the host is responsible to always submitting the head l1 block
@@ -36,7 +37,7 @@ type l1BlockProcessor struct {
 	lastIngestedBlock *async.Timestamp
 }
 
-func NewBlockProcessor(storage storage.Storage, cc *crosschain.Processors, gasOracle gas.Oracle, logger gethlog.Logger) L1BlockProcessor {
+func NewBlockProcessor(storage storage.Storage, cc *crosschain.Processors, forcedInclusion *ForcedInclusion, gasOracle gas.Oracle, logger gethlog.Logger) L1BlockProcessor {
 	var l1BlockHash *common.L1BlockHash
 	head, err := storage.FetchHeadBlock(context.Background())
 	if err != nil {
@@ -53,6 +54,7 @@ func NewBlockProcessor(storage storage.Storage, cc *crosschain.Processors, gasOr
 		logger:               logger,
 		gasOracle:            gasOracle,
 		crossChainProcessors: cc,
+		forcedInclusion:      forcedInclusion,
 		currentL1Head:        l1BlockHash,
 		healthTimeout:        time.Minute,
 		lastIngestedBlock:    async.NewAsyncTimestamp(time.Now().Add(-time.Minute)),
@@ -78,6 +80,11 @@ func (bp *l1BlockProcessor) Process(ctx context.Context, processed *common.Proce
 		if err != nil {
 			return nil, fmt.Errorf("failed to process cross chain transfers. Cause: %w", err)
 		}
+
+		err = bp.forcedInclusion.StoreForcedTransactions(ctx, processed)
+		if err != nil {
+			return nil, fmt.Errorf("failed to process forced transactions. Cause: %w", err)
+		}
 	}
 
 	// todo @siliev - not sure if this is the best way to update the price, will pick up random stale blocks from forks?
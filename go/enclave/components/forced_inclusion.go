@@ -0,0 +1,199 @@
+package components
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+var (
+	ErrForcedTxNotIncluded = errors.New("batch does not include a due forced transaction")
+
+	// the non-indexed arguments of the ForcedTransactionSubmitted event
+	forcedTxEventArgs = crosschain.MgmtContractABI.Events["ForcedTransactionSubmitted"].Inputs.NonIndexed()
+)
+
+// ForcedInclusion - the escape hatch that allows users to get their transactions executed when the sequencer censors
+// them or is offline.
+// Users submit their L2 transactions, encrypted with the RPC key, to the management contract. The enclaves extract them
+// from the L1 blocks, and every batch must include the transactions that reach their deadline
+// (common.ForcedInclusionDelay L1 blocks after submission) unless they can't be executed.
+type ForcedInclusion struct {
+	mgmtContractAddr *gethcommon.Address
+	rpcKeyService    *crypto.RPCKeyService
+	storage          storage.Storage
+	chainID          int64
+	logger           gethlog.Logger
+}
+
+func NewForcedInclusion(mgmtContractAddr *gethcommon.Address, rpcKeyService *crypto.RPCKeyService, storage storage.Storage, chainID int64, logger gethlog.Logger) *ForcedInclusion {
+	return &ForcedInclusion{
+		mgmtContractAddr: mgmtContractAddr,
+		rpcKeyService:    rpcKeyService,
+		storage:          storage,
+		chainID:          chainID,
+		logger:           logger,
+	}
+}
+
+// StoreForcedTransactions - extracts the forced transactions from the management contract events of the L1 block, and
+// stores the ones that can be decrypted and are signed for the TEN network. The others are ignored.
+// The transactions are decrypted with the key of the secret epoch in effect at the L1 block, so all the enclaves reach
+// the same result regardless of when they process the block.
+func (fi *ForcedInclusion) StoreForcedTransactions(ctx context.Context, processed *common.ProcessedL1Data) error {
+	events := processed.GetEvents(common.ForcedTransactionTx)
+	if len(events) == 0 {
+		return nil
+	}
+	epoch, err := fi.epochAt(ctx, processed.BlockHeader.Number.Uint64())
+	if err != nil {
+		return err
+	}
+
+	var txs []*common.L2Tx
+	for _, txData := range events {
+		for _, l := range txData.Receipt.Logs {
+			if l.Address != *fi.mgmtContractAddr || len(l.Topics) != 2 || l.Topics[0] != crosschain.ForcedTransactionSubmittedID {
+				continue
+			}
+			tx, err := fi.decodeForcedTx(l.Data, epoch)
+			// the validity of the transaction can't be decided without the key, so it is not ignored
+			if errors.Is(err, crypto.ErrUnknownSecretEpoch) {
+				return fmt.Errorf("could not decrypt forced transaction of L1 tx %s. Cause: %w", txData.Transaction.Hash(), err)
+			}
+			if err != nil {
+				fi.logger.Info("Ignoring invalid forced transaction", "l1_tx", txData.Transaction.Hash(), log.ErrKey, err)
+				continue
+			}
+			fi.logger.Info("Forced transaction submitted", log.TxKey, tx.Hash(), "l1_tx", txData.Transaction.Hash())
+			txs = append(txs, tx)
+		}
+	}
+	if len(txs) == 0 {
+		return nil
+	}
+	return fi.storage.StoreForcedTransactions(ctx, processed.BlockHeader.Hash(), txs)
+}
+
+// epochAt - returns the secret epoch in effect at the L1 block with the height. The secret rotations are processed after
+// the forced transactions of their block, so an epoch is in effect from the block after the one that distributed it.
+func (fi *ForcedInclusion) epochAt(ctx context.Context, height uint64) (uint64, error) {
+	epoch := crypto.GenesisSecretEpoch
+	for {
+		rotationHeight, err := fi.storage.FetchSecretEpochL1Height(ctx, epoch+1)
+		if errors.Is(err, errutil.ErrNotFound) {
+			return epoch, nil
+		}
+		if err != nil {
+			return 0, fmt.Errorf("could not fetch the L1 height of secret epoch %d. Cause: %w", epoch+1, err)
+		}
+		if rotationHeight >= height {
+			return epoch, nil
+		}
+		epoch++
+	}
+}
+
+func (fi *ForcedInclusion) decodeForcedTx(data []byte, epoch uint64) (*common.L2Tx, error) {
+	args, err := forcedTxEventArgs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode event. Cause: %w", err)
+	}
+	encryptedTx, ok := args[0].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected event data")
+	}
+	// users may have encrypted the transaction with the key of the previous epoch, as for the RPC requests
+	encodedTx, err := fi.rpcKeyService.DecryptForEpoch(epoch, encryptedTx)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt transaction with the key of epoch %d. Cause: %w", epoch, err)
+	}
+	tx := new(common.L2Tx)
+	if err := tx.UnmarshalBinary(encodedTx); err != nil {
+		return nil, fmt.Errorf("could not decode transaction. Cause: %w", err)
+	}
+	if tx.ChainId().Int64() != fi.chainID {
+		return nil, fmt.Errorf("transaction signed for chain %d", tx.ChainId())
+	}
+	if _, err := core.GetAuthenticatedSender(fi.chainID, tx); err != nil {
+		return nil, fmt.Errorf("could not recover the sender. Cause: %w", err)
+	}
+	return tx, nil
+}
+
+// DueTransactions - returns the forced transactions that are due in a batch with the L1 proof `block` extending
+// `parentBatch`, which has the L1 proof `parentBlock`, in the order of their submission, and that were not included in
+// a canonical batch up to the parent yet.
+// A transaction is due from its deadline until it expires.
+func (fi *ForcedInclusion) DueTransactions(ctx context.Context, parentBatch *common.BatchHeader, parentBlock *types.Header, block *types.Header) ([]*common.L2Tx, error) {
+	height := block.Number.Uint64()
+	parentHeight := parentBlock.Number.Uint64()
+	if height < common.ForcedInclusionDelay || height <= parentHeight {
+		return nil, nil
+	}
+	// the transactions that expired with the parent proof are no longer due
+	fromHeight := uint64(0)
+	if parentHeight >= common.ForcedInclusionDelay+common.ForcedInclusionExpiry {
+		fromHeight = parentHeight - common.ForcedInclusionDelay - common.ForcedInclusionExpiry + 1
+	}
+	toHeight := height - common.ForcedInclusionDelay
+
+	forcedTxs, err := fi.storage.FetchCanonicalForcedTransactions(ctx, fromHeight, toHeight)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch forced transactions. Cause: %w", err)
+	}
+
+	due := make([]*common.L2Tx, 0, len(forcedTxs))
+	seen := make(map[gethcommon.Hash]bool)
+	for _, tx := range forcedTxs {
+		// the same transaction can be submitted multiple times
+		if seen[tx.Hash()] {
+			continue
+		}
+		seen[tx.Hash()] = true
+
+		included, err := fi.isIncluded(ctx, tx.Hash(), parentBatch)
+		if err != nil {
+			return nil, err
+		}
+		if !included {
+			due = append(due, tx)
+		}
+	}
+	return due, nil
+}
+
+// isIncluded - true if the transaction was included by the sequencer in a canonical batch up to `parentBatch`. The
+// transactions of the batches that were reorged out, or that come after the parent, are due again.
+func (fi *ForcedInclusion) isIncluded(ctx context.Context, txHash gethcommon.Hash, parentBatch *common.BatchHeader) (bool, error) {
+	_, batchHash, batchHeight, _, err := fi.storage.GetTransaction(ctx, txHash)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("could not read transaction %s. Cause: %w", txHash, err)
+	}
+	if batchHeight > parentBatch.Number.Uint64() {
+		return false, nil
+	}
+	batch, err := fi.storage.FetchBatchHeader(ctx, batchHash)
+	if err != nil {
+		return false, fmt.Errorf("could not read batch %s of transaction %s. Cause: %w", batchHash, txHash, err)
+	}
+	canonical, err := fi.storage.IsBatchCanonical(ctx, batch.SequencerOrderNo.Uint64())
+	if err != nil {
+		return false, fmt.Errorf("could not check batch %s of transaction %s. Cause: %w", batchHash, txHash, err)
+	}
+	return canonical, nil
+}
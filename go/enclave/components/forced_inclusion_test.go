@@ -0,0 +1,276 @@
+package components
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/ecies"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+const testChainID = 443
+
+var testMgmtContract = gethcommon.HexToAddress("0x1234")
+
+// forcedTxStorage - stores the forced transactions in memory, the other storage methods are not used by the tests
+type forcedTxStorage struct {
+	storage.Storage
+	forcedTxs     map[uint64][]*common.L2Tx
+	includedTxs   map[gethcommon.Hash]*common.BatchHeader // the batch that includes each transaction
+	canonical     map[uint64]bool                         // the canonical batches, by sequence number
+	blockHeights  map[gethcommon.Hash]uint64
+	epochHeights  map[uint64]uint64 // the heights of the L1 blocks that distributed the secret epochs
+	fetchedRanges [][2]uint64
+}
+
+func newForcedTxStorage() *forcedTxStorage {
+	return &forcedTxStorage{
+		forcedTxs:    map[uint64][]*common.L2Tx{},
+		includedTxs:  map[gethcommon.Hash]*common.BatchHeader{},
+		canonical:    map[uint64]bool{},
+		blockHeights: map[gethcommon.Hash]uint64{},
+		epochHeights: map[uint64]uint64{},
+	}
+}
+
+func (s *forcedTxStorage) FetchSecretEpochL1Height(_ context.Context, epoch uint64) (uint64, error) {
+	height, found := s.epochHeights[epoch]
+	if !found {
+		return 0, errutil.ErrNotFound
+	}
+	return height, nil
+}
+
+func (s *forcedTxStorage) StoreForcedTransactions(_ context.Context, blockHash common.L1BlockHash, txs []*common.L2Tx) error {
+	height := s.blockHeights[blockHash]
+	s.forcedTxs[height] = append(s.forcedTxs[height], txs...)
+	return nil
+}
+
+func (s *forcedTxStorage) FetchCanonicalForcedTransactions(_ context.Context, fromHeight uint64, toHeight uint64) ([]*common.L2Tx, error) {
+	s.fetchedRanges = append(s.fetchedRanges, [2]uint64{fromHeight, toHeight})
+	var txs []*common.L2Tx
+	for h := fromHeight; h <= toHeight; h++ {
+		txs = append(txs, s.forcedTxs[h]...)
+	}
+	return txs, nil
+}
+
+func (s *forcedTxStorage) GetTransaction(_ context.Context, txHash common.L2TxHash) (*types.Transaction, common.L2BatchHash, uint64, uint64, error) {
+	batch, ok := s.includedTxs[txHash]
+	if !ok {
+		return nil, gethcommon.Hash{}, 0, 0, errutil.ErrNotFound
+	}
+	return nil, batch.Hash(), batch.Number.Uint64(), 0, nil
+}
+
+func (s *forcedTxStorage) FetchBatchHeader(_ context.Context, hash common.L2BatchHash) (*common.BatchHeader, error) {
+	for _, batch := range s.includedTxs {
+		if batch.Hash() == hash {
+			return batch, nil
+		}
+	}
+	return nil, errutil.ErrNotFound
+}
+
+func (s *forcedTxStorage) IsBatchCanonical(_ context.Context, seq uint64) (bool, error) {
+	return s.canonical[seq], nil
+}
+
+// include - records the transaction as included in a batch with the given height and sequence number
+func (s *forcedTxStorage) include(tx *common.L2Tx, height uint64, seq uint64, canonical bool) {
+	s.includedTxs[tx.Hash()] = &common.BatchHeader{Number: big.NewInt(int64(height)), SequencerOrderNo: big.NewInt(int64(seq))}
+	s.canonical[seq] = canonical
+}
+
+func TestStoreForcedTransactions(t *testing.T) {
+	sss := crypto.NewSharedSecretService(gethlog.New())
+	sss.GenerateSharedSecret()
+	rpcKeyService := crypto.NewRPCKeyService(sss, gethlog.New())
+	st := newForcedTxStorage()
+	fi := NewForcedInclusion(&testMgmtContract, rpcKeyService, st, testChainID, gethlog.New())
+
+	block := &types.Header{Number: big.NewInt(100)}
+	st.blockHeights[block.Hash()] = 100
+
+	validTx := signedTestTx(t, testChainID, 0)
+	wrongChainTx := signedTestTx(t, 1, 0)
+	logs := []*types.Log{
+		forcedTxLog(t, testMgmtContract, encryptForRPCKey(t, rpcKeyService, validTx)),
+		// emitted by another contract
+		forcedTxLog(t, gethcommon.HexToAddress("0x5678"), encryptForRPCKey(t, rpcKeyService, signedTestTx(t, testChainID, 1))),
+		// signed for another chain
+		forcedTxLog(t, testMgmtContract, encryptForRPCKey(t, rpcKeyService, wrongChainTx)),
+		// not encrypted with the RPC key
+		forcedTxLog(t, testMgmtContract, []byte("garbage")),
+	}
+	processed := &common.ProcessedL1Data{BlockHeader: block}
+	processed.AddEvent(common.ForcedTransactionTx, &common.L1TxData{
+		Transaction: types.NewTx(&types.LegacyTx{}),
+		Receipt:     &types.Receipt{Logs: logs},
+	})
+
+	require.NoError(t, fi.StoreForcedTransactions(context.Background(), processed))
+	require.Len(t, st.forcedTxs[100], 1)
+	require.Equal(t, validTx.Hash(), st.forcedTxs[100][0].Hash())
+}
+
+// the enclaves decrypt the forced transactions with the key in effect at their L1 block, regardless of their current epoch
+func TestForcedTransactionsAreDecryptedWithTheEpochKeyOfTheirBlock(t *testing.T) {
+	sss := crypto.NewSharedSecretService(gethlog.New())
+	sss.GenerateSharedSecret()
+	rpcKeyService := crypto.NewRPCKeyService(sss, gethlog.New())
+	st := newForcedTxStorage()
+	fi := NewForcedInclusion(&testMgmtContract, rpcKeyService, st, testChainID, gethlog.New())
+
+	tx := signedTestTx(t, testChainID, 0)
+	encryptedTx := encryptForRPCKey(t, rpcKeyService, tx)
+	submit := func(height int64) error {
+		block := &types.Header{Number: big.NewInt(height)}
+		st.blockHeights[block.Hash()] = uint64(height)
+		processed := &common.ProcessedL1Data{BlockHeader: block}
+		processed.AddEvent(common.ForcedTransactionTx, &common.L1TxData{
+			Transaction: types.NewTx(&types.LegacyTx{}),
+			Receipt:     &types.Receipt{Logs: []*types.Log{forcedTxLog(t, testMgmtContract, encryptedTx)}},
+		})
+		return fi.StoreForcedTransactions(context.Background(), processed)
+	}
+
+	// the secret of the next epoch is distributed at the same height, but is not known by this enclave yet
+	st.epochHeights[1] = 100
+	require.NoError(t, submit(100))
+	require.Len(t, st.forcedTxs[100], 1)
+	require.ErrorIs(t, submit(101), crypto.ErrUnknownSecretEpoch)
+
+	// the enclave is on a later epoch when it processes the blocks
+	for epoch := uint64(1); epoch <= 2; epoch++ {
+		secret, err := crypto.GenerateEpochSecret()
+		require.NoError(t, err)
+		require.NoError(t, sss.AddEpoch(crypto.SecretEpoch{Epoch: epoch, Secret: secret}))
+	}
+	st.epochHeights[2] = 110
+	require.NoError(t, submit(100))
+	require.Len(t, st.forcedTxs[100], 2)
+	// the key of the previous epoch is accepted, as for the RPC requests
+	require.NoError(t, submit(110))
+	require.Len(t, st.forcedTxs[110], 1)
+	require.NoError(t, submit(111))
+	require.Empty(t, st.forcedTxs[111])
+}
+
+func TestDueForcedTransactions(t *testing.T) {
+	st := newForcedTxStorage()
+	fi := NewForcedInclusion(&testMgmtContract, nil, st, testChainID, gethlog.New())
+	delay := common.ForcedInclusionDelay
+	parentBatch := &common.BatchHeader{Number: big.NewInt(10)}
+
+	included := signedTestTx(t, testChainID, 0)
+	pending := signedTestTx(t, testChainID, 1)
+	st.include(included, 5, 5, true)
+	// the pending transaction was submitted twice
+	st.forcedTxs[100] = []*common.L2Tx{included, pending}
+	st.forcedTxs[101] = []*common.L2Tx{pending}
+
+	// the transactions are due once the batch proof reaches the deadline
+	due, err := fi.DueTransactions(context.Background(), parentBatch, &types.Header{Number: big.NewInt(90)}, &types.Header{Number: big.NewInt(int64(100 + delay - 1))})
+	require.NoError(t, err)
+	require.Empty(t, due)
+
+	due, err = fi.DueTransactions(context.Background(), parentBatch, &types.Header{Number: big.NewInt(int64(100 + delay - 1))}, &types.Header{Number: big.NewInt(int64(101 + delay))})
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, pending.Hash(), due[0].Hash())
+	// the transactions remain due until they expire, in case they could not be included in the previous batches
+	require.Equal(t, [2]uint64{100 - common.ForcedInclusionExpiry, 101}, st.fetchedRanges[len(st.fetchedRanges)-1])
+
+	// the transactions which are not included before they expire are no longer due
+	expiry := common.ForcedInclusionExpiry
+	due, err = fi.DueTransactions(context.Background(), parentBatch, &types.Header{Number: big.NewInt(int64(101 + delay + expiry))}, &types.Header{Number: big.NewInt(int64(102 + delay + expiry))})
+	require.NoError(t, err)
+	require.Empty(t, due)
+	require.Equal(t, [2]uint64{102, 102 + expiry}, st.fetchedRanges[len(st.fetchedRanges)-1])
+
+	// batches with the same L1 proof as their parent have no due transactions
+	st.fetchedRanges = nil
+	due, err = fi.DueTransactions(context.Background(), parentBatch, &types.Header{Number: big.NewInt(int64(101 + delay))}, &types.Header{Number: big.NewInt(int64(101 + delay))})
+	require.NoError(t, err)
+	require.Empty(t, due)
+	require.Empty(t, st.fetchedRanges)
+}
+
+func TestReorgedForcedTransactionsAreDueAgain(t *testing.T) {
+	st := newForcedTxStorage()
+	fi := NewForcedInclusion(&testMgmtContract, nil, st, testChainID, gethlog.New())
+	delay := common.ForcedInclusionDelay
+	parentBlock := &types.Header{Number: big.NewInt(int64(100 + delay - 1))}
+	block := &types.Header{Number: big.NewInt(int64(100 + delay))}
+	parentBatch := &common.BatchHeader{Number: big.NewInt(10)}
+
+	tx := signedTestTx(t, testChainID, 0)
+	st.forcedTxs[100] = []*common.L2Tx{tx}
+
+	// included in a canonical batch of the chain extended by the new batch
+	st.include(tx, 10, 10, true)
+	due, err := fi.DueTransactions(context.Background(), parentBatch, parentBlock, block)
+	require.NoError(t, err)
+	require.Empty(t, due)
+
+	// the batch that included the transaction was reorged out
+	st.canonical[10] = false
+	due, err = fi.DueTransactions(context.Background(), parentBatch, parentBlock, block)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, tx.Hash(), due[0].Hash())
+
+	// the transaction was included again in a canonical batch after the parent, e.g. when the batches are replayed
+	st.include(tx, 11, 12, true)
+	due, err = fi.DueTransactions(context.Background(), parentBatch, parentBlock, block)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+}
+
+func signedTestTx(t *testing.T, chainID int64, nonce uint64) *common.L2Tx {
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	tx, err := types.SignNewTx(key, types.LatestSignerForChainID(big.NewInt(chainID)), &types.DynamicFeeTx{
+		ChainID:   big.NewInt(chainID),
+		Nonce:     nonce,
+		Gas:       21_000,
+		GasFeeCap: big.NewInt(1),
+	})
+	require.NoError(t, err)
+	return tx
+}
+
+func encryptForRPCKey(t *testing.T, rpcKeyService *crypto.RPCKeyService, tx *common.L2Tx) []byte {
+	keyBytes, err := rpcKeyService.PublicKey()
+	require.NoError(t, err)
+	pubKey, err := gethcrypto.DecompressPubkey(keyBytes)
+	require.NoError(t, err)
+	encodedTx, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	encryptedTx, err := ecies.Encrypt(rand.Reader, ecies.ImportECDSAPublic(pubKey), encodedTx, nil, nil)
+	require.NoError(t, err)
+	return encryptedTx
+}
+
+func forcedTxLog(t *testing.T, contract gethcommon.Address, encryptedTx []byte) *types.Log {
+	data, err := forcedTxEventArgs.Pack(encryptedTx)
+	require.NoError(t, err)
+	return &types.Log{
+		Address: contract,
+		Topics:  []gethcommon.Hash{crosschain.ForcedTransactionSubmittedID, gethcommon.BytesToHash([]byte("sender"))},
+		Data:    data,
+	}
+}
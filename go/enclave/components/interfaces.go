@@ -60,7 +60,6 @@ type BatchExecutionContext struct {
 	Creator     gethcommon.Address
 	ChainConfig *params.ChainConfig
 	SequencerNo *big.Int
-	BaseFee     *big.Int
 	GasPool     *gethcore.GasPool
 
 	EthHeader *types.Header
@@ -71,7 +70,9 @@ type BatchExecutionContext struct {
 	l1block       *types.Header
 	parentL1Block *types.Header
 	parentBatch   *common.BatchHeader
+	baseFee       *big.Int // derived from the gas used by the parent batch
 	usedGas       *uint64
+	txsGasUsed    uint64 // the part of usedGas consumed by the transactions of the batch, excluding the synthetic ones
 
 	xChainMsgs      common.CrossChainMessages
 	xChainValueMsgs common.ValueTransferEvents
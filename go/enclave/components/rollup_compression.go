@@ -94,7 +94,6 @@ type batchFromRollup struct {
 	time         uint64
 	l1Proof      common.L1BlockHash
 	coinbase     gethcommon.Address
-	baseFee      *big.Int
 	gasLimit     uint64
 
 	header *common.BatchHeader // for reorgs
@@ -106,7 +105,14 @@ func (rc *RollupCompression) CreateExtRollup(ctx context.Context, r *core.Rollup
 	if err != nil {
 		return nil, err
 	}
-	encryptedHeader, err := rc.serialiseCompressAndEncrypt(header)
+	// the rollup is tagged with the secret epoch, so that it can be decrypted after the keys are rotated, and with the
+	// revelation period of its compression block, so that it can be decrypted by anyone once the period is revealed
+	r.Header.SecretEpoch = rc.daEncryptionService.CurrentEpoch()
+	r.Header.RevelationPeriod, err = rc.revelationPeriod(ctx, r.Header.CompressionL1Head)
+	if err != nil {
+		return nil, err
+	}
+	encryptedHeader, err := rc.serialiseCompressAndEncrypt(r.Header, header)
 	if err != nil {
 		return nil, err
 	}
@@ -115,7 +121,7 @@ func (rc *RollupCompression) CreateExtRollup(ctx context.Context, r *core.Rollup
 	for i, batch := range r.Batches {
 		transactions[i] = batch.Transactions
 	}
-	encryptedTransactions, err := rc.serialiseCompressAndEncrypt(transactions)
+	encryptedTransactions, err := rc.serialiseCompressAndEncrypt(r.Header, transactions)
 	if err != nil {
 		return nil, err
 	}
@@ -129,14 +135,26 @@ func (rc *RollupCompression) CreateExtRollup(ctx context.Context, r *core.Rollup
 
 // ProcessExtRollup - given an External rollup, responsible with checking and saving all batches found inside
 func (rc *RollupCompression) ProcessExtRollup(ctx context.Context, rollup *common.ExtRollup) (*common.CalldataRollupHeader, error) {
+	// the rollups published before the revelation periods were introduced have no period, and are decrypted with the
+	// legacy key
+	if !isLegacyRollup(rollup) {
+		period, err := rc.revelationPeriod(ctx, rollup.Header.CompressionL1Head)
+		if err != nil {
+			return nil, err
+		}
+		if rollup.Header.RevelationPeriod != period {
+			return nil, fmt.Errorf("rollup revelation period %d does not match the period %d of its compression block", rollup.Header.RevelationPeriod, period)
+		}
+	}
+
 	transactionsPerBatch := make([][]*common.L2Tx, 0)
-	err := rc.decryptDecompressAndDeserialise(rollup.BatchPayloads, &transactionsPerBatch)
+	err := rc.decryptDecompressAndDeserialise(rollup.Header, rollup.BatchPayloads, &transactionsPerBatch)
 	if err != nil {
 		return nil, err
 	}
 
 	calldataRollupHeader := new(common.CalldataRollupHeader)
-	err = rc.decryptDecompressAndDeserialise(rollup.CalldataRollupHeader, calldataRollupHeader)
+	err = rc.decryptDecompressAndDeserialise(rollup.Header, rollup.CalldataRollupHeader, calldataRollupHeader)
 	if err != nil {
 		return nil, err
 	}
@@ -158,6 +176,16 @@ func (rc *RollupCompression) ProcessExtRollup(ctx context.Context, rollup *commo
 	return calldataRollupHeader, nil
 }
 
+// isLegacyRollup - whether the rollup was published before the layout of the encrypted blobs was versioned. Such a
+// rollup has no secret epoch nor revelation period, and its header is not encrypted with the versioned layout for them.
+func isLegacyRollup(rollup *common.ExtRollup) bool {
+	if rollup.Header.SecretEpoch != crypto.GenesisSecretEpoch || rollup.Header.RevelationPeriod != 0 {
+		return false
+	}
+	blob := rollup.CalldataRollupHeader
+	return crypto.ValidateBlob(blob) != nil || crypto.BlobEpoch(blob) != crypto.GenesisSecretEpoch || crypto.BlobPeriod(blob) != 0
+}
+
 // the main logic that goes from a list of batches to the rollup header
 func (rc *RollupCompression) createRollupHeader(ctx context.Context, rollup *core.Rollup) (*common.CalldataRollupHeader, error) {
 	batches := rollup.Batches
@@ -263,6 +291,7 @@ func (rc *RollupCompression) createRollupHeader(ctx context.Context, rollup *cor
 		//	BatchHashes:           batchHashes,
 		//	BatchHeaders:          batchHeaders,
 		Coinbase: batches[0].Header.Coinbase,
+		// only used for the genesis batch, the base fee of the other batches is derived from the gas used by their parent
 		BaseFee:  batches[0].Header.BaseFee,
 		GasLimit: batches[0].Header.GasLimit,
 	}
@@ -355,7 +384,6 @@ func (rc *RollupCompression) createIncompleteBatches(ctx context.Context, callda
 			l1Proof:      block.Hash(),
 			header:       fullReorgedHeader,
 			coinbase:     calldataRollupHeader.Coinbase,
-			baseFee:      calldataRollupHeader.BaseFee,
 			gasLimit:     calldataRollupHeader.GasLimit,
 		}
 		rc.logger.Info("Rollup decompressed batch", log.BatchSeqNoKey, currentSeqNo, log.BatchHeightKey, currentHeight, "rollup_idx", currentBatchIdx, "l1_height", block.Number, "l1_hash", block.Hash())
@@ -499,7 +527,6 @@ func (rc *RollupCompression) executeAndSaveIncompleteBatches(ctx context.Context
 				incompleteBatch.time,
 				incompleteBatch.seqNo,
 				incompleteBatch.coinbase,
-				incompleteBatch.baseFee,
 			)
 			if err != nil {
 				return err
@@ -538,7 +565,16 @@ func (rc *RollupCompression) executeAndSaveIncompleteBatches(ctx context.Context
 	return nil
 }
 
-func (rc *RollupCompression) serialiseCompressAndEncrypt(obj any) ([]byte, error) {
+// revelationPeriod - the rollup data is revealed according to the height of the compression block
+func (rc *RollupCompression) revelationPeriod(ctx context.Context, compressionL1Head common.L1BlockHash) (uint64, error) {
+	block, err := rc.storage.FetchBlock(ctx, compressionL1Head)
+	if err != nil {
+		return 0, fmt.Errorf("could not fetch compression block %s. Cause: %w", compressionL1Head, err)
+	}
+	return crypto.RevelationPeriodOf(block.Number.Uint64(), rc.config.RevelationPeriod), nil
+}
+
+func (rc *RollupCompression) serialiseCompressAndEncrypt(header *common.RollupHeader, obj any) ([]byte, error) {
 	serialised, err := rlp.EncodeToBytes(obj)
 	if err != nil {
 		return nil, err
@@ -547,17 +583,17 @@ func (rc *RollupCompression) serialiseCompressAndEncrypt(obj any) ([]byte, error
 	if err != nil {
 		return nil, err
 	}
-	encrypted, err := rc.daEncryptionService.Encrypt(compressed)
+	encrypted, err := rc.daEncryptionService.EncryptForPeriod(header.SecretEpoch, header.RevelationPeriod, compressed)
 	if err != nil {
 		return nil, err
 	}
 	return encrypted, nil
 }
 
-func (rc *RollupCompression) decryptDecompressAndDeserialise(blob []byte, obj any) error {
-	plaintextBlob, err := rc.daEncryptionService.Decrypt(blob)
+func (rc *RollupCompression) decryptDecompressAndDeserialise(header *common.RollupHeader, blob []byte, obj any) error {
+	plaintextBlob, err := rc.daEncryptionService.DecryptForPeriod(header.SecretEpoch, header.RevelationPeriod, blob)
 	if err != nil {
-		return err
+		return fmt.Errorf("invalid rollup payload. Cause: %w", err)
 	}
 	serialisedBlob, err := rc.dataCompressionService.Decompress(plaintextBlob)
 	if err != nil {
@@ -578,7 +614,6 @@ func (rc *RollupCompression) computeBatch(
 	AtTime uint64,
 	SequencerNo *big.Int,
 	Coinbase gethcommon.Address,
-	BaseFee *big.Int,
 ) (*ComputedBatch, error) {
 	return rc.batchExecutor.ComputeBatch(
 		ctx,
@@ -591,7 +626,6 @@ func (rc *RollupCompression) computeBatch(
 			Creator:      Coinbase,
 			ChainConfig:  rc.chainConfig,
 			SequencerNo:  SequencerNo,
-			BaseFee:      big.NewInt(0).Set(BaseFee),
 		}, false)
 }
 
@@ -0,0 +1,88 @@
+package components
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/compression"
+	enclaveconfig "github.com/ten-protocol/go-ten/go/enclave/config"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+)
+
+// the rollups published before the revelation periods were introduced have no period, whatever the height of their
+// compression block, and are still processed
+func TestProcessLegacyRollup(t *testing.T) {
+	ctx := context.Background()
+	sss := crypto.NewSharedSecretService(gethlog.New())
+	sss.GenerateSharedSecret()
+	l1Block := &types.Header{Number: big.NewInt(10_000), Difficulty: big.NewInt(0)}
+	e := newSnapshotTestEnclave(t, sss, l1Block)
+	genesis := e.storeBatch(t, l1Block, nil)
+
+	dataCompression := compression.NewBrotliDataCompressionService()
+	daEncryption := crypto.NewDAEncryptionService(sss, gethlog.New())
+	rc := NewRollupCompression(e.registry, nil, daEncryption, dataCompression, e.storage, e.service.gethEncodingService, nil,
+		&enclaveconfig.EnclaveConfig{RevelationPeriod: 7200}, gethlog.New())
+
+	gobEncode := func(i int64) []byte {
+		enc, err := big.NewInt(i).GobEncode()
+		require.NoError(t, err)
+		return enc
+	}
+	calldataRollupHeader := &common.CalldataRollupHeader{
+		FirstBatchSequence:    big.NewInt(int64(common.L2GenesisSeqNo)),
+		FirstCanonBatchHeight: big.NewInt(0),
+		BatchTimeDeltas:       [][]byte{gobEncode(0)},
+		L1HeightDeltas:        [][]byte{gobEncode(l1Block.Number.Int64())},
+		ReOrgs:                [][]byte{nil},
+	}
+	compress := func(obj any) []byte {
+		serialised, err := rlp.EncodeToBytes(obj)
+		require.NoError(t, err)
+		compressed, err := dataCompression.CompressRollup(serialised)
+		require.NoError(t, err)
+		return compressed
+	}
+	// the legacy layout is the nonce followed by the ciphertext, encrypted with the key derived from the genesis secret
+	block, err := aes.NewCipher(sss.ExtendEntropy([]byte{0}))
+	require.NoError(t, err)
+	legacyCipher, err := cipher.NewGCM(block)
+	require.NoError(t, err)
+	legacyEncrypt := func(plaintext []byte) []byte {
+		nonce := make([]byte, crypto.GCMNonceLength)
+		_, err := rand.Read(nonce)
+		require.NoError(t, err)
+		return legacyCipher.Seal(nonce, nonce, plaintext, nil)
+	}
+
+	legacyRollup := &common.ExtRollup{
+		Header:               &common.RollupHeader{CompressionL1Head: l1Block.Hash()},
+		BatchPayloads:        legacyEncrypt(compress([][]*common.L2Tx{{}})),
+		CalldataRollupHeader: legacyEncrypt(compress(calldataRollupHeader)),
+	}
+	processed, err := rc.ProcessExtRollup(ctx, legacyRollup)
+	require.NoError(t, err)
+	require.Equal(t, genesis.Header.ParentHash, processed.FirstCanonParentHash)
+	require.Equal(t, common.L2GenesisSeqNo, processed.FirstBatchSequence.Uint64())
+
+	// a rollup encrypted with the versioned layout must match the period of its compression block
+	versionedPayloads, err := daEncryption.EncryptForPeriod(crypto.GenesisSecretEpoch, 0, compress([][]*common.L2Tx{{}}))
+	require.NoError(t, err)
+	versionedHeader, err := daEncryption.EncryptForPeriod(crypto.GenesisSecretEpoch, 0, compress(calldataRollupHeader))
+	require.NoError(t, err)
+	_, err = rc.ProcessExtRollup(ctx, &common.ExtRollup{
+		Header:               &common.RollupHeader{CompressionL1Head: l1Block.Hash()},
+		BatchPayloads:        versionedPayloads,
+		CalldataRollupHeader: versionedHeader,
+	})
+	require.ErrorContains(t, err, "does not match the period")
+}
@@ -137,6 +137,17 @@ func (rc *rollupConsumerImpl) extractAndVerifyRollups(processed *common.Processe
 			continue
 		}
 
+		if calldataRollup, ok := t.(*common.L1RollupTx); ok {
+			r, err := common.DecodeRollup(calldataRollup.Rollup)
+			if err != nil {
+				rc.logger.Warn(fmt.Sprintf("could not decode rollup published in the calldata at index %d. Cause: %s", i, err))
+				continue
+			}
+			rollups = append(rollups, r)
+			rc.logger.Info("Extracted rollup from block calldata", log.RollupHashKey, r.Hash(), log.BlockHashKey, processed.BlockHeader.Hash())
+			continue
+		}
+
 		rollupHashes, ok := t.(*common.L1RollupHashes)
 		if !ok {
 			continue
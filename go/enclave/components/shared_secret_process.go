@@ -2,13 +2,19 @@ package components
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	gethcrypto "github.com/ethereum/go-ethereum/crypto"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/signature"
+	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
 	"github.com/ten-protocol/go-ten/go/enclave/crypto"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
@@ -18,24 +24,27 @@ type SharedSecretProcessor struct {
 	mgmtContractLib     mgmtcontractlib.MgmtContractLib
 	sharedSecretService *crypto.SharedSecretService
 	attestationProvider AttestationProvider // interface for producing attestation reports and verifying them
+	enclaveKeyService   *crypto.EnclaveAttestedKeyService
 	enclaveID           gethcommon.Address
 	storage             storage.Storage
 	logger              gethlog.Logger
 }
 
-func NewSharedSecretProcessor(mgmtcontractlib mgmtcontractlib.MgmtContractLib, attestationProvider AttestationProvider, enclaveID gethcommon.Address, storage storage.Storage, sharedSecretService *crypto.SharedSecretService, logger gethlog.Logger) *SharedSecretProcessor {
+func NewSharedSecretProcessor(mgmtcontractlib mgmtcontractlib.MgmtContractLib, attestationProvider AttestationProvider, enclaveKeyService *crypto.EnclaveAttestedKeyService, storage storage.Storage, sharedSecretService *crypto.SharedSecretService, logger gethlog.Logger) *SharedSecretProcessor {
 	return &SharedSecretProcessor{
 		mgmtContractLib:     mgmtcontractlib,
 		attestationProvider: attestationProvider,
-		enclaveID:           enclaveID,
+		enclaveKeyService:   enclaveKeyService,
+		enclaveID:           enclaveKeyService.EnclaveID(),
 		storage:             storage,
 		sharedSecretService: sharedSecretService,
 		logger:              logger,
 	}
 }
 
-// ProcessNetworkSecretMsgs we watch for all messages that are requesting or receiving the secret and we store the nodes attested keys
-func (ssp *SharedSecretProcessor) ProcessNetworkSecretMsgs(ctx context.Context, processed *common.ProcessedL1Data) []*common.ProducedSecretResponse {
+// ProcessNetworkSecretMsgs we watch for all messages that are requesting or receiving the secret and we store the nodes attested keys.
+// When the enclave is the active sequencer, it also returns the secret of the new epoch if a rotation was requested.
+func (ssp *SharedSecretProcessor) ProcessNetworkSecretMsgs(ctx context.Context, processed *common.ProcessedL1Data, isActiveSequencer bool) ([]*common.ProducedSecretResponse, *common.SecretDistribution) {
 	var responses []*common.ProducedSecretResponse
 	block := processed.BlockHeader
 
@@ -78,7 +87,222 @@ func (ssp *SharedSecretProcessor) ProcessNetworkSecretMsgs(ctx context.Context,
 		responses = append(responses, resp)
 	}
 
-	return responses
+	// process secret rotations
+	for _, txData := range processed.GetEvents(common.SecretRotationTx) {
+		if err := ssp.processSecretRotation(ctx, block, txData); err != nil {
+			ssp.logger.Error("Failed to process shared secret rotation.", log.TxKey, txData.Transaction.Hash(), log.ErrKey, err)
+		}
+	}
+	// the rotations which could not be applied yet are retried with every block
+	ssp.applyStoredRotations(ctx)
+
+	// process rotation requests, after the rotations so that a request is not answered for an epoch that was distributed in the same block
+	var distribution *common.SecretDistribution
+	if isActiveSequencer {
+		for _, txData := range processed.GetEvents(common.SecretRotationRequestTx) {
+			d, err := ssp.processRotationRequest(ctx, txData)
+			if err != nil {
+				ssp.logger.Error("Failed to process shared secret rotation request.", log.TxKey, txData.Transaction.Hash(), log.ErrKey, err)
+				continue
+			}
+			if d != nil {
+				distribution = d
+			}
+		}
+	}
+
+	return responses, distribution
+}
+
+// processRotationRequest - the sequencer enclave generates the secret of the requested epoch from fresh entropy, and
+// encrypts it for each attested enclave, including itself. The secret is persisted before it is distributed, so that
+// the same secret is distributed again if the request is re-included after an L1 reorg.
+func (ssp *SharedSecretProcessor) processRotationRequest(ctx context.Context, txData *common.L1TxData) (*common.SecretDistribution, error) {
+	epochNo, found := ssp.findEpochInLogs(txData, crosschain.NetworkSecretRotationRequestedID)
+	if !found {
+		return nil, nil
+	}
+	current := ssp.sharedSecretService.CurrentEpoch()
+	if epochNo != current+1 {
+		// the epoch was already distributed, or the request is out of order
+		ssp.logger.Info("Ignoring secret rotation request.", "epoch", epochNo, "current_epoch", current)
+		return nil, nil
+	}
+
+	secret, err := ssp.storage.FetchPendingSecretEpoch(ctx, epochNo)
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return nil, fmt.Errorf("could not fetch the pending secret of epoch %d. Cause: %w", epochNo, err)
+	}
+	if secret == nil {
+		generated, err := crypto.GenerateEpochSecret()
+		if err != nil {
+			return nil, err
+		}
+		secret = &crypto.SecretEpoch{Epoch: epochNo, Secret: generated}
+		if err := ssp.storage.StorePendingSecretEpoch(ctx, *secret); err != nil {
+			return nil, fmt.Errorf("could not store the pending secret of epoch %d. Cause: %w", epochNo, err)
+		}
+	}
+
+	enclaves, err := ssp.storage.GetAttestedEnclaves(ctx)
+	if err != nil {
+		return nil, err
+	}
+	distribution := &common.SecretDistribution{Epoch: epochNo, AttesterID: ssp.enclaveID}
+	includesSelf := false
+	for _, enclave := range enclaves {
+		includesSelf = includesSelf || *enclave.EnclaveID == ssp.enclaveID
+		encrypted, err := crypto.EncryptEpochSecret(secret.Secret, enclave.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not encrypt the secret of epoch %d for enclave %s. Cause: %w", epochNo, enclave.EnclaveID, err)
+		}
+		distribution.EnclaveIDs = append(distribution.EnclaveIDs, *enclave.EnclaveID)
+		distribution.Secrets = append(distribution.Secrets, encrypted)
+	}
+	if !includesSelf {
+		encrypted, err := crypto.EncryptEpochSecret(secret.Secret, ssp.enclaveKeyService.PublicKey())
+		if err != nil {
+			return nil, fmt.Errorf("could not encrypt the secret of epoch %d. Cause: %w", epochNo, err)
+		}
+		distribution.EnclaveIDs = append(distribution.EnclaveIDs, ssp.enclaveID)
+		distribution.Secrets = append(distribution.Secrets, encrypted)
+	}
+
+	distribution.Signature, err = ssp.enclaveKeyService.Sign(distribution.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("could not sign the secret distribution. Cause: %w", err)
+	}
+	ssp.logger.Info("Distributing the secret of a new epoch.", "epoch", epochNo, "recipients", len(distribution.EnclaveIDs))
+	return distribution, nil
+}
+
+// processSecretRotation - verifies the distribution of the secret of a new epoch, signed by a sequencer enclave, and
+// stores it so that it is applied by `applyStoredRotations`, in order, even if this enclave can't apply it yet.
+// The height of the L1 block is recorded, so that the forced transactions are decrypted with the key in effect at their block.
+// Processing the same distribution again, e.g. after an L1 reorg, is a no-op.
+func (ssp *SharedSecretProcessor) processSecretRotation(ctx context.Context, block *types.Header, txData *common.L1TxData) error {
+	logEpoch, found := ssp.findEpochInLogs(txData, crosschain.NetworkSecretRotatedID)
+	t := ssp.mgmtContractLib.DecodeTx(txData.Transaction)
+	distribution, ok := t.(*common.SecretDistribution)
+	if !ok {
+		return fmt.Errorf("could not decode the secret distribution")
+	}
+	if found && logEpoch != distribution.Epoch {
+		return fmt.Errorf("secret distribution for epoch %d emitted the epoch %d", distribution.Epoch, logEpoch)
+	}
+	if err := ssp.verifyDistribution(ctx, distribution); err != nil {
+		return err
+	}
+	if err := ssp.storage.StoreSecretEpochL1Height(ctx, distribution.Epoch, block.Number.Uint64()); err != nil {
+		return fmt.Errorf("could not store the L1 height of secret epoch %d. Cause: %w", distribution.Epoch, err)
+	}
+	if distribution.Epoch <= ssp.sharedSecretService.CurrentEpoch() {
+		return ssp.applyRotation(ctx, distribution)
+	}
+	if err := ssp.storage.StoreSecretDistribution(ctx, distribution); err != nil {
+		return fmt.Errorf("could not store the secret distribution of epoch %d. Cause: %w", distribution.Epoch, err)
+	}
+	return nil
+}
+
+// applyStoredRotations - applies the stored distributions of the epochs following the current epoch, in order. A
+// rotation which fails, e.g. because of a storage error, stops the rotations and is retried with the next block.
+func (ssp *SharedSecretProcessor) applyStoredRotations(ctx context.Context) {
+	for {
+		epoch := ssp.sharedSecretService.CurrentEpoch() + 1
+		distribution, err := ssp.storage.FetchSecretDistribution(ctx, epoch)
+		if errors.Is(err, errutil.ErrNotFound) {
+			return
+		}
+		if err == nil {
+			err = ssp.applyRotation(ctx, distribution)
+		}
+		if err != nil {
+			ssp.logger.Error("Failed to rotate the shared secret keys. Retrying with the next block.", "epoch", epoch, log.ErrKey, err)
+			return
+		}
+	}
+}
+
+// applyRotation - the secret of the new epoch is decrypted from the distribution. The keys derived from it are used for
+// all new data, while the keys of the previous epochs are kept to decrypt historical data. The distributions of the
+// known epochs are ignored.
+func (ssp *SharedSecretProcessor) applyRotation(ctx context.Context, distribution *common.SecretDistribution) error {
+	known := distribution.Epoch <= ssp.sharedSecretService.CurrentEpoch()
+	encrypted, found := distribution.SecretFor(ssp.enclaveID)
+	if !found {
+		if known {
+			// this enclave received the secret of the epoch when it joined the network
+			return nil
+		}
+		return fmt.Errorf("the secret of epoch %d was not distributed to this enclave, which must request the secret of the network again", distribution.Epoch)
+	}
+	decrypted, err := ssp.enclaveKeyService.Decrypt(encrypted)
+	if err != nil {
+		return fmt.Errorf("could not decrypt the secret of epoch %d. Cause: %w", distribution.Epoch, err)
+	}
+	if len(decrypted) != len(crypto.SharedEnclaveSecret{}) {
+		return fmt.Errorf("invalid secret length %d for epoch %d", len(decrypted), distribution.Epoch)
+	}
+	epoch := crypto.SecretEpoch{Epoch: distribution.Epoch}
+	copy(epoch.Secret[:], decrypted)
+
+	known, sameSecret := ssp.sharedSecretService.HasEpoch(epoch)
+	if known {
+		if !sameSecret {
+			// the contract only accepts one distribution per requested epoch, so this can only happen if a competing
+			// distribution was included on a fork. The first secret is kept, because data may already be encrypted with it.
+			ssp.logger.Error("Ignoring a different secret for a known epoch.", "epoch", epoch.Epoch)
+		}
+		return nil
+	}
+	if current := ssp.sharedSecretService.CurrentEpoch(); epoch.Epoch != current+1 {
+		return fmt.Errorf("secret epoch %d does not follow the current epoch %d", epoch.Epoch, current)
+	}
+	if err := ssp.storage.StoreSecretEpoch(ctx, epoch); err != nil {
+		return fmt.Errorf("could not store secret epoch %d. Cause: %w", epoch.Epoch, err)
+	}
+	if err := ssp.sharedSecretService.AddEpoch(epoch); err != nil {
+		return err
+	}
+	ssp.logger.Info("Rotated the shared secret keys.", "epoch", epoch.Epoch)
+	return nil
+}
+
+// verifyDistribution - the distribution must be signed by a sequencer enclave
+func (ssp *SharedSecretProcessor) verifyDistribution(ctx context.Context, distribution *common.SecretDistribution) error {
+	if len(distribution.EnclaveIDs) != len(distribution.Secrets) {
+		return fmt.Errorf("secret distribution has %d recipients and %d secrets", len(distribution.EnclaveIDs), len(distribution.Secrets))
+	}
+	sequencerIDs, err := ssp.storage.GetSequencerEnclaveIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("could not fetch the sequencer enclaves. Cause: %w", err)
+	}
+	if !slices.Contains(sequencerIDs, distribution.AttesterID) {
+		return fmt.Errorf("secret distribution attester %s is not a sequencer enclave", distribution.AttesterID)
+	}
+	signer, err := signature.RecoverAddress(distribution.Hash().Bytes(), distribution.Signature)
+	if err != nil {
+		return fmt.Errorf("could not recover the signer of the secret distribution. Cause: %w", err)
+	}
+	if *signer != distribution.AttesterID {
+		return fmt.Errorf("secret distribution was signed by %s instead of the attester %s", signer, distribution.AttesterID)
+	}
+	return nil
+}
+
+// findEpochInLogs - the epoch is the indexed argument of the secret rotation events of the management contract
+func (ssp *SharedSecretProcessor) findEpochInLogs(txData *common.L1TxData, eventID gethcommon.Hash) (uint64, bool) {
+	if txData.Receipt == nil {
+		return 0, false
+	}
+	for _, l := range txData.Receipt.Logs {
+		if l.Address != *ssp.mgmtContractLib.GetContractAddr() || len(l.Topics) < 2 || l.Topics[0] != eventID {
+			continue
+		}
+		return l.Topics[1].Big().Uint64(), true
+	}
+	return 0, false
 }
 
 func (ssp *SharedSecretProcessor) processSecretRequest(ctx context.Context, req *common.L1RequestSecretTx) (*common.ProducedSecretResponse, error) {
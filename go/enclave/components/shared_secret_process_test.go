@@ -0,0 +1,256 @@
+package components
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
+)
+
+// secretStorage - stores the attestations and the secret epochs in memory, the other storage methods are not used by the tests
+type secretStorage struct {
+	storage.Storage
+	enclaves      []*storage.AttestedEnclave
+	sequencerIDs  []common.EnclaveID
+	epochs        map[uint64]crypto.SecretEpoch
+	pendingEpochs map[uint64]crypto.SecretEpoch
+	distributions map[uint64]*common.SecretDistribution
+	epochHeights  map[uint64]uint64
+	storeErr      error // returned once by StoreSecretEpoch
+}
+
+func newSecretStorage(sequencer *crypto.EnclaveAttestedKeyService, validators ...*crypto.EnclaveAttestedKeyService) *secretStorage {
+	s := &secretStorage{
+		sequencerIDs:  []common.EnclaveID{sequencer.EnclaveID()},
+		epochs:        map[uint64]crypto.SecretEpoch{},
+		pendingEpochs: map[uint64]crypto.SecretEpoch{},
+		distributions: map[uint64]*common.SecretDistribution{},
+		epochHeights:  map[uint64]uint64{},
+	}
+	for _, eks := range append([]*crypto.EnclaveAttestedKeyService{sequencer}, validators...) {
+		enclaveID := eks.EnclaveID()
+		s.enclaves = append(s.enclaves, &storage.AttestedEnclave{PubKey: eks.PublicKey(), EnclaveID: &enclaveID})
+	}
+	return s
+}
+
+func (s *secretStorage) GetAttestedEnclaves(context.Context) ([]*storage.AttestedEnclave, error) {
+	return s.enclaves, nil
+}
+
+func (s *secretStorage) GetSequencerEnclaveIDs(context.Context) ([]common.EnclaveID, error) {
+	return s.sequencerIDs, nil
+}
+
+func (s *secretStorage) StoreSecretEpoch(_ context.Context, epoch crypto.SecretEpoch) error {
+	if err := s.storeErr; err != nil {
+		s.storeErr = nil
+		return err
+	}
+	s.epochs[epoch.Epoch] = epoch
+	return nil
+}
+
+func (s *secretStorage) FetchSecretDistribution(_ context.Context, epoch uint64) (*common.SecretDistribution, error) {
+	distribution, found := s.distributions[epoch]
+	if !found {
+		return nil, errutil.ErrNotFound
+	}
+	return distribution, nil
+}
+
+func (s *secretStorage) StoreSecretDistribution(_ context.Context, distribution *common.SecretDistribution) error {
+	if _, found := s.distributions[distribution.Epoch]; !found {
+		s.distributions[distribution.Epoch] = distribution
+	}
+	return nil
+}
+
+func (s *secretStorage) StoreSecretEpochL1Height(_ context.Context, epoch uint64, height uint64) error {
+	s.epochHeights[epoch] = height
+	return nil
+}
+
+func (s *secretStorage) FetchPendingSecretEpoch(_ context.Context, epoch uint64) (*crypto.SecretEpoch, error) {
+	pending, found := s.pendingEpochs[epoch]
+	if !found {
+		return nil, errutil.ErrNotFound
+	}
+	return &pending, nil
+}
+
+func (s *secretStorage) StorePendingSecretEpoch(_ context.Context, epoch crypto.SecretEpoch) error {
+	if _, found := s.pendingEpochs[epoch.Epoch]; found {
+		return fmt.Errorf("pending secret epoch %d already stored", epoch.Epoch)
+	}
+	s.pendingEpochs[epoch.Epoch] = epoch
+	return nil
+}
+
+// the secret of a new epoch is generated by the sequencer enclave and distributed to the validators
+func TestSecretRotationIsDistributed(t *testing.T) {
+	logger := gethlog.New()
+	mgmtContractLib := mgmtcontractlib.NewMgmtContractLib(&testMgmtContract, logger)
+	sequencer, validator := newTestEnclaveKey(t), newTestEnclaveKey(t)
+	genesis, err := crypto.GenerateEpochSecret()
+	require.NoError(t, err)
+
+	newProcessor := func(eks *crypto.EnclaveAttestedKeyService) (*SharedSecretProcessor, *crypto.SharedSecretService, *secretStorage) {
+		sss := crypto.NewSharedSecretService(logger)
+		sss.SetSharedSecret(&genesis)
+		st := newSecretStorage(sequencer, validator)
+		return NewSharedSecretProcessor(mgmtContractLib, nil, eks, st, sss, logger), sss, st
+	}
+	seqProcessor, seqSecrets, seqStorage := newProcessor(sequencer)
+	valProcessor, valSecrets, valStorage := newProcessor(validator)
+
+	// only the active sequencer answers the rotation request
+	request := rotationEvent(common.SecretRotationRequestTx, &common.L1TxData{
+		Transaction: types.NewTx(&types.LegacyTx{}),
+		Receipt:     &types.Receipt{Logs: []*types.Log{epochLog(crosschain.NetworkSecretRotationRequestedID, 1)}},
+	})
+	_, distribution := valProcessor.ProcessNetworkSecretMsgs(context.Background(), request, false)
+	require.Nil(t, distribution)
+	_, distribution = seqProcessor.ProcessNetworkSecretMsgs(context.Background(), request, true)
+	require.NotNil(t, distribution)
+	require.Len(t, distribution.EnclaveIDs, 2)
+
+	// the request is answered with the same secret if it is processed again, e.g. after an L1 reorg
+	_, again := seqProcessor.ProcessNetworkSecretMsgs(context.Background(), request, true)
+	require.NotNil(t, again)
+	require.Len(t, seqStorage.pendingEpochs, 1)
+
+	distributionTx, err := mgmtContractLib.CreateSecretDistribution(distribution)
+	require.NoError(t, err)
+	rotation := rotationEvent(common.SecretRotationTx, &common.L1TxData{
+		Transaction: types.NewTx(distributionTx),
+		Receipt:     &types.Receipt{Logs: []*types.Log{epochLog(crosschain.NetworkSecretRotatedID, 1)}},
+	})
+	seqProcessor.ProcessNetworkSecretMsgs(context.Background(), rotation, true)
+	valProcessor.ProcessNetworkSecretMsgs(context.Background(), rotation, false)
+
+	require.Equal(t, uint64(1), seqSecrets.CurrentEpoch())
+	require.Equal(t, uint64(1), valSecrets.CurrentEpoch())
+	require.Equal(t, seqStorage.pendingEpochs[1], valStorage.epochs[1])
+	require.NotEqual(t, genesis, valStorage.epochs[1].Secret)
+
+	// re-including the distribution is a no-op
+	valProcessor.ProcessNetworkSecretMsgs(context.Background(), rotation, false)
+	require.Equal(t, uint64(1), valSecrets.CurrentEpoch())
+
+	// once the epoch is distributed, the sequencer does not answer the request again
+	_, distribution = seqProcessor.ProcessNetworkSecretMsgs(context.Background(), request, true)
+	require.Nil(t, distribution)
+}
+
+// a distribution which was not signed by a sequencer enclave is ignored
+func TestSecretRotationRequiresTheSequencerSignature(t *testing.T) {
+	logger := gethlog.New()
+	mgmtContractLib := mgmtcontractlib.NewMgmtContractLib(&testMgmtContract, logger)
+	sequencer, validator := newTestEnclaveKey(t), newTestEnclaveKey(t)
+	sss := crypto.NewSharedSecretService(logger)
+	sss.GenerateSharedSecret()
+	processor := NewSharedSecretProcessor(mgmtContractLib, nil, validator, newSecretStorage(sequencer, validator), sss, logger)
+
+	secret, err := crypto.GenerateEpochSecret()
+	require.NoError(t, err)
+	encrypted, err := crypto.EncryptEpochSecret(secret, validator.PublicKey())
+	require.NoError(t, err)
+	distribution := &common.SecretDistribution{
+		Epoch:      1,
+		AttesterID: validator.EnclaveID(),
+		EnclaveIDs: []common.EnclaveID{validator.EnclaveID()},
+		Secrets:    [][]byte{encrypted},
+	}
+	distribution.Signature, err = validator.Sign(distribution.Hash())
+	require.NoError(t, err)
+
+	distributionTx, err := mgmtContractLib.CreateSecretDistribution(distribution)
+	require.NoError(t, err)
+	processor.ProcessNetworkSecretMsgs(context.Background(), rotationEvent(common.SecretRotationTx, &common.L1TxData{
+		Transaction: types.NewTx(distributionTx),
+		Receipt:     &types.Receipt{},
+	}), false)
+	require.Equal(t, crypto.GenesisSecretEpoch, sss.CurrentEpoch())
+}
+
+// the rotations which can't be applied when they are seen are retried with the next blocks, in order
+func TestFailedSecretRotationsAreRetried(t *testing.T) {
+	logger := gethlog.New()
+	mgmtContractLib := mgmtcontractlib.NewMgmtContractLib(&testMgmtContract, logger)
+	sequencer, validator := newTestEnclaveKey(t), newTestEnclaveKey(t)
+	sss := crypto.NewSharedSecretService(logger)
+	sss.GenerateSharedSecret()
+	st := newSecretStorage(sequencer, validator)
+	processor := NewSharedSecretProcessor(mgmtContractLib, nil, validator, st, sss, logger)
+
+	rotation := func(epoch uint64) *common.ProcessedL1Data {
+		secret, err := crypto.GenerateEpochSecret()
+		require.NoError(t, err)
+		encrypted, err := crypto.EncryptEpochSecret(secret, validator.PublicKey())
+		require.NoError(t, err)
+		distribution := &common.SecretDistribution{
+			Epoch:      epoch,
+			AttesterID: sequencer.EnclaveID(),
+			EnclaveIDs: []common.EnclaveID{validator.EnclaveID()},
+			Secrets:    [][]byte{encrypted},
+		}
+		distribution.Signature, err = sequencer.Sign(distribution.Hash())
+		require.NoError(t, err)
+		distributionTx, err := mgmtContractLib.CreateSecretDistribution(distribution)
+		require.NoError(t, err)
+		return rotationEvent(common.SecretRotationTx, &common.L1TxData{
+			Transaction: types.NewTx(distributionTx),
+			Receipt:     &types.Receipt{Logs: []*types.Log{epochLog(crosschain.NetworkSecretRotatedID, epoch)}},
+		})
+	}
+	emptyBlock := &common.ProcessedL1Data{BlockHeader: &types.Header{Number: big.NewInt(101)}}
+
+	// the secret of the first epoch can't be stored
+	st.storeErr = errors.New("storage unavailable")
+	processor.ProcessNetworkSecretMsgs(context.Background(), rotation(1), false)
+	require.Equal(t, crypto.GenesisSecretEpoch, sss.CurrentEpoch())
+	processor.ProcessNetworkSecretMsgs(context.Background(), emptyBlock, false)
+	require.Equal(t, uint64(1), sss.CurrentEpoch())
+	require.Contains(t, st.epochs, uint64(1))
+
+	// the third epoch is applied once the second epoch is seen
+	processor.ProcessNetworkSecretMsgs(context.Background(), rotation(3), false)
+	require.Equal(t, uint64(1), sss.CurrentEpoch())
+	processor.ProcessNetworkSecretMsgs(context.Background(), rotation(2), false)
+	require.Equal(t, uint64(3), sss.CurrentEpoch())
+	require.Equal(t, map[uint64]uint64{1: 100, 2: 100, 3: 100}, st.epochHeights)
+}
+
+func newTestEnclaveKey(t *testing.T) *crypto.EnclaveAttestedKeyService {
+	eks := crypto.NewEnclaveAttestedKeyService(gethlog.New())
+	key, err := eks.GenerateEnclaveKey()
+	require.NoError(t, err)
+	eks.SetEnclaveKey(key)
+	return eks
+}
+
+func rotationEvent(eventType common.L1TenEventType, txData *common.L1TxData) *common.ProcessedL1Data {
+	processed := &common.ProcessedL1Data{BlockHeader: &types.Header{Number: big.NewInt(100)}}
+	processed.AddEvent(eventType, txData)
+	return processed
+}
+
+func epochLog(eventID gethcommon.Hash, epoch uint64) *types.Log {
+	return &types.Log{
+		Address: testMgmtContract,
+		Topics:  []gethcommon.Hash{eventID, gethcommon.BigToHash(new(big.Int).SetUint64(epoch))},
+	}
+}
@@ -0,0 +1,619 @@
+package components
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/compression"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/measure"
+	"github.com/ten-protocol/go-ten/go/common/signature"
+	enclaveconfig "github.com/ten-protocol/go-ten/go/enclave/config"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/system"
+)
+
+// snapshotChunkSize - the size of the content of a chunk before compression and encryption. It keeps the chunks well
+// below the maximum size of the messages of the enclave RPC.
+const snapshotChunkSize = 1024 * 1024
+
+// the content of a chunk of a state snapshot, before compression and encryption. The chunks contain the trie nodes and
+// the codes of the state, followed by the canonical batches since genesis. The system contract addresses are in the
+// last chunk.
+type snapshotChunk struct {
+	TrieNodes       [][]byte
+	Codes           [][]byte
+	Batches         []*snapshotBatch
+	SystemContracts []byte // json encoded, as in the database
+}
+
+type snapshotBatch struct {
+	Header       *common.BatchHeader
+	Transactions []*common.L2Tx
+	SyntheticTxs []*snapshotSyntheticTx
+	WithReceipts bool               // false if the exporting enclave does not store the executed transactions
+	Receipts     []*snapshotReceipt // the receipts of the transactions followed by the receipts of the synthetic transactions
+}
+
+type snapshotSyntheticTx struct {
+	Tx     *common.L2Tx
+	Sender gethcommon.Address
+}
+
+type snapshotReceipt struct {
+	Type              uint8
+	PostState         []byte
+	Status            uint64
+	CumulativeGasUsed uint64
+	EffectiveGasPrice uint64
+	ContractAddress   gethcommon.Address
+	Logs              []*snapshotLog
+	CreatedContracts  []byte // json encoded, because the visibility configs have optional fields
+}
+
+type snapshotLog struct {
+	Address gethcommon.Address
+	Topics  []gethcommon.Hash
+	Data    []byte
+	Index   uint64
+}
+
+// StateSnapshotService - exports the state of the network at a batch, and imports it in a new validator enclave, so
+// that the validator doesn't have to replay all the rollups since genesis.
+//
+// The snapshot contains the state of the snapshot batch and all the canonical batches since genesis, and is streamed in
+// chunks. The batches are stored with their executed transactions, so the importing enclave rebuilds the contracts,
+// event types and logs which are not part of the state, and finds the batches of the rollups it processes.
+//
+// The snapshot is trusted because its chunks are encrypted with a key derived from the shared secret, and it is signed
+// by the exporting enclave with the key of its attestation. Before the snapshot is activated, the importing enclave
+// checks that the batches chain from genesis to the snapshot batch, that the transactions and receipts match the roots
+// of the batch headers, and that the imported trie is complete for the state root of the snapshot batch.
+type StateSnapshotService struct {
+	storage                storage.Storage
+	registry               BatchRegistry
+	gethEncodingService    gethencoding.EncodingService
+	daEncryptionService    *crypto.DAEncryptionService
+	dataCompressionService compression.DataCompressionService
+	enclaveKeyService      *crypto.EnclaveAttestedKeyService
+	attestationProvider    AttestationProvider
+	sigVerifier            *SignatureValidator
+	systemContracts        system.SystemContractCallbacks
+	msgBusManager          system.SystemContractsInitializable
+	config                 *enclaveconfig.EnclaveConfig
+	logger                 gethlog.Logger
+}
+
+func NewStateSnapshotService(
+	storage storage.Storage,
+	registry BatchRegistry,
+	gethEncodingService gethencoding.EncodingService,
+	daEncryptionService *crypto.DAEncryptionService,
+	dataCompressionService compression.DataCompressionService,
+	enclaveKeyService *crypto.EnclaveAttestedKeyService,
+	attestationProvider AttestationProvider,
+	systemContracts system.SystemContractCallbacks,
+	msgBusManager system.SystemContractsInitializable,
+	config *enclaveconfig.EnclaveConfig,
+	logger gethlog.Logger,
+) *StateSnapshotService {
+	sigVerifier, err := NewSignatureValidator(storage)
+	if err != nil {
+		logger.Crit("Could not initialise the signature validator", log.ErrKey, err)
+	}
+	return &StateSnapshotService{
+		storage:                storage,
+		registry:               registry,
+		gethEncodingService:    gethEncodingService,
+		daEncryptionService:    daEncryptionService,
+		dataCompressionService: dataCompressionService,
+		enclaveKeyService:      enclaveKeyService,
+		attestationProvider:    attestationProvider,
+		sigVerifier:            sigVerifier,
+		systemContracts:        systemContracts,
+		msgBusManager:          msgBusManager,
+		config:                 config,
+		logger:                 logger,
+	}
+}
+
+// Export - creates a snapshot of the state at the canonical batch with the sequence number. The chunks of the snapshot
+// are passed to `onChunk` before the snapshot is returned.
+func (s *StateSnapshotService) Export(ctx context.Context, seqNo uint64, onChunk func(chunk []byte) error) (*common.StateSnapshot, error) {
+	defer core.LogMethodDuration(s.logger, measure.NewStopwatch(), "Exported state snapshot", log.BatchSeqNoKey, seqNo)
+
+	header, err := s.storage.FetchBatchHeaderBySeqNo(ctx, seqNo)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch batch %d. Cause: %w", seqNo, err)
+	}
+	canonical, err := s.storage.IsBatchCanonical(ctx, seqNo)
+	if err != nil {
+		return nil, fmt.Errorf("could not check if batch %d is canonical. Cause: %w", seqNo, err)
+	}
+	executed, err := s.storage.BatchWasExecuted(ctx, header.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("could not check if batch %d was executed. Cause: %w", seqNo, err)
+	}
+	if !canonical || !executed {
+		return nil, fmt.Errorf("batch %d is not a canonical executed batch", seqNo)
+	}
+
+	w := &snapshotWriter{service: s, onChunk: onChunk, content: &snapshotChunk{}}
+	err = s.storage.ExportState(header.Root, w.addTrieNode, w.addCode)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis, err := s.storage.FetchBatchByHeight(ctx, 0)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch genesis batch. Cause: %w", err)
+	}
+	headers, err := s.storage.FetchCanonicalBatchesBetween(ctx, genesis.SeqNo().Uint64(), seqNo)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch canonical batches. Cause: %w", err)
+	}
+	for _, h := range headers {
+		b, err := s.exportBatch(ctx, h.SequencerOrderNo.Uint64(), s.config.StoreExecutedTransactions)
+		if err != nil {
+			return nil, fmt.Errorf("could not export batch %d. Cause: %w", h.SequencerOrderNo, err)
+		}
+		if err := w.addBatch(b); err != nil {
+			return nil, err
+		}
+	}
+
+	addresses, err := s.storage.GetSystemContractAddresses(ctx)
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return nil, fmt.Errorf("could not fetch system contract addresses. Cause: %w", err)
+	}
+	if len(addresses) > 0 {
+		w.content.SystemContracts, err = json.Marshal(addresses)
+		if err != nil {
+			return nil, fmt.Errorf("could not encode system contract addresses. Cause: %w", err)
+		}
+	}
+	if err := w.flush(); err != nil {
+		return nil, err
+	}
+
+	attestation, err := s.attestationProvider.CreateAttestationReport(ctx, s.config.HostAddress)
+	if err != nil {
+		return nil, fmt.Errorf("could not create attestation report. Cause: %w", err)
+	}
+	snapshot := &common.StateSnapshot{
+		BatchSeqNo:  seqNo,
+		BatchHash:   header.Hash(),
+		StateRoot:   header.Root,
+		Attestation: attestation,
+		Chunks:      w.chunks,
+		ChunksHash:  w.chunksHash,
+	}
+	snapshot.Signature, err = s.enclaveKeyService.Sign(snapshot.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("could not sign snapshot. Cause: %w", err)
+	}
+
+	s.logger.Info("Exported state snapshot", log.BatchSeqNoKey, seqNo, log.BatchHashKey, snapshot.BatchHash,
+		"batches", len(headers), "trie_nodes", w.trieNodes, "chunks", w.chunks, "size", w.size)
+	return snapshot, nil
+}
+
+// snapshotWriter - groups the content of a snapshot into chunks, and passes every chunk to `onChunk` once it is full
+type snapshotWriter struct {
+	service     *StateSnapshotService
+	onChunk     func(chunk []byte) error
+	content     *snapshotChunk // the content of the next chunk
+	contentSize int
+	trieNodes   int
+	chunks      uint64
+	chunksHash  gethcommon.Hash
+	size        int // the total size of the chunks
+}
+
+func (w *snapshotWriter) addTrieNode(blob []byte) error {
+	w.content.TrieNodes = append(w.content.TrieNodes, blob)
+	w.trieNodes++
+	return w.added(len(blob))
+}
+
+func (w *snapshotWriter) addCode(code []byte) error {
+	w.content.Codes = append(w.content.Codes, code)
+	return w.added(len(code))
+}
+
+func (w *snapshotWriter) addBatch(b *snapshotBatch) error {
+	encoded, err := rlp.EncodeToBytes(b)
+	if err != nil {
+		return fmt.Errorf("could not encode batch %d. Cause: %w", b.Header.SequencerOrderNo, err)
+	}
+	w.content.Batches = append(w.content.Batches, b)
+	return w.added(len(encoded))
+}
+
+func (w *snapshotWriter) added(size int) error {
+	w.contentSize += size
+	if w.contentSize < snapshotChunkSize {
+		return nil
+	}
+	return w.flush()
+}
+
+func (w *snapshotWriter) flush() error {
+	encoded, err := rlp.EncodeToBytes(w.content)
+	if err != nil {
+		return fmt.Errorf("could not encode snapshot chunk. Cause: %w", err)
+	}
+	compressed, err := w.service.dataCompressionService.CompressRollup(encoded)
+	if err != nil {
+		return fmt.Errorf("could not compress snapshot chunk. Cause: %w", err)
+	}
+	encrypted, err := w.service.daEncryptionService.Encrypt(compressed)
+	if err != nil {
+		return fmt.Errorf("could not encrypt snapshot chunk. Cause: %w", err)
+	}
+	if err := w.onChunk(encrypted); err != nil {
+		return fmt.Errorf("could not send snapshot chunk %d. Cause: %w", w.chunks, err)
+	}
+	w.chunks++
+	w.chunksHash = common.NextStateSnapshotChunksHash(w.chunksHash, encrypted)
+	w.size += len(encrypted)
+	w.content = &snapshotChunk{}
+	w.contentSize = 0
+	return nil
+}
+
+func (s *StateSnapshotService) exportBatch(ctx context.Context, seqNo uint64, withReceipts bool) (*snapshotBatch, error) {
+	batch, err := s.storage.FetchBatchBySeqNo(ctx, seqNo)
+	if err != nil {
+		return nil, err
+	}
+	syntheticTxs, err := s.storage.FetchBatchSyntheticTransactions(ctx, batch.Header.Number.Uint64())
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch synthetic transactions. Cause: %w", err)
+	}
+	b := &snapshotBatch{
+		Header:       batch.Header,
+		Transactions: batch.Transactions,
+		SyntheticTxs: make([]*snapshotSyntheticTx, len(syntheticTxs)),
+		WithReceipts: withReceipts,
+	}
+	for i, tx := range syntheticTxs {
+		b.SyntheticTxs[i] = &snapshotSyntheticTx{Tx: tx.Tx, Sender: *tx.Sender}
+	}
+	if !withReceipts {
+		return b, nil
+	}
+
+	receipts, err := s.storage.FetchBatchReceipts(ctx, seqNo)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch receipts. Cause: %w", err)
+	}
+	createdContracts, err := s.storage.FetchContractsCreatedAtHeight(ctx, batch.Header.Number.Uint64())
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch created contracts. Cause: %w", err)
+	}
+	b.Receipts = make([]*snapshotReceipt, len(receipts))
+	for i, r := range receipts {
+		sr := &snapshotReceipt{
+			Type:              r.TxType,
+			PostState:         r.PostState,
+			Status:            r.Status,
+			CumulativeGasUsed: r.CumulativeGasUsed,
+			Logs:              make([]*snapshotLog, len(r.Logs)),
+		}
+		if r.EffectiveGasPrice != nil {
+			sr.EffectiveGasPrice = *r.EffectiveGasPrice
+		}
+		if r.CreatedContract != nil {
+			sr.ContractAddress = *r.CreatedContract
+		}
+		for j, l := range r.Logs {
+			sr.Logs[j] = &snapshotLog{Address: l.Address, Topics: l.Topics, Data: l.Data, Index: uint64(l.Index)}
+		}
+		if contracts, ok := createdContracts[r.TxHash]; ok {
+			sr.CreatedContracts, err = json.Marshal(contracts)
+			if err != nil {
+				return nil, fmt.Errorf("could not encode created contracts. Cause: %w", err)
+			}
+		}
+		b.Receipts[i] = sr
+	}
+	return b, nil
+}
+
+// Import - verifies the snapshot and stores its batches and state, making the snapshot batch the head of the enclave.
+// The chunks of the snapshot are read with `nextChunk`, which returns io.EOF after the last chunk.
+// The batches which are already stored by the enclave must match the batches of the snapshot.
+func (s *StateSnapshotService) Import(ctx context.Context, snapshot *common.StateSnapshot, nextChunk func() ([]byte, error)) error {
+	defer core.LogMethodDuration(s.logger, measure.NewStopwatch(), "Imported state snapshot", log.BatchSeqNoKey, snapshot.BatchSeqNo)
+
+	if err := s.verifySignature(snapshot); err != nil {
+		return fmt.Errorf("invalid state snapshot. Cause: %w", err)
+	}
+	if head := s.registry.HeadBatchSeq(); head != nil && head.Uint64() >= snapshot.BatchSeqNo {
+		return fmt.Errorf("enclave is already at batch %d", head)
+	}
+
+	// the trie nodes and codes are written as they arrive, which is safe because they are keyed by their hash. The
+	// state is only used once the snapshot is verified and activated.
+	var snapshotBatches []*snapshotBatch
+	var systemContracts []byte
+	chunks := uint64(0)
+	chunksHash := gethcommon.Hash{}
+	for {
+		chunk, err := nextChunk()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("could not read snapshot chunk %d. Cause: %w", chunks, err)
+		}
+		if chunks == snapshot.Chunks {
+			return fmt.Errorf("invalid state snapshot. Cause: more than %d chunks", snapshot.Chunks)
+		}
+		chunks++
+		chunksHash = common.NextStateSnapshotChunksHash(chunksHash, chunk)
+
+		content, err := s.decryptChunk(chunk)
+		if err != nil {
+			return fmt.Errorf("invalid state snapshot chunk %d. Cause: %w", chunks-1, err)
+		}
+		if err := s.storage.WriteStateNodes(content.TrieNodes, content.Codes); err != nil {
+			return err
+		}
+		snapshotBatches = append(snapshotBatches, content.Batches...)
+		if len(content.SystemContracts) > 0 {
+			systemContracts = content.SystemContracts
+		}
+	}
+	if chunks != snapshot.Chunks || chunksHash != snapshot.ChunksHash {
+		return fmt.Errorf("invalid state snapshot. Cause: the chunks do not match the snapshot")
+	}
+	if err := s.storage.CheckState(snapshot.StateRoot); err != nil {
+		return fmt.Errorf("invalid state snapshot. Cause: %w", err)
+	}
+
+	batches, results, err := s.verifyBatches(snapshot, snapshotBatches)
+	if err != nil {
+		return fmt.Errorf("invalid state snapshot. Cause: %w", err)
+	}
+	for _, b := range batches {
+		existing, err := s.storage.FetchBatchHeaderBySeqNo(ctx, b.SeqNo().Uint64())
+		if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+			return fmt.Errorf("could not fetch batch %d. Cause: %w", b.SeqNo(), err)
+		}
+		if existing != nil && existing.Hash() != b.Hash() {
+			return fmt.Errorf("batch %d of the snapshot conflicts with the stored batch %s", b.SeqNo(), existing.Hash())
+		}
+	}
+
+	for i, b := range batches {
+		executed, err := s.storage.BatchWasExecuted(ctx, b.Hash())
+		if err != nil {
+			return fmt.Errorf("could not check if batch %d was executed. Cause: %w", b.SeqNo(), err)
+		}
+		if executed {
+			continue
+		}
+		convertedHeader, err := s.gethEncodingService.CreateEthHeaderForBatch(ctx, b.Header)
+		if err != nil {
+			return fmt.Errorf("could not convert batch %d. Cause: %w", b.SeqNo(), err)
+		}
+		if err := s.storage.StoreBatch(ctx, b, convertedHeader.Hash()); err != nil {
+			return fmt.Errorf("could not store batch %d. Cause: %w", b.SeqNo(), err)
+		}
+		if err := s.storage.StoreExecutedBatch(ctx, b, results[i]); err != nil {
+			return fmt.Errorf("could not store executed batch %d. Cause: %w", b.SeqNo(), err)
+		}
+	}
+
+	if len(systemContracts) > 0 {
+		var addresses common.SystemContractAddresses
+		if err := json.Unmarshal(systemContracts, &addresses); err != nil {
+			return fmt.Errorf("could not decode system contract addresses. Cause: %w", err)
+		}
+		if err := s.storage.StoreSystemContractAddresses(ctx, addresses); err != nil {
+			return fmt.Errorf("could not store system contract addresses. Cause: %w", err)
+		}
+		if err := s.systemContracts.Load(s.msgBusManager); err != nil {
+			return fmt.Errorf("could not load system contracts. Cause: %w", err)
+		}
+	}
+
+	// activate the snapshot
+	head := batches[len(batches)-1]
+	if err := s.registry.OnBatchExecuted(head.Header, nil); err != nil {
+		return fmt.Errorf("could not activate snapshot batch. Cause: %w", err)
+	}
+	s.logger.Info("Imported state snapshot", log.BatchSeqNoKey, snapshot.BatchSeqNo, log.BatchHashKey, snapshot.BatchHash,
+		"batches", len(batches), "exported_by", snapshot.Attestation.EnclaveID)
+	return nil
+}
+
+func (s *StateSnapshotService) verifySignature(snapshot *common.StateSnapshot) error {
+	att := snapshot.Attestation
+	if att == nil {
+		return fmt.Errorf("missing attestation")
+	}
+	data, err := s.attestationProvider.VerifyReport(att)
+	if err != nil {
+		return fmt.Errorf("could not verify attestation report. Cause: %w", err)
+	}
+	if err := VerifyIdentity(data, att); err != nil {
+		return err
+	}
+	pubKey, err := gethcrypto.DecompressPubkey(att.PubKey)
+	if err != nil {
+		return fmt.Errorf("could not parse attested public key. Cause: %w", err)
+	}
+	if gethcrypto.PubkeyToAddress(*pubKey) != att.EnclaveID {
+		return fmt.Errorf("attested public key does not match enclave %s", att.EnclaveID)
+	}
+	if err := signature.VerifySignature(pubKey, snapshot.Hash().Bytes(), snapshot.Signature); err != nil {
+		return fmt.Errorf("could not verify signature of enclave %s. Cause: %w", att.EnclaveID, err)
+	}
+	return nil
+}
+
+func (s *StateSnapshotService) decryptChunk(chunk []byte) (*snapshotChunk, error) {
+	// the chunks can only be decrypted by the enclaves which share the secret of the network
+	compressed, err := s.daEncryptionService.Decrypt(chunk)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt chunk. Cause: %w", err)
+	}
+	encoded, err := s.dataCompressionService.Decompress(compressed)
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress chunk. Cause: %w", err)
+	}
+	content := new(snapshotChunk)
+	if err := rlp.DecodeBytes(encoded, content); err != nil {
+		return nil, fmt.Errorf("could not decode chunk. Cause: %w", err)
+	}
+	return content, nil
+}
+
+// verifyBatches - checks that the batches form a chain from genesis to the snapshot batch, and that their content
+// matches the roots of their headers. Returns the batches with the results to be stored.
+func (s *StateSnapshotService) verifyBatches(snapshot *common.StateSnapshot, snapshotBatches []*snapshotBatch) ([]*core.Batch, []core.TxExecResults, error) {
+	if len(snapshotBatches) == 0 {
+		return nil, nil, fmt.Errorf("no batches")
+	}
+
+	batches := make([]*core.Batch, len(snapshotBatches))
+	results := make([]core.TxExecResults, len(snapshotBatches))
+	for i, sb := range snapshotBatches {
+		b := &core.Batch{Header: sb.Header, Transactions: sb.Transactions}
+		if i == 0 && !b.Header.IsGenesis() {
+			return nil, nil, fmt.Errorf("batches do not start with the genesis batch")
+		}
+		if i > 0 {
+			parent := batches[i-1]
+			if b.Header.ParentHash != parent.Hash() || b.Number().Uint64() != parent.Number().Uint64()+1 || b.SeqNo().Cmp(parent.SeqNo()) <= 0 {
+				return nil, nil, fmt.Errorf("batch %d is not a child of batch %d", b.SeqNo(), parent.SeqNo())
+			}
+		}
+		if s.config.StoreExecutedTransactions && !sb.WithReceipts {
+			return nil, nil, fmt.Errorf("batch %d does not contain the executed transactions, which this enclave is configured to store", b.SeqNo())
+		}
+		if derivedTxHash(b.Transactions) != b.Header.TxHash {
+			return nil, nil, fmt.Errorf("transactions of batch %d do not match the header", b.SeqNo())
+		}
+		res, err := txExecResults(b, sb)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid batch %d. Cause: %w", b.SeqNo(), err)
+		}
+		batches[i] = b
+		results[i] = res
+	}
+
+	head := batches[len(batches)-1]
+	if head.SeqNo().Uint64() != snapshot.BatchSeqNo || head.Hash() != snapshot.BatchHash || head.Header.Root != snapshot.StateRoot {
+		return nil, nil, fmt.Errorf("batches do not end with the snapshot batch")
+	}
+	// the hash chain links every batch to the head, which must be signed by the sequencer
+	if err := s.sigVerifier.CheckSequencerSignature(head.Hash(), head.Header.Signature); err != nil {
+		return nil, nil, fmt.Errorf("invalid sequencer signature. Cause: %w", err)
+	}
+	return batches, results, nil
+}
+
+// txExecResults - recreates the results of the execution of the batch, which are used to store the synthetic
+// transactions and the receipts
+func txExecResults(b *core.Batch, sb *snapshotBatch) (core.TxExecResults, error) {
+	txs := make([]*core.TxWithSender, 0, len(b.Transactions)+len(sb.SyntheticTxs))
+	for _, tx := range b.Transactions {
+		sender, err := core.GetExternalTxSigner(tx)
+		if err != nil {
+			return nil, fmt.Errorf("could not recover sender of tx %s. Cause: %w", tx.Hash(), err)
+		}
+		txs = append(txs, &core.TxWithSender{Tx: tx, Sender: &sender})
+	}
+	for _, tx := range sb.SyntheticTxs {
+		sender := tx.Sender
+		txs = append(txs, &core.TxWithSender{Tx: tx.Tx, Sender: &sender, IsSynthetic: true})
+	}
+
+	results := make(core.TxExecResults, len(txs))
+	if !sb.WithReceipts {
+		for i, tx := range txs {
+			results[i] = &core.TxExecResult{TxWithSender: tx}
+		}
+		return results, nil
+	}
+
+	if len(sb.Receipts) != len(txs) {
+		return nil, fmt.Errorf("expected %d receipts, got %d", len(txs), len(sb.Receipts))
+	}
+	receipts := make(types.Receipts, len(txs))
+	var prevCumulativeGas uint64
+	for i, sr := range sb.Receipts {
+		r := &types.Receipt{
+			Type:              sr.Type,
+			PostState:         sr.PostState,
+			Status:            sr.Status,
+			CumulativeGasUsed: sr.CumulativeGasUsed,
+			TxHash:            txs[i].Tx.Hash(),
+			ContractAddress:   sr.ContractAddress,
+			GasUsed:           sr.CumulativeGasUsed - prevCumulativeGas,
+			EffectiveGasPrice: new(big.Int).SetUint64(sr.EffectiveGasPrice),
+			BlockHash:         b.Hash(),
+			BlockNumber:       b.Number(),
+			TransactionIndex:  uint(i),
+			Logs:              make([]*types.Log, len(sr.Logs)),
+		}
+		prevCumulativeGas = sr.CumulativeGasUsed
+		for j, sl := range sr.Logs {
+			r.Logs[j] = &types.Log{
+				Address:     sl.Address,
+				Topics:      sl.Topics,
+				Data:        sl.Data,
+				BlockNumber: b.Number().Uint64(),
+				TxHash:      r.TxHash,
+				TxIndex:     uint(i),
+				BlockHash:   b.Hash(),
+				Index:       uint(sl.Index),
+			}
+		}
+		r.Bloom = types.CreateBloom(types.Receipts{r})
+
+		var createdContracts map[gethcommon.Address]*core.ContractVisibilityConfig
+		if len(sr.CreatedContracts) > 0 {
+			if err := json.Unmarshal(sr.CreatedContracts, &createdContracts); err != nil {
+				return nil, fmt.Errorf("could not decode created contracts. Cause: %w", err)
+			}
+		}
+		receipts[i] = r
+		results[i] = &core.TxExecResult{Receipt: r, CreatedContracts: createdContracts, TxWithSender: txs[i]}
+	}
+
+	receiptHash := types.EmptyRootHash
+	if len(receipts) > 0 {
+		receiptHash = types.DeriveSha(receipts, trie.NewStackTrie(nil))
+	}
+	if receiptHash != b.Header.ReceiptHash {
+		return nil, fmt.Errorf("receipts do not match the header")
+	}
+	return results, nil
+}
+
+func derivedTxHash(txs []*common.L2Tx) gethcommon.Hash {
+	if len(txs) == 0 {
+		return types.EmptyRootHash
+	}
+	return types.DeriveSha(types.Transactions(txs), trie.NewStackTrie(nil))
+}
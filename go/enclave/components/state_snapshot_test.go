@@ -0,0 +1,273 @@
+package components
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/big"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/compression"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	enclaveconfig "github.com/ten-protocol/go-ten/go/enclave/config"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+	"github.com/ten-protocol/go-ten/go/enclave/system"
+)
+
+func TestSnapshotIsSplitInChunks(t *testing.T) {
+	sss := crypto.NewSharedSecretService(gethlog.New())
+	sss.GenerateSharedSecret()
+	s := &StateSnapshotService{
+		daEncryptionService:    crypto.NewDAEncryptionService(sss, gethlog.New()),
+		dataCompressionService: compression.NewBrotliDataCompressionService(),
+	}
+
+	var chunks [][]byte
+	w := &snapshotWriter{service: s, content: &snapshotChunk{}, onChunk: func(chunk []byte) error {
+		chunks = append(chunks, chunk)
+		return nil
+	}}
+	// 8 trie nodes fill a chunk
+	nodes := make([][]byte, 50)
+	for i := range nodes {
+		nodes[i] = bytes.Repeat([]byte{byte(i)}, snapshotChunkSize/8)
+		if err := w.addTrieNode(nodes[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.addBatch(&snapshotBatch{Header: &common.BatchHeader{Number: big.NewInt(1), SequencerOrderNo: big.NewInt(2)}}); err != nil {
+		t.Fatal(err)
+	}
+	w.content.SystemContracts = []byte("{}")
+	if err := w.flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(chunks) != 7 || w.chunks != uint64(len(chunks)) {
+		t.Fatalf("unexpected number of chunks %d", len(chunks))
+	}
+	chunksHash := gethcommon.Hash{}
+	var imported [][]byte
+	for i, chunk := range chunks {
+		chunksHash = common.NextStateSnapshotChunksHash(chunksHash, chunk)
+		content, err := s.decryptChunk(chunk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		imported = append(imported, content.TrieNodes...)
+		// the batches and the system contracts are in the last chunk
+		last := i == len(chunks)-1
+		if (len(content.Batches) > 0) != last || (len(content.SystemContracts) > 0) != last {
+			t.Errorf("unexpected content in chunk %d", i)
+		}
+	}
+	if chunksHash != w.chunksHash {
+		t.Errorf("the chunks do not match the hash")
+	}
+	if len(imported) != len(nodes) {
+		t.Fatalf("expected %d trie nodes, got %d", len(nodes), len(imported))
+	}
+	for i := range nodes {
+		if !bytes.Equal(imported[i], nodes[i]) {
+			t.Errorf("unexpected trie node %d", i)
+		}
+	}
+}
+
+// snapshotTestRegistry - tracks the head batch, the other registry methods are not used by the tests
+type snapshotTestRegistry struct {
+	BatchRegistry
+	head *common.BatchHeader
+}
+
+func (r *snapshotTestRegistry) HeadBatchSeq() *big.Int {
+	if r.head == nil {
+		return nil
+	}
+	return r.head.SequencerOrderNo
+}
+
+func (r *snapshotTestRegistry) OnBatchExecuted(batch *common.BatchHeader, _ []*core.TxExecResult) error {
+	r.head = batch
+	return nil
+}
+
+type snapshotTestMsgBus struct{}
+
+func (snapshotTestMsgBus) Initialize(common.SystemContractAddresses) error { return nil }
+
+// snapshotTestEnclave - an enclave that stores the batches it is given as if it had executed them
+type snapshotTestEnclave struct {
+	storage  storage.Storage
+	registry *snapshotTestRegistry
+	service  *StateSnapshotService
+}
+
+func newSnapshotTestEnclave(t *testing.T, sss *crypto.SharedSecretService, l1Block *types.Header) *snapshotTestEnclave {
+	logger := gethlog.New()
+	config := &enclaveconfig.EnclaveConfig{UseInMemoryDB: true, RPCTimeout: time.Second, StoreExecutedTransactions: true}
+	db, err := sqlite.CreateTemporarySQLiteDB("", "_foreign_keys=on", *config, logger)
+	require.NoError(t, err)
+	cachingService := storage.NewCacheService(logger, true)
+	s := storage.NewStorage(db, cachingService, config, nil, logger)
+	t.Cleanup(func() { _ = s.Close() })
+	require.NoError(t, s.StoreBlock(context.Background(), l1Block, nil))
+
+	keyService := crypto.NewEnclaveAttestedKeyService(logger)
+	enclaveKey, err := keyService.GenerateEnclaveKey()
+	require.NoError(t, err)
+	keyService.SetEnclaveKey(enclaveKey)
+	registry := &snapshotTestRegistry{}
+	return &snapshotTestEnclave{
+		storage:  s,
+		registry: registry,
+		service: NewStateSnapshotService(
+			s,
+			registry,
+			gethencoding.NewGethEncodingService(s, cachingService, crypto.NewEvmEntropyService(sss, logger), logger),
+			crypto.NewDAEncryptionService(sss, logger),
+			compression.NewBrotliDataCompressionService(),
+			keyService,
+			&DummyAttestationProvider{enclaveKeyService: keyService},
+			system.NewSystemContractCallbacks(s, nil, 0, logger),
+			snapshotTestMsgBus{},
+			config,
+			logger,
+		),
+	}
+}
+
+// storeBatch - stores a batch on top of the head batch, with the transactions and the results of their execution
+func (e *snapshotTestEnclave) storeBatch(t *testing.T, l1Block *types.Header, results core.TxExecResults) *core.Batch {
+	ctx := context.Background()
+	header := &common.BatchHeader{
+		Number:           big.NewInt(0),
+		SequencerOrderNo: big.NewInt(int64(common.L2GenesisSeqNo)),
+		L1Proof:          l1Block.Hash(),
+		Root:             types.EmptyRootHash,
+		ReceiptHash:      types.EmptyRootHash,
+		GasLimit:         30_000_000,
+		Signature:        []byte{1},
+	}
+	if parent := e.registry.head; parent != nil {
+		header.ParentHash = parent.Hash()
+		header.Number = new(big.Int).Add(parent.Number, big.NewInt(1))
+		header.SequencerOrderNo = new(big.Int).Add(parent.SequencerOrderNo, big.NewInt(1))
+		header.Time = parent.Time + 1
+	}
+	batch := &core.Batch{Header: header}
+	receipts := make(types.Receipts, len(results))
+	for i, res := range results {
+		batch.Transactions = append(batch.Transactions, res.TxWithSender.Tx)
+		res.Receipt.BlockNumber = header.Number
+		res.Receipt.TransactionIndex = uint(i)
+		for _, l := range res.Receipt.Logs {
+			l.BlockNumber = header.Number.Uint64()
+			l.TxHash = res.Receipt.TxHash
+			l.TxIndex = uint(i)
+		}
+		res.Receipt.Bloom = types.CreateBloom(types.Receipts{res.Receipt})
+		receipts[i] = res.Receipt
+	}
+	header.TxHash = derivedTxHash(batch.Transactions)
+	if len(receipts) > 0 {
+		header.ReceiptHash = types.DeriveSha(receipts, trie.NewStackTrie(nil))
+	}
+	for _, r := range receipts {
+		r.BlockHash = batch.Hash()
+		for _, l := range r.Logs {
+			l.BlockHash = batch.Hash()
+		}
+	}
+
+	convertedHeader, err := e.service.gethEncodingService.CreateEthHeaderForBatch(ctx, header)
+	require.NoError(t, err)
+	require.NoError(t, e.storage.StoreBatch(ctx, batch, convertedHeader.Hash()))
+	require.NoError(t, e.storage.StoreExecutedBatch(ctx, batch, results))
+	require.NoError(t, e.registry.OnBatchExecuted(header, results))
+	return batch
+}
+
+func TestImportSnapshotOfLongChain(t *testing.T) {
+	ctx := context.Background()
+	sss := crypto.NewSharedSecretService(gethlog.New())
+	sss.GenerateSharedSecret()
+	l1Block := &types.Header{Number: big.NewInt(1), Difficulty: big.NewInt(0)}
+	exporter := newSnapshotTestEnclave(t, sss, l1Block)
+	importer := newSnapshotTestEnclave(t, sss, l1Block)
+
+	key, err := gethcrypto.GenerateKey()
+	require.NoError(t, err)
+	sender := gethcrypto.PubkeyToAddress(key.PublicKey)
+	signer := types.LatestSignerForChainID(big.NewInt(testChainID))
+	nonce := uint64(0)
+	execute := func(to *gethcommon.Address, contractAddress gethcommon.Address, logs []*types.Log, created map[gethcommon.Address]*core.ContractVisibilityConfig) core.TxExecResults {
+		tx, err := types.SignNewTx(key, signer, &types.LegacyTx{Nonce: nonce, To: to, Gas: 100_000, GasPrice: big.NewInt(1)})
+		require.NoError(t, err)
+		nonce++
+		receipt := &types.Receipt{
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: 50_000,
+			GasUsed:           50_000,
+			EffectiveGasPrice: big.NewInt(1),
+			TxHash:            tx.Hash(),
+			ContractAddress:   contractAddress,
+			Logs:              logs,
+		}
+		return core.TxExecResults{{Receipt: receipt, CreatedContracts: created, TxWithSender: &core.TxWithSender{Tx: tx, Sender: &sender}}}
+	}
+
+	// a system contract is deployed after genesis, and the chain grows well beyond 256 batches
+	systemContract := gethcrypto.CreateAddress(sender, 0)
+	exporter.storeBatch(t, l1Block, nil)
+	exporter.storeBatch(t, l1Block, execute(nil, systemContract, nil, map[gethcommon.Address]*core.ContractVisibilityConfig{systemContract: {AutoConfig: true}}))
+	for i := 0; i < 300; i++ {
+		exporter.storeBatch(t, l1Block, nil)
+	}
+	require.NoError(t, exporter.storage.StoreSystemContractAddresses(ctx, common.SystemContractAddresses{"TransactionsPostProcessor": &systemContract}))
+
+	head := exporter.registry.head
+	var chunks [][]byte
+	snapshot, err := exporter.service.Export(ctx, head.SequencerOrderNo.Uint64(), func(chunk []byte) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = importer.service.Import(ctx, snapshot, func() ([]byte, error) {
+		if len(chunks) == 0 {
+			return nil, io.EOF
+		}
+		chunk := chunks[0]
+		chunks = chunks[1:]
+		return chunk, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, head.Hash(), importer.registry.head.Hash())
+	genesis, err := importer.storage.FetchBatchByHeight(ctx, 0)
+	require.NoError(t, err)
+	require.True(t, genesis.Header.IsGenesis())
+
+	// the importer stores the events of the system contract emitted by the next batch
+	eventSig := gethcommon.HexToHash("0xe1")
+	eventLog := &types.Log{Address: systemContract, Topics: []gethcommon.Hash{eventSig, gethcommon.BytesToHash(sender.Bytes())}, Data: []byte{1}}
+	next := importer.storeBatch(t, l1Block, execute(&systemContract, gethcommon.Address{}, []*types.Log{eventLog}, nil))
+
+	receipts, err := importer.storage.FetchBatchReceipts(ctx, next.SeqNo().Uint64())
+	require.NoError(t, err)
+	require.Len(t, receipts, 1)
+	require.Len(t, receipts[0].Logs, 1)
+	require.Equal(t, systemContract, receipts[0].Logs[0].Address)
+	require.Equal(t, eventSig, receipts[0].Logs[0].Topics[0])
+}
@@ -11,6 +11,7 @@ import (
 	"unsafe"
 
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ten-protocol/go-ten/go/common/log"
 
@@ -56,6 +57,7 @@ type TxPool struct {
 	stateMutex   sync.Mutex
 	logger       gethlog.Logger
 	validateOnly atomic.Bool
+	pendingFeed  event.Feed // the transactions which become executable, only on the nodes which don't just validate
 }
 
 // NewTxPool returns a new instance of the tx pool
@@ -94,6 +96,11 @@ func (t *TxPool) SetValidateMode(validateOnly bool) {
 	t.validateOnly.Store(validateOnly)
 }
 
+// IsValidateOnly - the nodes which only validate the transactions forward them to the sequencer, so their mempool is empty
+func (t *TxPool) IsValidateOnly() bool {
+	return t.validateOnly.Load()
+}
+
 // can only be started after t.blockchain has at least one block inside
 // note - blocking method that waits for the block.Call only as goroutine
 func (t *TxPool) start() {
@@ -144,9 +151,32 @@ func (t *TxPool) _startInternalPool() error {
 
 	t.pool = memp
 	t.running.Store(true)
+	go t.forwardPendingTransactions()
 	return nil
 }
 
+// SubscribePendingTransactions - the channel receives the transactions which become executable. The mempool of the
+// nodes that only validate the transactions is empty, so their subscribers never receive anything
+func (t *TxPool) SubscribePendingTransactions(ch chan<- core.NewTxsEvent) event.Subscription {
+	return t.pendingFeed.Subscribe(ch)
+}
+
+func (t *TxPool) forwardPendingTransactions() {
+	txsCh := make(chan core.NewTxsEvent, 100)
+	sub := t.legacyPool.SubscribeTransactions(txsCh, false)
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case txs := <-txsCh:
+			if !t.validateOnly.Load() {
+				t.pendingFeed.Send(txs)
+			}
+		case <-sub.Err():
+			return
+		}
+	}
+}
+
 func (t *TxPool) SubmitTx(transaction *common.L2Tx) error {
 	err := t.waitUntilPoolRunning()
 	if err != nil {
@@ -179,8 +209,8 @@ func (t *TxPool) waitUntilPoolRunning() error {
 	}
 }
 
-// PendingTransactions returns all pending transactions grouped per address and ordered per nonce
-func (t *TxPool) PendingTransactions() map[gethcommon.Address][]*gethtxpool.LazyTransaction {
+// PendingTransactions returns the pending transactions that can pay the base fee, grouped per address and ordered per nonce
+func (t *TxPool) PendingTransactions(baseFee *big.Int) map[gethcommon.Address][]*gethtxpool.LazyTransaction {
 	if !t.running.Load() {
 		t.logger.Error("tx pool not running")
 		return nil
@@ -191,18 +221,38 @@ func (t *TxPool) PendingTransactions() map[gethcommon.Address][]*gethtxpool.Lazy
 		return nil
 	}
 
-	// todo - for now using the base fee from the block
-	currentBlock := t.Chain.CurrentBlock()
-	if currentBlock == nil {
-		return make(map[gethcommon.Address][]*gethtxpool.LazyTransaction)
-	}
-	baseFee := currentBlock.BaseFee
 	return t.pool.Pending(gethtxpool.PendingFilter{
 		BaseFee:      uint256.NewInt(baseFee.Uint64()),
 		OnlyPlainTxs: true,
 	})
 }
 
+// ContentFrom returns the executable and the queued (non-executable, e.g. because of a nonce gap) transactions of the
+// address, ordered by nonce. The pool is empty on the nodes that only validate the transactions
+func (t *TxPool) ContentFrom(addr gethcommon.Address) ([]*types.Transaction, []*types.Transaction) {
+	if !t.running.Load() || t.validateOnly.Load() {
+		return nil, nil
+	}
+	return t.legacyPool.ContentFrom(addr)
+}
+
+// Get returns the transaction if it is in the pool, whether it is executable or queued, or nil otherwise
+func (t *TxPool) Get(hash gethcommon.Hash) *types.Transaction {
+	if !t.running.Load() || t.validateOnly.Load() {
+		return nil
+	}
+	return t.legacyPool.Get(hash)
+}
+
+// Status returns whether the transaction is executable (pending), waiting for a nonce gap to be filled (queued), or
+// unknown to the pool. The pool is empty on the nodes that only validate the transactions
+func (t *TxPool) Status(hash gethcommon.Hash) gethtxpool.TxStatus {
+	if !t.running.Load() || t.validateOnly.Load() {
+		return gethtxpool.TxStatusUnknown
+	}
+	return t.legacyPool.Status(hash)
+}
+
 func (t *TxPool) Close() error {
 	defer func() {
 		if err := recover(); err != nil {
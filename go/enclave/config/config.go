@@ -9,6 +9,11 @@ import (
 	"github.com/ten-protocol/go-ten/go/config"
 )
 
+const (
+	StateRetentionArchive = "archive"
+	StateRetentionPruned  = "pruned"
+)
+
 // For now, this is the bridge between TenConfig and the config used internally by the enclave service.
 
 // EnclaveConfig contains the full configuration for an Obscuro enclave service.
@@ -44,6 +49,11 @@ type EnclaveConfig struct {
 	// filepath for the sqlite DB persistence file (can be empty if a throwaway file in /tmp/ is acceptable or
 	//	if using InMemory DB or if attestation is enabled)
 	SqliteDBPath string
+	// StateRetention - whether the state of every batch is kept (StateRetentionArchive), or only the state of the recent
+	// batches and of the rollup checkpoints (StateRetentionPruned)
+	StateRetention string
+	// RetainedBatches - the number of recent batches whose state is kept when the state is pruned
+	RetainedBatches uint64
 	// ProfilerEnabled starts a profiler instance
 	ProfilerEnabled bool
 	// MinGasPrice is the minimum gas price for mining a transaction
@@ -65,11 +75,22 @@ type EnclaveConfig struct {
 	// a protocol limit, but a miner imposed limit and it might be hard to find someone
 	// to include a transaction if it goes above it
 	MaxRollupSize uint64
+	// RevelationPeriod - the number of L1 blocks in a revelation period (zero disables revelation)
+	RevelationPeriod uint64
+	// RevelationDelay - the number of L1 blocks after the end of a revelation period before its DA keys can be revealed
+	RevelationDelay uint64
 
 	GasPaymentAddress        gethcommon.Address
 	BaseFee                  *big.Int
 	GasBatchExecutionLimit   uint64
+	GasBatchTarget           uint64 // the base fee is adjusted according to the gas used by the batches against this target
 	GasLocalExecutionCapFlag uint64
+	// GasBaseFeeActivationHeight - the batch height from which the base fee is adjusted and the batches record their gas used
+	GasBaseFeeActivationHeight uint64
+	// GasOracleActivationHeight - the batch height from which the GasOracle system contract is installed and updated
+	GasOracleActivationHeight uint64
+	// GasBlobCostActivationHeight - the batch height from which the L1 cost of the transactions considers the blob cost
+	GasBlobCostActivationHeight uint64
 
 	// RPCTimeout - calls that are longer than this will be cancelled, to prevent resource starvation
 	// normally, the context is propagated from the host, but in some cases ( like the evm, we have to create a context)
@@ -106,6 +127,9 @@ func EnclaveConfigFromTenConfig(tenCfg *config.TenConfig) *EnclaveConfig {
 		EdgelessDBHost: tenCfg.Enclave.DB.EdgelessDBHost,
 		SqliteDBPath:   tenCfg.Enclave.DB.SqlitePath,
 
+		StateRetention:  tenCfg.Enclave.DB.StateRetention,
+		RetainedBatches: tenCfg.Enclave.DB.RetainedBatches,
+
 		ProfilerEnabled:       tenCfg.Enclave.Debug.EnableProfiler,
 		DebugNamespaceEnabled: tenCfg.Enclave.Debug.EnableDebugNamespace,
 
@@ -113,10 +137,18 @@ func EnclaveConfigFromTenConfig(tenCfg *config.TenConfig) *EnclaveConfig {
 		GasPaymentAddress:        tenCfg.Network.Gas.PaymentAddress,
 		BaseFee:                  tenCfg.Network.Gas.BaseFee,
 		GasBatchExecutionLimit:   tenCfg.Network.Gas.BatchExecutionLimit,
+		GasBatchTarget:           tenCfg.Network.Gas.BatchTarget,
 		GasLocalExecutionCapFlag: tenCfg.Network.Gas.LocalExecutionCap,
 
+		GasBaseFeeActivationHeight:  tenCfg.Network.Gas.BaseFeeActivationHeight,
+		GasOracleActivationHeight:   tenCfg.Network.Gas.OracleActivationHeight,
+		GasBlobCostActivationHeight: tenCfg.Network.Gas.BlobCostActivationHeight,
+
 		TenGenesis:    tenCfg.Network.GenesisJSON,
 		MaxBatchSize:  tenCfg.Network.Batch.MaxSize,
 		MaxRollupSize: tenCfg.Network.Rollup.MaxSize,
+
+		RevelationPeriod: tenCfg.Network.Rollup.RevelationPeriod,
+		RevelationDelay:  tenCfg.Network.Rollup.RevelationDelay,
 	}
 }
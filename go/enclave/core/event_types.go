@@ -21,6 +21,7 @@ type EventVisibilityConfig struct {
 	Public                                      bool  // everyone can see and query for this event
 	Topic1CanView, Topic2CanView, Topic3CanView *bool // If the event is not public, and this is true, it means that the address from topicI is an EOA that can view this event
 	SenderCanView                               *bool // if true, the tx signer will see this event. Default false
+	ContractCanView                             *bool // if true, the contract decides at query time who can see this event, by implementing `canView`
 }
 
 // ContractVisibilityConfig represents the configuration as defined by the dApp developer in the smart contract
@@ -28,6 +29,11 @@ type ContractVisibilityConfig struct {
 	AutoConfig   bool                                       // true for contracts that have no explicit configuration
 	Transparent  *bool                                      // users can configure contracts to be fully transparent. All events will be public, and it will expose the internal storage.
 	EventConfigs map[gethcommon.Hash]*EventVisibilityConfig // map from the event log signature (topics[0]) to the settings
+
+	// the storage slots of a private contract that everyone can read via eth_getStorageAt
+	PublicSlots []gethcommon.Hash
+	// the base slots of the mappings of a private contract whose entries everyone can read, by providing the key
+	PublicMappingSlots []gethcommon.Hash
 }
 
 type TxExecResult struct {
@@ -36,6 +36,10 @@ var (
 	NetworkSecretRespondedID          = MgmtContractABI.Events["NetworkSecretResponded"].ID
 	RollupAddedID                     = MgmtContractABI.Events["RollupAdded"].ID
 	ImportantContractAddressUpdatedID = MgmtContractABI.Events["ImportantContractAddressUpdated"].ID
+	NetworkSecretRotationRequestedID  = MgmtContractABI.Events["NetworkSecretRotationRequested"].ID
+	NetworkSecretRotatedID            = MgmtContractABI.Events["NetworkSecretRotated"].ID
+	ForcedTransactionSubmittedID      = MgmtContractABI.Events["ForcedTransactionSubmitted"].ID
+	FraudReportedID                   = MgmtContractABI.Events["FraudReported"].ID
 )
 
 func lazilyLogReceiptChecksum(block *types.Header, receipts types.Receipts, logger gethlog.Logger) {
@@ -167,6 +171,27 @@ func ConvertLogsToValueTransfers(logs []types.Log, eventName string, messageBusA
 	return messages, nil
 }
 
+// DecodeFraudReported - converts a FraudReported log of the management contract to the fraud report it was emitted for.
+// The signature of the validator is not part of the event, it was checked by the management contract.
+func DecodeFraudReported(log *types.Log) (*common.FraudReport, error) {
+	if len(log.Topics) != 3 {
+		return nil, fmt.Errorf("invalid number of topics in log: %d", len(log.Topics))
+	}
+	var event ManagementContract.ManagementContractFraudReported
+	err := MgmtContractABI.UnpackIntoInterface(&event, "FraudReported", log.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack event data: %w", err)
+	}
+	return &common.FraudReport{
+		BatchSeqNo:        event.BatchSeqNo,
+		BatchHash:         event.BatchHash,
+		ExpectedStateRoot: event.ExpectedStateRoot,
+		ReceivedStateRoot: event.ReceivedStateRoot,
+		Sequencer:         gethcommon.BytesToAddress(log.Topics[1].Bytes()),
+		EnclaveID:         gethcommon.BytesToAddress(log.Topics[2].Bytes()),
+	}, nil
+}
+
 type MerkleBatches []*core.Batch
 
 func (mb MerkleBatches) Len() int {
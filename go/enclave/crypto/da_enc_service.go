@@ -3,31 +3,65 @@ package crypto
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
+	"sync"
 
 	gethlog "github.com/ethereum/go-ethereum/log"
 
+	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/log"
 )
 
 const (
 	// GCMNonceLength is the nonce's length in bytes for encrypting and decrypting transactions.
 	GCMNonceLength = 12
+	// DABlobVersion is the version of the layout of the encrypted blobs, which is their first byte
+	DABlobVersion = byte(1)
+	// VersionPrefixLength is the length in bytes of the layout version prepended to the encrypted blobs
+	VersionPrefixLength = 1
+	// EpochPrefixLength is the length in bytes of the secret epoch prepended to the encrypted blobs, after the version
+	EpochPrefixLength = 8
+	// PeriodPrefixLength is the length in bytes of the revelation period prepended to the encrypted blobs, after the epoch
+	PeriodPrefixLength = 8
+	// BlobPrefixLength - the encrypted blobs start with the version, the epoch, the revelation period and the nonce
+	BlobPrefixLength = VersionPrefixLength + EpochPrefixLength + PeriodPrefixLength + GCMNonceLength
 	// daSuffix is used for generating the encryption key from the shared secret
 	daSuffix = 0
 )
 
+// UnrevealedPeriod - the revelation period of the data which is never revealed, like the batches sent between nodes.
+// Their transactions are revealed through the rollups.
+const UnrevealedPeriod = uint64(math.MaxUint64)
+
+// daKeyID - there is a DA key for each revelation period of each secret epoch
+type daKeyID struct {
+	epoch  uint64
+	period uint64
+}
+
 // DAEncryptionService - handles encryption/decryption of the data stored in the DA layer
+// Data is encrypted with the key of the current secret epoch and of the revelation period of the data. The epoch and the
+// period are prepended to the ciphertext so that historical data can be decrypted with the key it was encrypted with,
+// after a version byte which allows the layout to change.
+// The data encrypted before the layout was versioned (the nonce followed by the ciphertext, for example the rollups
+// already published on the L1) is decrypted with the legacy key, which is derived from the genesis secret.
+// Once a revelation period is over, and the configured delay has elapsed, its keys are revealed so that anyone can
+// decrypt the rollups of that period. The keys of a period reveal nothing about the keys of the other periods.
 type DAEncryptionService struct {
 	sharedSecretService *SharedSecretService
-	cipher              *cipher.AEAD
+	ciphers             map[daKeyID]cipher.AEAD
+	legacyCipher        cipher.AEAD // the cipher of the blobs encrypted before the layout was versioned
+	ciphersMutex        sync.Mutex
 	logger              gethlog.Logger
 }
 
 func NewDAEncryptionService(sharedSecretService *SharedSecretService, logger gethlog.Logger) *DAEncryptionService {
 	da := &DAEncryptionService{
 		sharedSecretService: sharedSecretService,
+		ciphers:             map[daKeyID]cipher.AEAD{},
 		logger:              logger,
 	}
 
@@ -40,16 +74,45 @@ func (t *DAEncryptionService) Initialise() error {
 	if !t.sharedSecretService.IsInitialised() {
 		return errors.New("shared secret service is not initialised")
 	}
-	var err error
-	t.cipher, err = createCypher(t.sharedSecretService)
+	t.ciphersMutex.Lock()
+	defer t.ciphersMutex.Unlock()
+	t.ciphers = map[daKeyID]cipher.AEAD{}
+	t.legacyCipher = nil
+	_, err := t.cipherFor(daKeyID{epoch: t.sharedSecretService.CurrentEpoch(), period: UnrevealedPeriod})
 	if err != nil {
 		return fmt.Errorf("error creating cypher: %w", err)
 	}
 	return nil
 }
 
-func createCypher(sharedSecretService *SharedSecretService) (*cipher.AEAD, error) {
-	key := sharedSecretService.ExtendEntropy([]byte{byte(daSuffix)})
+// CurrentEpoch - the secret epoch used to encrypt new data
+func (t *DAEncryptionService) CurrentEpoch() uint64 {
+	return t.sharedSecretService.CurrentEpoch()
+}
+
+// cipherFor - must be called while holding the lock
+func (t *DAEncryptionService) cipherFor(id daKeyID) (cipher.AEAD, error) {
+	if c, found := t.ciphers[id]; found {
+		return c, nil
+	}
+	key, err := t.periodKey(id.epoch, id.period)
+	if err != nil {
+		return nil, err
+	}
+	c, err := createCypher(key)
+	if err != nil {
+		return nil, err
+	}
+	t.ciphers[id] = c
+	return c, nil
+}
+
+// periodKey - the key of a revelation period is derived from the secret of the epoch
+func (t *DAEncryptionService) periodKey(epoch uint64, period uint64) ([]byte, error) {
+	return t.sharedSecretService.ExtendEntropyForEpoch(epoch, binary.BigEndian.AppendUint64([]byte{byte(daSuffix)}, period))
+}
+
+func createCypher(key []byte) (cipher.AEAD, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, fmt.Errorf("could not initialise AES cipher for enclave DA key. cause %w", err)
@@ -58,39 +121,194 @@ func createCypher(sharedSecretService *SharedSecretService) (*cipher.AEAD, error
 	if err != nil {
 		return nil, fmt.Errorf("could not initialise GCM cipher for enclave DA key. cause %w", err)
 	}
-	return &cipher, nil
+	return cipher, nil
 }
 
+// Encrypt - encrypts data which is never revealed, with the key of the current epoch
 func (t *DAEncryptionService) Encrypt(blob []byte) ([]byte, error) {
-	if t.cipher == nil {
+	return t.EncryptForPeriod(t.sharedSecretService.CurrentEpoch(), UnrevealedPeriod, blob)
+}
+
+// EncryptForPeriod - encrypts the blob with the key of the given epoch and revelation period
+func (t *DAEncryptionService) EncryptForPeriod(epoch uint64, period uint64, blob []byte) ([]byte, error) {
+	if !t.sharedSecretService.IsInitialised() {
 		return nil, errors.New("not initialised")
 	}
 
+	t.ciphersMutex.Lock()
+	c, err := t.cipherFor(daKeyID{epoch: epoch, period: period})
+	t.ciphersMutex.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
 	nonce, err := generateSecureEntropy(GCMNonceLength)
 	if err != nil {
 		t.logger.Error("could not generate nonce to encrypt transactions.", log.ErrKey, err)
 		return nil, err
 	}
 
-	ciphertext := (*t.cipher).Seal(nil, nonce, blob, nil)
-	// We prepend the nonce to the ciphertext, so that it can be retrieved when decrypting.
-	return append(nonce, ciphertext...), nil //nolint:makezero
+	// We prepend the version, the epoch, the period and the nonce to the ciphertext, so that they can be retrieved when decrypting.
+	ciphertext := make([]byte, 0, BlobPrefixLength+len(blob)+c.Overhead())
+	ciphertext = append(ciphertext, DABlobVersion)
+	ciphertext = binary.BigEndian.AppendUint64(ciphertext, epoch)
+	ciphertext = binary.BigEndian.AppendUint64(ciphertext, period)
+	ciphertext = append(ciphertext, nonce...)
+	return c.Seal(ciphertext, nonce, blob, nil), nil
 }
 
+// Decrypt - decrypts a blob with the key of the epoch and period of its prefix, or a legacy blob with the legacy key
 func (t *DAEncryptionService) Decrypt(blob []byte) ([]byte, error) {
-	if t.cipher == nil {
+	if !t.sharedSecretService.IsInitialised() {
 		return nil, errors.New("not initialised")
 	}
+	// the first byte of a legacy blob is random, so it can look like a versioned blob
+	if ValidateBlob(blob) == nil {
+		if plaintext, err := t.decryptVersioned(blob); err == nil {
+			return plaintext, nil
+		}
+	}
+	return t.decryptLegacy(blob)
+}
 
-	// The nonce is prepended to the ciphertext.
-	nonce := blob[0:GCMNonceLength]
-	ciphertext := blob[GCMNonceLength:]
+// DecryptForPeriod - decrypts a blob which was encrypted with the key of the given epoch and revelation period. The
+// legacy blobs have no epoch nor period, which are both zero for the data encrypted before the layout was versioned.
+func (t *DAEncryptionService) DecryptForPeriod(epoch uint64, period uint64, blob []byte) ([]byte, error) {
+	if !t.sharedSecretService.IsInitialised() {
+		return nil, errors.New("not initialised")
+	}
+	if ValidateBlob(blob) == nil && BlobEpoch(blob) == epoch && BlobPeriod(blob) == period {
+		plaintext, err := t.decryptVersioned(blob)
+		if err == nil || epoch != GenesisSecretEpoch || period != 0 {
+			return plaintext, err
+		}
+	}
+	if epoch != GenesisSecretEpoch || period != 0 {
+		return nil, fmt.Errorf("blob was not encrypted with the keys of the secret epoch %d and revelation period %d", epoch, period)
+	}
+	return t.decryptLegacy(blob)
+}
 
-	plaintext, err := (*t.cipher).Open(nil, nonce, ciphertext, nil)
+func (t *DAEncryptionService) decryptVersioned(blob []byte) ([]byte, error) {
+	t.ciphersMutex.Lock()
+	c, err := t.cipherFor(daKeyID{epoch: BlobEpoch(blob), period: BlobPeriod(blob)})
+	t.ciphersMutex.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := openBlob(c, blob)
 	if err != nil {
 		t.logger.Error("could not decrypt blob.", log.ErrKey, err)
 		return nil, err
 	}
+	return plaintext, nil
+}
+
+// decryptLegacy - decrypts a blob with the layout used before the versioning: the nonce followed by the ciphertext
+func (t *DAEncryptionService) decryptLegacy(blob []byte) ([]byte, error) {
+	if len(blob) < GCMNonceLength {
+		return nil, errors.New("encrypted blob is too short")
+	}
+	t.ciphersMutex.Lock()
+	defer t.ciphersMutex.Unlock()
+	if t.legacyCipher == nil {
+		key, err := t.sharedSecretService.ExtendEntropyForEpoch(GenesisSecretEpoch, []byte{byte(daSuffix)})
+		if err != nil {
+			return nil, err
+		}
+		if t.legacyCipher, err = createCypher(key); err != nil {
+			return nil, err
+		}
+	}
 
+	plaintext, err := t.legacyCipher.Open(nil, blob[:GCMNonceLength], blob[GCMNonceLength:], nil)
+	if err != nil {
+		t.logger.Error("could not decrypt legacy blob.", log.ErrKey, err)
+		return nil, err
+	}
 	return plaintext, nil
 }
+
+// RevealKeys - returns the keys of the revelation period for each secret epoch. The caller is responsible for checking
+// that the period can be revealed.
+func (t *DAEncryptionService) RevealKeys(period uint64) ([]common.RevealedDAKey, error) {
+	if !t.sharedSecretService.IsInitialised() {
+		return nil, errors.New("not initialised")
+	}
+	if period == UnrevealedPeriod {
+		return nil, errors.New("the data of the unrevealed period is never revealed")
+	}
+	keys := make([]common.RevealedDAKey, 0)
+	for epoch := GenesisSecretEpoch; epoch <= t.sharedSecretService.CurrentEpoch(); epoch++ {
+		key, err := t.periodKey(epoch, period)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, common.RevealedDAKey{Epoch: epoch, Period: period, Key: key})
+	}
+	return keys, nil
+}
+
+// DecryptWithRevealedKey - decrypts a blob outside the enclave, using the revealed key of its epoch and period
+func DecryptWithRevealedKey(key common.RevealedDAKey, blob []byte) ([]byte, error) {
+	if err := ValidateBlob(blob); err != nil {
+		return nil, err
+	}
+	if BlobEpoch(blob) != key.Epoch || BlobPeriod(blob) != key.Period {
+		return nil, fmt.Errorf("blob was encrypted for epoch %d and period %d, not with the key of epoch %d and period %d",
+			BlobEpoch(blob), BlobPeriod(blob), key.Epoch, key.Period)
+	}
+	c, err := createCypher(key.Key)
+	if err != nil {
+		return nil, err
+	}
+	return openBlob(c, blob)
+}
+
+func openBlob(c cipher.AEAD, blob []byte) ([]byte, error) {
+	// The version, the epoch, the period and the nonce are prepended to the ciphertext.
+	nonce := blob[VersionPrefixLength+EpochPrefixLength+PeriodPrefixLength : BlobPrefixLength]
+	ciphertext := blob[BlobPrefixLength:]
+	return c.Open(nil, nonce, ciphertext, nil)
+}
+
+// ValidateBlob - checks that the blob is long enough for its prefix, and that its layout is supported
+func ValidateBlob(blob []byte) error {
+	if len(blob) < BlobPrefixLength {
+		return errors.New("encrypted blob is too short")
+	}
+	if blob[0] != DABlobVersion {
+		return fmt.Errorf("unsupported version %d of the encrypted blob", blob[0])
+	}
+	return nil
+}
+
+// BlobEpoch - the secret epoch used to encrypt the blob, which must have been validated
+func BlobEpoch(blob []byte) uint64 {
+	return binary.BigEndian.Uint64(blob[VersionPrefixLength : VersionPrefixLength+EpochPrefixLength])
+}
+
+// BlobPeriod - the revelation period of the key used to encrypt the blob, which must have been validated
+func BlobPeriod(blob []byte) uint64 {
+	start := VersionPrefixLength + EpochPrefixLength
+	return binary.BigEndian.Uint64(blob[start : start+PeriodPrefixLength])
+}
+
+// RevelationPeriodOf - the revelation period of the data bound to the L1 block at the given height.
+// When revelation is disabled (a zero period length) the data is never revealed.
+func RevelationPeriodOf(l1Height uint64, periodLength uint64) uint64 {
+	if periodLength == 0 {
+		return UnrevealedPeriod
+	}
+	return l1Height / periodLength
+}
+
+// RevealableAt - the first L1 height at which the keys of the revelation period can be revealed, and false if they can
+// never be revealed
+func RevealableAt(period uint64, periodLength uint64, delay uint64) (uint64, bool) {
+	if periodLength == 0 || period == UnrevealedPeriod || period >= (math.MaxUint64-delay)/periodLength {
+		return 0, false
+	}
+	return (period+1)*periodLength + delay, true
+}
@@ -0,0 +1,95 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// the revealed keys of a period decrypt its data outside the enclave, but not the data of other periods
+func TestRevealedKeysDecryptTheirPeriod(t *testing.T) {
+	sss := newTestSharedSecretService()
+	da := NewDAEncryptionService(sss, testLogger)
+
+	period5Blob, err := da.EncryptForPeriod(GenesisSecretEpoch, 5, []byte("period 5"))
+	require.NoError(t, err)
+	period6Blob, err := da.EncryptForPeriod(GenesisSecretEpoch, 6, []byte("period 6"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), BlobPeriod(period5Blob))
+
+	require.NoError(t, sss.AddEpoch(newTestEpoch(t, 1)))
+	rotatedBlob, err := da.EncryptForPeriod(1, 5, []byte("rotated"))
+	require.NoError(t, err)
+
+	keys, err := da.RevealKeys(5)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	require.NotEqual(t, keys[0].Key, keys[1].Key)
+
+	plaintext, err := DecryptWithRevealedKey(keys[0], period5Blob)
+	require.NoError(t, err)
+	require.Equal(t, "period 5", string(plaintext))
+	plaintext, err = DecryptWithRevealedKey(keys[1], rotatedBlob)
+	require.NoError(t, err)
+	require.Equal(t, "rotated", string(plaintext))
+
+	_, err = DecryptWithRevealedKey(keys[0], period6Blob)
+	require.Error(t, err)
+	forged := keys[0]
+	forged.Period = 6
+	_, err = DecryptWithRevealedKey(forged, period6Blob)
+	require.Error(t, err)
+
+	// the data sent between nodes is never revealed
+	_, err = da.RevealKeys(UnrevealedPeriod)
+	require.Error(t, err)
+}
+
+func TestRevelationPeriods(t *testing.T) {
+	require.Equal(t, uint64(0), RevelationPeriodOf(99, 100))
+	require.Equal(t, uint64(1), RevelationPeriodOf(100, 100))
+	require.Equal(t, UnrevealedPeriod, RevelationPeriodOf(100, 0))
+
+	revealableAt, ok := RevealableAt(1, 100, 50)
+	require.True(t, ok)
+	require.Equal(t, uint64(250), revealableAt)
+
+	_, ok = RevealableAt(1, 0, 50)
+	require.False(t, ok)
+	_, ok = RevealableAt(UnrevealedPeriod, 100, 50)
+	require.False(t, ok)
+}
+
+// the blobs encrypted before the layout was versioned, like the rollups already published, can still be decrypted
+func TestLegacyBlobsAreDecrypted(t *testing.T) {
+	sss := newTestSharedSecretService()
+	da := NewDAEncryptionService(sss, testLogger)
+	require.NoError(t, sss.AddEpoch(newTestEpoch(t, 1)))
+
+	legacyCipher, err := createCypher(sss.ExtendEntropy([]byte{byte(daSuffix)}))
+	require.NoError(t, err)
+	for _, firstByte := range []byte{0, DABlobVersion} {
+		// the nonce was prepended to the ciphertext
+		nonce := make([]byte, GCMNonceLength)
+		nonce[0] = firstByte
+		legacyBlob := legacyCipher.Seal(nonce, nonce, []byte("legacy rollup"), nil)
+
+		plaintext, err := da.Decrypt(legacyBlob)
+		require.NoError(t, err)
+		require.Equal(t, "legacy rollup", string(plaintext))
+		plaintext, err = da.DecryptForPeriod(GenesisSecretEpoch, 0, legacyBlob)
+		require.NoError(t, err)
+		require.Equal(t, "legacy rollup", string(plaintext))
+		// only the rollups without epoch and period can be legacy rollups
+		_, err = da.DecryptForPeriod(1, 0, legacyBlob)
+		require.Error(t, err)
+	}
+
+	blob, err := da.EncryptForPeriod(GenesisSecretEpoch, 0, []byte("versioned rollup"))
+	require.NoError(t, err)
+	plaintext, err := da.DecryptForPeriod(GenesisSecretEpoch, 0, blob)
+	require.NoError(t, err)
+	require.Equal(t, "versioned rollup", string(plaintext))
+	_, err = da.DecryptForPeriod(GenesisSecretEpoch, 1, blob)
+	require.Error(t, err)
+}
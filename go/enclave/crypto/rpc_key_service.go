@@ -2,6 +2,7 @@ package crypto
 
 import (
 	"fmt"
+	"sync"
 
 	gethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/ecies"
@@ -12,14 +13,18 @@ import (
 const rpcSuffix = 1
 
 // RPCKeyService - manages the "TEN - RPC key" used by clients (like the TEN gateway) to make RPC requests
+// There is a key for each secret epoch. Requests encrypted with the key of the previous epoch are still accepted, to
+// give clients time to fetch the new key.
 type RPCKeyService struct {
-	privKey             *ecies.PrivateKey
+	privKeys            map[uint64]*ecies.PrivateKey
+	privKeysMutex       sync.Mutex
 	sharedSecretService *SharedSecretService
 	logger              gethlog.Logger
 }
 
 func NewRPCKeyService(sharedSecretService *SharedSecretService, logger gethlog.Logger) *RPCKeyService {
 	s := &RPCKeyService{
+		privKeys:            map[uint64]*ecies.PrivateKey{},
 		sharedSecretService: sharedSecretService,
 		logger:              logger,
 	}
@@ -35,23 +40,66 @@ func NewRPCKeyService(sharedSecretService *SharedSecretService, logger gethlog.L
 
 // Initialise - called when the shared secret is available
 func (s *RPCKeyService) Initialise() error {
-	// the key is derived from the shared secret to allow transactions to be broadcast
-	bytes := s.sharedSecretService.ExtendEntropy([]byte{byte(rpcSuffix)})
-	ecdsaKey, err := gethcrypto.ToECDSA(bytes)
-	if err != nil {
-		return err
-	}
-	s.privKey = ecies.ImportECDSA(ecdsaKey)
-	return nil
+	s.privKeysMutex.Lock()
+	defer s.privKeysMutex.Unlock()
+	s.privKeys = map[uint64]*ecies.PrivateKey{}
+	_, err := s.keyForEpoch(s.sharedSecretService.CurrentEpoch())
+	return err
 }
 
 func (s *RPCKeyService) DecryptRPCRequest(bytes []byte) ([]byte, error) {
-	return s.privKey.Decrypt(bytes, nil, nil)
+	return s.DecryptForEpoch(s.sharedSecretService.CurrentEpoch(), bytes)
+}
+
+// DecryptForEpoch - decrypts data encrypted with the key of the epoch, or with the key of the previous epoch, since the
+// clients may have fetched the key before the rotation
+func (s *RPCKeyService) DecryptForEpoch(epoch uint64, bytes []byte) ([]byte, error) {
+	s.privKeysMutex.Lock()
+	defer s.privKeysMutex.Unlock()
+	key, err := s.keyForEpoch(epoch)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := key.Decrypt(bytes, nil, nil)
+	if err == nil || epoch == GenesisSecretEpoch {
+		return plaintext, err
+	}
+
+	previousKey, prevErr := s.keyForEpoch(epoch - 1)
+	if prevErr != nil {
+		return nil, err
+	}
+	return previousKey.Decrypt(bytes, nil, nil)
 }
 
 func (s *RPCKeyService) PublicKey() ([]byte, error) {
-	if s.privKey == nil {
+	if !s.sharedSecretService.IsInitialised() {
 		return nil, fmt.Errorf("rpc key service is not initialised")
 	}
-	return gethcrypto.CompressPubkey(s.privKey.PublicKey.ExportECDSA()), nil
+	s.privKeysMutex.Lock()
+	defer s.privKeysMutex.Unlock()
+	key, err := s.keyForEpoch(s.sharedSecretService.CurrentEpoch())
+	if err != nil {
+		return nil, err
+	}
+	return gethcrypto.CompressPubkey(key.PublicKey.ExportECDSA()), nil
+}
+
+// keyForEpoch - the keys are derived from the secret of the epoch to allow transactions to be broadcast.
+// Must be called while holding the lock.
+func (s *RPCKeyService) keyForEpoch(epoch uint64) (*ecies.PrivateKey, error) {
+	if key, found := s.privKeys[epoch]; found {
+		return key, nil
+	}
+	bytes, err := s.sharedSecretService.ExtendEntropyForEpoch(epoch, []byte{byte(rpcSuffix)})
+	if err != nil {
+		return nil, err
+	}
+	ecdsaKey, err := gethcrypto.ToECDSA(bytes)
+	if err != nil {
+		return nil, err
+	}
+	key := ecies.ImportECDSA(ecdsaKey)
+	s.privKeys[epoch] = key
+	return key, nil
 }
@@ -1,25 +1,54 @@
 package crypto
 
 import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
 	"fmt"
+	"sync"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/log"
 )
 
 const (
 	sharedSecretLenInBytes = 32
+	// GenesisSecretEpoch - the epoch of the secret generated by the genesis enclave, before any rotation
+	GenesisSecretEpoch = uint64(0)
 )
 
+// ErrUnknownSecretEpoch - the secret of the epoch was not received by this enclave yet
+var ErrUnknownSecretEpoch = errors.New("unknown secret epoch")
+
 // SharedEnclaveSecret - the entropy
 type SharedEnclaveSecret [sharedSecretLenInBytes]byte
 
+// SecretEpoch - a rotation of the shared secret, distributed through the management contract
+type SecretEpoch struct {
+	Epoch  uint64
+	Secret SharedEnclaveSecret // generated from fresh entropy by the sequencer enclave that distributed it
+}
+
+// secretBundle - the secrets sent to an enclave which joins the network: the genesis secret and the secrets of the epochs
+type secretBundle struct {
+	Secret SharedEnclaveSecret
+	Epochs []SecretEpoch
+}
+
 // SharedSecretService provides functionality to encapsulate, generate, extend, and encrypt the shared secret of the TEN network.
+// The keys derived from the secret are rotated in epochs. The secret of each epoch is generated from fresh entropy by
+// a sequencer enclave and distributed to the attested enclaves, so the keys of an epoch reveal nothing about the keys
+// of the other epochs.
 type SharedSecretService struct {
 	secret *SharedEnclaveSecret
+
+	epochs      []SecretEpoch // the rotations, in order. The genesis epoch is implicit.
+	epochsMutex sync.RWMutex
+
 	logger gethlog.Logger
 }
 
@@ -29,13 +58,22 @@ func NewSharedSecretService(logger gethlog.Logger) *SharedSecretService {
 
 // GenerateSharedSecret - called only by the genesis
 func (sss *SharedSecretService) GenerateSharedSecret() {
-	secret, err := generateSecureEntropy(sharedSecretLenInBytes)
+	secret, err := GenerateEpochSecret()
 	if err != nil {
 		sss.logger.Crit("could not generate secret", log.ErrKey, err)
 	}
-	var tempSecret SharedEnclaveSecret
-	copy(tempSecret[:], secret)
-	sss.secret = &tempSecret
+	sss.SetSharedSecret(&secret)
+}
+
+// GenerateEpochSecret - fresh entropy for the genesis secret or for the secret of a new epoch
+func GenerateEpochSecret() (SharedEnclaveSecret, error) {
+	var secret SharedEnclaveSecret
+	entropy, err := generateSecureEntropy(sharedSecretLenInBytes)
+	if err != nil {
+		return secret, fmt.Errorf("could not generate secret. Cause: %w", err)
+	}
+	copy(secret[:], entropy)
+	return secret, nil
 }
 
 // Secret - should only be used before storing it
@@ -44,14 +82,80 @@ func (sss *SharedSecretService) Secret() *SharedEnclaveSecret {
 }
 
 func (sss *SharedSecretService) SetSharedSecret(ss *SharedEnclaveSecret) {
+	sss.epochsMutex.Lock()
+	defer sss.epochsMutex.Unlock()
 	sss.secret = ss
 }
 
-// ExtendEntropy derives more entropy from the shared secret
+// ExtendEntropy derives more entropy from the shared secret of the genesis epoch
 func (sss *SharedSecretService) ExtendEntropy(extra []byte) []byte {
 	return crypto.Keccak256(sss.secret[:], extra)
 }
 
+// ExtendEntropyForEpoch derives more entropy from the secret of the epoch
+func (sss *SharedSecretService) ExtendEntropyForEpoch(epoch uint64, extra []byte) ([]byte, error) {
+	sss.epochsMutex.RLock()
+	defer sss.epochsMutex.RUnlock()
+	epochSecret, found := sss.epochSecret(epoch)
+	if !found {
+		return nil, fmt.Errorf("%w %d", ErrUnknownSecretEpoch, epoch)
+	}
+	return crypto.Keccak256(epochSecret[:], extra), nil
+}
+
+// AddEpoch - called when the secret of a new epoch is distributed. The epochs must be added in order.
+func (sss *SharedSecretService) AddEpoch(epoch SecretEpoch) error {
+	sss.epochsMutex.Lock()
+	defer sss.epochsMutex.Unlock()
+	if current := sss.currentEpoch(); epoch.Epoch != current+1 {
+		return fmt.Errorf("secret epoch %d does not follow the current epoch %d", epoch.Epoch, current)
+	}
+	sss.epochs = append(sss.epochs, epoch)
+	return nil
+}
+
+// HasEpoch - returns whether the epoch is known, and whether it has the given secret
+func (sss *SharedSecretService) HasEpoch(epoch SecretEpoch) (known bool, sameSecret bool) {
+	sss.epochsMutex.RLock()
+	defer sss.epochsMutex.RUnlock()
+	secret, found := sss.epochSecret(epoch.Epoch)
+	if !found {
+		return false, false
+	}
+	return true, secret == epoch.Secret
+}
+
+// CurrentEpoch - the epoch used to derive the keys for new data
+func (sss *SharedSecretService) CurrentEpoch() uint64 {
+	sss.epochsMutex.RLock()
+	defer sss.epochsMutex.RUnlock()
+	return sss.currentEpoch()
+}
+
+func (sss *SharedSecretService) currentEpoch() uint64 {
+	if len(sss.epochs) == 0 {
+		return GenesisSecretEpoch
+	}
+	return sss.epochs[len(sss.epochs)-1].Epoch
+}
+
+// epochSecret - must be called while holding the lock
+func (sss *SharedSecretService) epochSecret(epoch uint64) (SharedEnclaveSecret, bool) {
+	if epoch == GenesisSecretEpoch {
+		if sss.secret == nil {
+			return SharedEnclaveSecret{}, false
+		}
+		return *sss.secret, true
+	}
+	for _, e := range sss.epochs {
+		if e.Epoch == epoch {
+			return e.Secret, true
+		}
+	}
+	return SharedEnclaveSecret{}, false
+}
+
+// EncryptSecretWithKey - encrypts the genesis secret and the secrets of all the epochs, for an enclave which joins the network
 func (sss *SharedSecretService) EncryptSecretWithKey(pubKey []byte) (common.EncryptedSharedEnclaveSecret, error) {
 	sss.logger.Info(fmt.Sprintf("Encrypting secret with public key %s", gethcommon.Bytes2Hex(pubKey)))
 	key, err := crypto.DecompressPubkey(pubKey)
@@ -59,13 +163,40 @@ func (sss *SharedSecretService) EncryptSecretWithKey(pubKey []byte) (common.Encr
 		return nil, fmt.Errorf("failed to parse public key %w", err)
 	}
 
-	encKey, err := encryptWithPublicKey(sss.secret[:], key)
+	sss.epochsMutex.RLock()
+	bundle, err := rlp.EncodeToBytes(&secretBundle{Secret: *sss.secret, Epochs: sss.epochs})
+	sss.epochsMutex.RUnlock()
+	if err != nil {
+		return nil, fmt.Errorf("could not encode the secrets. Cause: %w", err)
+	}
+
+	encKey, err := encryptWithPublicKey(bundle, key)
 	if err != nil {
 		sss.logger.Info("Failed to encrypt key", log.ErrKey, err)
 	}
 	return encKey, err
 }
 
+// EncryptEpochSecret - encrypts the secret of a new epoch for an attested enclave
+func EncryptEpochSecret(secret SharedEnclaveSecret, pubKey *ecdsa.PublicKey) ([]byte, error) {
+	return encryptWithPublicKey(secret[:], pubKey)
+}
+
+// DecodeSecrets - decodes the secrets received by an enclave which joins the network. The genesis enclave publishes
+// the secret on its own, without epochs.
+func DecodeSecrets(decrypted []byte) (*SharedEnclaveSecret, []SecretEpoch, error) {
+	if len(decrypted) == sharedSecretLenInBytes {
+		var secret SharedEnclaveSecret
+		copy(secret[:], decrypted)
+		return &secret, nil, nil
+	}
+	var bundle secretBundle
+	if err := rlp.Decode(bytes.NewReader(decrypted), &bundle); err != nil {
+		return nil, nil, fmt.Errorf("could not decode the secrets. Cause: %w", err)
+	}
+	return &bundle.Secret, bundle.Epochs, nil
+}
+
 func (sss *SharedSecretService) IsInitialised() bool {
 	return sss.secret != nil
 }
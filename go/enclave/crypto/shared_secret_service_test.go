@@ -0,0 +1,122 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"testing"
+
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/ecies"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common/log"
+)
+
+var testLogger = log.New(log.EnclaveCmp, int(gethlog.LevelError), log.SysOut)
+
+// the keys of each epoch are distinct, and the data of previous epochs can still be decrypted
+func TestDAEncryptionAcrossEpochs(t *testing.T) {
+	sss := newTestSharedSecretService()
+	da := NewDAEncryptionService(sss, testLogger)
+
+	genesisBlob, err := da.Encrypt([]byte("genesis"))
+	require.NoError(t, err)
+	require.Equal(t, GenesisSecretEpoch, BlobEpoch(genesisBlob))
+
+	require.NoError(t, sss.AddEpoch(newTestEpoch(t, 1)))
+	rotatedBlob, err := da.Encrypt([]byte("rotated"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), BlobEpoch(rotatedBlob))
+
+	for blob, expected := range map[string]string{string(genesisBlob): "genesis", string(rotatedBlob): "rotated"} {
+		plaintext, err := da.Decrypt([]byte(blob))
+		require.NoError(t, err)
+		require.Equal(t, expected, string(plaintext))
+	}
+
+	genesisKey, err := sss.ExtendEntropyForEpoch(GenesisSecretEpoch, []byte{daSuffix})
+	require.NoError(t, err)
+	rotatedKey, err := sss.ExtendEntropyForEpoch(1, []byte{daSuffix})
+	require.NoError(t, err)
+	require.NotEqual(t, genesisKey, rotatedKey)
+
+	// the epochs must follow each other
+	require.Error(t, sss.AddEpoch(SecretEpoch{Epoch: 3}))
+	_, err = sss.ExtendEntropyForEpoch(2, []byte{daSuffix})
+	require.Error(t, err)
+
+	// blobs with an unknown layout are rejected
+	unversioned := append([]byte{}, rotatedBlob...)
+	unversioned[0] = DABlobVersion + 1
+	_, err = da.Decrypt(unversioned)
+	require.Error(t, err)
+}
+
+// an enclave which joins the network receives the secrets of all the epochs
+func TestSecretsAreSharedWithTheirEpochs(t *testing.T) {
+	sss := newTestSharedSecretService()
+	epoch1 := newTestEpoch(t, 1)
+	require.NoError(t, sss.AddEpoch(epoch1))
+
+	joiner, err := ecdsa.GenerateKey(gethcrypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	encrypted, err := sss.EncryptSecretWithKey(gethcrypto.CompressPubkey(&joiner.PublicKey))
+	require.NoError(t, err)
+	decrypted, err := decryptWithPrivateKey(encrypted, joiner)
+	require.NoError(t, err)
+
+	secret, epochs, err := DecodeSecrets(decrypted)
+	require.NoError(t, err)
+	require.Equal(t, *sss.Secret(), *secret)
+	require.Equal(t, []SecretEpoch{epoch1}, epochs)
+
+	// the genesis enclave shares the raw secret
+	secret, epochs, err = DecodeSecrets(sss.Secret()[:])
+	require.NoError(t, err)
+	require.Equal(t, *sss.Secret(), *secret)
+	require.Empty(t, epochs)
+}
+
+// after a rotation, requests encrypted with the key of the previous epoch are accepted, but not older ones
+func TestRPCKeyRotation(t *testing.T) {
+	sss := newTestSharedSecretService()
+	rpcKeys := NewRPCKeyService(sss, testLogger)
+	genesisPubKey, err := rpcKeys.PublicKey()
+	require.NoError(t, err)
+
+	require.NoError(t, sss.AddEpoch(newTestEpoch(t, 1)))
+	epoch1PubKey, err := rpcKeys.PublicKey()
+	require.NoError(t, err)
+	require.NotEqual(t, genesisPubKey, epoch1PubKey)
+
+	_, err = rpcKeys.DecryptRPCRequest(encryptRequest(t, genesisPubKey))
+	require.NoError(t, err)
+	_, err = rpcKeys.DecryptRPCRequest(encryptRequest(t, epoch1PubKey))
+	require.NoError(t, err)
+
+	require.NoError(t, sss.AddEpoch(newTestEpoch(t, 2)))
+	_, err = rpcKeys.DecryptRPCRequest(encryptRequest(t, epoch1PubKey))
+	require.NoError(t, err)
+	_, err = rpcKeys.DecryptRPCRequest(encryptRequest(t, genesisPubKey))
+	require.Error(t, err)
+}
+
+func newTestSharedSecretService() *SharedSecretService {
+	sss := NewSharedSecretService(testLogger)
+	sss.GenerateSharedSecret()
+	return sss
+}
+
+func newTestEpoch(t *testing.T, epoch uint64) SecretEpoch {
+	secret, err := GenerateEpochSecret()
+	require.NoError(t, err)
+	return SecretEpoch{Epoch: epoch, Secret: secret}
+}
+
+func encryptRequest(t *testing.T, compressedPubKey []byte) []byte {
+	pubKey, err := gethcrypto.DecompressPubkey(compressedPubKey)
+	require.NoError(t, err)
+	ciphertext, err := ecies.Encrypt(rand.Reader, ecies.ImportECDSAPublic(pubKey), []byte("request"), nil, nil)
+	require.NoError(t, err)
+	return ciphertext
+}
@@ -0,0 +1,98 @@
+package debugger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+// errPrivateContract - the traces expose the internal state of the executed contracts (storage, stack, memory), which
+// is only public for transparent contracts. See also TenStorageRead.
+var errPrivateContract = errors.New("tracing is only supported for the execution of transparent contracts")
+
+// contractGuard - records the contracts whose code runs during a trace, so the trace is only returned when all of them
+// are transparent, or were created during the traced execution.
+// This also covers the state overrides, which can replace the code of a private contract with code that reads its storage.
+// The guard also hides from the tracer the nonces of the accounts the requester can't read, whether they have code or not.
+type contractGuard struct {
+	entered map[gethcommon.Address]bool
+	created map[gethcommon.Address]bool
+}
+
+// guard - wraps the hooks of the tracer to record the executed contracts, and to redact the nonces read by the tracer
+// from the state of the accounts for which `canRead` returns false
+func guard(tracer *tracers.Tracer, canRead func(gethcommon.Address) bool) *contractGuard {
+	g := &contractGuard{
+		entered: make(map[gethcommon.Address]bool),
+		created: make(map[gethcommon.Address]bool),
+	}
+	hooks := *tracer.Hooks
+	onTxStart := hooks.OnTxStart
+	hooks.OnTxStart = func(env *tracing.VMContext, tx *types.Transaction, from gethcommon.Address) {
+		if onTxStart == nil {
+			return
+		}
+		redacted := *env
+		redacted.StateDB = &redactedState{StateDB: env.StateDB, canRead: canRead}
+		onTxStart(&redacted, tx, from)
+	}
+	onEnter := hooks.OnEnter
+	hooks.OnEnter = func(depth int, typ byte, from gethcommon.Address, to gethcommon.Address, input []byte, gas uint64, value *big.Int) {
+		switch vm.OpCode(typ) {
+		case vm.CREATE, vm.CREATE2:
+			g.created[to] = true
+		default:
+			g.entered[to] = true
+		}
+		if onEnter != nil {
+			onEnter(depth, typ, from, to, input, gas, value)
+		}
+	}
+	tracer.Hooks = &hooks
+	return g
+}
+
+// redactedState - the state seen by the tracer. The nonces are private, like in `eth_getTransactionCount`, so the
+// tracer reads 0 for the accounts the requester can't read.
+type redactedState struct {
+	tracing.StateDB
+	canRead func(gethcommon.Address) bool
+}
+
+func (s *redactedState) GetNonce(address gethcommon.Address) uint64 {
+	if !s.canRead(address) {
+		return 0
+	}
+	return s.StateDB.GetNonce(address)
+}
+
+// check - returns errPrivateContract if the code of a private contract ran during the execution
+func (g *contractGuard) check(ctx context.Context, statedb *state.StateDB, storage storage.Storage) error {
+	for address := range g.entered {
+		// accounts without code are EOAs or precompiles, which run no code. Their nonces are redacted by redactedState.
+		if g.created[address] || statedb.GetCodeSize(address) == 0 {
+			continue
+		}
+		contract, err := storage.ReadContract(ctx, address)
+		if err != nil {
+			if errors.Is(err, errutil.ErrNotFound) {
+				return errPrivateContract
+			}
+			return fmt.Errorf("could not read contract %s. Cause: %w", address, err)
+		}
+		if !contract.IsTransparent() {
+			return errPrivateContract
+		}
+	}
+	return nil
+}
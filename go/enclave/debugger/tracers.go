@@ -4,145 +4,377 @@ package debugger
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
 
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/gethapi"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
 	"github.com/ten-protocol/go-ten/go/common/tracers"
-	"github.com/ten-protocol/go-ten/go/enclave/l2chain"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	enclaveconfig "github.com/ten-protocol/go-ten/go/enclave/config"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/evm"
+	"github.com/ten-protocol/go-ten/go/enclave/gas"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/system"
+	gethrpc "github.com/ten-protocol/go-ten/lib/gethfork/rpc"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core"
 )
 
-const (
 // defaultTraceTimeout is the amount of time a single transaction can execute
 // by default before being forcefully aborted.
-// defaultTraceTimeout = 5 * time.Second
+const defaultTraceTimeout = 5 * time.Second
 
-// defaultTraceReexec is the number of blocks the tracer is willing to go back
-// and reexecute to produce missing historical state necessary to run a specific
-// trace.
-// defaultTraceReexec = uint64(128)
-)
+// maxTraceTimeout is the longest timeout a request can set, so that a single trace can't hold the enclave for long.
+const maxTraceTimeout = 30 * time.Second
 
+// Debugger - replays transactions on top of the stored batches and state, and runs the native tracers over them.
+// Note - the Debugger only checks that the traced execution doesn't run the code of private contracts, whose internal
+// state would be exposed by the traces, and hides from the tracers the nonces of the accounts the requester can't read.
+// The authorisation of the requester is the responsibility of the caller.
 type Debugger struct {
-	chain       l2chain.ObscuroChain
-	storage     storage.Storage
-	chainConfig *params.ChainConfig
+	storage             storage.Storage
+	registry            components.BatchRegistry
+	gasOracle           gas.Oracle
+	systemContracts     system.SystemContractCallbacks
+	entropyService      *crypto.EvmEntropyService
+	gethEncodingService gethencoding.EncodingService
+	config              *enclaveconfig.EnclaveConfig
+	chainConfig         *params.ChainConfig
+	logger              gethlog.Logger
 }
 
-func New(chain l2chain.ObscuroChain, storage storage.Storage, config *params.ChainConfig) *Debugger {
+func New(
+	storage storage.Storage,
+	registry components.BatchRegistry,
+	gasOracle gas.Oracle,
+	systemContracts system.SystemContractCallbacks,
+	entropyService *crypto.EvmEntropyService,
+	gethEncodingService gethencoding.EncodingService,
+	config *enclaveconfig.EnclaveConfig,
+	chainConfig *params.ChainConfig,
+	logger gethlog.Logger,
+) *Debugger {
 	return &Debugger{
-		chain:       chain,
-		chainConfig: config,
-		storage:     storage,
+		storage:             storage,
+		registry:            registry,
+		gasOracle:           gasOracle,
+		systemContracts:     systemContracts,
+		entropyService:      entropyService,
+		gethEncodingService: gethEncodingService,
+		config:              config,
+		chainConfig:         chainConfig,
+		logger:              logger,
+	}
+}
+
+// replayEnv - the environment required to re-execute the transactions of a batch exactly like the batch executor did
+type replayEnv struct {
+	ethHeader *types.Header
+	l1Block   *types.Header
+	chain     *evm.TenChainContext
+	gasPool   *gethcore.GasPool
+	usedGas   uint64
+}
+
+// DebugTraceTransaction returns the trace of a transaction included in a canonical batch.
+// The batch is replayed on top of the state of its parent up to the transaction index, and the transaction
+// itself is then executed with the tracer configured in `config`.
+// `canRead` returns true for the accounts whose nonce can be returned to the requester.
+func (d *Debugger) DebugTraceTransaction(ctx context.Context, txHash gethcommon.Hash, config *tracers.TraceConfig, canRead func(gethcommon.Address) bool) (json.RawMessage, error) {
+	_, batchHash, _, txIndex, err := d.storage.GetTransaction(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	batch, err := d.storage.FetchBatch(ctx, batchHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch batch %s. Cause: %w", batchHash, err)
+	}
+	// It shouldn't happen in practice.
+	if batch.NumberU64() == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	// synthetic transactions are executed after the user transactions and depend on data that is not part of the batch
+	if int(txIndex) >= len(batch.Transactions) {
+		return nil, errors.New("synthetic transactions are not traceable")
+	}
+
+	env, statedb, err := d.stateAtTransaction(ctx, batch, int(txIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	txctx := &tracers.Context{
+		BlockHash:   batchHash,
+		BlockNumber: batch.Number(),
+		TxIndex:     int(txIndex),
+		TxHash:      txHash,
+	}
+	return d.traceTx(ctx, env, statedb, batch.Transactions[txIndex], txctx, config, canRead)
+}
+
+// DebugTraceCall returns the trace of a call executed on top of the state of the batch at `blockNumber`.
+// The state overrides from the config are applied before the call is executed.
+func (d *Debugger) DebugTraceCall(ctx context.Context, args *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber, config *tracers.TraceCallConfig, canRead func(gethcommon.Address) bool) (json.RawMessage, error) {
+	batch, err := d.registry.GetBatchAtHeight(ctx, *blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch batch %d. Cause: %w", blockNumber.Int64(), err)
+	}
+	statedb, err := d.registry.GetBatchStateAtHeight(ctx, blockNumber)
+	if err != nil {
+		return nil, fmt.Errorf("could not create stateDB for batch %s. Cause: %w", batch.Hash(), err)
+	}
+
+	var traceConfig *tracers.TraceConfig
+	if config != nil {
+		if err := config.StateOverrides.Apply(statedb); err != nil {
+			return nil, err
+		}
+		traceConfig = &config.TraceConfig
+	}
+
+	msg, err := args.ToMessage(batch.Header.GasLimit-1, batch.Header.BaseFee)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert TransactionArgs to Message - %w", err)
+	}
+
+	txctx := &tracers.Context{
+		BlockHash:   batch.Hash(),
+		BlockNumber: batch.Number(),
+	}
+	tracer, timeout, err := newTracer(txctx, traceConfig)
+	if err != nil {
+		return nil, err
+	}
+	contracts := guard(tracer, canRead)
+	cancel := stopOnTimeout(ctx, tracer, timeout)
+	defer cancel()
+
+	_, err = evm.ExecuteCall(ctx, msg, statedb, batch.Header, d.storage, d.gethEncodingService, d.chainConfig, d.config.GasLocalExecutionCapFlag, *d.config, tracer.Hooks, d.logger)
+	if err != nil {
+		return nil, fmt.Errorf("tracing failed: %w", err)
+	}
+	if err := contracts.check(ctx, statedb, d.storage); err != nil {
+		return nil, err
+	}
+	return tracer.GetResult()
+}
+
+// DebugTraceBatch replays all the user transactions of a batch and returns the traces of the transactions
+// for which `isVisible` returns true. The other transactions are executed without being traced.
+func (d *Debugger) DebugTraceBatch(ctx context.Context, batch *core.Batch, config *tracers.TraceConfig, isVisible func(tx *common.L2Tx) bool, canRead func(gethcommon.Address) bool) ([]*tracers.TxTraceResult, error) {
+	if batch.NumberU64() == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	env, statedb, err := d.stateAtTransaction(ctx, batch, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return traceBatchTxs(batch.Transactions, isVisible, func(idx int, tx *common.L2Tx, traced bool) (json.RawMessage, error, error) {
+		if !traced {
+			result, err := d.executeTx(env, statedb, tx, idx, vm.Config{})
+			if err != nil {
+				return nil, nil, err
+			}
+			return nil, result.Err, nil
+		}
+
+		txctx := &tracers.Context{
+			BlockHash:   batch.Hash(),
+			BlockNumber: batch.Number(),
+			TxIndex:     idx,
+			TxHash:      tx.Hash(),
+		}
+		tracer, timeout, err := newTracer(txctx, config)
+		if err != nil {
+			return nil, nil, err
+		}
+		contracts := guard(tracer, canRead)
+		cancel := stopOnTimeout(ctx, tracer, timeout)
+		defer cancel()
+
+		result, err := d.executeTx(env, statedb, tx, idx, vm.Config{Tracer: tracer.Hooks})
+		if err != nil {
+			return nil, nil, err
+		}
+		if result.Err != nil {
+			return nil, result.Err, nil
+		}
+		if err := contracts.check(ctx, statedb, d.storage); err != nil {
+			if errors.Is(err, errPrivateContract) {
+				return nil, err, nil
+			}
+			return nil, nil, err
+		}
+		res, err := tracer.GetResult()
+		if err != nil {
+			return nil, err, nil
+		}
+		return res, nil, nil
+	})
+}
+
+// executeTxFunc - executes the transaction, and traces it if `traced` is true. The execution error is returned
+// separately from the errors that prevent the replay of the batch from continuing.
+type executeTxFunc func(idx int, tx *common.L2Tx, traced bool) (json.RawMessage, error, error)
+
+// traceBatchTxs - executes the transactions in order and returns the results of the visible ones.
+// A transaction that fails to execute doesn't stop the replay, whether it is visible or not, so the results returned
+// for the visible transactions don't depend on the outcome of the hidden ones. The failure is only reported for
+// the visible transactions.
+func traceBatchTxs(txs []*common.L2Tx, isVisible func(tx *common.L2Tx) bool, execute executeTxFunc) ([]*tracers.TxTraceResult, error) {
+	results := make([]*tracers.TxTraceResult, 0)
+	for idx, tx := range txs {
+		visible := isVisible(tx)
+		res, execErr, err := execute(idx, tx, visible)
+		if err != nil {
+			return nil, err
+		}
+		if !visible {
+			continue
+		}
+		if execErr != nil {
+			results = append(results, &tracers.TxTraceResult{TxHash: tx.Hash(), Error: fmt.Sprintf("tracing failed: %s", execErr)})
+			continue
+		}
+		results = append(results, &tracers.TxTraceResult{TxHash: tx.Hash(), Result: res})
+	}
+	return results, nil
+}
+
+// stateAtTransaction - returns the state after applying all the transactions in the batch preceding the transaction at `txIndex`.
+func (d *Debugger) stateAtTransaction(ctx context.Context, batch *core.Batch, txIndex int) (*replayEnv, *state.StateDB, error) {
+	statedb, err := d.registry.GetBatchState(ctx, gethrpc.BlockNumberOrHash{BlockHash: &batch.Header.ParentHash})
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create stateDB for the parent of batch %s. Cause: %w", batch.Hash(), err)
+	}
+
+	l1Block, err := d.storage.FetchBlock(ctx, batch.Header.L1Proof)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not fetch the l1 block of batch %s. Cause: %w", batch.Hash(), err)
+	}
+	// the batch executor publishes the l1 prices before executing the transactions
+	d.systemContracts.UpdateGasOracle(statedb, batch.NumberU64(), d.gasOracle.L1Prices(l1Block, batch.NumberU64()))
+
+	ethHeader, err := d.gethEncodingService.CreateEthHeaderForBatch(ctx, batch.Header)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not create eth header for batch %s. Cause: %w", batch.Hash(), err)
+	}
+
+	gp := gethcore.GasPool(d.config.GasBatchExecutionLimit)
+	env := &replayEnv{
+		ethHeader: ethHeader,
+		l1Block:   l1Block,
+		chain:     evm.NewTenChainContext(d.storage, d.gethEncodingService, *d.config, d.logger),
+		gasPool:   &gp,
+	}
+
+	for idx, tx := range batch.Transactions[:txIndex] {
+		result, err := d.executeTx(env, statedb, tx, idx, vm.Config{})
+		if err != nil {
+			return nil, nil, err
+		}
+		if result.Err != nil {
+			return nil, nil, fmt.Errorf("transaction %s failed during replay. Cause: %w", tx.Hash(), result.Err)
+		}
 	}
+	return env, statedb, nil
 }
 
-func (d *Debugger) DebugTraceTransaction(context.Context, gethcommon.Hash, *tracers.TraceConfig) (json.RawMessage, error) {
-	return nil, nil
-	//_, blockHash, blockNumber, index, err := d.storage.GetTransaction(txHash)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//// It shouldn't happen in practice.
-	//if blockNumber == 0 {
-	//	return nil, errors.New("genesis is not traceable")
-	//}
-	//reexec := defaultTraceReexec
-	//if config != nil && config.Reexec != nil {
-	//	reexec = *config.Reexec
-	//}
-	//batch, err := d.storage.FetchBatch(blockHash)
-	//if err != nil {
-	//	return nil, err
-	//}
-
-	//msg, vmctx, statedb, err := d.chain.GetChainStateAtTransaction(batch, int(index), reexec)
-	//if err != nil {
-	//	return nil, err
-	//}
-	//
-	//txctx := &gethtracers.Context{
-	//	BlockHash: blockHash,
-	//	TxIndex:   int(index),
-	//	TxHash:    txHash,
-	//}
-	//return d.traceTx(ctx, msg, txctx, vmctx, statedb, config)
+// executeTx - executes a user transaction with the same l1 cost and entropy it was originally executed with
+func (d *Debugger) executeTx(env *replayEnv, statedb *state.StateDB, tx *common.L2Tx, idx int, vmCfg vm.Config) (*core.TxExecResult, error) {
+	cost, err := d.gasOracle.EstimateL1StorageGasCost(tx, env.l1Block, env.ethHeader.Number.Uint64())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get gas cost for tx %s. Cause: %w", tx.Hash(), err)
+	}
+	pricedTx := &common.L2PricedTransaction{
+		Tx:             tx,
+		PublishingCost: big.NewInt(0).Set(cost),
+	}
+
+	ethHeader := *env.ethHeader
+	ethHeader.MixDigest = d.entropyService.TxEntropy(env.ethHeader.MixDigest.Bytes(), idx)
+
+	return evm.ExecuteTransaction(pricedTx, statedb, &ethHeader, env.chain, d.chainConfig, env.gasPool, &env.usedGas, vmCfg, idx, d.logger), nil
 }
 
 // traceTx configures a new tracer according to the provided configuration, and
-// executes the given message in the provided environment. The return value will
+// executes the given transaction in the provided environment. The return value will
 // be tracer dependent.
-//
-//func (d *Debugger) traceTx(ctx context.Context, message *core.Message, txctx *gethtracers.Context, vmctx vm.BlockContext, statedb *state.StateDB, config *tracers.TraceConfig) (json.RawMessage, error) {
-//	// Assemble the structured logger or the JavaScript tracer
-//	var (
-//		tracer    vm.EVMLogger
-//		err       error
-//		txContext = core.NewEVMTxContext(message)
-//	)
-//	switch {
-//	case config == nil:
-//		tracer = logger.NewStructLogger(nil)
-//	case config.Tracer != nil:
-//		// Define a meaningful timeout of a single transaction trace
-//		timeout := defaultTraceTimeout
-//		if config.Timeout != nil {
-//			if timeout, err = time.ParseDuration(*config.Timeout); err != nil {
-//				return nil, err
-//			}
-//		}
-//		//if t, err := tracers.New(*config.Tracer, (*tracers.Context)(txctx)); err != nil {
-//		//	return nil, err
-//		//} else {
-//		//	deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
-//		//	go func() {
-//		//		<-deadlineCtx.Done()
-//		//		if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
-//		//			t.Stop(errors.New("execution timeout"))
-//		//		}
-//		//	}()
-//		//	defer cancel()
-//		//	tracer = t
-//		//}
-//	default:
-//		tracer = logger.NewStructLogger(config.Config)
-//	}
-//	// Run the transaction with tracing enabled.
-//	vmenv := vm.NewEVM(vmctx, txContext, statedb, d.chainConfig, vm.Config{Debug: true, Tracer: tracer, NoBaseFee: true})
-//
-//	// Call Prepare to clear out the statedb access list
-//	statedb.Prepare(txctx.TxHash, txctx.TxIndex)
-//
-//	result, err := core.ApplyMessage(vmenv, message, new(core.GasPool).AddGas(message.Gas()))
-//	if err != nil {
-//		return nil, fmt.Errorf("tracing failed: %w", err)
-//	}
-//
-//	// Depending on the tracer type, format and return the output.
-//	switch tracer := tracer.(type) {
-//	case *logger.StructLogger:
-//		// If the result contains a revert reason, return it.
-//		returnVal := fmt.Sprintf("%x", result.Return())
-//		if len(result.Revert()) > 0 {
-//			returnVal = fmt.Sprintf("%x", result.Revert())
-//		}
-//		exec := &gethapi.ExecutionResult{
-//			Gas:         result.UsedGas,
-//			Failed:      result.Failed(),
-//			ReturnValue: returnVal,
-//			StructLogs:  gethapi.FormatLogs(tracer.StructLogs()),
-//		}
-//		jsonRaw, err := json.Marshal(exec)
-//		if err != nil {
-//			return nil, err
-//		}
-//		return jsonRaw, nil
-//
-//	case tracers.Tracer:
-//		return tracer.GetResult()
-//
-//	default:
-//		panic(fmt.Sprintf("bad tracer type %T", tracer))
-//	}
-//}
+func (d *Debugger) traceTx(ctx context.Context, env *replayEnv, statedb *state.StateDB, tx *common.L2Tx, txctx *tracers.Context, config *tracers.TraceConfig, canRead func(gethcommon.Address) bool) (json.RawMessage, error) {
+	tracer, timeout, err := newTracer(txctx, config)
+	if err != nil {
+		return nil, err
+	}
+	contracts := guard(tracer, canRead)
+
+	cancel := stopOnTimeout(ctx, tracer, timeout)
+	defer cancel()
+
+	result, err := d.executeTx(env, statedb, tx, txctx.TxIndex, vm.Config{Tracer: tracer.Hooks})
+	if err != nil {
+		return nil, err
+	}
+	if result.Err != nil {
+		return nil, fmt.Errorf("tracing failed: %w", result.Err)
+	}
+	if err := contracts.check(ctx, statedb, d.storage); err != nil {
+		return nil, err
+	}
+	return tracer.GetResult()
+}
+
+// stopOnTimeout - stops the tracer if the trace takes longer than the timeout.
+// The returned function must be called when the trace is finished.
+func stopOnTimeout(ctx context.Context, tracer *tracers.Tracer, timeout time.Duration) context.CancelFunc {
+	deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
+	go func() {
+		<-deadlineCtx.Done()
+		if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
+			tracer.Stop(errors.New("execution timeout"))
+		}
+	}()
+	return cancel
+}
+
+// newTracer - returns the tracer requested in the config and the timeout of the trace.
+// The default tracer is the struct logger.
+func newTracer(txctx *tracers.Context, config *tracers.TraceConfig) (*tracers.Tracer, time.Duration, error) {
+	if config == nil {
+		config = &tracers.TraceConfig{}
+	}
+
+	timeout := defaultTraceTimeout
+	if config.Timeout != nil {
+		var err error
+		if timeout, err = time.ParseDuration(*config.Timeout); err != nil {
+			return nil, 0, fmt.Errorf("invalid trace timeout. Cause: %w", err)
+		}
+		if timeout > maxTraceTimeout {
+			timeout = maxTraceTimeout
+		}
+	}
+
+	if config.Tracer == nil {
+		return tracers.NewStructLogger(config.Config), timeout, nil
+	}
+
+	tracer, err := tracers.DefaultDirectory.New(*config.Tracer, txctx, config.TracerConfig)
+	if err != nil {
+		return nil, 0, err
+	}
+	return tracer, timeout, nil
+}
@@ -0,0 +1,253 @@
+package debugger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethcore "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/gethapi"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+	_ "github.com/ten-protocol/go-ten/go/common/tracers/native"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/enclavedb"
+)
+
+var (
+	requester   = gethcommon.HexToAddress("0x1000")
+	otherEOA    = gethcommon.HexToAddress("0x2000")
+	private     = gethcommon.HexToAddress("0x3000")
+	transparent = gethcommon.HexToAddress("0x4000")
+	unknown     = gethcommon.HexToAddress("0x5000")
+
+	// returns the value of the storage slot 0
+	readSlotCode = hexutil.MustDecode("0x60005460005260206000f3")
+)
+
+// callCode - the code of a contract that calls `to` and returns nothing
+func callCode(to gethcommon.Address) []byte {
+	// PUSH1 0 (x4), PUSH20 to, GAS, STATICCALL, STOP
+	code := []byte{0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x73}
+	code = append(code, to.Bytes()...)
+	return append(code, 0x5a, 0xfa, 0x00)
+}
+
+// contractsStorage - the storage of the contracts registered by the tests
+type contractsStorage struct {
+	storage.Storage
+	contracts map[gethcommon.Address]*enclavedb.Contract
+}
+
+func (s *contractsStorage) ReadContract(_ context.Context, address gethcommon.Address) (*enclavedb.Contract, error) {
+	c, ok := s.contracts[address]
+	if !ok {
+		return nil, errutil.ErrNotFound
+	}
+	return c, nil
+}
+
+func newTestState(t *testing.T) (*state.StateDB, storage.Storage) {
+	statedb, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+	statedb.SetNonce(requester, 3)
+	statedb.SetNonce(otherEOA, 5)
+	statedb.SetCode(private, readSlotCode)
+	statedb.SetState(private, gethcommon.Hash{}, gethcommon.HexToHash("0x42"))
+	statedb.SetCode(transparent, readSlotCode)
+	statedb.SetCode(unknown, readSlotCode)
+
+	isTransparent, isPrivate := true, false
+	return statedb, &contractsStorage{contracts: map[gethcommon.Address]*enclavedb.Contract{
+		private:     {Address: private, Transparent: &isPrivate},
+		transparent: {Address: transparent, Transparent: &isTransparent},
+	}}
+}
+
+// traceCall - executes a call from the requester with the guarded tracer, and returns the result of the guard check
+func traceCall(t *testing.T, statedb *state.StateDB, s storage.Storage, tracer *tracers.Tracer, to gethcommon.Address) error {
+	contracts := guard(tracer, func(address gethcommon.Address) bool { return address == requester })
+
+	blockCtx := vm.BlockContext{
+		CanTransfer: gethcore.CanTransfer,
+		Transfer:    gethcore.Transfer,
+		GetHash:     func(uint64) gethcommon.Hash { return gethcommon.Hash{} },
+		BlockNumber: big.NewInt(1),
+		Difficulty:  big.NewInt(0),
+		BaseFee:     big.NewInt(0),
+		Random:      &gethcommon.Hash{},
+		GasLimit:    30_000_000,
+	}
+	evm := vm.NewEVM(blockCtx, vm.TxContext{Origin: requester, GasPrice: big.NewInt(0)}, statedb, params.MergedTestChainConfig, vm.Config{Tracer: tracer.Hooks})
+
+	tx := types.NewTx(&types.LegacyTx{Nonce: 3, To: &to, Gas: 1_000_000, GasPrice: big.NewInt(0)})
+	tracer.OnTxStart(evm.GetVMContext(), tx, requester)
+	_, _, err := evm.Call(vm.AccountRef(requester), to, nil, tx.Gas(), uint256.NewInt(0))
+	require.NoError(t, err)
+	tracer.OnTxEnd(&types.Receipt{}, nil)
+
+	return contracts.check(context.Background(), statedb, s)
+}
+
+func newNamedTracer(t *testing.T, name string) *tracers.Tracer {
+	tracer, err := tracers.DefaultDirectory.New(name, &tracers.Context{}, nil)
+	require.NoError(t, err)
+	return tracer
+}
+
+func TestGuardRefusesPrivateCode(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		to       gethcommon.Address
+		expected error
+	}{
+		{"eoa", otherEOA, nil},
+		{"transparent contract", transparent, nil},
+		{"private contract", private, errPrivateContract},
+		// contracts without a visibility config are private
+		{"unregistered contract", unknown, errPrivateContract},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			statedb, s := newTestState(t)
+			err := traceCall(t, statedb, s, newNamedTracer(t, "callTracer"), tc.to)
+			require.ErrorIs(t, err, tc.expected)
+		})
+	}
+
+	// the code of the private contract runs in a nested call of a transparent contract
+	statedb, s := newTestState(t)
+	statedb.SetCode(transparent, callCode(private))
+	require.ErrorIs(t, traceCall(t, statedb, s, newNamedTracer(t, "callTracer"), transparent), errPrivateContract)
+}
+
+func TestGuardRefusesStateOverrides(t *testing.T) {
+	// the overrides replace the code of a private contract with code that reads its storage
+	statedb, s := newTestState(t)
+	readSlot := hexutil.Bytes(readSlotCode)
+	callPrivate := hexutil.Bytes(callCode(private))
+	overrides := gethapi.StateOverride{
+		private:     {Code: &readSlot},
+		transparent: {Code: &callPrivate},
+	}
+	require.NoError(t, overrides.Apply(statedb))
+	require.ErrorIs(t, traceCall(t, statedb, s, newNamedTracer(t, "callTracer"), private), errPrivateContract)
+	require.ErrorIs(t, traceCall(t, statedb, s, newNamedTracer(t, "callTracer"), transparent), errPrivateContract)
+
+	// the overrides install code at the address of an EOA
+	statedb, s = newTestState(t)
+	overrides = gethapi.StateOverride{otherEOA: {Code: &readSlot}}
+	require.NoError(t, overrides.Apply(statedb))
+	require.ErrorIs(t, traceCall(t, statedb, s, newNamedTracer(t, "callTracer"), otherEOA), errPrivateContract)
+}
+
+func TestGuardRedactsNonces(t *testing.T) {
+	statedb, s := newTestState(t)
+	tracer := newNamedTracer(t, "prestateTracer")
+	require.NoError(t, traceCall(t, statedb, s, tracer, otherEOA))
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	var prestate map[gethcommon.Address]struct {
+		Nonce uint64 `json:"nonce"`
+	}
+	require.NoError(t, json.Unmarshal(res, &prestate))
+	require.Contains(t, prestate, requester)
+	require.Contains(t, prestate, otherEOA)
+	require.Equal(t, uint64(3), prestate[requester].Nonce)
+	// the requester can't read the account of the recipient
+	require.Equal(t, uint64(0), prestate[otherEOA].Nonce)
+	require.Equal(t, uint64(5), statedb.GetNonce(otherEOA))
+}
+
+func TestTraceBatchTxsOnlyTracesVisibleTxs(t *testing.T) {
+	txs := make([]*common.L2Tx, 3)
+	for i := range txs {
+		txs[i] = types.NewTx(&types.LegacyTx{Nonce: uint64(i), GasPrice: big.NewInt(1)})
+	}
+	isVisible := func(tx *common.L2Tx) bool { return tx.Hash() == txs[1].Hash() }
+
+	executed := make([]bool, len(txs))
+	results, err := traceBatchTxs(txs, isVisible, func(idx int, tx *common.L2Tx, traced bool) (json.RawMessage, error, error) {
+		executed[idx] = true
+		// the hidden transactions are executed without a tracer
+		require.Equal(t, isVisible(tx), traced)
+		return json.RawMessage(`{}`), nil, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, true, true}, executed)
+	require.Len(t, results, 1)
+	require.Equal(t, txs[1].Hash(), results[0].TxHash)
+}
+
+func TestTraceBatchTxsIgnoresFailingHiddenTxs(t *testing.T) {
+	txs := make([]*common.L2Tx, 4)
+	for i := range txs {
+		txs[i] = types.NewTx(&types.LegacyTx{Nonce: uint64(i), GasPrice: big.NewInt(1)})
+	}
+	visible := map[common.TxHash]bool{txs[1].Hash(): true, txs[3].Hash(): true}
+	isVisible := func(tx *common.L2Tx) bool { return visible[tx.Hash()] }
+
+	trace := json.RawMessage(`{"gas":1}`)
+	executeWithFailures := func(failing map[int]bool) executeTxFunc {
+		return func(idx int, _ *common.L2Tx, traced bool) (json.RawMessage, error, error) {
+			if failing[idx] {
+				return nil, errors.New("nonce too low"), nil
+			}
+			if traced {
+				return trace, nil, nil
+			}
+			return nil, nil, nil
+		}
+	}
+
+	expected, err := traceBatchTxs(txs, isVisible, executeWithFailures(nil))
+	require.NoError(t, err)
+	require.Len(t, expected, 2)
+
+	// a failing hidden transaction doesn't change the results of the visible ones
+	results, err := traceBatchTxs(txs, isVisible, executeWithFailures(map[int]bool{0: true, 2: true}))
+	require.NoError(t, err)
+	require.Equal(t, expected, results)
+
+	// a failing visible transaction is reported in its own result, and doesn't change the others
+	results, err = traceBatchTxs(txs, isVisible, executeWithFailures(map[int]bool{1: true}))
+	require.NoError(t, err)
+	require.Equal(t, []*tracers.TxTraceResult{
+		{TxHash: txs[1].Hash(), Error: "tracing failed: nonce too low"},
+		expected[1],
+	}, results)
+
+	// the errors that prevent the replay are returned regardless of the visibility of the transaction
+	_, err = traceBatchTxs(txs, isVisible, func(idx int, _ *common.L2Tx, _ bool) (json.RawMessage, error, error) {
+		if idx == 2 {
+			return nil, nil, errors.New("could not read l1 block")
+		}
+		return trace, nil, nil
+	})
+	require.Error(t, err)
+}
+
+func TestTraceTimeoutIsCapped(t *testing.T) {
+	_, timeout, err := newTracer(&tracers.Context{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, defaultTraceTimeout, timeout)
+
+	for requested, expected := range map[string]time.Duration{"2s": 2 * time.Second, "1h": maxTraceTimeout} {
+		_, timeout, err = newTracer(&tracers.Context{}, &tracers.TraceConfig{Timeout: &requested})
+		require.NoError(t, err)
+		require.Equal(t, expected, timeout)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/responses"
 
 	"github.com/ten-protocol/go-ten/go/enclave/genesis"
+	"github.com/ten-protocol/go-ten/go/enclave/l2chain"
 
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 
@@ -29,7 +30,6 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/gethencoding"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
-	"github.com/ten-protocol/go-ten/go/common/tracers"
 	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
 	"github.com/ten-protocol/go-ten/go/enclave/events"
 
@@ -85,7 +85,7 @@ func NewEnclave(config *enclaveconfig.EnclaveConfig, genesis *genesis.Genesis, m
 	crossChainProcessors := crosschain.New(&config.MessageBusAddress, storage, big.NewInt(config.ObscuroChainID), logger)
 
 	// initialise system contracts
-	scb := system.NewSystemContractCallbacks(storage, &config.SystemContractOwner, logger)
+	scb := system.NewSystemContractCallbacks(storage, &config.SystemContractOwner, config.GasOracleActivationHeight, logger)
 	err = scb.Load(crossChainProcessors.Local)
 	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
 		logger.Crit("failed to load system contracts", log.ErrKey, err)
@@ -100,10 +100,11 @@ func NewEnclave(config *enclaveconfig.EnclaveConfig, genesis *genesis.Genesis, m
 		logger.Crit("unable to init eth tx pool", log.ErrKey, err)
 	}
 
-	gasOracle := gas.NewGasOracle()
-	blockProcessor := components.NewBlockProcessor(storage, crossChainProcessors, gasOracle, logger)
+	gasOracle := gas.NewGasOracle(config.GasBlobCostActivationHeight)
+	forcedInclusion := components.NewForcedInclusion(mgmtContractLib.GetContractAddr(), rpcKeyService, storage, config.ObscuroChainID, logger)
+	blockProcessor := components.NewBlockProcessor(storage, crossChainProcessors, forcedInclusion, gasOracle, logger)
 	dataCompressionService := compression.NewBrotliDataCompressionService()
-	batchExecutor := components.NewBatchExecutor(storage, batchRegistry, *config, gethEncodingService, crossChainProcessors, genesis, gasOracle, chainConfig, scb, evmEntropyService, mempool, dataCompressionService, logger)
+	batchExecutor := components.NewBatchExecutor(storage, batchRegistry, *config, gethEncodingService, crossChainProcessors, forcedInclusion, genesis, gasOracle, chainConfig, scb, evmEntropyService, mempool, dataCompressionService, logger)
 
 	// ensure cached chain state data is up-to-date using the persisted batch data
 	err = restoreStateDBCache(context.Background(), storage, batchRegistry, batchExecutor, genesis, logger)
@@ -111,7 +112,18 @@ func NewEnclave(config *enclaveconfig.EnclaveConfig, genesis *genesis.Genesis, m
 		logger.Crit("failed to resync L2 chain state DB after restart", log.ErrKey, err)
 	}
 
-	subscriptionManager := events.NewSubscriptionManager(storage, batchRegistry, config.ObscuroChainID, logger)
+	chain := l2chain.NewChain(
+		storage,
+		*config,
+		gethEncodingService,
+		chainConfig,
+		genesis,
+		logger,
+		batchRegistry,
+		config.GasLocalExecutionCapFlag,
+	)
+	logFilter := events.NewLogFilter(storage, chain, logger)
+	subscriptionManager := events.NewSubscriptionManager(storage, batchRegistry, logFilter, config.ObscuroChainID, logger)
 
 	// todo (#1474) - make sure the enclave cannot be started in production with WillAttest=false
 	attestationProvider := components.NewAttestationProvider(enclaveKeyService, config.WillAttest, logger)
@@ -119,10 +131,12 @@ func NewEnclave(config *enclaveconfig.EnclaveConfig, genesis *genesis.Genesis, m
 	// signal to stop the enclave
 	stopControl := stopcontrol.New()
 
+	stateSnapshotService := components.NewStateSnapshotService(storage, batchRegistry, gethEncodingService, daEncryptionService, dataCompressionService, enclaveKeyService, attestationProvider, scb, crossChainProcessors.Local, config, logger)
+
 	// these services are directly exposed as the API of the Enclave
 	initAPI := NewEnclaveInitAPI(config, storage, logger, blockProcessor, enclaveKeyService, attestationProvider, sharedSecretService, daEncryptionService, rpcKeyService)
-	adminAPI := NewEnclaveAdminAPI(config, storage, logger, blockProcessor, batchRegistry, batchExecutor, gethEncodingService, stopControl, subscriptionManager, enclaveKeyService, mempool, chainConfig, mgmtContractLib, attestationProvider, sharedSecretService, daEncryptionService)
-	rpcAPI := NewEnclaveRPCAPI(config, storage, logger, blockProcessor, batchRegistry, gethEncodingService, cachingService, mempool, chainConfig, crossChainProcessors, scb, subscriptionManager, genesis, gasOracle, sharedSecretService, rpcKeyService)
+	adminAPI := NewEnclaveAdminAPI(config, storage, logger, blockProcessor, batchRegistry, batchExecutor, gethEncodingService, stopControl, subscriptionManager, enclaveKeyService, mempool, chainConfig, mgmtContractLib, attestationProvider, sharedSecretService, daEncryptionService, stateSnapshotService)
+	rpcAPI := NewEnclaveRPCAPI(config, storage, logger, blockProcessor, batchRegistry, gethEncodingService, cachingService, mempool, chainConfig, crossChainProcessors, scb, subscriptionManager, chain, logFilter, gasOracle, sharedSecretService, rpcKeyService, enclaveKeyService, evmEntropyService)
 
 	logger.Info("Enclave service created successfully.", log.EnclaveIDKey, enclaveKeyService.EnclaveID())
 	return &enclaveImpl{
@@ -172,8 +186,11 @@ func (e *enclaveImpl) RPCEncryptionKey(ctx context.Context) ([]byte, common.Syst
 	return e.initAPI.RPCEncryptionKey(ctx)
 }
 
-func (e *enclaveImpl) DebugTraceTransaction(ctx context.Context, txHash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, common.SystemError) {
-	return e.rpcAPI.DebugTraceTransaction(ctx, txHash, config)
+func (e *enclaveImpl) EndorseP2PKey(ctx context.Context, p2pPubKey []byte, p2pAddress string) ([]byte, common.SystemError) {
+	if systemError := checkStopping(e.stopControl); systemError != nil {
+		return nil, systemError
+	}
+	return e.initAPI.EndorseP2PKey(ctx, p2pPubKey, p2pAddress)
 }
 
 func (e *enclaveImpl) GetTotalContractCount(ctx context.Context) (*big.Int, common.SystemError) {
@@ -183,6 +200,20 @@ func (e *enclaveImpl) GetTotalContractCount(ctx context.Context) (*big.Int, comm
 	return e.rpcAPI.GetTotalContractCount(ctx)
 }
 
+func (e *enclaveImpl) GetPublicStorageAt(ctx context.Context, address gethcommon.Address, slot gethcommon.Hash, mappingKey *gethcommon.Hash) (*common.PublicStorageValue, common.SystemError) {
+	if systemError := checkStopping(e.stopControl); systemError != nil {
+		return nil, systemError
+	}
+	return e.rpcAPI.GetPublicStorageAt(ctx, address, slot, mappingKey)
+}
+
+func (e *enclaveImpl) GetBatchTxFees(ctx context.Context, fromHeight uint64, toHeight uint64, rewardPercentiles []float64) ([]common.BatchTxFees, common.SystemError) {
+	if systemError := checkStopping(e.stopControl); systemError != nil {
+		return nil, systemError
+	}
+	return e.rpcAPI.GetBatchTxFees(ctx, fromHeight, toHeight, rewardPercentiles)
+}
+
 func (e *enclaveImpl) EnclavePublicConfig(ctx context.Context) (*common.EnclavePublicConfig, common.SystemError) {
 	if systemError := checkStopping(e.stopControl); systemError != nil {
 		return nil, systemError
@@ -236,6 +267,27 @@ func (e *enclaveImpl) ExportCrossChainData(ctx context.Context, fromSeqNo uint64
 	return e.adminAPI.ExportCrossChainData(ctx, fromSeqNo, toSeqNo)
 }
 
+func (e *enclaveImpl) GetFraudReport(ctx context.Context) (*common.FraudReport, common.SystemError) {
+	if systemError := checkStopping(e.stopControl); systemError != nil {
+		return nil, systemError
+	}
+	return e.adminAPI.GetFraudReport(ctx)
+}
+
+func (e *enclaveImpl) ExportStateSnapshot(ctx context.Context, seqNo uint64, onChunk func(chunk []byte) error) (*common.StateSnapshot, common.SystemError) {
+	if systemError := checkStopping(e.stopControl); systemError != nil {
+		return nil, systemError
+	}
+	return e.adminAPI.ExportStateSnapshot(ctx, seqNo, onChunk)
+}
+
+func (e *enclaveImpl) ImportStateSnapshot(ctx context.Context, snapshot *common.StateSnapshot, nextChunk func() ([]byte, error)) common.SystemError {
+	if systemError := checkStopping(e.stopControl); systemError != nil {
+		return systemError
+	}
+	return e.adminAPI.ImportStateSnapshot(ctx, snapshot, nextChunk)
+}
+
 func (e *enclaveImpl) GetBatch(ctx context.Context, hash common.L2BatchHash) (*common.ExtBatch, common.SystemError) {
 	if systemError := checkStopping(e.stopControl); systemError != nil {
 		return nil, systemError
@@ -257,6 +309,13 @@ func (e *enclaveImpl) GetRollupData(ctx context.Context, hash common.L2RollupHas
 	return e.adminAPI.GetRollupData(ctx, hash)
 }
 
+func (e *enclaveImpl) RevealDAKeys(ctx context.Context, period uint64, hostL1Head common.L1BlockHash) ([]common.RevealedDAKey, common.SystemError) {
+	if systemError := checkStopping(e.stopControl); systemError != nil {
+		return nil, systemError
+	}
+	return e.adminAPI.RevealDAKeys(ctx, period, hostL1Head)
+}
+
 func (e *enclaveImpl) StreamL2Updates() (chan common.StreamL2UpdatesResponse, func()) {
 	return e.adminAPI.StreamL2Updates()
 }
@@ -322,6 +381,16 @@ func loadSharedSecret(storage storage.Storage, sharedSecretService *crypto.Share
 	if sharedSecret != nil {
 		sharedSecretService.SetSharedSecret(sharedSecret)
 	}
+
+	epochs, err := storage.FetchSecretEpochs(context.Background())
+	if err != nil {
+		logger.Crit("Failed to fetch secret epochs", "err", err)
+	}
+	for _, epoch := range epochs {
+		if err := sharedSecretService.AddEpoch(epoch); err != nil {
+			return fmt.Errorf("could not load secret epoch %d. Cause: %w", epoch.Epoch, err)
+		}
+	}
 	return nil
 }
 
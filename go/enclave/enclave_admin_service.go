@@ -1,11 +1,13 @@
 package enclave
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/params"
@@ -14,7 +16,9 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	enclaveconfig "github.com/ten-protocol/go-ten/go/enclave/config"
 
+	gethcore "github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/compression"
@@ -23,6 +27,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/measure"
 	"github.com/ten-protocol/go-ten/go/common/profiler"
+	"github.com/ten-protocol/go-ten/go/common/signature"
 	"github.com/ten-protocol/go-ten/go/common/stopcontrol"
 	"github.com/ten-protocol/go-ten/go/enclave/components"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
@@ -55,9 +60,11 @@ type enclaveAdminService struct {
 	enclaveKeyService      *crypto.EnclaveAttestedKeyService
 	mempool                *components.TxPool
 	sharedSecretService    *crypto.SharedSecretService
+	stateSnapshotService   *components.StateSnapshotService
+	fraudReport            atomic.Pointer[common.FraudReport] // the last batch divergence detected by the validator
 }
 
-func NewEnclaveAdminAPI(config *enclaveconfig.EnclaveConfig, storage storage.Storage, logger gethlog.Logger, blockProcessor components.L1BlockProcessor, registry components.BatchRegistry, batchExecutor components.BatchExecutor, gethEncodingService gethencoding.EncodingService, stopControl *stopcontrol.StopControl, subscriptionManager *events.SubscriptionManager, enclaveKeyService *crypto.EnclaveAttestedKeyService, mempool *components.TxPool, chainConfig *params.ChainConfig, mgmtContractLib mgmtcontractlib.MgmtContractLib, attestationProvider components.AttestationProvider, sharedSecretService *crypto.SharedSecretService, daEncryptionService *crypto.DAEncryptionService) common.EnclaveAdmin {
+func NewEnclaveAdminAPI(config *enclaveconfig.EnclaveConfig, storage storage.Storage, logger gethlog.Logger, blockProcessor components.L1BlockProcessor, registry components.BatchRegistry, batchExecutor components.BatchExecutor, gethEncodingService gethencoding.EncodingService, stopControl *stopcontrol.StopControl, subscriptionManager *events.SubscriptionManager, enclaveKeyService *crypto.EnclaveAttestedKeyService, mempool *components.TxPool, chainConfig *params.ChainConfig, mgmtContractLib mgmtcontractlib.MgmtContractLib, attestationProvider components.AttestationProvider, sharedSecretService *crypto.SharedSecretService, daEncryptionService *crypto.DAEncryptionService, stateSnapshotService *components.StateSnapshotService) common.EnclaveAdmin {
 	var prof *profiler.Profiler
 	// don't run a profiler on an attested enclave
 	if !config.WillAttest && config.ProfilerEnabled {
@@ -67,7 +74,7 @@ func NewEnclaveAdminAPI(config *enclaveconfig.EnclaveConfig, storage storage.Sto
 			logger.Crit("unable to start the profiler", log.ErrKey, err)
 		}
 	}
-	sharedSecretProcessor := components.NewSharedSecretProcessor(mgmtContractLib, attestationProvider, enclaveKeyService.EnclaveID(), storage, sharedSecretService, logger)
+	sharedSecretProcessor := components.NewSharedSecretProcessor(mgmtContractLib, attestationProvider, enclaveKeyService, storage, sharedSecretService, logger)
 	sigVerifier, err := components.NewSignatureValidator(storage)
 	if err != nil {
 		logger.Crit("Could not initialise the signature validator", log.ErrKey, err)
@@ -112,6 +119,7 @@ func NewEnclaveAdminAPI(config *enclaveconfig.EnclaveConfig, storage storage.Sto
 		enclaveKeyService:      enclaveKeyService,
 		mempool:                mempool,
 		sharedSecretService:    sharedSecretService,
+		stateSnapshotService:   stateSnapshotService,
 	}
 
 	// if the current enclave was already marked as an active/backup sequencer, it needs to set the right mempool mode
@@ -194,10 +202,12 @@ func (e *enclaveAdminService) SubmitL1Block(ctx context.Context, processed *comm
 
 	err = e.service.OnL1Block(ctx, blockHeader, result)
 	if err != nil {
+		e.recordFraudReport(ctx, err)
 		return nil, e.rejectBlockErr(ctx, fmt.Errorf("could not submit L1 block. Cause: %w", err))
 	}
 
-	bsr := &common.BlockSubmissionResponse{ProducedSecretResponses: e.sharedSecretProcessor.ProcessNetworkSecretMsgs(ctx, processed)}
+	secretResponses, secretDistribution := e.sharedSecretProcessor.ProcessNetworkSecretMsgs(ctx, processed, e.isActiveSequencer(ctx))
+	bsr := &common.BlockSubmissionResponse{ProducedSecretResponses: secretResponses, SecretDistribution: secretDistribution}
 	return bsr, nil
 }
 
@@ -244,6 +254,7 @@ func (e *enclaveAdminService) SubmitBatch(ctx context.Context, extBatch *common.
 
 	err = e.validator().ExecuteStoredBatches(ctx)
 	if err != nil {
+		e.recordFraudReport(ctx, err)
 		return responses.ToInternalError(fmt.Errorf("could not execute batches. Cause: %w", err))
 	}
 
@@ -305,6 +316,104 @@ func (e *enclaveAdminService) ExportCrossChainData(ctx context.Context, fromSeqN
 	return bundle, nil
 }
 
+func (e *enclaveAdminService) GetFraudReport(ctx context.Context) (*common.FraudReport, common.SystemError) {
+	if report := e.fraudReport.Load(); report != nil {
+		return report, nil
+	}
+	// the report detected before a restart
+	report, err := e.storage.GetFraudReport(ctx)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, responses.ToInternalError(fmt.Errorf("could not fetch fraud report. Cause: %w", err))
+	}
+	e.fraudReport.Store(report)
+	return report, nil
+}
+
+// recordFraudReport - if the batches could not be executed because the state root of a batch signed by the sequencer
+// differs from the state root computed by this enclave, a signed report is kept for the host to publish.
+// The other differences, e.g. in the header fields set by the sequencer, are not a proof that the sequencer executed the
+// transactions incorrectly, so they are only logged.
+// The report is persisted, so that the host can still read it after the enclave restarts.
+func (e *enclaveAdminService) recordFraudReport(ctx context.Context, err error) {
+	var divergence *components.BatchDivergenceError
+	if !errors.As(err, &divergence) {
+		return
+	}
+	if divergence.Incoming.Root == divergence.Computed.Root {
+		e.logger.Warn("Batch signed by the sequencer diverges from the computed batch with the same state root",
+			log.BatchHashKey, divergence.Incoming.Hash(), "computed_hash", divergence.Computed.Hash())
+		return
+	}
+	report, err := e.createFraudReport(divergence)
+	if err != nil {
+		e.logger.Error("Could not create fraud report", log.BatchHashKey, divergence.Incoming.Hash(), log.ErrKey, err)
+		return
+	}
+	e.logger.Error("Batch signed by the sequencer diverges from the computed batch", log.BatchSeqNoKey, report.BatchSeqNo,
+		log.BatchHashKey, report.BatchHash, "expected_root", report.ExpectedStateRoot, "received_root", report.ReceivedStateRoot,
+		"sequencer", report.Sequencer)
+	e.fraudReport.Store(report)
+	if err := e.storage.StoreFraudReport(ctx, report); err != nil {
+		e.logger.Error("Could not store fraud report", log.BatchHashKey, report.BatchHash, log.ErrKey, err)
+	}
+}
+
+func (e *enclaveAdminService) createFraudReport(divergence *components.BatchDivergenceError) (*common.FraudReport, error) {
+	// the signature is copied because the recovery modifies it
+	sequencer, err := signature.RecoverAddress(divergence.Incoming.Hash().Bytes(), bytes.Clone(divergence.Incoming.Signature))
+	if err != nil {
+		return nil, fmt.Errorf("could not recover the sequencer. Cause: %w", err)
+	}
+	report := &common.FraudReport{
+		BatchSeqNo:        divergence.Incoming.SequencerOrderNo.Uint64(),
+		BatchHash:         divergence.Incoming.Hash(),
+		ExpectedStateRoot: divergence.Computed.Root,
+		ReceivedStateRoot: divergence.Incoming.Root,
+		Sequencer:         *sequencer,
+		EnclaveID:         e.enclaveKeyService.EnclaveID(),
+	}
+	report.Signature, err = e.enclaveKeyService.Sign(report.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("could not sign fraud report. Cause: %w", err)
+	}
+	return report, nil
+}
+
+func (e *enclaveAdminService) ExportStateSnapshot(ctx context.Context, seqNo uint64, onChunk func(chunk []byte) error) (*common.StateSnapshot, common.SystemError) {
+	// the batches must not change while they are exported
+	e.dataInMutex.RLock()
+	defer e.dataInMutex.RUnlock()
+
+	snapshot, err := e.stateSnapshotService.Export(ctx, seqNo, onChunk)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not export state snapshot at batch %d. Cause: %w", seqNo, err))
+	}
+	return snapshot, nil
+}
+
+func (e *enclaveAdminService) ImportStateSnapshot(ctx context.Context, snapshot *common.StateSnapshot, nextChunk func() ([]byte, error)) common.SystemError {
+	e.mainMutex.Lock()
+	defer e.mainMutex.Unlock()
+	e.dataInMutex.Lock()
+	defer e.dataInMutex.Unlock()
+
+	if e.isActiveSequencer(ctx) {
+		return responses.ToInternalError(fmt.Errorf("the active sequencer can't import a state snapshot"))
+	}
+	if !e.sharedSecretService.IsInitialised() {
+		return responses.ToInternalError(fmt.Errorf("the shared secret is required to import a state snapshot"))
+	}
+
+	err := e.stateSnapshotService.Import(ctx, snapshot, nextChunk)
+	if err != nil {
+		return responses.ToInternalError(fmt.Errorf("could not import state snapshot at batch %d. Cause: %w", snapshot.BatchSeqNo, err))
+	}
+	return nil
+}
+
 func (e *enclaveAdminService) GetBatch(ctx context.Context, hash common.L2BatchHash) (*common.ExtBatch, common.SystemError) {
 	batch, err := e.storage.FetchBatch(ctx, hash)
 	if err != nil {
@@ -343,6 +452,50 @@ func (e *enclaveAdminService) GetRollupData(ctx context.Context, hash common.L2R
 	return metadata, nil
 }
 
+// RevealDAKeys - the keys of a revelation period are revealed once the L1 head is past the end of the period by the
+// revelation delay. The L1 head seen by the host must have been processed by the enclave and be canonical in its view,
+// so that neither a lagging host nor an enclave fed a fork that the host abandoned reveals the keys early.
+// No keys are returned for the periods which are never revealed.
+func (e *enclaveAdminService) RevealDAKeys(ctx context.Context, period uint64, hostL1Head common.L1BlockHash) ([]common.RevealedDAKey, common.SystemError) {
+	revealableAt, ok := crypto.RevealableAt(period, e.config.RevelationPeriod, e.config.RevelationDelay)
+	if !ok {
+		return nil, nil
+	}
+	enclaveL1Head, err := e.storage.FetchHeadBlock(ctx)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, responses.ToInternalError(fmt.Errorf("could not fetch L1 head. Cause: %w", err))
+	}
+	if enclaveL1Head.Number.Uint64() < revealableAt {
+		return nil, nil
+	}
+
+	hostHead, err := e.storage.FetchBlock(ctx, hostL1Head)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			// the enclave has not processed the head of the host yet
+			return nil, nil
+		}
+		return nil, responses.ToInternalError(fmt.Errorf("could not fetch the L1 head of the host. Cause: %w", err))
+	}
+	canonical, err := e.storage.IsBlockCanonical(ctx, hostL1Head)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not check the L1 head of the host. Cause: %w", err))
+	}
+	if !canonical || hostHead.Number.Uint64() < revealableAt {
+		return nil, nil
+	}
+
+	keys, err := e.daEncryptionService.RevealKeys(period)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not reveal the keys of period %d. Cause: %w", period, err))
+	}
+	e.logger.Info("Revealing DA keys", "period", period, "epochs", len(keys))
+	return keys, nil
+}
+
 func (e *enclaveAdminService) StreamL2Updates() (chan common.StreamL2UpdatesResponse, func()) {
 	l2UpdatesChannel := make(chan common.StreamL2UpdatesResponse, 100)
 
@@ -358,8 +511,38 @@ func (e *enclaveAdminService) StreamL2Updates() (chan common.StreamL2UpdatesResp
 		}
 	})
 
+	pendingTxsChannel := make(chan gethcore.NewTxsEvent, 100)
+	pendingTxsSub := e.mempool.SubscribePendingTransactions(pendingTxsChannel)
+	go e.streamPendingTransactions(pendingTxsChannel, pendingTxsSub, l2UpdatesChannel)
+
 	return l2UpdatesChannel, func() {
 		e.registry.UnsubscribeFromBatches()
+		pendingTxsSub.Unsubscribe()
+	}
+}
+
+// streamPendingTransactions - sends the transactions which become executable to the subscribers who sent them. The
+// notifications are dropped rather than blocking the mempool when the host does not keep up
+func (e *enclaveAdminService) streamPendingTransactions(pendingTxs chan gethcore.NewTxsEvent, sub event.Subscription, outChannel chan common.StreamL2UpdatesResponse) {
+	for {
+		select {
+		case txs := <-pendingTxs:
+			encrypted, err := e.subscriptionManager.GetSubscribedPendingTransactions(txs.Txs)
+			if err != nil {
+				e.logger.Error("Error while getting the subscribed pending transactions", log.ErrKey, err)
+				continue
+			}
+			if encrypted == nil {
+				continue
+			}
+			select {
+			case outChannel <- common.StreamL2UpdatesResponse{Logs: encrypted}:
+			default:
+				e.logger.Warn("Dropped pending transaction notifications, the host is not keeping up")
+			}
+		case <-sub.Err():
+			return
+		}
 	}
 }
 
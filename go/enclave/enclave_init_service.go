@@ -13,6 +13,7 @@ import (
 
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/signature"
 	_ "github.com/ten-protocol/go-ten/go/common/tracers/native" // make sure the tracers are loaded
 	"github.com/ten-protocol/go-ten/go/enclave/crypto"
 
@@ -114,27 +115,40 @@ func (e *enclaveInitService) GenerateSecret(ctx context.Context) (common.Encrypt
 
 // InitEnclave - initialise an enclave with a shared secret received from another enclave
 func (e *enclaveInitService) InitEnclave(ctx context.Context, s common.EncryptedSharedEnclaveSecret) common.SystemError {
-	secret, err := e.enclaveKeyService.Decrypt(s)
+	decrypted, err := e.enclaveKeyService.Decrypt(s)
 	if err != nil {
 		return responses.ToInternalError(err)
 	}
-	err = e.storage.StoreSecret(ctx, crypto.SharedEnclaveSecret(secret))
+	secret, epochs, err := crypto.DecodeSecrets(decrypted)
+	if err != nil {
+		return responses.ToInternalError(err)
+	}
+	err = e.storage.StoreSecret(ctx, *secret)
 	if err != nil {
 		return responses.ToInternalError(fmt.Errorf("could not store secret. Cause: %w", err))
 	}
-	var fixedSizeSecret crypto.SharedEnclaveSecret
-	copy(fixedSizeSecret[:], secret)
+	// the secrets of the epochs rotated before the enclave joined the network
+	for _, epoch := range epochs {
+		if err := e.storage.StoreSecretEpoch(ctx, epoch); err != nil {
+			return responses.ToInternalError(fmt.Errorf("could not store secret epoch %d. Cause: %w", epoch.Epoch, err))
+		}
+	}
 
 	// notify the encryption services that depend on the shared secret
-	err = e.notifyCryptoServices(fixedSizeSecret)
+	err = e.notifyCryptoServices(*secret, epochs...)
 	if err != nil {
 		return responses.ToInternalError(err)
 	}
 	return nil
 }
 
-func (e *enclaveInitService) notifyCryptoServices(sharedSecret crypto.SharedEnclaveSecret) error {
+func (e *enclaveInitService) notifyCryptoServices(sharedSecret crypto.SharedEnclaveSecret, epochs ...crypto.SecretEpoch) error {
 	e.sharedSecretService.SetSharedSecret(&sharedSecret)
+	for _, epoch := range epochs {
+		if err := e.sharedSecretService.AddEpoch(epoch); err != nil {
+			return err
+		}
+	}
 	err := e.rpcKeyService.Initialise()
 	if err != nil {
 		return err
@@ -146,6 +160,17 @@ func (e *enclaveInitService) EnclaveID(context.Context) (common.EnclaveID, commo
 	return e.enclaveKeyService.EnclaveID(), nil
 }
 
+func (e *enclaveInitService) EndorseP2PKey(_ context.Context, p2pPubKey []byte, p2pAddress string) ([]byte, common.SystemError) {
+	if len(p2pPubKey) == 0 {
+		return nil, responses.ToInternalError(fmt.Errorf("empty p2p key"))
+	}
+	endorsement, err := e.enclaveKeyService.Sign(signature.P2PEndorsementHash(p2pPubKey, p2pAddress))
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not endorse the p2p key. Cause: %w", err))
+	}
+	return endorsement, nil
+}
+
 func (e *enclaveInitService) RPCEncryptionKey(ctx context.Context) ([]byte, common.SystemError) {
 	k, err := e.rpcKeyService.PublicKey()
 	if err != nil {
@@ -2,24 +2,20 @@ package enclave
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"math/big"
 
 	"github.com/ten-protocol/go-ten/go/enclave/crypto"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ten-protocol/go-ten/go/common/gethencoding"
 	"github.com/ten-protocol/go-ten/go/enclave/gas"
-	"github.com/ten-protocol/go-ten/go/enclave/genesis"
 	"github.com/ten-protocol/go-ten/go/enclave/l2chain"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ten-protocol/go-ten/go/common"
-	"github.com/ten-protocol/go-ten/go/common/syserr"
-	"github.com/ten-protocol/go-ten/go/common/tracers"
 	"github.com/ten-protocol/go-ten/go/enclave/components"
 	enclaveconfig "github.com/ten-protocol/go-ten/go/enclave/config"
 	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
@@ -37,7 +33,6 @@ type enclaveRPCService struct {
 	registry             components.BatchRegistry
 	subscriptionManager  *events.SubscriptionManager
 	config               *enclaveconfig.EnclaveConfig
-	debugger             *debugger.Debugger
 	storage              storage.Storage
 	crossChainProcessors *crosschain.Processors
 	scb                  system.SystemContractCallbacks
@@ -45,28 +40,17 @@ type enclaveRPCService struct {
 	logger               gethlog.Logger
 }
 
-func NewEnclaveRPCAPI(config *enclaveconfig.EnclaveConfig, storage storage.Storage, logger gethlog.Logger, blockProcessor components.L1BlockProcessor, batchRegistry components.BatchRegistry, gethEncodingService gethencoding.EncodingService, cachingService *storage.CacheService, mempool *components.TxPool, chainConfig *params.ChainConfig, crossChainProcessors *crosschain.Processors, scb system.SystemContractCallbacks, subscriptionManager *events.SubscriptionManager, genesis *genesis.Genesis, gasOracle gas.Oracle, sharedSecretService *crypto.SharedSecretService, rpcKeyService *crypto.RPCKeyService) common.EnclaveClientRPC {
+func NewEnclaveRPCAPI(config *enclaveconfig.EnclaveConfig, storage storage.Storage, logger gethlog.Logger, blockProcessor components.L1BlockProcessor, batchRegistry components.BatchRegistry, gethEncodingService gethencoding.EncodingService, cachingService *storage.CacheService, mempool *components.TxPool, chainConfig *params.ChainConfig, crossChainProcessors *crosschain.Processors, scb system.SystemContractCallbacks, subscriptionManager *events.SubscriptionManager, chain l2chain.ObscuroChain, logFilter *events.LogFilter, gasOracle gas.Oracle, sharedSecretService *crypto.SharedSecretService, rpcKeyService *crypto.RPCKeyService, enclaveKeyService *crypto.EnclaveAttestedKeyService, evmEntropyService *crypto.EvmEntropyService) common.EnclaveClientRPC {
 	// TODO ensure debug is allowed/disallowed
-	chain := l2chain.NewChain(
-		storage,
-		*config,
-		gethEncodingService,
-		chainConfig,
-		genesis,
-		logger,
-		batchRegistry,
-		config.GasLocalExecutionCapFlag,
-	)
-	debug := debugger.New(chain, storage, chainConfig)
-
-	rpcEncryptionManager := rpc.NewEncryptionManager(storage, cachingService, batchRegistry, mempool, crossChainProcessors, config, gasOracle, storage, blockProcessor, chain, rpcKeyService, logger)
+	debug := debugger.New(storage, batchRegistry, gasOracle, scb, evmEntropyService, gethEncodingService, config, chainConfig, logger)
+
+	rpcEncryptionManager := rpc.NewEncryptionManager(storage, cachingService, batchRegistry, mempool, crossChainProcessors, config, gasOracle, storage, blockProcessor, chain, logFilter, rpcKeyService, enclaveKeyService, debug, logger)
 
 	return &enclaveRPCService{
 		rpcEncryptionManager: rpcEncryptionManager,
 		registry:             batchRegistry,
 		subscriptionManager:  subscriptionManager,
 		config:               config,
-		debugger:             debug,
 		storage:              storage,
 		crossChainProcessors: crossChainProcessors,
 		scb:                  scb,
@@ -104,26 +88,40 @@ func (e *enclaveRPCService) Unsubscribe(id gethrpc.ID) common.SystemError {
 	return nil
 }
 
-func (e *enclaveRPCService) DebugTraceTransaction(ctx context.Context, txHash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, common.SystemError) {
-	// ensure the debug namespace is enabled
-	if !e.config.DebugNamespaceEnabled {
-		return nil, responses.ToInternalError(fmt.Errorf("debug namespace not enabled"))
-	}
+func (e *enclaveRPCService) GetTotalContractCount(ctx context.Context) (*big.Int, common.SystemError) {
+	return e.storage.GetContractCount(ctx)
+}
 
-	jsonMsg, err := e.debugger.DebugTraceTransaction(ctx, txHash, config)
+func (e *enclaveRPCService) GetPublicStorageAt(ctx context.Context, address gethcommon.Address, slot gethcommon.Hash, mappingKey *gethcommon.Hash) (*common.PublicStorageValue, common.SystemError) {
+	disclosedSlot, err := e.rpcEncryptionManager.DisclosedStorageSlot(ctx, address, hexutil.EncodeBig(slot.Big()), mappingKey)
 	if err != nil {
-		if errors.Is(err, syserr.InternalError{}) {
-			return nil, responses.ToInternalError(err)
-		}
-		// TODO *Pedro* MOVE THIS TO Enclave Response
-		return json.RawMessage(err.Error()), nil
+		return nil, responses.ToInternalError(err)
+	}
+	if disclosedSlot == nil {
+		return nil, nil
 	}
 
-	return jsonMsg, nil
+	stateDB, err := e.registry.GetBatchState(ctx, gethrpc.BlockNumberOrHashWithNumber(gethrpc.LatestBlockNumber))
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not create stateDB. Cause: %w", err))
+	}
+	storageSlot := gethcommon.HexToHash(*disclosedSlot)
+	return &common.PublicStorageValue{Slot: storageSlot, Value: stateDB.GetState(address, storageSlot)}, nil
 }
 
-func (e *enclaveRPCService) GetTotalContractCount(ctx context.Context) (*big.Int, common.SystemError) {
-	return e.storage.GetContractCount(ctx)
+func (e *enclaveRPCService) GetBatchTxFees(ctx context.Context, fromHeight uint64, toHeight uint64, rewardPercentiles []float64) ([]common.BatchTxFees, common.SystemError) {
+	// the batch following the newest one of the fee history is requested as well, for its base fee
+	if toHeight < fromHeight || toHeight-fromHeight > common.MaxFeeHistoryBatches {
+		return nil, responses.ToInternalError(fmt.Errorf("invalid batch range [%d, %d]. At most %d batches can be requested", fromHeight, toHeight, common.MaxFeeHistoryBatches+1))
+	}
+	if err := common.ValidateRewardPercentiles(rewardPercentiles); err != nil {
+		return nil, responses.ToInternalError(err)
+	}
+	prices, err := e.storage.ReadBatchTxPrices(ctx, fromHeight, toHeight)
+	if err != nil {
+		return nil, responses.ToInternalError(fmt.Errorf("could not read the fees of the batches. Cause: %w", err))
+	}
+	return batchTxFees(prices, rewardPercentiles), nil
 }
 
 func (e *enclaveRPCService) EnclavePublicConfig(context.Context) (*common.EnclavePublicConfig, common.SystemError) {
@@ -0,0 +1,267 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/gethapi"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/enclave/l2chain"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	gethrpc "github.com/ten-protocol/go-ten/lib/gethfork/rpc"
+)
+
+const (
+	canViewABIJSON = `[{"inputs":[{"internalType":"address","name":"requester","type":"address"},{"internalType":"bytes32","name":"eventSig","type":"bytes32"},{"internalType":"bytes32[]","name":"topics","type":"bytes32[]"}],"name":"canView","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"}]`
+	canViewMethod  = "canView"
+	// the gas available to the `canView` function of the contract
+	maxGasForCanView = 100_000
+	// the maximum number of `canView` calls executed for a request. The cached results don't count.
+	maxCanViewCalls = 500
+	// the number of `canView` results kept in memory
+	canViewCacheSize = 100_000
+)
+
+var (
+	canViewABI, _ = abi.JSON(strings.NewReader(canViewABIJSON))
+
+	ErrTooManyCanViewCalls = errors.New("too many logs whose visibility is decided by the contract. Narrow the filter")
+)
+
+// canViewKey - identifies the result of a `canView` call for a log
+type canViewKey struct {
+	contract gethcommon.Address
+	topics   gethcommon.Hash // the hash of the topics of the log
+	account  gethcommon.Address
+	head     gethcommon.Hash // the head batch the call was executed against
+}
+
+// canViewBudget - counts the `canView` calls executed for a request
+type canViewBudget struct {
+	calls int
+}
+
+func (b *canViewBudget) spend() error {
+	if b.calls >= maxCanViewCalls {
+		return ErrTooManyCanViewCalls
+	}
+	b.calls++
+	return nil
+}
+
+// LogFilter - returns the event logs visible to a list of accounts.
+// Besides the visibility rules stored with the event types, a contract can configure its events to be visible to a
+// dynamic set of accounts (e.g. the members of a DAO) by implementing `canView`.
+// The function is called against the state of the head batch, so the current members of the set can view all the
+// logs, and the results are cached until the head batch changes.
+type LogFilter struct {
+	storage storage.Storage
+	chain   l2chain.ObscuroChain
+
+	canViewResults *lru.Cache[canViewKey, bool]
+
+	logger gethlog.Logger
+}
+
+func NewLogFilter(storage storage.Storage, chain l2chain.ObscuroChain, logger gethlog.Logger) *LogFilter {
+	return &LogFilter{
+		storage:        storage,
+		chain:          chain,
+		canViewResults: lru.NewCache[canViewKey, bool](canViewCacheSize),
+		logger:         logger,
+	}
+}
+
+// FilterLogs - returns the logs matching the filter that are visible to any of the requesting accounts, sorted by
+// batch and index.
+func (lf *LogFilter) FilterLogs(
+	ctx context.Context,
+	requestingAccounts []*gethcommon.Address,
+	fromBlock, toBlock *big.Int,
+	blockHash *common.L2BatchHash,
+	addresses []gethcommon.Address,
+	topics [][]gethcommon.Hash,
+) ([]*types.Log, error) {
+	logs, err := lf.storage.FilterLogs(ctx, requestingAccounts, fromBlock, toBlock, blockHash, addresses, topics)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, err := lf.storage.FilterContractVisibleLogs(ctx, fromBlock, toBlock, blockHash, addresses, topics)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return logs, nil
+	}
+
+	// the logs already visible through the static rules don't require a call to the contract
+	type logKey struct {
+		batch gethcommon.Hash
+		index uint
+	}
+	visible := make(map[logKey]struct{}, len(logs))
+	for _, l := range logs {
+		visible[logKey{l.BlockHash, l.Index}] = struct{}{}
+	}
+	hidden := make([]*types.Log, 0, len(candidates))
+	for _, l := range candidates {
+		if _, ok := visible[logKey{l.BlockHash, l.Index}]; !ok {
+			hidden = append(hidden, l)
+		}
+	}
+	contractVisible, err := lf.FilterContractVisibleLogs(ctx, requestingAccounts, hidden)
+	if err != nil {
+		return nil, err
+	}
+	logs = append(logs, contractVisible...)
+
+	sort.Slice(logs, func(i, j int) bool {
+		if logs[i].BlockNumber == logs[j].BlockNumber {
+			return logs[i].Index < logs[j].Index
+		}
+		return logs[i].BlockNumber < logs[j].BlockNumber
+	})
+	return logs, nil
+}
+
+// FilterReceiptLogs - adds the logs of the transaction whose visibility is decided by the contract, and that are
+// visible to any of the requesting accounts, to the logs of a receipt. The result is sorted by index.
+func (lf *LogFilter) FilterReceiptLogs(ctx context.Context, requestingAccounts []*gethcommon.Address, batchHash common.L2BatchHash, txHash common.L2TxHash, logs []*types.Log) ([]*types.Log, error) {
+	candidates, err := lf.storage.FilterContractVisibleLogs(ctx, nil, nil, &batchHash, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	visible := make(map[uint]struct{}, len(logs))
+	for _, l := range logs {
+		visible[l.Index] = struct{}{}
+	}
+	txCandidates := make([]*types.Log, 0)
+	for _, l := range candidates {
+		if _, ok := visible[l.Index]; !ok && l.TxHash == txHash {
+			txCandidates = append(txCandidates, l)
+		}
+	}
+	contractVisible, err := lf.FilterContractVisibleLogs(ctx, requestingAccounts, txCandidates)
+	if err != nil {
+		return nil, err
+	}
+	if len(contractVisible) == 0 {
+		return logs, nil
+	}
+	logs = append(logs, contractVisible...)
+	sort.Slice(logs, func(i, j int) bool {
+		return logs[i].Index < logs[j].Index
+	})
+	return logs, nil
+}
+
+// FilterContractVisibleLogs - returns the logs visible to any of the requesting accounts according to the `canView`
+// function of the contracts that emitted them. Fails when the logs require more than `maxCanViewCalls` calls.
+func (lf *LogFilter) FilterContractVisibleLogs(ctx context.Context, requestingAccounts []*gethcommon.Address, logs []*types.Log) ([]*types.Log, error) {
+	visible := make([]*types.Log, 0)
+	if len(logs) == 0 {
+		return visible, nil
+	}
+	// all the calls of a request are executed against the same head batch
+	head, err := lf.storage.FetchHeadBatchHeader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch the head batch. Cause: %w", err)
+	}
+	budget := &canViewBudget{}
+	for _, l := range logs {
+		canView, err := lf.anyCanView(ctx, requestingAccounts, l, head, budget)
+		if err != nil {
+			return nil, err
+		}
+		if canView {
+			visible = append(visible, l)
+		}
+	}
+	return visible, nil
+}
+
+func (lf *LogFilter) anyCanView(ctx context.Context, requestingAccounts []*gethcommon.Address, l *types.Log, head *common.BatchHeader, budget *canViewBudget) (bool, error) {
+	for _, account := range requestingAccounts {
+		canView, err := lf.canView(ctx, *account, l, head, budget)
+		if err != nil {
+			return false, err
+		}
+		if canView {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// canView - calls the `canView` function of the contract that emitted the log, against the state of the head batch.
+// A failing call means the log is not visible.
+func (lf *LogFilter) canView(ctx context.Context, account gethcommon.Address, l *types.Log, head *common.BatchHeader, budget *canViewBudget) (bool, error) {
+	if len(l.Topics) == 0 {
+		return false, nil
+	}
+	key := canViewKey{
+		contract: l.Address,
+		topics:   crypto.Keccak256Hash(topicsBytes(l.Topics)...),
+		account:  account,
+		head:     head.Hash(),
+	}
+	if result, found := lf.canViewResults.Get(key); found {
+		return result, nil
+	}
+	if err := budget.spend(); err != nil {
+		return false, err
+	}
+
+	data, err := canViewABI.Pack(canViewMethod, account, l.Topics[0], l.Topics[1:])
+	if err != nil {
+		return false, fmt.Errorf("could not pack canView call. Cause: %w", err)
+	}
+	callData := hexutil.Bytes(data)
+	gas := hexutil.Uint64(maxGasForCanView)
+	args := &gethapi.TransactionArgs{
+		From: &account,
+		To:   &l.Address,
+		Gas:  &gas,
+		Data: &callData,
+	}
+
+	headNumber := gethrpc.BlockNumber(head.Number.Int64())
+	execResult, err := lf.chain.ObsCallAtBlock(ctx, args, &headNumber)
+	if err != nil {
+		return false, fmt.Errorf("could not call canView on %s. Cause: %w", l.Address, err)
+	}
+
+	result := false
+	if execResult.Err == nil {
+		out, err := canViewABI.Unpack(canViewMethod, execResult.ReturnData)
+		if err == nil && len(out) == 1 {
+			result, _ = out[0].(bool)
+		}
+	} else {
+		lf.logger.Debug("canView call failed", log.CtrErrKey, execResult.Err, "contract", l.Address)
+	}
+
+	lf.canViewResults.Add(key, result)
+	return result, nil
+}
+
+func topicsBytes(topics []gethcommon.Hash) [][]byte {
+	b := make([][]byte, len(topics))
+	for i, topic := range topics {
+		b[i] = topic.Bytes()
+	}
+	return b
+}
@@ -0,0 +1,128 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/gethapi"
+	"github.com/ten-protocol/go-ten/go/enclave/l2chain"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	gethrpc "github.com/ten-protocol/go-ten/lib/gethfork/rpc"
+)
+
+// testChain - lets the accounts with an even last byte view the logs, and records the batches the calls are executed
+// against
+type testChain struct {
+	l2chain.ObscuroChain
+	calls       int
+	lastBatchNo gethrpc.BlockNumber
+}
+
+func (c *testChain) ObsCallAtBlock(_ context.Context, apiArgs *gethapi.TransactionArgs, blockNumber *gethrpc.BlockNumber) (*gethcore.ExecutionResult, error) {
+	c.calls++
+	c.lastBatchNo = *blockNumber
+	data, err := canViewABI.Methods[canViewMethod].Outputs.Pack(apiArgs.From[19]%2 == 0)
+	if err != nil {
+		return nil, err
+	}
+	return &gethcore.ExecutionResult{ReturnData: data}, nil
+}
+
+// testStorage - returns the head batch, the other storage methods are not used by the tests
+type testStorage struct {
+	storage.Storage
+	head *common.BatchHeader
+}
+
+func (s *testStorage) FetchHeadBatchHeader(context.Context) (*common.BatchHeader, error) {
+	return s.head, nil
+}
+
+func newTestStorage(headNumber int64) *testStorage {
+	return &testStorage{head: &common.BatchHeader{Number: big.NewInt(headNumber)}}
+}
+
+func testLogs(n int, blockNumber uint64) []*types.Log {
+	logs := make([]*types.Log, n)
+	for i := range logs {
+		logs[i] = &types.Log{
+			Address:     gethcommon.HexToAddress("0xc0"),
+			Topics:      []gethcommon.Hash{{1}, gethcommon.BigToHash(gethcommon.Big1), {byte(i), byte(i >> 8)}},
+			BlockNumber: blockNumber,
+			BlockHash:   gethcommon.Hash{byte(blockNumber)},
+			Index:       uint(i),
+		}
+	}
+	return logs
+}
+
+func TestCanViewResultsAreCachedAcrossRequests(t *testing.T) {
+	chain := &testChain{}
+	lf := NewLogFilter(newTestStorage(100), chain, gethlog.New())
+	viewer, other := gethcommon.HexToAddress("0x2"), gethcommon.HexToAddress("0x3")
+	logs := testLogs(maxCanViewCalls/2, 10)
+
+	visible, err := lf.FilterContractVisibleLogs(context.Background(), []*gethcommon.Address{&other, &viewer}, logs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(visible) != len(logs) || chain.calls != 2*len(logs) {
+		t.Fatalf("unexpected result: %d visible logs, %d calls", len(visible), chain.calls)
+	}
+
+	// the cached results don't require calls to the contract
+	for i := 0; i < 3; i++ {
+		visible, err = lf.FilterContractVisibleLogs(context.Background(), []*gethcommon.Address{&other}, logs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(visible) != 0 || chain.calls != 2*len(logs) {
+			t.Fatalf("unexpected result: %d visible logs, %d calls", len(visible), chain.calls)
+		}
+	}
+}
+
+func TestCanViewCallsAreCapped(t *testing.T) {
+	chain := &testChain{}
+	lf := NewLogFilter(newTestStorage(100), chain, gethlog.New())
+	account := gethcommon.HexToAddress("0x3")
+
+	_, err := lf.FilterContractVisibleLogs(context.Background(), []*gethcommon.Address{&account}, testLogs(maxCanViewCalls+1, 10))
+	if !errors.Is(err, ErrTooManyCanViewCalls) {
+		t.Fatalf("expected the calls to be capped, got %v", err)
+	}
+	if chain.calls != maxCanViewCalls {
+		t.Fatalf("unexpected number of calls %d", chain.calls)
+	}
+}
+
+// the visibility of the logs is decided by the state of the head batch, whichever batch emitted them, and is decided
+// again when the head batch changes
+func TestCanViewIsCalledAgainstTheHeadBatch(t *testing.T) {
+	chain := &testChain{}
+	s := newTestStorage(100)
+	lf := NewLogFilter(s, chain, gethlog.New())
+	account := gethcommon.HexToAddress("0x2")
+	logs := testLogs(1, 10)
+
+	for i, head := range []int64{100, 100, 101} {
+		s.head = &common.BatchHeader{Number: big.NewInt(head)}
+		visible, err := lf.FilterContractVisibleLogs(context.Background(), []*gethcommon.Address{&account}, logs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(visible) != 1 || chain.lastBatchNo != gethrpc.BlockNumber(head) {
+			t.Fatalf("request %d: %d visible logs, called against batch %d", i, len(visible), chain.lastBatchNo)
+		}
+	}
+	if chain.calls != 2 {
+		t.Fatalf("expected a call per head batch, got %d calls", chain.calls)
+	}
+}
@@ -3,7 +3,9 @@ package events
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/ten-protocol/go-ten/go/enclave/components"
@@ -15,9 +17,11 @@ import (
 
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/log"
 )
 
 type logSubscription struct {
@@ -29,23 +33,27 @@ type logSubscription struct {
 // SubscriptionManager manages the creation/deletion of subscriptions, and the filtering and encryption of logs for
 // active subscriptions.
 type SubscriptionManager struct {
-	storage  storage.Storage
-	registry components.BatchRegistry
+	storage   storage.Storage
+	registry  components.BatchRegistry
+	logFilter *LogFilter
 
 	subscriptions     map[gethrpc.ID]*logSubscription
 	chainID           int64
+	signer            types.Signer
 	subscriptionMutex *sync.RWMutex // the mutex guards the subscriptions/lastHead pair
 
 	logger gethlog.Logger
 }
 
-func NewSubscriptionManager(storage storage.Storage, registry components.BatchRegistry, chainID int64, logger gethlog.Logger) *SubscriptionManager {
+func NewSubscriptionManager(storage storage.Storage, registry components.BatchRegistry, logFilter *LogFilter, chainID int64, logger gethlog.Logger) *SubscriptionManager {
 	return &SubscriptionManager{
-		storage:  storage,
-		registry: registry,
+		storage:   storage,
+		registry:  registry,
+		logFilter: logFilter,
 
 		subscriptions:     map[gethrpc.ID]*logSubscription{},
 		chainID:           chainID,
+		signer:            types.LatestSignerForChainID(big.NewInt(chainID)),
 		subscriptionMutex: &sync.RWMutex{},
 		logger:            logger,
 	}
@@ -64,6 +72,9 @@ func (s *SubscriptionManager) AddSubscription(id gethrpc.ID, encodedSubscription
 	if err != nil {
 		return fmt.Errorf("unable to authenticate the viewing key for subscription  - %w", err)
 	}
+	if err := authenticateViewingKey.CheckRevocation(context.Background(), s.storage); err != nil {
+		return err
+	}
 
 	s.subscriptionMutex.Lock()
 	defer s.subscriptionMutex.Unlock()
@@ -102,7 +113,19 @@ func (s *SubscriptionManager) GetSubscribedLogsForBatch(ctx context.Context, bat
 	}
 
 	for id, sub := range s.subscriptions {
-		relevantLogsForSub, err := s.storage.FilterLogs(ctx, sub.ViewingKeyEncryptor.AccountAddress, nil, nil, &h, sub.Subscription.Filter.Addresses, sub.Subscription.Filter.Topics)
+		if sub.Subscription.PendingTransactions {
+			continue
+		}
+		// the delegation of the viewing key can be revoked while the subscription is active
+		if err := sub.ViewingKeyEncryptor.CheckRevocation(ctx, s.storage); err != nil {
+			return nil, err
+		}
+		relevantLogsForSub, err := s.logFilter.FilterLogs(ctx, sub.ViewingKeyEncryptor.ReadableAccounts(), nil, nil, &h, sub.Subscription.Filter.Addresses, sub.Subscription.Filter.Topics)
+		// a subscription matching too many logs that require calls to the contracts must not hold up the others
+		if errors.Is(err, ErrTooManyCanViewCalls) {
+			s.logger.Warn("Skipped the logs of a subscription", "subscription", id, "batch", h, log.ErrKey, err)
+			continue
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -115,28 +138,62 @@ func (s *SubscriptionManager) GetSubscribedLogsForBatch(ctx context.Context, bat
 	return s.encryptLogs(relevantLogsPerSubscription)
 }
 
+// GetSubscribedPendingTransactions - returns the transactions which became executable in the mempool to the subscribers
+// who sent them, encrypted with their viewing key
+func (s *SubscriptionManager) GetSubscribedPendingTransactions(txs []*types.Transaction) (common.EncryptedSubscriptionLogs, error) {
+	s.subscriptionMutex.RLock()
+	defer s.subscriptionMutex.RUnlock()
+
+	txsPerSender := map[gethcommon.Address][]*types.Transaction{}
+	for _, tx := range txs {
+		sender, err := types.Sender(s.signer, tx)
+		if err != nil {
+			continue
+		}
+		txsPerSender[sender] = append(txsPerSender[sender], tx)
+	}
+
+	txsPerSubscription := map[gethrpc.ID][]*types.Transaction{}
+	for id, sub := range s.subscriptions {
+		if !sub.Subscription.PendingTransactions {
+			continue
+		}
+		if senderTxs := txsPerSender[*sub.ViewingKeyEncryptor.AccountAddress]; len(senderTxs) > 0 {
+			txsPerSubscription[id] = senderTxs
+		}
+	}
+	if len(txsPerSubscription) == 0 {
+		return nil, nil
+	}
+	return encryptPerSubscription(s.subscriptions, txsPerSubscription)
+}
+
 // Encrypts each log with the appropriate viewing key.
 func (s *SubscriptionManager) encryptLogs(logsByID map[gethrpc.ID][]*types.Log) (map[gethrpc.ID][]byte, error) {
-	encryptedLogsByID := map[gethrpc.ID][]byte{}
+	return encryptPerSubscription(s.subscriptions, logsByID)
+}
+
+func encryptPerSubscription[T any](subscriptions map[gethrpc.ID]*logSubscription, itemsByID map[gethrpc.ID][]T) (map[gethrpc.ID][]byte, error) {
+	encryptedByID := map[gethrpc.ID][]byte{}
 
-	for subID, logs := range logsByID {
-		subscription, found := s.subscriptions[subID]
+	for subID, items := range itemsByID {
+		subscription, found := subscriptions[subID]
 		if !found {
 			continue // The subscription has been removed, so there's no need to return anything.
 		}
 
-		jsonLogs, err := json.Marshal(logs)
+		jsonItems, err := json.Marshal(items)
 		if err != nil {
-			return nil, fmt.Errorf("could not marshal logs to JSON. Cause: %w", err)
+			return nil, fmt.Errorf("could not marshal subscription items to JSON. Cause: %w", err)
 		}
 
-		encryptedLogs, err := subscription.ViewingKeyEncryptor.Encrypt(jsonLogs)
+		encrypted, err := subscription.ViewingKeyEncryptor.Encrypt(jsonItems)
 		if err != nil {
-			return nil, fmt.Errorf("unable to encrypt logs - %w", err)
+			return nil, fmt.Errorf("unable to encrypt subscription items - %w", err)
 		}
 
-		encryptedLogsByID[subID] = encryptedLogs
+		encryptedByID[subID] = encrypted
 	}
 
-	return encryptedLogsByID, nil
+	return encryptedByID, nil
 }
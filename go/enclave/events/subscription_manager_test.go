@@ -0,0 +1,89 @@
+package events
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/viewingkey"
+	"github.com/ten-protocol/go-ten/go/wallet"
+	gethrpc "github.com/ten-protocol/go-ten/lib/gethfork/rpc"
+)
+
+const testChainID = 443
+
+type subscriber struct {
+	key *ecdsa.PrivateKey
+	vk  *viewingkey.ViewingKey
+}
+
+func newSubscriber(t *testing.T) *subscriber {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	vk, err := viewingkey.GenerateViewingKeyForWallet(wallet.NewInMemoryWalletFromPK(big.NewInt(testChainID), key, gethlog.New()))
+	require.NoError(t, err)
+	return &subscriber{key: key, vk: vk}
+}
+
+func (s *subscriber) subscribe(t *testing.T, manager *SubscriptionManager, id gethrpc.ID, pendingTxs bool) {
+	subscription, err := common.CreateAuthenticatedLogSubscriptionPayload(nil, s.vk)
+	require.NoError(t, err)
+	subscription.PendingTransactions = pendingTxs
+	encoded, err := json.Marshal(subscription)
+	require.NoError(t, err)
+	require.NoError(t, manager.AddSubscription(id, encoded))
+}
+
+func (s *subscriber) signTx(t *testing.T, nonce uint64) *types.Transaction {
+	tx, err := types.SignNewTx(s.key, types.LatestSignerForChainID(big.NewInt(testChainID)), &types.LegacyTx{Nonce: nonce, GasPrice: big.NewInt(1)})
+	require.NoError(t, err)
+	return tx
+}
+
+// received - the hashes of the pending transactions notified to the subscription
+func (s *subscriber) received(t *testing.T, encrypted []byte) []gethcommon.Hash {
+	decrypted, err := s.vk.PrivateKey.Decrypt(encrypted, nil, nil)
+	require.NoError(t, err)
+	var txs []*types.Transaction
+	require.NoError(t, json.Unmarshal(decrypted, &txs))
+	hashes := make([]gethcommon.Hash, len(txs))
+	for i, tx := range txs {
+		hashes[i] = tx.Hash()
+	}
+	return hashes
+}
+
+func TestPendingTransactionsAreOnlySentToTheirSender(t *testing.T) {
+	manager := NewSubscriptionManager(nil, nil, nil, testChainID, gethlog.New())
+	alice, bob, carol := newSubscriber(t), newSubscriber(t), newSubscriber(t)
+	alice.subscribe(t, manager, "alice-pending", true)
+	alice.subscribe(t, manager, "alice-logs", false)
+	bob.subscribe(t, manager, "bob-pending", true)
+
+	aliceTxs := []*types.Transaction{alice.signTx(t, 0), alice.signTx(t, 1)}
+	bobTx := bob.signTx(t, 0)
+	carolTx := carol.signTx(t, 0)
+	encrypted, err := manager.GetSubscribedPendingTransactions([]*types.Transaction{aliceTxs[0], carolTx, bobTx, aliceTxs[1]})
+	require.NoError(t, err)
+
+	// the log subscriptions don't receive the pending transactions, and nobody receives the transactions of carol
+	require.Len(t, encrypted, 2)
+	require.Equal(t, []gethcommon.Hash{aliceTxs[0].Hash(), aliceTxs[1].Hash()}, alice.received(t, encrypted["alice-pending"]))
+	require.Equal(t, []gethcommon.Hash{bobTx.Hash()}, bob.received(t, encrypted["bob-pending"]))
+
+	// the notifications are encrypted with the viewing key of the subscriber
+	_, err = bob.vk.PrivateKey.Decrypt(encrypted["alice-pending"], nil, nil)
+	require.Error(t, err)
+
+	// nothing is sent when none of the transactions belongs to a subscriber
+	encrypted, err = manager.GetSubscribedPendingTransactions([]*types.Transaction{carolTx})
+	require.NoError(t, err)
+	require.Empty(t, encrypted)
+}
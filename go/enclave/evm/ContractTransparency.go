@@ -34,6 +34,7 @@ const (
 	topic3
 	sender
 	everyone
+	contractCanView
 )
 
 const (
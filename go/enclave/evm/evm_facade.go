@@ -65,9 +65,12 @@ func ExecuteTransaction(
 	snap := s.Snapshot()
 	s.SetTxContext(tx.Tx.Hash(), tCount)
 
-	s.SetLogger(&tracing.Hooks{
+	stateHooks := &tracing.Hooks{
 		// called when the code of a contract changes.
 		OnCodeChange: func(addr gethcommon.Address, prevCodeHash gethcommon.Hash, prevCode []byte, codeHash gethcommon.Hash, code []byte) {
+			if vmCfg.Tracer != nil && vmCfg.Tracer.OnCodeChange != nil {
+				vmCfg.Tracer.OnCodeChange(addr, prevCodeHash, prevCode, codeHash, code)
+			}
 			// only proceed for new deployments.
 			if len(prevCode) > 0 {
 				logger.Debug("OnCodeChange: Skipping contract deployment", "address", addr.Hex())
@@ -76,7 +79,15 @@ func ExecuteTransaction(
 			createdContracts = append(createdContracts, &addr)
 			logger.Debug("OnCodeChange: Contract deployed", "address", addr.Hex())
 		},
-	})
+	}
+	// when the transaction is traced, the state changes must reach the tracer as well
+	if vmCfg.Tracer != nil {
+		stateHooks.OnBalanceChange = vmCfg.Tracer.OnBalanceChange
+		stateHooks.OnNonceChange = vmCfg.Tracer.OnNonceChange
+		stateHooks.OnStorageChange = vmCfg.Tracer.OnStorageChange
+		stateHooks.OnLog = vmCfg.Tracer.OnLog
+	}
+	s.SetLogger(stateHooks)
 	defer s.SetLogger(nil)
 
 	var vmenv *vm.EVM
@@ -184,7 +195,8 @@ const (
 )
 
 func readVisibilityConfig(vmenv *vm.EVM, contractAddress *gethcommon.Address) *core.ContractVisibilityConfig {
-	cc, err := NewTransparencyConfigCaller(*contractAddress, &localContractCaller{evm: vmenv, maxGasForVisibility: maxGasForVisibility})
+	caller := &localContractCaller{evm: vmenv, maxGasForVisibility: maxGasForVisibility}
+	cc, err := NewTransparencyConfigCaller(*contractAddress, caller)
 	if err != nil {
 		// unrecoverable error. should not happen
 		panic(fmt.Sprintf("could not create transparency config caller. %v", err))
@@ -215,6 +227,7 @@ func readVisibilityConfig(vmenv *vm.EVM, contractAddress *gethcommon.Address) *c
 		logConfig := visibilityRules.EventLogConfigs[i]
 		cfg.EventConfigs[logConfig.EventSignature] = eventCfg(logConfig)
 	}
+	cfg.PublicSlots, cfg.PublicMappingSlots = readPublicStorage(caller, *contractAddress)
 
 	return cfg
 }
@@ -234,13 +247,15 @@ func eventCfg(logConfig ContractTransparencyConfigEventLogConfig) *core.EventVis
 	t2 := relevantToMap[topic2]
 	t3 := relevantToMap[topic3]
 	s := relevantToMap[sender]
+	c := relevantToMap[contractCanView]
 	return &core.EventVisibilityConfig{
-		AutoConfig:    false,
-		Public:        false,
-		Topic1CanView: &t1,
-		Topic2CanView: &t2,
-		Topic3CanView: &t3,
-		SenderCanView: &s,
+		AutoConfig:      false,
+		Public:          false,
+		Topic1CanView:   &t1,
+		Topic2CanView:   &t2,
+		Topic3CanView:   &t3,
+		SenderCanView:   &s,
+		ContractCanView: &c,
 	}
 }
 
@@ -276,6 +291,7 @@ func ExecuteCall(
 	chainConfig *params.ChainConfig,
 	gasEstimationCap uint64,
 	config enclaveconfig.EnclaveConfig,
+	tracer *tracing.Hooks,
 	logger gethlog.Logger,
 ) (*gethcore.ExecutionResult, error) {
 	noBaseFee := true
@@ -298,11 +314,26 @@ func ExecuteCall(
 	cleanState := createCleanState(s, msg, ethHeader, chainConfig)
 
 	chain, vmCfg := initParams(storage, gethEncodingService, config, noBaseFee, nil)
+	// the tracer receives both the evm and the state changes
+	if tracer != nil {
+		vmCfg.Tracer = tracer
+		cleanState.SetLogger(tracer)
+	}
 	blockContext := gethcore.NewEVMBlockContext(ethHeader, chain, nil)
 	// sets TxKey.origin
 	txContext := gethcore.NewEVMTxContext(msg)
 	vmenv := vm.NewEVM(blockContext, txContext, cleanState, chainConfig, vmCfg)
+	if tracer != nil && tracer.OnTxStart != nil {
+		tracer.OnTxStart(vmenv.GetVMContext(), messageToTx(msg), msg.From)
+	}
 	result, err := gethcore.ApplyMessage(vmenv, msg, &gp)
+	if tracer != nil && tracer.OnTxEnd != nil {
+		receipt := &types.Receipt{}
+		if result != nil {
+			receipt.GasUsed = result.UsedGas
+		}
+		tracer.OnTxEnd(receipt, err)
+	}
 	// Follow the same error check structure as in geth
 	// 1 - vmError / stateDB err check
 	// 2 - evm.Cancelled()  todo (#1576) - support the ability to cancel function call if it takes too long
@@ -322,6 +353,18 @@ func ExecuteCall(
 	return result, nil
 }
 
+// messageToTx - the tracers expect the executed transaction, so the call message is converted to an unsigned transaction
+func messageToTx(msg *gethcore.Message) *types.Transaction {
+	return types.NewTx(&types.LegacyTx{
+		Nonce:    msg.Nonce,
+		To:       msg.To,
+		Value:    msg.Value,
+		Gas:      msg.GasLimit,
+		GasPrice: msg.GasPrice,
+		Data:     msg.Data,
+	})
+}
+
 func createCleanState(s *state.StateDB, msg *gethcore.Message, ethHeader *types.Header, chainConfig *params.ChainConfig) *state.StateDB {
 	cleanState := s.Copy()
 	cleanState.Prepare(chainConfig.Rules(ethHeader.Number, true, 0), msg.From, ethHeader.Coinbase, msg.To, nil, msg.AccessList)
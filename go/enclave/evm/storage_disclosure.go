@@ -0,0 +1,49 @@
+package evm
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// the ABI of the "publicStorage" function of the ContractStorageDisclosure interface
+const (
+	publicStorageABIJSON = `[{"inputs":[],"name":"publicStorage","outputs":[{"internalType":"bytes32[]","name":"slots","type":"bytes32[]"},{"internalType":"bytes32[]","name":"mappingSlots","type":"bytes32[]"}],"stateMutability":"pure","type":"function"}]`
+	publicStorageMethod  = "publicStorage"
+)
+
+var publicStorageABI, _ = abi.JSON(strings.NewReader(publicStorageABIJSON))
+
+// readPublicStorage - calls the `publicStorage` function of a private contract to find the storage slots and the mapping
+// base slots that can be read by everyone. Contracts that don't implement it have no public slots.
+func readPublicStorage(caller *localContractCaller, contractAddress gethcommon.Address) ([]gethcommon.Hash, []gethcommon.Hash) {
+	data, err := publicStorageABI.Pack(publicStorageMethod)
+	if err != nil {
+		return nil, nil
+	}
+	ret, err := caller.CallContract(context.Background(), ethereum.CallMsg{To: &contractAddress, Data: data}, nil)
+	if err != nil {
+		return nil, nil
+	}
+	out, err := publicStorageABI.Unpack(publicStorageMethod, ret)
+	if err != nil || len(out) != 2 {
+		return nil, nil
+	}
+	slots, ok1 := out[0].([][32]byte)
+	mappingSlots, ok2 := out[1].([][32]byte)
+	if !ok1 || !ok2 {
+		return nil, nil
+	}
+	return toHashes(slots), toHashes(mappingSlots)
+}
+
+func toHashes(values [][32]byte) []gethcommon.Hash {
+	hashes := make([]gethcommon.Hash, len(values))
+	for i, v := range values {
+		hashes[i] = v
+	}
+	return hashes
+}
@@ -0,0 +1,86 @@
+package enclave
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/enclavedb"
+)
+
+// txTip - the gas used by a transaction and the tip it paid on top of the base fee of its batch
+type txTip struct {
+	gasUsed uint64
+	tip     *big.Int
+}
+
+// batchTxFees - aggregates the prices paid by the transactions of each batch, so that only the gas used and the rewards
+// at the requested percentiles leave the enclave
+func batchTxFees(batches []*enclavedb.BatchTxPrices, percentiles []float64) []common.BatchTxFees {
+	result := make([]common.BatchTxFees, len(batches))
+	for i, batch := range batches {
+		baseFee := new(big.Int)
+		if batch.Header.BaseFee != nil {
+			baseFee.Set(batch.Header.BaseFee)
+		}
+		tips := batchTips(baseFee, batch)
+
+		var gasUsed uint64
+		for _, t := range tips {
+			gasUsed += t.gasUsed
+		}
+		result[i] = common.BatchTxFees{
+			Height:   batch.Header.Number.Uint64(),
+			BaseFee:  baseFee,
+			GasLimit: batch.Header.GasLimit,
+			GasUsed:  gasUsed,
+			Rewards:  rewardsAtPercentiles(tips, percentiles),
+		}
+	}
+	return result
+}
+
+// batchTips - the tip of a transaction is the part of its effective gas price above the base fee of the batch
+func batchTips(baseFee *big.Int, batch *enclavedb.BatchTxPrices) []txTip {
+	tips := make([]txTip, 0, len(batch.GasUsed))
+	for i, gasUsed := range batch.GasUsed {
+		tip := new(big.Int).Sub(new(big.Int).SetUint64(batch.EffectiveGasPrices[i]), baseFee)
+		if tip.Sign() < 0 {
+			tip.SetUint64(0)
+		}
+		tips = append(tips, txTip{gasUsed: gasUsed, tip: tip})
+	}
+	return tips
+}
+
+// rewardsAtPercentiles - same algorithm as geth: the tips are sorted and weighted by the gas used by each transaction,
+// so the reward at a percentile is the tip of the transaction that brings the gas used past that percentile
+func rewardsAtPercentiles(tips []txTip, percentiles []float64) []*big.Int {
+	if len(tips) == 0 || len(percentiles) == 0 {
+		return nil
+	}
+
+	sorted := make([]txTip, len(tips))
+	copy(sorted, tips)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].tip.Cmp(sorted[j].tip) < 0
+	})
+
+	var totalGasUsed uint64
+	for _, t := range sorted {
+		totalGasUsed += t.gasUsed
+	}
+
+	rewards := make([]*big.Int, len(percentiles))
+	txIndex := 0
+	sumGasUsed := sorted[0].gasUsed
+	for i, p := range percentiles {
+		thresholdGasUsed := uint64(float64(totalGasUsed) * p / 100)
+		for sumGasUsed < thresholdGasUsed && txIndex < len(sorted)-1 {
+			txIndex++
+			sumGasUsed += sorted[txIndex].gasUsed
+		}
+		rewards[i] = new(big.Int).Set(sorted[txIndex].tip)
+	}
+	return rewards
+}
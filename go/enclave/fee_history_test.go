@@ -0,0 +1,35 @@
+package enclave
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/enclavedb"
+)
+
+func TestBatchTxFees(t *testing.T) {
+	batches := []*enclavedb.BatchTxPrices{
+		{
+			Header: &common.BatchHeader{Number: big.NewInt(5), BaseFee: big.NewInt(10), GasLimit: 1_000_000},
+			// tips of 5, 1 and 0 (the price of the last transaction is below the base fee)
+			GasUsed:            []uint64{100_000, 300_000, 100_000},
+			EffectiveGasPrices: []uint64{15, 11, 8},
+		},
+		{Header: &common.BatchHeader{Number: big.NewInt(6), BaseFee: big.NewInt(20), GasLimit: 1_000_000}},
+	}
+
+	fees := batchTxFees(batches, []float64{10, 50, 90})
+	require.Len(t, fees, 2)
+	require.Equal(t, uint64(500_000), fees[0].GasUsed)
+	require.Equal(t, uint64(1_000_000), fees[0].GasLimit)
+	require.Equal(t, int64(10), fees[0].BaseFee.Int64())
+	require.Equal(t, []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(5)}, fees[0].Rewards)
+
+	// batches without paid transactions have no rewards
+	require.Equal(t, uint64(0), fees[1].GasUsed)
+	require.Nil(t, fees[1].Rewards)
+
+	require.Nil(t, batchTxFees(batches, nil)[0].Rewards)
+}
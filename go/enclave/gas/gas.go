@@ -6,6 +6,10 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 )
 
+// BlobCapacity - the number of bytes which fit in a blob. Only 31 bytes of every field element are counted, since the
+// field elements must be smaller than the BLS modulus.
+const BlobCapacity = params.BlobTxFieldElementsPerBlob * (params.BlobTxBytesPerFieldElement - 1)
+
 // CalculateL1GasUsed - calculates the gas cost of having a transaction on the l1.
 func CalculateL1GasUsed(data []byte, overhead *big.Int) *big.Int {
 	reducedTxSize := uint64(len(data))
@@ -15,3 +19,12 @@ func CalculateL1GasUsed(data []byte, overhead *big.Int) *big.Int {
 	l1Gas := new(big.Int).SetUint64(reducedTxSize)
 	return new(big.Int).Add(l1Gas, overhead)
 }
+
+// CalculateL1BlobGasUsed - calculates the blob gas cost of having a transaction in a blob on the l1.
+// The transactions of a rollup share its blobs, so each transaction is charged pro-rata for the bytes it uses.
+func CalculateL1BlobGasUsed(data []byte, overheadBytes uint64) *big.Int {
+	reducedTxSize := uint64(len(data))
+	reducedTxSize = (reducedTxSize * 90) / 100
+
+	return new(big.Int).SetUint64((reducedTxSize + overheadBytes) * params.BlobTxBlobGasPerBlob / BlobCapacity)
+}
@@ -0,0 +1,61 @@
+package gas
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateL1BlobGasUsedIsProRata(t *testing.T) {
+	// 90% of the size is charged: 900 bytes + 100 bytes of overhead use 1000/126976 of a blob
+	require.Equal(t, big.NewInt(1000*params.BlobTxBlobGasPerBlob/BlobCapacity), CalculateL1BlobGasUsed(make([]byte, 1000), 100))
+	require.Equal(t, big.NewInt(1032), CalculateL1BlobGasUsed(make([]byte, 1000), 100))
+
+	// a full blob is charged the blob gas of a blob, and the data is not rounded up to whole blobs
+	require.Equal(t, big.NewInt(params.BlobTxBlobGasPerBlob), CalculateL1BlobGasUsed(nil, BlobCapacity))
+	require.Equal(t, big.NewInt(params.BlobTxBlobGasPerBlob+1), CalculateL1BlobGasUsed(nil, BlobCapacity+1))
+	require.Equal(t, big.NewInt(0), CalculateL1BlobGasUsed(nil, 0))
+}
+
+func TestL1CostChargesTheCheapestOfCalldataAndBlobs(t *testing.T) {
+	data := make([]byte, 1000)
+	l1Gas := CalculateL1GasUsed(data, big.NewInt(0))
+	l1BlobGas := CalculateL1BlobGasUsed(data, 0)
+	require.Equal(t, big.NewInt(900*16), l1Gas)
+	require.Equal(t, big.NewInt(929), l1BlobGas)
+
+	prices := &L1Prices{BaseFee: big.NewInt(10), BlobBaseFee: big.NewInt(1), BlobCostActive: true}
+	require.Equal(t, big.NewInt(929), prices.l1Cost(l1Gas, l1BlobGas))
+
+	prices = &L1Prices{BaseFee: big.NewInt(1), BlobBaseFee: big.NewInt(100), BlobCostActive: true}
+	require.Equal(t, big.NewInt(900*16), prices.l1Cost(l1Gas, l1BlobGas))
+
+	// blobs are not considered when the L1 does not support them
+	prices = &L1Prices{BaseFee: big.NewInt(1), BlobBaseFee: big.NewInt(0), BlobCostActive: true}
+	require.Equal(t, big.NewInt(900*16), prices.l1Cost(l1Gas, l1BlobGas))
+
+	// nor before the activation height of the blob cost
+	prices = &L1Prices{BaseFee: big.NewInt(10), BlobBaseFee: big.NewInt(1)}
+	require.Equal(t, big.NewInt(900*16*10), prices.l1Cost(l1Gas, l1BlobGas))
+}
+
+func TestL1CostChargesTheCalldataBelowTheBlobCostActivationHeight(t *testing.T) {
+	excessBlobGas := uint64(0)
+	block := &types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(10), ExcessBlobGas: &excessBlobGas}
+	tx := types.NewTx(&types.LegacyTx{Data: make([]byte, 1000)})
+	o := NewGasOracle(10)
+
+	calldataCost, err := o.EstimateL1StorageGasCost(tx, block, 9)
+	require.NoError(t, err)
+	blobCost, err := o.EstimateL1StorageGasCost(tx, block, 10)
+	require.NoError(t, err)
+	require.True(t, blobCost.Cmp(calldataCost) < 0)
+
+	encodedTx, err := rlp.EncodeToBytes(tx)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(0).Mul(CalculateL1GasUsed(encodedTx, big.NewInt(0)), block.BaseFee), calldataCost)
+}
@@ -3,27 +3,82 @@ package gas
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ten-protocol/go-ten/go/common/gethapi"
 )
 
-// Oracle - the interface for the future precompiled gas oracle contract
-// which will expose necessary l1 information.
+// txOverheadBytes - the estimated size of the fields of a transaction other than its data
+const txOverheadBytes = 150
+
+// L1Prices - the prices of the L1 block a batch is built on. They are published to the GasOracle system contract at the
+// start of every batch, and the L1 cost of the transactions is charged with the same prices.
+type L1Prices struct {
+	BlockNumber *big.Int
+	BaseFee     *big.Int
+	BlobBaseFee *big.Int
+	// BlobCostActive - whether the cost of publishing in blobs is considered, from the activation height of the blob cost
+	BlobCostActive bool
+}
+
+// l1Cost - the cost of publishing data which uses `l1Gas` when published in the calldata, or `l1BlobGas` when published
+// in blobs. The host publishes the rollups in blobs, unless the calldata is cheaper, so the cheapest of the two is
+// charged. Blobs are not considered before the activation height of the blob cost, or when the L1 does not support them.
+func (p *L1Prices) l1Cost(l1Gas *big.Int, l1BlobGas *big.Int) *big.Int {
+	calldataCost := big.NewInt(0).Mul(l1Gas, p.BaseFee)
+	if !p.BlobCostActive || p.BlobBaseFee.Sign() == 0 {
+		return calldataCost
+	}
+	blobCost := big.NewInt(0).Mul(l1BlobGas, p.BlobBaseFee)
+	if blobCost.Cmp(calldataCost) < 0 {
+		return blobCost
+	}
+	return calldataCost
+}
+
+// IsBlobCostActive - whether the transactions of the batch at `height` are charged the cheapest of the calldata and blob
+// costs. The batches below the activation height were charged the calldata cost, which is kept so that they can be replayed.
+func IsBlobCostActive(height uint64, activationHeight uint64) bool {
+	return height >= activationHeight
+}
+
+// L1PricesOf - returns the prices of the L1 block. The prices are zero when the block predates the corresponding fork
+// (the blob base fee is never zero otherwise). The blob cost is not active.
+func L1PricesOf(block *types.Header) *L1Prices {
+	prices := &L1Prices{
+		BlockNumber: new(big.Int).Set(block.Number),
+		BaseFee:     big.NewInt(0),
+		BlobBaseFee: big.NewInt(0),
+	}
+	if block.BaseFee != nil {
+		prices.BaseFee.Set(block.BaseFee)
+	}
+	if block.ExcessBlobGas != nil {
+		prices.BlobBaseFee = eip4844.CalcBlobFee(*block.ExcessBlobGas)
+	}
+	return prices
+}
+
+// Oracle - computes the L1 cost charged to the L2 transactions, using the L1 prices exposed to the contracts by the
+// GasOracle system contract.
 type Oracle interface {
 	ProcessL1Block(block *types.Header)
-	EstimateL1StorageGasCost(tx *types.Transaction, block *types.Header) (*big.Int, error)
-	EstimateL1CostForMsg(args *gethapi.TransactionArgs, block *types.Header) (*big.Int, error)
+	L1Prices(block *types.Header, batchHeight uint64) *L1Prices
+	EstimateL1StorageGasCost(tx *types.Transaction, block *types.Header, batchHeight uint64) (*big.Int, error)
+	EstimateL1CostForMsg(args *gethapi.TransactionArgs, block *types.Header, batchHeight uint64) (*big.Int, error)
 }
 
 type oracle struct {
-	baseFee *big.Int
+	baseFee                  *big.Int
+	blobCostActivationHeight uint64
 }
 
-func NewGasOracle() Oracle {
+func NewGasOracle(blobCostActivationHeight uint64) Oracle {
 	return &oracle{
-		baseFee: big.NewInt(1),
+		baseFee:                  big.NewInt(1),
+		blobCostActivationHeight: blobCostActivationHeight,
 	}
 }
 
@@ -37,23 +92,28 @@ func (o *oracle) ProcessL1Block(block *types.Header) {
 	}
 }
 
-// EstimateL1StorageGasCost - Returns the expected l1 gas cost for a transaction at a given l1 block.
-func (o *oracle) EstimateL1StorageGasCost(tx *types.Transaction, block *types.Header) (*big.Int, error) {
+// L1Prices - the prices of the L1 block of the batch at `batchHeight`, which are published to the GasOracle and used to
+// charge the L1 cost of its transactions
+func (o *oracle) L1Prices(block *types.Header, batchHeight uint64) *L1Prices {
+	prices := L1PricesOf(block)
+	prices.BlobCostActive = IsBlobCostActive(batchHeight, o.blobCostActivationHeight)
+	return prices
+}
+
+// EstimateL1StorageGasCost - Returns the expected l1 gas cost for a transaction at a given l1 block, when included in
+// the batch at `batchHeight`.
+func (o *oracle) EstimateL1StorageGasCost(tx *types.Transaction, block *types.Header, batchHeight uint64) (*big.Int, error) {
 	encodedTx, err := rlp.EncodeToBytes(tx)
 	if err != nil {
 		return nil, err
 	}
 
-	blockBaseFee := block.BaseFee
-	if blockBaseFee == nil {
-		return big.NewInt(0), nil
-	}
-
 	l1Gas := CalculateL1GasUsed(encodedTx, big.NewInt(0))
-	return big.NewInt(0).Mul(l1Gas, block.BaseFee), nil
+	l1BlobGas := CalculateL1BlobGasUsed(encodedTx, 0)
+	return o.L1Prices(block, batchHeight).l1Cost(l1Gas, l1BlobGas), nil
 }
 
-func (o *oracle) EstimateL1CostForMsg(args *gethapi.TransactionArgs, block *types.Header) (*big.Int, error) {
+func (o *oracle) EstimateL1CostForMsg(args *gethapi.TransactionArgs, block *types.Header, batchHeight uint64) (*big.Int, error) {
 	encoded := make([]byte, 0)
 	if args.Data != nil {
 		encoded = append(encoded, *args.Data...)
@@ -62,12 +122,10 @@ func (o *oracle) EstimateL1CostForMsg(args *gethapi.TransactionArgs, block *type
 	// We get the non zero gas cost per byte of calldata, and multiply it by the fixed bytes
 	// of a transaction. Then we take the data of a transaction and calculate the l1 gas used for it.
 	// Both are added together and multiplied by the base fee to give us the final cost for the message.
+	// The same is done for the blob gas, in case the rollup is published in blobs.
 	nonZeroGas := big.NewInt(int64(params.TxDataNonZeroGasEIP2028))
-	overhead := big.NewInt(0).Mul(big.NewInt(150), nonZeroGas)
+	overhead := big.NewInt(0).Mul(big.NewInt(txOverheadBytes), nonZeroGas)
 	l1Gas := CalculateL1GasUsed(encoded, overhead)
-	baseFee := big.NewInt(0)
-	if block.BaseFee != nil {
-		baseFee = block.BaseFee
-	}
-	return big.NewInt(0).Mul(l1Gas, baseFee), nil
+	l1BlobGas := CalculateL1BlobGasUsed(encoded, txOverheadBytes)
+	return o.L1Prices(block, batchHeight).l1Cost(l1Gas, l1BlobGas), nil
 }
@@ -231,7 +231,6 @@ func (s *sequencer) produceBatch(
 			Transactions: transactions,
 			AtTime:       batchTime,
 			Creator:      s.settings.GasPaymentAddress,
-			BaseFee:      s.settings.BaseFee,
 			ChainConfig:  s.chainConfig,
 			SequencerNo:  sequencerNo,
 		}, failForEmptyBatch)
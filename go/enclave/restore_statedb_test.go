@@ -0,0 +1,154 @@
+package enclave
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/triedb"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/components"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	gethrpc "github.com/ten-protocol/go-ten/lib/gethfork/rpc"
+)
+
+// the batches executed after the last flush of the state are replayed on top of it after an unclean shutdown
+func TestRestoreStateAfterUncleanShutdown(t *testing.T) {
+	diskDB := rawdb.NewMemoryDatabase()
+	chain := newTestStateChain(diskDB)
+
+	batches := []*core.Batch{{Header: &common.BatchHeader{Number: big.NewInt(0), SequencerOrderNo: big.NewInt(1), Root: types.EmptyRootHash}}}
+	for i := 1; i <= 5; i++ {
+		batch, err := chain.execute(batches[i-1], i)
+		require.NoError(t, err)
+		batches = append(batches, batch)
+		// only the state of the batch 2 is flushed, e.g. because it ends a rollup
+		if i == 2 {
+			require.NoError(t, chain.trieDB.Commit(batch.Header.Root, false))
+		}
+	}
+
+	// the node crashes without flushing the state of the last batches
+	restarted := newTestStateChain(diskDB)
+	replayed, err := restarted.restore(batches)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 4, 5}, replayed)
+	require.True(t, restarted.hasState(batches[5].Header.Root))
+}
+
+// testStateChain - a chain of batches which each credit a new account
+type testStateChain struct {
+	trieDB     *triedb.Database
+	stateCache state.Database
+	replayed   []uint64 // the numbers of the replayed batches
+}
+
+func newTestStateChain(diskDB ethdb.Database) *testStateChain {
+	trieDB := triedb.NewDatabase(diskDB, nil)
+	return &testStateChain{trieDB: trieDB, stateCache: state.NewDatabaseWithNodeDB(diskDB, trieDB)}
+}
+
+func (c *testStateChain) execute(parent *core.Batch, number int) (*core.Batch, error) {
+	stateDB, err := state.New(parent.Header.Root, c.stateCache, nil)
+	if err != nil {
+		return nil, err
+	}
+	stateDB.AddBalance(gethcommon.BigToAddress(big.NewInt(int64(number))), uint256.NewInt(uint64(number)), tracing.BalanceChangeUnspecified)
+	root, err := stateDB.Commit(uint64(number), true)
+	if err != nil {
+		return nil, err
+	}
+	return &core.Batch{Header: &common.BatchHeader{
+		ParentHash:       parent.Hash(),
+		Number:           big.NewInt(int64(number)),
+		SequencerOrderNo: big.NewInt(int64(number + 1)),
+		Root:             root,
+	}}, nil
+}
+
+func (c *testStateChain) hasState(root gethcommon.Hash) bool {
+	_, err := state.New(root, c.stateCache, nil)
+	return err == nil
+}
+
+func (c *testStateChain) restore(batches []*core.Batch) ([]uint64, error) {
+	st := &testBatchStorage{batches: batches}
+	registry := &testStateRegistry{chain: c, batches: batches}
+	executor := &testReplayExecutor{chain: c, batches: batches}
+	err := restoreStateDBCache(context.Background(), st, registry, executor, nil, gethlog.New())
+	return c.replayed, err
+}
+
+type testBatchStorage struct {
+	storage.Storage
+	batches []*core.Batch
+}
+
+func (s *testBatchStorage) FetchBatchBySeqNo(_ context.Context, seqNo uint64) (*core.Batch, error) {
+	for _, b := range s.batches {
+		if b.SeqNo().Uint64() == seqNo {
+			return b, nil
+		}
+	}
+	return nil, errutil.ErrNotFound
+}
+
+func (s *testBatchStorage) FetchBatch(_ context.Context, hash common.L2BatchHash) (*core.Batch, error) {
+	for _, b := range s.batches {
+		if b.Hash() == hash {
+			return b, nil
+		}
+	}
+	return nil, errutil.ErrNotFound
+}
+
+type testStateRegistry struct {
+	components.BatchRegistry
+	chain   *testStateChain
+	batches []*core.Batch
+}
+
+func (r *testStateRegistry) HeadBatchSeq() *big.Int {
+	return r.batches[len(r.batches)-1].SeqNo()
+}
+
+func (r *testStateRegistry) GetBatchState(_ context.Context, blockNumberOrHash gethrpc.BlockNumberOrHash) (*state.StateDB, error) {
+	for _, b := range r.batches {
+		if b.Hash() == *blockNumberOrHash.BlockHash {
+			return state.New(b.Header.Root, r.chain.stateCache, nil)
+		}
+	}
+	return nil, errutil.ErrNotFound
+}
+
+// testReplayExecutor - re-executes a batch on top of the state of its parent and checks the resulting state root
+type testReplayExecutor struct {
+	components.BatchExecutor
+	chain   *testStateChain
+	batches []*core.Batch
+}
+
+func (e *testReplayExecutor) ExecuteBatch(_ context.Context, batch *core.Batch) ([]*core.TxExecResult, error) {
+	parent := e.batches[batch.NumberU64()-1]
+	computed, err := e.chain.execute(parent, int(batch.NumberU64()))
+	if err != nil {
+		return nil, err
+	}
+	if computed.Header.Root != batch.Header.Root {
+		return nil, fmt.Errorf("unexpected state root for batch %d", batch.NumberU64())
+	}
+	e.chain.replayed = append(e.chain.replayed, batch.NumberU64())
+	return nil, nil
+}
@@ -80,15 +80,13 @@ func DebugLogsExecute(builder *CallBuilder[filters.FilterCriteria, []*common.Deb
 	}
 
 	// the FilterCriteria must have a single contract address and only the topics on the position 0 ( event signatures)
-	// the caller must be the contract deployer
+	// the caller must be the contract deployer, or a viewing key it delegated to
 	if len(filter.Addresses) != 1 {
 		builder.Err = fmt.Errorf("invalid debug filter. you must specify a single contract address")
 		return nil
 	}
 	contractAddress := filter.Addresses[0]
 
-	caller := builder.VK.AccountAddress
-
 	contract, err := rpc.storage.ReadContract(builder.ctx, contractAddress)
 	if err != nil {
 		if errors.Is(err, errutil.ErrNotFound) {
@@ -98,7 +96,7 @@ func DebugLogsExecute(builder *CallBuilder[filters.FilterCriteria, []*common.Deb
 		return err
 	}
 
-	if contract.Creator != *caller {
+	if !builder.VK.CanRead(contract.Creator) {
 		builder.Err = fmt.Errorf("invalid debug call. only the contract deployer can invoke the endpoint")
 		return nil
 	}
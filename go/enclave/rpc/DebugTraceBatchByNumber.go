@@ -0,0 +1,87 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	gethrpc "github.com/ten-protocol/go-ten/lib/gethfork/rpc"
+)
+
+type TraceBatchParams struct {
+	block  *gethrpc.BlockNumber
+	config *tracers.TraceConfig
+}
+
+func DebugTraceBatchByNumberValidate(reqParams []any, builder *CallBuilder[TraceBatchParams, []*tracers.TxTraceResult], rpc *EncryptionManager) error {
+	if !rpc.config.DebugNamespaceEnabled {
+		builder.Err = fmt.Errorf("debug namespace not enabled")
+		return nil
+	}
+	// Parameters are [BlockNumber, TraceConfig]
+	if len(reqParams) < 1 || len(reqParams) > 2 {
+		builder.Err = fmt.Errorf("unexpected number of parameters")
+		return nil
+	}
+	blkNumber, err := gethencoding.ExtractBlockNumber(reqParams[0])
+	if err != nil || blkNumber.BlockNumber == nil {
+		builder.Err = fmt.Errorf("unable to extract requested batch number")
+		return nil
+	}
+
+	config, err := extractTraceConfig[tracers.TraceConfig](reqParams, 1)
+	if err != nil {
+		builder.Err = err
+		return nil
+	}
+
+	builder.Param = &TraceBatchParams{block: blkNumber.BlockNumber, config: config}
+	return nil
+}
+
+func DebugTraceBatchByNumberExecute(builder *CallBuilder[TraceBatchParams, []*tracers.TxTraceResult], rpc *EncryptionManager) error {
+	batch, err := rpc.registry.GetBatchAtHeight(builder.ctx, *builder.Param.block)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			builder.Status = NotFound
+			return nil
+		}
+		return err
+	}
+
+	// authorise - only the transactions signed by the accounts readable by the requester are traced
+	visible := make(map[common.TxHash]bool)
+	for _, tx := range batch.Transactions {
+		sender, err := core.GetExternalTxSigner(tx)
+		if err != nil {
+			return fmt.Errorf("could not recover the tx %s sender. Cause: %w", tx.Hash(), err)
+		}
+		if builder.VK.CanRead(sender) {
+			visible[tx.Hash()] = true
+		}
+	}
+	// the requester is not a party to this batch
+	if len(visible) == 0 {
+		builder.Status = NotAuthorised
+		return nil
+	}
+
+	traces, err := rpc.debugger.DebugTraceBatch(builder.ctx, batch, builder.Param.config, func(tx *common.L2Tx) bool {
+		return visible[tx.Hash()]
+	}, builder.VK.CanRead)
+	if err != nil {
+		if errors.Is(err, syserr.InternalError{}) {
+			return err
+		}
+		builder.Err = fmt.Errorf("could not trace batch %d. Cause: %w", batch.NumberU64(), err)
+		return nil
+	}
+
+	builder.ReturnValue = &traces
+	return nil
+}
@@ -0,0 +1,83 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ten-protocol/go-ten/go/common/gethapi"
+	"github.com/ten-protocol/go-ten/go/common/gethencoding"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+	gethrpc "github.com/ten-protocol/go-ten/lib/gethfork/rpc"
+)
+
+type TraceCallParams struct {
+	callParams *gethapi.TransactionArgs
+	block      *gethrpc.BlockNumber
+	config     *tracers.TraceCallConfig
+}
+
+func DebugTraceCallValidate(reqParams []any, builder *CallBuilder[TraceCallParams, json.RawMessage], rpc *EncryptionManager) error {
+	if !rpc.config.DebugNamespaceEnabled {
+		builder.Err = fmt.Errorf("debug namespace not enabled")
+		return nil
+	}
+	// Parameters are [TransactionArgs, BlockNumber, TraceCallConfig]
+	if len(reqParams) < 2 || len(reqParams) > 3 {
+		builder.Err = fmt.Errorf("unexpected number of parameters")
+		return nil
+	}
+	apiArgs, err := gethencoding.ExtractEthCall(reqParams[0])
+	if err != nil {
+		builder.Err = fmt.Errorf("unable to decode EthCall Params - %w", err)
+		return nil
+	}
+
+	if apiArgs.From == nil {
+		builder.Err = fmt.Errorf("no from address provided")
+		return nil
+	}
+
+	blkNumber, err := gethencoding.ExtractBlockNumber(reqParams[1])
+	if err != nil {
+		builder.Err = fmt.Errorf("unable to extract requested block number - %w", err)
+		return nil
+	}
+	// todo - support BlockNumberOrHash
+	if blkNumber.BlockNumber == nil {
+		builder.Err = fmt.Errorf("only block numbers are supported")
+		return nil
+	}
+
+	config, err := extractTraceConfig[tracers.TraceCallConfig](reqParams, 2)
+	if err != nil {
+		builder.Err = err
+		return nil
+	}
+
+	builder.From = apiArgs.From
+	builder.Param = &TraceCallParams{callParams: apiArgs, block: blkNumber.BlockNumber, config: config}
+	return nil
+}
+
+func DebugTraceCallExecute(builder *CallBuilder[TraceCallParams, json.RawMessage], rpc *EncryptionManager) error {
+	// the call is executed on behalf of the "from", which must be the owner of the viewing key
+	err := authenticateFrom(builder.VK, builder.From)
+	if err != nil {
+		builder.Err = err
+		return nil //nolint:nilerr
+	}
+
+	trace, err := rpc.debugger.DebugTraceCall(builder.ctx, builder.Param.callParams, builder.Param.block, builder.Param.config, builder.VK.CanRead)
+	if err != nil {
+		if errors.Is(err, syserr.InternalError{}) {
+			return err
+		}
+		builder.Err = fmt.Errorf("could not trace call. Cause: %w", err)
+		return nil
+	}
+
+	builder.ReturnValue = &trace
+	return nil
+}
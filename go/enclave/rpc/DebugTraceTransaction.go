@@ -0,0 +1,99 @@
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/syserr"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type TraceTransactionParams struct {
+	txHash gethcommon.Hash
+	config *tracers.TraceConfig
+}
+
+func DebugTraceTransactionValidate(reqParams []any, builder *CallBuilder[TraceTransactionParams, json.RawMessage], rpc *EncryptionManager) error {
+	if !rpc.config.DebugNamespaceEnabled {
+		builder.Err = fmt.Errorf("debug namespace not enabled")
+		return nil
+	}
+	if !storeTxEnabled(rpc, builder) {
+		return nil
+	}
+	// Parameters are [Hash, TraceConfig]
+	if len(reqParams) < 1 || len(reqParams) > 2 {
+		builder.Err = fmt.Errorf("unexpected number of parameters")
+		return nil
+	}
+	txHashStr, ok := reqParams[0].(string)
+	if !ok {
+		builder.Err = fmt.Errorf("invalid transaction hash")
+		return nil
+	}
+
+	config, err := extractTraceConfig[tracers.TraceConfig](reqParams, 1)
+	if err != nil {
+		builder.Err = err
+		return nil
+	}
+
+	builder.Param = &TraceTransactionParams{txHash: gethcommon.HexToHash(txHashStr), config: config}
+	return nil
+}
+
+func DebugTraceTransactionExecute(builder *CallBuilder[TraceTransactionParams, json.RawMessage], rpc *EncryptionManager) error {
+	txHash := builder.Param.txHash
+	tx, _, _, _, err := rpc.storage.GetTransaction(builder.ctx, txHash)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			builder.Status = NotFound
+			return nil
+		}
+		return err
+	}
+
+	sender, err := core.GetExternalTxSigner(tx)
+	if err != nil {
+		return fmt.Errorf("could not recover the tx %s sender. Cause: %w", tx.Hash(), err)
+	}
+
+	// authorise - only the signer, or the viewing keys it delegated to, can trace the transaction
+	if !builder.VK.CanRead(sender) {
+		builder.Status = NotAuthorised
+		return nil
+	}
+
+	trace, err := rpc.debugger.DebugTraceTransaction(builder.ctx, txHash, builder.Param.config, builder.VK.CanRead)
+	if err != nil {
+		if errors.Is(err, syserr.InternalError{}) {
+			return err
+		}
+		builder.Err = fmt.Errorf("could not trace transaction %s. Cause: %w", txHash, err)
+		return nil
+	}
+
+	builder.ReturnValue = &trace
+	return nil
+}
+
+// extractTraceConfig - the trace config is optional and is sent as a json object
+func extractTraceConfig[C tracers.TraceConfig | tracers.TraceCallConfig](reqParams []any, idx int) (*C, error) {
+	if len(reqParams) <= idx || reqParams[idx] == nil {
+		return nil, nil //nolint:nilnil
+	}
+	serialised, err := json.Marshal(reqParams[idx])
+	if err != nil {
+		return nil, fmt.Errorf("invalid trace config: %w", err)
+	}
+	var config C
+	if err := json.Unmarshal(serialised, &config); err != nil {
+		return nil, fmt.Errorf("invalid trace config: %w", err)
+	}
+	return &config, nil
+}
@@ -85,7 +85,7 @@ func GetLogsExecute(builder *CallBuilder[filters.FilterCriteria, []*types.Log],
 	}
 
 	// We retrieve the relevant logs that match the filter.
-	filteredLogs, err := rpc.storage.FilterLogs(builder.ctx, builder.VK.AccountAddress, from, to, nil, filter.Addresses, filter.Topics)
+	filteredLogs, err := rpc.logFilter.FilterLogs(builder.ctx, builder.VK.ReadableAccounts(), from, to, nil, filter.Addresses, filter.Topics)
 	if err != nil {
 		if errors.Is(err, syserr.InternalError{}) {
 			return err
@@ -0,0 +1,80 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/enclave/vkhandler"
+)
+
+// errNoMempool - returned by the nodes which forward the transactions to the sequencer instead of holding them
+var errNoMempool = errors.New("this node does not hold a mempool, the pending transactions are only known to the sequencer")
+
+// PoolTransactions - the transactions of an account waiting in the mempool, indexed by nonce (same format as geth's txpool_contentFrom)
+type PoolTransactions struct {
+	Pending map[string]*RpcTransaction `json:"pending"` // can be included in the next batch
+	Queued  map[string]*RpcTransaction `json:"queued"`  // can't be included yet, e.g. because of a nonce gap
+}
+
+func GetPoolTransactionsValidate(reqParams []any, builder *CallBuilder[gethcommon.Address, PoolTransactions], _ *EncryptionManager) error {
+	// Parameters are [Address]
+	if len(reqParams) != 1 {
+		builder.Err = fmt.Errorf("unexpected number of parameters (expected %d, got %d)", 1, len(reqParams))
+		return nil
+	}
+	addressStr, ok := reqParams[0].(string)
+	if !ok {
+		builder.Err = fmt.Errorf("unexpected address parameter")
+		return nil
+	}
+
+	address := gethcommon.HexToAddress(addressStr)
+	builder.From = &address
+	builder.Param = &address
+	return nil
+}
+
+// GetPoolTransactionsExecute - only the sender can see the transactions waiting in the mempool
+func GetPoolTransactionsExecute(builder *CallBuilder[gethcommon.Address, PoolTransactions], rpc *EncryptionManager) error {
+	builder.ReturnValue, builder.Err = readPoolTransactions(builder.VK, builder.From, rpc.mempool)
+	return nil
+}
+
+// poolContent - the part of the mempool which holds the transactions of each account
+type poolContent interface {
+	IsValidateOnly() bool
+	ContentFrom(addr gethcommon.Address) ([]*types.Transaction, []*types.Transaction)
+}
+
+func readPoolTransactions(vk *vkhandler.AuthenticatedViewingKey, from *gethcommon.Address, pool poolContent) (*PoolTransactions, error) {
+	if err := authenticateFrom(vk, from); err != nil {
+		return nil, err
+	}
+
+	// an empty result would be mistaken for an account without pending transactions
+	if pool.IsValidateOnly() {
+		return nil, errNoMempool
+	}
+
+	pending, queued := pool.ContentFrom(*from)
+	return &PoolTransactions{
+		Pending: toRPCTransactionsByNonce(pending, *from),
+		Queued:  toRPCTransactionsByNonce(queued, *from),
+	}, nil
+}
+
+func toRPCTransactionsByNonce(txs []*types.Transaction, from gethcommon.Address) map[string]*RpcTransaction {
+	res := make(map[string]*RpcTransaction, len(txs))
+	for _, tx := range txs {
+		res[strconv.FormatUint(tx.Nonce(), 10)] = NewPendingRPCTransaction(tx, from)
+	}
+	return res
+}
+
+// NewPendingRPCTransaction - the RPC representation of a transaction which is not included in a batch yet
+func NewPendingRPCTransaction(tx *types.Transaction, from gethcommon.Address) *RpcTransaction {
+	return newRPCTransaction(tx, gethcommon.Hash{}, 0, 0, nil, from)
+}
@@ -0,0 +1,97 @@
+package rpc
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common/viewingkey"
+	"github.com/ten-protocol/go-ten/go/enclave/vkhandler"
+	"github.com/ten-protocol/go-ten/go/wallet"
+)
+
+const testChainID = 443
+
+// testPool - a mempool holding the pending and the queued transactions of several accounts
+type testPool struct {
+	validateOnly bool
+	pending      map[gethcommon.Address][]*types.Transaction
+	queued       map[gethcommon.Address][]*types.Transaction
+}
+
+func (p *testPool) IsValidateOnly() bool {
+	return p.validateOnly
+}
+
+func (p *testPool) ContentFrom(addr gethcommon.Address) ([]*types.Transaction, []*types.Transaction) {
+	return p.pending[addr], p.queued[addr]
+}
+
+func newTestVK(t *testing.T) (*vkhandler.AuthenticatedViewingKey, gethcommon.Address) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return newTestVKForKey(t, key)
+}
+
+func newTestVKForKey(t *testing.T, key *ecdsa.PrivateKey) (*vkhandler.AuthenticatedViewingKey, gethcommon.Address) {
+	vk, err := viewingkey.GenerateViewingKeyForWallet(wallet.NewInMemoryWalletFromPK(big.NewInt(testChainID), key, gethlog.New()))
+	require.NoError(t, err)
+	authVK, err := vkhandler.VerifyViewingKey(&viewingkey.RPCSignedViewingKey{
+		PublicKey:               vk.PublicKey,
+		SignatureWithAccountKey: vk.SignatureWithAccountKey,
+		SignatureType:           vk.SignatureType,
+	}, testChainID)
+	require.NoError(t, err)
+	return authVK, *vk.Account
+}
+
+func newPoolTxs(nonces ...uint64) []*types.Transaction {
+	txs := make([]*types.Transaction, len(nonces))
+	for i, nonce := range nonces {
+		txs[i] = types.NewTx(&types.LegacyTx{Nonce: nonce, GasPrice: big.NewInt(1)})
+	}
+	return txs
+}
+
+func TestReadPoolTransactionsOnlyReturnsTheTxsOfTheViewingKeyHolder(t *testing.T) {
+	aliceVK, alice := newTestVK(t)
+	_, bob := newTestVK(t)
+	pool := &testPool{
+		pending: map[gethcommon.Address][]*types.Transaction{alice: newPoolTxs(0, 1), bob: newPoolTxs(0)},
+		queued:  map[gethcommon.Address][]*types.Transaction{alice: newPoolTxs(5), bob: newPoolTxs(3)},
+	}
+
+	res, err := readPoolTransactions(aliceVK, &alice, pool)
+	require.NoError(t, err)
+	require.Len(t, res.Pending, 2)
+	require.Len(t, res.Queued, 1)
+	require.Equal(t, pool.pending[alice][1].Hash(), res.Pending["1"].Hash)
+	require.Equal(t, pool.queued[alice][0].Hash(), res.Queued["5"].Hash)
+	for _, tx := range append(toList(res.Pending), toList(res.Queued)...) {
+		require.Equal(t, alice, tx.From)
+	}
+
+	// the transactions of other accounts can't be read with the viewing key
+	_, err = readPoolTransactions(aliceVK, &bob, pool)
+	require.Error(t, err)
+	_, err = readPoolTransactions(aliceVK, nil, pool)
+	require.Error(t, err)
+
+	// the nodes which forward the transactions to the sequencer don't report an empty pool
+	pool.validateOnly = true
+	_, err = readPoolTransactions(aliceVK, &alice, pool)
+	require.ErrorIs(t, err, errNoMempool)
+}
+
+func toList(txs map[string]*RpcTransaction) []*RpcTransaction {
+	res := make([]*RpcTransaction, 0, len(txs))
+	for _, tx := range txs {
+		res = append(res, tx)
+	}
+	return res
+}
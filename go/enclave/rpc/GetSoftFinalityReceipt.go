@@ -0,0 +1,121 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtxpool "github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+	"github.com/ten-protocol/go-ten/go/enclave/vkhandler"
+)
+
+// softFinalityBatchWindow - the number of batches after the current head within which the sequencer aims to include an
+// executable transaction. The margin covers the batches that are already being produced or that are full.
+const softFinalityBatchWindow = 3
+
+// errNotSequencer - only the active sequencer holds the executable transactions, and its enclave ID is the one the
+// clients check the receipts against
+var errNotSequencer = errors.New("only the active sequencer issues soft-finality receipts")
+
+func GetSoftFinalityReceiptValidate(reqParams []any, builder *CallBuilder[gethcommon.Hash, common.SoftFinalityReceipt], _ *EncryptionManager) error {
+	// Parameters are [Hash]
+	if len(reqParams) != 1 {
+		builder.Err = fmt.Errorf("unexpected number of parameters (expected %d, got %d)", 1, len(reqParams))
+		return nil
+	}
+	txHashStr, ok := reqParams[0].(string)
+	if !ok {
+		builder.Err = fmt.Errorf("unexpected tx hash parameter")
+		return nil
+	}
+	txHash := gethcommon.HexToHash(txHashStr)
+	builder.Param = &txHash
+	return nil
+}
+
+// GetSoftFinalityReceiptExecute - only the sender of the transaction, or the viewing keys it delegated to, can request
+// a receipt, because the receipt reveals whether the transaction is in the mempool of the sequencer
+func GetSoftFinalityReceiptExecute(builder *CallBuilder[gethcommon.Hash, common.SoftFinalityReceipt], rpc *EncryptionManager) error {
+	txHash := *builder.Param
+	if rpc.mempool.IsValidateOnly() {
+		builder.Err = errNotSequencer
+		return nil
+	}
+	head := rpc.registry.HeadBatchSeq()
+	if head == nil {
+		builder.Err = fmt.Errorf("not initialised yet")
+		return nil
+	}
+
+	batchSeqNo, err := softFinalityTarget(builder.ctx, rpc.storage, rpc.mempool, head.Uint64(), txHash, builder.VK)
+	if errors.Is(err, errutil.ErrNotFound) {
+		builder.Status = NotFound
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not issue soft-finality receipt for tx %s. Cause: %w", txHash, err)
+	}
+
+	receipt := &common.SoftFinalityReceipt{
+		TxHash:     txHash,
+		BatchSeqNo: batchSeqNo,
+		EnclaveID:  rpc.enclaveKeyService.EnclaveID(),
+	}
+	receipt.Signature, err = rpc.enclaveKeyService.Sign(receipt.Hash())
+	if err != nil {
+		return fmt.Errorf("could not sign soft-finality receipt. Cause: %w", err)
+	}
+	builder.ReturnValue = receipt
+	return nil
+}
+
+// pendingPool - the part of the mempool which tells whether a transaction is executable
+type pendingPool interface {
+	Get(hash gethcommon.Hash) *types.Transaction
+	Status(hash gethcommon.Hash) gethtxpool.TxStatus
+}
+
+// softFinalityTarget - returns the sequence number of the batch that already includes the transaction, or the batch by
+// which the sequencer aims to include it if it is executable.
+// Returns errutil.ErrNotFound if the transaction is neither included nor executable, or if the viewing key can't read
+// the sender of the transaction, so the response doesn't tell other accounts whether the transaction is in the mempool.
+func softFinalityTarget(ctx context.Context, s storage.Storage, pool pendingPool, head uint64, txHash common.L2TxHash, vk *vkhandler.AuthenticatedViewingKey) (uint64, error) {
+	tx, batchHash, _, _, err := s.GetTransaction(ctx, txHash)
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return 0, fmt.Errorf("could not read transaction. Cause: %w", err)
+	}
+	included := err == nil
+	if !included {
+		tx = pool.Get(txHash)
+		if tx == nil {
+			return 0, errutil.ErrNotFound
+		}
+	}
+
+	sender, err := core.GetExternalTxSigner(tx)
+	if err != nil {
+		return 0, fmt.Errorf("could not recover the tx sender. Cause: %w", err)
+	}
+	if !vk.CanRead(sender) {
+		return 0, errutil.ErrNotFound
+	}
+
+	if included {
+		batchHeader, err := s.FetchBatchHeader(ctx, batchHash)
+		if err != nil {
+			return 0, fmt.Errorf("could not fetch batch %s. Cause: %w", batchHash, err)
+		}
+		return batchHeader.SequencerOrderNo.Uint64(), nil
+	}
+	// queued transactions are not given a target, because their inclusion depends on other transactions being submitted
+	if pool.Status(txHash) != gethtxpool.TxStatusPending {
+		return 0, errutil.ErrNotFound
+	}
+	return head + softFinalityBatchWindow, nil
+}
@@ -0,0 +1,97 @@
+package rpc
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethtxpool "github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/storage"
+)
+
+// includedTxStorage - stores the transactions included in batches, the other storage methods are not used by the tests
+type includedTxStorage struct {
+	storage.Storage
+	txs     map[gethcommon.Hash]*types.Transaction
+	batches map[gethcommon.Hash]*common.BatchHeader
+}
+
+func (s *includedTxStorage) GetTransaction(_ context.Context, txHash common.L2TxHash) (*types.Transaction, common.L2BatchHash, uint64, uint64, error) {
+	tx, found := s.txs[txHash]
+	if !found {
+		return nil, gethcommon.Hash{}, 0, 0, errutil.ErrNotFound
+	}
+	for hash := range s.batches {
+		return tx, hash, 0, 0, nil
+	}
+	return nil, gethcommon.Hash{}, 0, 0, errutil.ErrNotFound
+}
+
+func (s *includedTxStorage) FetchBatchHeader(_ context.Context, hash common.L2BatchHash) (*common.BatchHeader, error) {
+	return s.batches[hash], nil
+}
+
+// statusPool - a mempool holding transactions with their status
+type statusPool struct {
+	txs    map[gethcommon.Hash]*types.Transaction
+	status map[gethcommon.Hash]gethtxpool.TxStatus
+}
+
+func (p *statusPool) Get(hash gethcommon.Hash) *types.Transaction {
+	return p.txs[hash]
+}
+
+func (p *statusPool) Status(hash gethcommon.Hash) gethtxpool.TxStatus {
+	return p.status[hash]
+}
+
+func TestSoftFinalityTargetRequiresTheSenderViewingKey(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	aliceVK, _ := newTestVKForKey(t, key)
+	bobVK, _ := newTestVK(t)
+	signer := types.LatestSignerForChainID(big.NewInt(testChainID))
+	newTx := func(nonce uint64) *types.Transaction {
+		tx, err := types.SignNewTx(key, signer, &types.LegacyTx{Nonce: nonce, GasPrice: big.NewInt(1)})
+		require.NoError(t, err)
+		return tx
+	}
+	included, pending, queued := newTx(0), newTx(1), newTx(3)
+
+	s := &includedTxStorage{
+		txs:     map[gethcommon.Hash]*types.Transaction{included.Hash(): included},
+		batches: map[gethcommon.Hash]*common.BatchHeader{{1}: {SequencerOrderNo: big.NewInt(7)}},
+	}
+	pool := &statusPool{
+		txs: map[gethcommon.Hash]*types.Transaction{pending.Hash(): pending, queued.Hash(): queued},
+		status: map[gethcommon.Hash]gethtxpool.TxStatus{
+			pending.Hash(): gethtxpool.TxStatusPending,
+			queued.Hash():  gethtxpool.TxStatusQueued,
+		},
+	}
+	const head = 10
+
+	seqNo, err := softFinalityTarget(context.Background(), s, pool, head, included.Hash(), aliceVK)
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), seqNo)
+
+	seqNo, err = softFinalityTarget(context.Background(), s, pool, head, pending.Hash(), aliceVK)
+	require.NoError(t, err)
+	require.Equal(t, uint64(head+softFinalityBatchWindow), seqNo)
+
+	// the queued transactions are not given a target
+	_, err = softFinalityTarget(context.Background(), s, pool, head, queued.Hash(), aliceVK)
+	require.ErrorIs(t, err, errutil.ErrNotFound)
+
+	// other accounts can't tell whether the transactions are known
+	for _, tx := range []*types.Transaction{included, pending, newTx(2)} {
+		_, err = softFinalityTarget(context.Background(), s, pool, head, tx.Hash(), bobVK)
+		require.ErrorIs(t, err, errutil.ErrNotFound)
+	}
+}
@@ -49,8 +49,8 @@ func GetTransactionExecute(builder *CallBuilder[gethcommon.Hash, RpcTransaction]
 		return fmt.Errorf("could not recover the tx %s sender. Cause: %w", tx.Hash(), err)
 	}
 
-	// authorise - only the signer can request the transaction
-	if sender.Hex() != builder.VK.AccountAddress.Hex() {
+	// authorise - only the signer, or the delegates of the signer, can request the transaction
+	if !builder.VK.CanRead(sender) {
 		builder.Status = NotAuthorised
 		// builder.ReturnValue= []byte{}
 		return nil
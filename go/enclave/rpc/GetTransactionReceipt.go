@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/ten-protocol/go-ten/go/enclave/events"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 	"github.com/ten-protocol/go-ten/go/enclave/storage/enclavedb"
+	"github.com/ten-protocol/go-ten/go/enclave/vkhandler"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -38,11 +41,14 @@ func GetTransactionReceiptValidate(reqParams []any, builder *CallBuilder[gethcom
 
 func GetTransactionReceiptExecute(builder *CallBuilder[gethcommon.Hash, map[string]interface{}], rpc *EncryptionManager) error {
 	txHash := *builder.Param
-	requester := builder.VK.AccountAddress
-	rpc.logger.Trace("Get receipt for ", log.TxKey, txHash, "requester", requester.Hex())
+	rpc.logger.Trace("Get receipt for ", log.TxKey, txHash, "requester", builder.VK.AccountAddress.Hex())
 
 	// first try the cache for recent transactions
-	result, err := fetchFromCache(builder.ctx, rpc.storage, rpc.cacheService, txHash, requester)
+	result, err := fetchFromCache(builder.ctx, rpc.storage, rpc.cacheService, rpc.logFilter, txHash, builder.VK)
+	if errors.Is(err, events.ErrTooManyCanViewCalls) {
+		builder.Err = err
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -63,7 +69,7 @@ func GetTransactionReceiptExecute(builder *CallBuilder[gethcommon.Hash, map[stri
 	}
 
 	// We retrieve the transaction receipt.
-	receipt, err := rpc.storage.GetFilteredInternalReceipt(builder.ctx, txHash, requester, false)
+	receipt, err := rpc.storage.GetFilteredInternalReceipt(builder.ctx, txHash, builder.VK.ReadableAccounts(), false)
 	if err != nil {
 		rpc.logger.Trace("error getting tx receipt", log.TxKey, txHash, log.ErrKey, err)
 		if errors.Is(err, errutil.ErrNotFound) {
@@ -73,6 +79,15 @@ func GetTransactionReceiptExecute(builder *CallBuilder[gethcommon.Hash, map[stri
 		// this is a system error
 		return fmt.Errorf("could not retrieve transaction receipt in eth_getTransactionReceipt request. Cause: %w", err)
 	}
+	// the visibility of some logs is decided by the contracts
+	receipt.Logs, err = rpc.logFilter.FilterReceiptLogs(builder.ctx, builder.VK.ReadableAccounts(), receipt.BlockHash, receipt.TxHash, receipt.Logs)
+	if errors.Is(err, events.ErrTooManyCanViewCalls) {
+		builder.Err = err
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not filter the logs in eth_getTransactionReceipt request. Cause: %w", err)
+	}
 
 	rpc.logger.Trace("Successfully retrieved receipt for ", log.TxKey, txHash, "rec", receipt)
 	r := receipt.MarshalToJson()
@@ -80,16 +95,15 @@ func GetTransactionReceiptExecute(builder *CallBuilder[gethcommon.Hash, map[stri
 	return nil
 }
 
-func fetchFromCache(ctx context.Context, storage storage.Storage, cacheService *storage.CacheService, txHash gethcommon.Hash, requester *gethcommon.Address) (map[string]interface{}, error) {
+func fetchFromCache(ctx context.Context, storage storage.Storage, cacheService *storage.CacheService, logFilter *events.LogFilter, txHash gethcommon.Hash, vk *vkhandler.AuthenticatedViewingKey) (map[string]interface{}, error) {
 	rec, _ := cacheService.ReadReceipt(ctx, txHash)
 	if rec == nil {
 		return nil, nil
 	}
 
 	// receipt found in cache
-	// for simplicity only the tx sender will access the cache
-	// check whether the requester is the sender
-	if *rec.From != *requester {
+	// for simplicity only the tx sender, or the viewing keys it delegated to, will access the cache
+	if !vk.CanRead(*rec.From) {
 		return nil, nil
 	}
 
@@ -103,7 +117,10 @@ func fetchFromCache(ctx context.Context, storage storage.Storage, cacheService *
 		}
 		// only filter when the transaction calls a contract. Value transfers emit no events.
 		if ctr != nil {
-			logs, err = filterLogs(ctx, storage, rec.Receipt.Logs, ctr, requester)
+			logs, err = filterLogs(ctx, storage, logFilter, rec.Receipt.Logs, ctr, vk.ReadableAccounts())
+			if errors.Is(err, events.ErrTooManyCanViewCalls) {
+				return nil, err
+			}
 			if err != nil && !errors.Is(err, errutil.ErrNotFound) {
 				return nil, fmt.Errorf("could not filter cached logs in eth_getTransactionReceipt request. Cause: %w", err)
 			}
@@ -117,46 +134,63 @@ func fetchFromCache(ctx context.Context, storage storage.Storage, cacheService *
 	return r, nil
 }
 
-func filterLogs(ctx context.Context, storage storage.Storage, logs []*types.Log, ctr *enclavedb.Contract, requester *gethcommon.Address) ([]*types.Log, error) {
+func filterLogs(ctx context.Context, storage storage.Storage, logFilter *events.LogFilter, logs []*types.Log, ctr *enclavedb.Contract, requesters []*gethcommon.Address) ([]*types.Log, error) {
 	filtered := make([]*types.Log, 0)
+	// the logs whose visibility is decided by the `canView` function of the contract
+	candidates := make([]*types.Log, 0)
 	for _, l := range logs {
-		canView, err := senderCanViewLog(ctx, storage, ctr, l, requester)
-		if err != nil {
+		eventType, err := storage.ReadEventType(ctx, ctr.Address, l.Topics[0])
+		if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+			return nil, fmt.Errorf("could not read event type in eth_getTransactionReceipt request. Cause: %w", err)
+		}
+		if errors.Is(err, errutil.ErrNotFound) {
 			return nil, err
 		}
-		if canView {
+		switch {
+		case senderCanViewLog(eventType, l, requesters):
 			filtered = append(filtered, l)
+		case eventType.ContractCanView != nil && *eventType.ContractCanView:
+			candidates = append(candidates, l)
 		}
 	}
+	if len(candidates) == 0 {
+		return filtered, nil
+	}
+
+	contractVisible, err := logFilter.FilterContractVisibleLogs(ctx, requesters, candidates)
+	if err != nil {
+		return nil, err
+	}
+	filtered = append(filtered, contractVisible...)
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].Index < filtered[j].Index
+	})
 	return filtered, nil
 }
 
-func senderCanViewLog(ctx context.Context, storage storage.Storage, ctr *enclavedb.Contract, l *types.Log, sender *gethcommon.Address) (bool, error) {
-	eventSig := l.Topics[0]
-	eventType, err := storage.ReadEventType(ctx, ctr.Address, eventSig)
-	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
-		return false, fmt.Errorf("could not read event type in eth_getTransactionReceipt request. Cause: %w", err)
-	}
-	if errors.Is(err, errutil.ErrNotFound) {
-		return false, err
-	}
+func senderCanViewLog(eventType *enclavedb.EventType, l *types.Log, requesters []*gethcommon.Address) bool {
 	// event visibility logic
-	canView := eventType.IsPublic() ||
+	return eventType.IsPublic() ||
 		(eventType.AutoPublic != nil && *eventType.AutoPublic) ||
 		(eventType.SenderCanView != nil && *eventType.SenderCanView) ||
-		(eventType.Topic1CanView != nil && *eventType.Topic1CanView && isAddress(l.Topics, 1, sender)) ||
-		(eventType.Topic2CanView != nil && *eventType.Topic2CanView && isAddress(l.Topics, 2, sender)) ||
-		(eventType.Topic3CanView != nil && *eventType.Topic3CanView && isAddress(l.Topics, 3, sender)) ||
-		(eventType.AutoVisibility && (isAddress(l.Topics, 1, sender) || isAddress(l.Topics, 2, sender) || isAddress(l.Topics, 3, sender)))
-	return canView, nil
+		(eventType.Topic1CanView != nil && *eventType.Topic1CanView && isAddress(l.Topics, 1, requesters)) ||
+		(eventType.Topic2CanView != nil && *eventType.Topic2CanView && isAddress(l.Topics, 2, requesters)) ||
+		(eventType.Topic3CanView != nil && *eventType.Topic3CanView && isAddress(l.Topics, 3, requesters)) ||
+		(eventType.AutoVisibility && (isAddress(l.Topics, 1, requesters) || isAddress(l.Topics, 2, requesters) || isAddress(l.Topics, 3, requesters)))
 }
 
-func isAddress(topics []gethcommon.Hash, nr int, requester *gethcommon.Address) bool {
+// isAddress - true if the topic is the address of one of the requesters
+func isAddress(topics []gethcommon.Hash, nr int, requesters []*gethcommon.Address) bool {
 	if len(topics) < nr+1 {
 		return false
 	}
 	addressFromTopic := gethcommon.BytesToAddress(topics[nr].Bytes())
-	return addressFromTopic == *requester
+	for _, requester := range requesters {
+		if addressFromTopic == *requester {
+			return true
+		}
+	}
+	return false
 }
 
 // marshalReceipt marshals a transaction receipt into a JSON object.
@@ -0,0 +1,46 @@
+package rpc
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ten-protocol/go-ten/go/common/viewingkey"
+)
+
+func RevokeDelegationValidate(reqParams []any, builder *CallBuilder[[]byte, bool], _ *EncryptionManager) error {
+	// Parameters are [Delegate], the user id of the delegated viewing key
+	if len(reqParams) != 1 {
+		builder.Err = fmt.Errorf("unexpected number of parameters (expected %d, got %d)", 1, len(reqParams))
+		return nil
+	}
+	delegateStr, ok := reqParams[0].(string)
+	if !ok {
+		builder.Err = fmt.Errorf("unexpected delegate parameter")
+		return nil
+	}
+	delegate, err := hexutil.Decode(delegateStr)
+	if err != nil || len(delegate) != viewingkey.UserIDLength {
+		builder.Err = fmt.Errorf("invalid delegate %s", delegateStr)
+		return nil
+	}
+
+	builder.Param = &delegate
+	return nil
+}
+
+// RevokeDelegationExecute - revokes the delegations signed by the account of the viewing key to the delegate. The
+// delegates can't revoke the delegations, because a delegation doesn't change the account of a viewing key.
+// The revocation is recorded by the enclave that receives the request.
+func RevokeDelegationExecute(builder *CallBuilder[[]byte, bool], rpc *EncryptionManager) error {
+	delegator := *builder.VK.AccountAddress
+	err := rpc.storage.RevokeDelegations(builder.ctx, delegator, *builder.Param, uint64(time.Now().Unix()))
+	if err != nil {
+		return fmt.Errorf("could not revoke delegations of %s. Cause: %w", delegator, err)
+	}
+	rpc.logger.Info("Revoked delegations", "delegator", delegator, "delegate", hexutil.Encode(*builder.Param))
+
+	revoked := true
+	builder.ReturnValue = &revoked
+	return nil
+}
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -8,8 +9,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/gethencoding"
 	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/privacy"
 	"github.com/ten-protocol/go-ten/go/common/syserr"
 	gethrpc "github.com/ten-protocol/go-ten/lib/gethfork/rpc"
 )
@@ -21,7 +24,8 @@ type storageReadWithBlock struct {
 }
 
 func TenStorageReadValidate(reqParams []any, builder *CallBuilder[storageReadWithBlock, string], rpc *EncryptionManager) error {
-	if len(reqParams) < 2 || len(reqParams) > 3 {
+	// the optional 4th parameter is the key of a mapping entry, in which case the slot is the base slot of the mapping
+	if len(reqParams) < 2 || len(reqParams) > 4 {
 		builder.Err = fmt.Errorf("unexpected number of parameters")
 		return nil
 	}
@@ -38,29 +42,86 @@ func TenStorageReadValidate(reqParams []any, builder *CallBuilder[storageReadWit
 		return nil
 	}
 
-	contract, err := rpc.storage.ReadContract(builder.ctx, *address)
-	if err != nil {
-		builder.Err = fmt.Errorf("eth_getStorageAt is not supported for this contract")
-		return nil
+	var mappingKey *common.Hash
+	if len(reqParams) == 4 && reqParams[3] != nil {
+		keyStr, ok := reqParams[3].(string)
+		if !ok {
+			builder.Err = fmt.Errorf("invalid mapping key")
+			return nil
+		}
+		key, err := parseStorageSlot(keyStr)
+		if err != nil {
+			builder.Err = fmt.Errorf("invalid mapping key - %w", err)
+			return nil
+		}
+		mappingKey = &key
 	}
 
-	// block the call for un-transparent contracts and non-whitelisted slots
-	if !rpc.storageSlotWhitelist.AllowedStorageSlots[slot] && !contract.IsTransparent() {
+	readableSlot, err := rpc.DisclosedStorageSlot(builder.ctx, *address, slot, mappingKey)
+	if err != nil {
+		return err
+	}
+	// block the call for un-transparent contracts and slots that are neither whitelisted nor declared public
+	if readableSlot == nil {
 		builder.Err = fmt.Errorf("eth_getStorageAt is not supported for this contract")
 		return nil
 	}
 
-	blkNumber, err := gethencoding.ExtractBlockNumber(reqParams[2])
+	var blockParam any
+	if len(reqParams) > 2 {
+		blockParam = reqParams[2]
+	}
+	blkNumber, err := gethencoding.ExtractBlockNumber(blockParam)
 	if err != nil {
 		builder.Err = fmt.Errorf("unable to extract requested block number - %w", err)
 		return nil
 	}
 
-	builder.Param = &storageReadWithBlock{address, slot, blkNumber}
+	builder.Param = &storageReadWithBlock{address, *readableSlot, blkNumber}
 
 	return nil
 }
 
+// DisclosedStorageSlot - returns the slot that can be read by everyone, or nil if the value is private.
+// Transparent contracts expose all their storage, while private contracts only expose the whitelisted proxy slots and
+// the slots they declared as public. When the mapping key is set, the slot is the base slot of a public mapping, and
+// the slot of the entry is returned.
+// Only system errors are returned.
+func (rpc *EncryptionManager) DisclosedStorageSlot(ctx context.Context, address common.Address, slot string, mappingKey *common.Hash) (*string, error) {
+	contract, err := rpc.storage.ReadContract(ctx, address)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to read contract. Cause: %w", err)
+	}
+
+	if mappingKey == nil && (contract.IsTransparent() || rpc.storageSlotWhitelist.AllowedStorageSlots[slot]) {
+		return &slot, nil
+	}
+
+	slotHash, err := parseStorageSlot(slot)
+	if err != nil {
+		// an invalid slot can't be public
+		return nil, nil //nolint:nilerr
+	}
+	if contract.IsTransparent() {
+		entrySlot := privacy.MappingEntrySlot(slotHash, *mappingKey).Hex()
+		return &entrySlot, nil
+	}
+
+	disclosure, err := rpc.storage.ReadStorageDisclosure(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the public storage slots. Cause: %w", err)
+	}
+	disclosedSlot, ok := disclosure.DisclosedSlot(slotHash, mappingKey)
+	if !ok {
+		return nil, nil
+	}
+	result := disclosedSlot.Hex()
+	return &result, nil
+}
+
 func TenStorageReadExecute(builder *CallBuilder[storageReadWithBlock, string], rpc *EncryptionManager) error {
 	var err error
 	var stateDb *state.StateDB
@@ -70,17 +131,12 @@ func TenStorageReadExecute(builder *CallBuilder[storageReadWithBlock, string], r
 		return nil
 	}
 
-	sl := new(big.Int)
-	sl, ok := sl.SetString(builder.Param.storageSlot, 0)
-	if !ok {
-		builder.Err = fmt.Errorf("unable to parse storage slot (%s)", builder.Param.storageSlot)
+	storageSlot, err := parseStorageSlot(builder.Param.storageSlot)
+	if err != nil {
+		builder.Err = err
 		return nil
 	}
 
-	// the storage slot needs to be 32 bytes padded with 0s
-	storageSlot := common.Hash{}
-	storageSlot.SetBytes(sl.Bytes())
-
 	account, err := stateDb.GetTrie().GetAccount(*builder.Param.address)
 	if err != nil {
 		builder.Err = fmt.Errorf("unable to get acct address - %w", err)
@@ -115,3 +171,12 @@ func TenStorageReadExecute(builder *CallBuilder[storageReadWithBlock, string], r
 	builder.ReturnValue = &encodedResult
 	return nil
 }
+
+// parseStorageSlot - the storage slot needs to be 32 bytes padded with 0s
+func parseStorageSlot(slot string) (common.Hash, error) {
+	sl, ok := new(big.Int).SetString(slot, 0)
+	if !ok || sl.Sign() < 0 || sl.BitLen() > 256 {
+		return common.Hash{}, fmt.Errorf("unable to parse storage slot (%s)", slot)
+	}
+	return common.BigToHash(sl), nil
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+	"github.com/ten-protocol/go-ten/go/enclave/debugger"
+	"github.com/ten-protocol/go-ten/go/enclave/events"
 
 	"github.com/ten-protocol/go-ten/go/common/privacy"
 	enclaveconfig "github.com/ten-protocol/go-ten/go/enclave/config"
@@ -19,7 +21,9 @@ import (
 // EncryptionManager manages the decryption and encryption of enclave comms.
 type EncryptionManager struct {
 	chain                l2chain.ObscuroChain
+	logFilter            *events.LogFilter
 	rpcKeyService        *crypto.RPCKeyService
+	enclaveKeyService    *crypto.EnclaveAttestedKeyService
 	storage              storage.Storage
 	cacheService         *storage.CacheService
 	registry             components.BatchRegistry
@@ -31,23 +35,27 @@ type EncryptionManager struct {
 	config               *enclaveconfig.EnclaveConfig
 	logger               gethlog.Logger
 	storageSlotWhitelist *privacy.Whitelist
+	debugger             *debugger.Debugger
 }
 
-func NewEncryptionManager(storage storage.Storage, cacheService *storage.CacheService, registry components.BatchRegistry, mempool *components.TxPool, processors *crosschain.Processors, config *enclaveconfig.EnclaveConfig, oracle gas.Oracle, blockResolver storage.BlockResolver, l1BlockProcessor components.L1BlockProcessor, chain l2chain.ObscuroChain, rpcKeyService *crypto.RPCKeyService, logger gethlog.Logger) *EncryptionManager {
+func NewEncryptionManager(storage storage.Storage, cacheService *storage.CacheService, registry components.BatchRegistry, mempool *components.TxPool, processors *crosschain.Processors, config *enclaveconfig.EnclaveConfig, oracle gas.Oracle, blockResolver storage.BlockResolver, l1BlockProcessor components.L1BlockProcessor, chain l2chain.ObscuroChain, logFilter *events.LogFilter, rpcKeyService *crypto.RPCKeyService, enclaveKeyService *crypto.EnclaveAttestedKeyService, debugger *debugger.Debugger, logger gethlog.Logger) *EncryptionManager {
 	return &EncryptionManager{
 		storage:              storage,
 		cacheService:         cacheService,
 		registry:             registry,
 		processors:           processors,
 		chain:                chain,
+		logFilter:            logFilter,
 		config:               config,
 		blockResolver:        blockResolver,
 		l1BlockProcessor:     l1BlockProcessor,
 		gasOracle:            oracle,
 		logger:               logger,
 		rpcKeyService:        rpcKeyService,
+		enclaveKeyService:    enclaveKeyService,
 		storageSlotWhitelist: privacy.NewWhitelist(),
 		mempool:              mempool,
+		debugger:             debugger,
 	}
 }
 
@@ -72,6 +72,9 @@ func HandleEncryptedRPC(ctx context.Context,
 	if err != nil {
 		return responses.AsPlaintextError(fmt.Errorf("invalid viewing key - %w", err)), nil
 	}
+	if err := vk.CheckRevocation(ctx, encManager.storage); err != nil {
+		return responses.AsPlaintextError(errInt), responses.ToInternalError(err)
+	}
 
 	// 4. Call the function that knows how to validate the request
 	switch decodedRequest.Method {
@@ -101,8 +104,20 @@ func HandleEncryptedRPC(ctx context.Context,
 		return withVKEncryption(ctx, encManager, decodedRequest, vk, TenStorageReadValidate, TenStorageReadExecute)
 	case rpc.ERPCDebugLogs:
 		return withVKEncryption(ctx, encManager, decodedRequest, vk, DebugLogsValidate, DebugLogsExecute)
+	case rpc.ERPCDebugTraceTransaction:
+		return withVKEncryption(ctx, encManager, decodedRequest, vk, DebugTraceTransactionValidate, DebugTraceTransactionExecute)
+	case rpc.ERPCDebugTraceCall:
+		return withVKEncryption(ctx, encManager, decodedRequest, vk, DebugTraceCallValidate, DebugTraceCallExecute)
+	case rpc.ERPCDebugTraceBatchByNumber:
+		return withVKEncryption(ctx, encManager, decodedRequest, vk, DebugTraceBatchByNumberValidate, DebugTraceBatchByNumberExecute)
 	case rpc.ERPCGetPersonalTransactions:
 		return withVKEncryption(ctx, encManager, decodedRequest, vk, GetPersonalTransactionsValidate, GetPersonalTransactionsExecute)
+	case rpc.ERPCGetPoolTransactions:
+		return withVKEncryption(ctx, encManager, decodedRequest, vk, GetPoolTransactionsValidate, GetPoolTransactionsExecute)
+	case rpc.ERPCRevokeDelegation:
+		return withVKEncryption(ctx, encManager, decodedRequest, vk, RevokeDelegationValidate, RevokeDelegationExecute)
+	case rpc.ERPCGetSoftFinalityReceipt:
+		return withVKEncryption(ctx, encManager, decodedRequest, vk, GetSoftFinalityReceiptValidate, GetSoftFinalityReceiptExecute)
 	default:
 		panic(fmt.Sprintf("unsupported method %s", decodedRequest.Method))
 	}
@@ -154,8 +169,10 @@ func withVKEncryption[P any, R any](
 	return responses.AsEncryptedResponse[R](builder.ReturnValue, vk), nil
 }
 
+// authenticateFrom - the "from" must be the account that signed the viewing key, or an account that delegated
+// read access to it
 func authenticateFrom(vk *vkhandler.AuthenticatedViewingKey, from *gethcommon.Address) error {
-	if from == nil || from.Hex() != vk.AccountAddress.Hex() {
+	if from == nil || !vk.CanRead(*from) {
 		return fmt.Errorf("failed authentication. Account: %s does not match the from: %s", vk.AccountAddress, from)
 	}
 	return nil
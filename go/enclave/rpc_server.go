@@ -15,7 +15,6 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/rpc"
 	"github.com/ten-protocol/go-ten/go/common/rpc/generated"
-	"github.com/ten-protocol/go-ten/go/common/tracers"
 	"google.golang.org/grpc"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -141,6 +140,15 @@ func (s *RPCServer) RPCEncryptionKey(ctx context.Context, _ *generated.RPCEncryp
 	return &generated.RPCEncryptionKeyResponse{RpcPubKey: key}, nil
 }
 
+func (s *RPCServer) EndorseP2PKey(ctx context.Context, request *generated.EndorseP2PKeyRequest) (*generated.EndorseP2PKeyResponse, error) {
+	endorsement, sysError := s.enclave.EndorseP2PKey(ctx, request.P2PPubKey, request.P2PAddress)
+	if sysError != nil {
+		s.logger.Error("Error endorsing the p2p key", log.ErrKey, sysError)
+		return &generated.EndorseP2PKeyResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.EndorseP2PKeyResponse{Endorsement: endorsement}, nil
+}
+
 func (s *RPCServer) SubmitL1Block(ctx context.Context, request *generated.SubmitBlockRequest) (*generated.SubmitBlockResponse, error) {
 	processedData, err := s.decodeProcessedData(request.EncodedProcessedData)
 	if err != nil {
@@ -257,23 +265,6 @@ func (s *RPCServer) CreateBatch(ctx context.Context, r *generated.CreateBatchReq
 	return &generated.CreateBatchResponse{}, sysError
 }
 
-func (s *RPCServer) DebugTraceTransaction(ctx context.Context, req *generated.DebugTraceTransactionRequest) (*generated.DebugTraceTransactionResponse, error) {
-	txHash := gethcommon.BytesToHash(req.TxHash)
-	var config tracers.TraceConfig
-
-	sysError := json.Unmarshal(req.Config, &config)
-	if sysError != nil {
-		s.logger.Error("Error calling debug tx", log.ErrKey, sysError)
-
-		return &generated.DebugTraceTransactionResponse{
-			SystemError: toRPCError(fmt.Errorf("unable to unmarshall config - %w", sysError)),
-		}, nil
-	}
-
-	traceTx, sysError := s.enclave.DebugTraceTransaction(ctx, txHash, &config)
-	return &generated.DebugTraceTransactionResponse{Msg: string(traceTx), SystemError: toRPCError(sysError)}, nil
-}
-
 func (s *RPCServer) GetBatch(ctx context.Context, request *generated.GetBatchRequest) (*generated.GetBatchResponse, error) {
 	batch, err := s.enclave.GetBatch(ctx, gethcommon.BytesToHash(request.KnownHead))
 	if err != nil {
@@ -334,6 +325,50 @@ func (s *RPCServer) ExportCrossChainData(ctx context.Context, request *generated
 	}, nil
 }
 
+func (s *RPCServer) GetFraudReport(ctx context.Context, _ *generated.GetFraudReportRequest) (*generated.GetFraudReportResponse, error) {
+	report, sysError := s.enclave.GetFraudReport(ctx)
+	if sysError != nil {
+		s.logger.Error("Error getting fraud report", log.ErrKey, sysError)
+		return &generated.GetFraudReportResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.GetFraudReportResponse{Report: rpc.ToFraudReportMsg(report)}, nil
+}
+
+func (s *RPCServer) ExportStateSnapshot(request *generated.ExportStateSnapshotRequest, stream generated.EnclaveProto_ExportStateSnapshotServer) error {
+	snapshot, sysError := s.enclave.ExportStateSnapshot(stream.Context(), request.BatchSeqNo, func(chunk []byte) error {
+		return stream.Send(&generated.ExportStateSnapshotResponse{Chunk: chunk})
+	})
+	if sysError != nil {
+		s.logger.Error("Error exporting state snapshot", log.ErrKey, sysError)
+		return stream.Send(&generated.ExportStateSnapshotResponse{SystemError: toRPCError(sysError)})
+	}
+	return stream.Send(&generated.ExportStateSnapshotResponse{Snapshot: rpc.ToStateSnapshotMsg(snapshot)})
+}
+
+func (s *RPCServer) ImportStateSnapshot(stream generated.EnclaveProto_ImportStateSnapshotServer) error {
+	// the snapshot is sent first, followed by its chunks
+	request, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if request.Snapshot == nil {
+		return stream.SendAndClose(&generated.ImportStateSnapshotResponse{SystemError: toRPCError(fmt.Errorf("missing state snapshot"))})
+	}
+	nextChunk := func() ([]byte, error) {
+		request, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return request.Chunk, nil
+	}
+	sysError := s.enclave.ImportStateSnapshot(stream.Context(), rpc.FromStateSnapshotMsg(request.Snapshot), nextChunk)
+	if sysError != nil {
+		s.logger.Error("Error importing state snapshot", log.ErrKey, sysError)
+		return stream.SendAndClose(&generated.ImportStateSnapshotResponse{SystemError: toRPCError(sysError)})
+	}
+	return stream.SendAndClose(&generated.ImportStateSnapshotResponse{})
+}
+
 func (s *RPCServer) GetRollupData(ctx context.Context, request *generated.GetRollupDataRequest) (*generated.GetRollupDataResponse, error) {
 	rollupMetadata, sysError := s.enclave.GetRollupData(ctx, gethcommon.BytesToHash(request.Hash))
 	if sysError != nil {
@@ -349,6 +384,15 @@ func (s *RPCServer) GetRollupData(ctx context.Context, request *generated.GetRol
 	}, nil
 }
 
+func (s *RPCServer) RevealDAKeys(ctx context.Context, request *generated.RevealDAKeysRequest) (*generated.RevealDAKeysResponse, error) {
+	keys, sysError := s.enclave.RevealDAKeys(ctx, request.Period, gethcommon.BytesToHash(request.HostL1Head))
+	if sysError != nil {
+		s.logger.Error("Error revealing DA keys", log.ErrKey, sysError)
+		return &generated.RevealDAKeysResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.RevealDAKeysResponse{Keys: rpc.ToRevealedDAKeyMsgs(keys)}, nil
+}
+
 func (s *RPCServer) StreamL2Updates(_ *generated.StreamL2UpdatesRequest, stream generated.EnclaveProto_StreamL2UpdatesServer) error {
 	batchChan, stop := s.enclave.StreamL2Updates()
 	defer stop()
@@ -377,6 +421,32 @@ func (s *RPCServer) StreamL2Updates(_ *generated.StreamL2UpdatesRequest, stream
 	return nil
 }
 
+func (s *RPCServer) GetBatchTxFees(ctx context.Context, request *generated.GetBatchTxFeesRequest) (*generated.GetBatchTxFeesResponse, error) {
+	fees, sysError := s.enclave.GetBatchTxFees(ctx, request.FromHeight, request.ToHeight, request.RewardPercentiles)
+	if sysError != nil {
+		s.logger.Error("Error reading the fees of the batches", log.ErrKey, sysError)
+		return &generated.GetBatchTxFeesResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	return &generated.GetBatchTxFeesResponse{Batches: rpc.ToBatchTxFeesMsgs(fees)}, nil
+}
+
+func (s *RPCServer) GetPublicStorageAt(ctx context.Context, request *generated.GetPublicStorageAtRequest) (*generated.GetPublicStorageAtResponse, error) {
+	var mappingKey *gethcommon.Hash
+	if len(request.MappingKey) > 0 {
+		key := gethcommon.BytesToHash(request.MappingKey)
+		mappingKey = &key
+	}
+	result, sysError := s.enclave.GetPublicStorageAt(ctx, gethcommon.BytesToAddress(request.Address), gethcommon.BytesToHash(request.Slot), mappingKey)
+	if sysError != nil {
+		s.logger.Error("Error reading public storage", log.ErrKey, sysError)
+		return &generated.GetPublicStorageAtResponse{SystemError: toRPCError(sysError)}, nil
+	}
+	if result == nil {
+		return &generated.GetPublicStorageAtResponse{IsPublic: false}, nil
+	}
+	return &generated.GetPublicStorageAtResponse{IsPublic: true, Slot: result.Slot.Bytes(), Value: result.Value.Bytes()}, nil
+}
+
 func (s *RPCServer) GetTotalContractCount(ctx context.Context, _ *generated.GetTotalContractCountRequest) (*generated.GetTotalContractCountResponse, error) {
 	count, sysError := s.enclave.GetTotalContractCount(ctx)
 	if sysError != nil {
@@ -23,6 +23,7 @@ import (
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/privacy"
 )
 
 // approximate cost in bytes of the cached values
@@ -34,6 +35,7 @@ const (
 	batchCost       = 1024 * 1024
 	receiptCost     = 1024 * 50
 	contractCost    = 60
+	disclosureCost  = 200
 	eventTypeCost   = 120
 	eventTopicCost  = 16
 	enclaveCost     = 100
@@ -65,6 +67,9 @@ type CacheService struct {
 	contractAddressCache *cache.Cache[*enclavedb.Contract]
 	eventTopicCache      *cache.Cache[*enclavedb.EventTopic]
 
+	// from contract address to the storage slots that the contract declared as public. Immutable after deployment.
+	storageDisclosureCache *cache.Cache[*privacy.StorageDisclosure]
+
 	// from contract_address||event_sig to the event_type object
 	eventTypeCache *cache.Cache[*enclavedb.EventType]
 
@@ -118,6 +123,8 @@ func NewCacheService(logger gethlog.Logger, testMode bool) *CacheService {
 		eventTypeCache:       cache.New[*enclavedb.EventType](newCache(logger, nrEventTypes, eventTypeCost)),
 		eventTopicCache:      cache.New[*enclavedb.EventTopic](newCache(logger, nrEventTypes, eventTopicCost)),
 
+		storageDisclosureCache: cache.New[*privacy.StorageDisclosure](newCache(logger, nrContractAddresses, disclosureCost)),
+
 		receiptCache:          cache.New[*CachedReceipt](newCache(logger, nrReceipts, receiptCost)),
 		attestedEnclavesCache: cache.New[*AttestedEnclave](newCache(logger, nrEnclaves, enclaveCost)),
 
@@ -198,6 +205,10 @@ func (cs *CacheService) ReadContractAddr(ctx context.Context, addr gethcommon.Ad
 	return getCachedValue(ctx, cs.contractAddressCache, cs.logger, addr, contractCost, onCacheMiss, true)
 }
 
+func (cs *CacheService) ReadStorageDisclosure(ctx context.Context, addr gethcommon.Address, onCacheMiss func(any) (*privacy.StorageDisclosure, error)) (*privacy.StorageDisclosure, error) {
+	return getCachedValue(ctx, cs.storageDisclosureCache, cs.logger, addr, disclosureCost, onCacheMiss, true)
+}
+
 func (cs *CacheService) ReadEventTopic(ctx context.Context, topic []byte, eventTypeId uint64, onCacheMiss func(any) (*enclavedb.EventTopic, error)) (*enclavedb.EventTopic, error) {
 	b := make([]byte, 8)
 	binary.LittleEndian.PutUint64(b, eventTypeId)
@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+func TestRevokeDelegations(t *testing.T) {
+	s := newTestStorage(t)
+	ctx := context.Background()
+	delegator := gethcommon.HexToAddress("0x1")
+	delegate := gethcommon.HexToAddress("0x2").Bytes()
+
+	if _, err := s.FetchDelegationRevocation(ctx, delegator, delegate); !errors.Is(err, errutil.ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	// a later revocation replaces the previous one
+	for _, revokedAt := range []uint64{100, 200} {
+		if err := s.RevokeDelegations(ctx, delegator, delegate, revokedAt); err != nil {
+			t.Fatal(err)
+		}
+		stored, err := s.FetchDelegationRevocation(ctx, delegator, delegate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stored != revokedAt {
+			t.Errorf("unexpected revocation time %d, want %d", stored, revokedAt)
+		}
+	}
+	// the revocation only applies to the delegate
+	if _, err := s.FetchDelegationRevocation(ctx, delegator, gethcommon.HexToAddress("0x3").Bytes()); !errors.Is(err, errutil.ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/core/types"
 
@@ -261,8 +262,8 @@ func fetchBatches(ctx context.Context, db *sql.DB, whereQuery string, args ...an
 	return result, nil
 }
 
-func ReadReceipt(ctx context.Context, db *sql.DB, txHash common.L2TxHash, requester *gethcommon.Address) (*core.InternalReceipt, error) {
-	rec, _, err := loadReceiptsAndEventLogs(ctx, db, requester, " AND curr_tx.hash=?", []any{txHash.Bytes()}, true)
+func ReadReceipt(ctx context.Context, db *sql.DB, txHash common.L2TxHash, requestingAccounts []*gethcommon.Address) (*core.InternalReceipt, error) {
+	rec, _, err := loadReceiptsAndEventLogs(ctx, db, requestingAccounts, " AND curr_tx.hash=?", []any{txHash.Bytes()}, true)
 	if err != nil {
 		return nil, err
 	}
@@ -340,6 +341,55 @@ func ReadBatchTransactions(ctx context.Context, db *sql.DB, height uint64) ([]*c
 	return txs, nil
 }
 
+// ReadBatchSyntheticTransactions - returns the synthetic transactions created by the enclave when the batch was executed,
+// together with their senders, in the order of execution
+func ReadBatchSyntheticTransactions(ctx context.Context, db *sql.DB, height uint64) ([]*core.TxWithSender, error) {
+	rows, err := db.QueryContext(ctx, "select tx.content, eoa.address from tx join externally_owned_account eoa on tx.sender_address=eoa.id where tx.batch_height=? and tx.is_synthetic=? order by tx.idx", height, true)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	txs := make([]*core.TxWithSender, 0)
+	for rows.Next() {
+		var txContent []byte
+		var sender gethcommon.Address
+		if err := rows.Scan(&txContent, &sender); err != nil {
+			return nil, err
+		}
+		tx := new(common.L2Tx)
+		if err := rlp.DecodeBytes(txContent, tx); err != nil {
+			return nil, fmt.Errorf("could not decode L2 transaction. Cause: %w", err)
+		}
+		txs = append(txs, &core.TxWithSender{Tx: tx, Sender: &sender, IsSynthetic: true})
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return txs, nil
+}
+
+// ReadBatchReceipts - returns all the receipts of the canonical batch with their event logs, without applying any
+// visibility rules, ordered by the transaction index
+func ReadBatchReceipts(ctx context.Context, db *sql.DB, seqNo uint64) ([]*core.InternalReceipt, error) {
+	receipts, _, err := loadReceiptsAndEventLogs(ctx, db, nil, " AND b.sequence=?", []any{seqNo}, true)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return []*core.InternalReceipt{}, nil
+		}
+		return nil, err
+	}
+	sort.Slice(receipts, func(i, j int) bool {
+		return receipts[i].TransactionIndex < receipts[j].TransactionIndex
+	})
+	for _, r := range receipts {
+		sort.Slice(r.Logs, func(i, j int) bool {
+			return r.Logs[i].Index < r.Logs[j].Index
+		})
+	}
+	return receipts, nil
+}
+
 func ReadContractCreationCount(ctx context.Context, db *sql.DB) (*big.Int, error) {
 	row := db.QueryRowContext(ctx, "select count(id) from contract")
 
@@ -352,6 +402,65 @@ func ReadContractCreationCount(ctx context.Context, db *sql.DB) (*big.Int, error
 	return big.NewInt(count), nil
 }
 
+// BatchTxPrices - the header of a canonical batch, with the gas used and the effective gas price of its paid
+// transactions ordered by their index in the batch
+type BatchTxPrices struct {
+	Header             *common.BatchHeader
+	GasUsed            []uint64
+	EffectiveGasPrices []uint64
+}
+
+// ReadBatchTxPrices - the gas used by each transaction is the difference between its cumulative gas used and the one
+// of the previous transaction of the batch. Synthetic transactions consume gas but are not paid for, so they are skipped.
+func ReadBatchTxPrices(ctx context.Context, db *sql.DB, fromHeight uint64, toHeight uint64) ([]*BatchTxPrices, error) {
+	headers, err := fetchBatches(ctx, db, " where b.is_canonical=true and b.height>=? and b.height<=? order by b.height", fromHeight, toHeight)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*BatchTxPrices, len(headers))
+	byHeight := make(map[uint64]*BatchTxPrices, len(headers))
+	for i, header := range headers {
+		result[i] = &BatchTxPrices{Header: header}
+		byHeight[header.Number.Uint64()] = result[i]
+	}
+
+	query := "select b.height, rec.cumulative_gas_used, rec.effective_gas_price, curr_tx.is_synthetic " +
+		"from receipt rec join batch b on rec.batch=b.sequence join tx curr_tx on rec.tx=curr_tx.id " +
+		"where b.is_canonical=true and b.height>=? and b.height<=? order by b.height, curr_tx.idx"
+	rows, err := db.QueryContext(ctx, query, fromHeight, toHeight)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prevHeight, prevCumulativeGas uint64
+	for rows.Next() {
+		var height, cumulativeGas uint64
+		var effectiveGasPrice *uint64
+		var isSynthetic bool
+		if err := rows.Scan(&height, &cumulativeGas, &effectiveGasPrice, &isSynthetic); err != nil {
+			return nil, err
+		}
+		if height != prevHeight {
+			prevHeight = height
+			prevCumulativeGas = 0
+		}
+		gasUsed := cumulativeGas - prevCumulativeGas
+		prevCumulativeGas = cumulativeGas
+		current, found := byHeight[height]
+		if isSynthetic || !found {
+			continue
+		}
+		var price uint64
+		if effectiveGasPrice != nil {
+			price = *effectiveGasPrice
+		}
+		current.GasUsed = append(current.GasUsed, gasUsed)
+		current.EffectiveGasPrices = append(current.EffectiveGasPrices, price)
+	}
+	return result, rows.Err()
+}
+
 func ReadUnexecutedBatches(ctx context.Context, db *sql.DB, from *big.Int) ([]*common.BatchHeader, error) {
 	return fetchBatches(ctx, db, "where is_executed=false and is_canonical=true and sequence >= ? order by b.sequence", from.Uint64())
 }
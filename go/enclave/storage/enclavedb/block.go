@@ -159,6 +159,49 @@ func FetchL1Messages[T any](ctx context.Context, db *sql.DB, blockHash common.L1
 	return result, nil
 }
 
+// WriteForcedTxs stores the L2 transactions submitted through the management contract in the L1 block
+func WriteForcedTxs(ctx context.Context, db *sql.Tx, blockId int64, txs []*common.L2Tx) error {
+	if len(txs) == 0 {
+		return nil
+	}
+	insert := "insert into forced_tx (hash, content, block) values " + repeat("(?,?,?)", ",", len(txs))
+	args := make([]any, 0)
+	for _, tx := range txs {
+		data, err := rlp.EncodeToBytes(tx)
+		if err != nil {
+			return fmt.Errorf("could not encode forced transaction. Cause: %w", err)
+		}
+		args = append(args, tx.Hash().Bytes(), data, blockId)
+	}
+	_, err := db.ExecContext(ctx, insert, args...)
+	return err
+}
+
+// FetchCanonicalForcedTxs returns the forced transactions submitted in the canonical L1 blocks within the height range,
+// in the order in which they were submitted
+func FetchCanonicalForcedTxs(ctx context.Context, db *sql.DB, fromHeight uint64, toHeight uint64) ([]*common.L2Tx, error) {
+	query := "select f.content from forced_tx f join block b on f.block=b.id where b.is_canonical=true and b.height >= ? and b.height <= ? order by b.height, f.id"
+	rows, err := db.QueryContext(ctx, query, fromHeight, toHeight)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var txs []*common.L2Tx
+	for rows.Next() {
+		var content []byte
+		if err := rows.Scan(&content); err != nil {
+			return nil, err
+		}
+		tx := new(common.L2Tx)
+		if err := rlp.DecodeBytes(content, tx); err != nil {
+			return nil, fmt.Errorf("could not decode forced transaction. Cause: %w", err)
+		}
+		txs = append(txs, tx)
+	}
+	return txs, rows.Err()
+}
+
 func WriteRollup(ctx context.Context, dbtx *sql.Tx, rollup *common.RollupHeader, blockId int64, internalHeader *common.CalldataRollupHeader) error {
 	// Write the encoded header
 	data, err := rlp.EncodeToBytes(rollup)
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ten-protocol/go-ten/go/common"
 
 	"github.com/ten-protocol/go-ten/go/common/errutil"
@@ -13,6 +14,7 @@ import (
 const (
 	cfgInsert = "insert into config values (?,?)"
 	cfgSelect = "select val from config where ky=?"
+	cfgUpdate = "update config set val=? where ky=?"
 )
 
 const (
@@ -20,12 +22,17 @@ const (
 	attSelect           = "select pub_key, node_type from attestation where enclave_id=?"
 	attUpdate           = "update attestation set node_type=? where enclave_id=?"
 	attSelectSequencers = "select enclave_id from attestation where node_type = ? or node_type = ?"
+	attSelectAll        = "select enclave_id, pub_key from attestation"
 )
 
 func WriteConfigToTx(ctx context.Context, dbtx *sql.Tx, key string, value any) (sql.Result, error) {
 	return dbtx.ExecContext(ctx, cfgInsert, key, value)
 }
 
+func UpdateConfigToTx(ctx context.Context, dbtx *sql.Tx, key string, value any) (sql.Result, error) {
+	return dbtx.ExecContext(ctx, cfgUpdate, value, key)
+}
+
 func WriteConfig(ctx context.Context, db *sql.Tx, key string, value []byte) (sql.Result, error) {
 	return db.ExecContext(ctx, cfgInsert, key, value)
 }
@@ -96,3 +103,56 @@ func FetchSequencerEnclaveIDs(ctx context.Context, db *sql.DB) ([]common.Enclave
 
 	return enclaveIDs, nil
 }
+
+// FetchAttestedEnclaves returns the IDs and the compressed public keys of all the attested enclaves
+func FetchAttestedEnclaves(ctx context.Context, db *sql.DB) ([]common.EnclaveID, [][]byte, error) {
+	rows, err := db.QueryContext(ctx, attSelectAll)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var enclaveIDs []common.EnclaveID
+	var pubKeys [][]byte
+	for rows.Next() {
+		var idBytes, pubKey []byte
+		if err := rows.Scan(&idBytes, &pubKey); err != nil {
+			return nil, nil, err
+		}
+		enclaveID := common.EnclaveID{}
+		enclaveID.SetBytes(idBytes)
+		enclaveIDs = append(enclaveIDs, enclaveID)
+		pubKeys = append(pubKeys, pubKey)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	return enclaveIDs, pubKeys, nil
+}
+
+// WriteDelegationRevocation - records that the delegations from the delegator to the delegate which start at or before
+// `revokedAt` are revoked, replacing any earlier revocation
+func WriteDelegationRevocation(ctx context.Context, dbTx *sql.Tx, delegator gethcommon.Address, delegate []byte, revokedAt uint64) error {
+	_, err := dbTx.ExecContext(ctx, "delete from delegation_revocation where delegator=? and delegate=?", delegator.Bytes(), delegate)
+	if err != nil {
+		return err
+	}
+	_, err = dbTx.ExecContext(ctx, "insert into delegation_revocation (delegator, delegate, revoked_at) values (?,?,?)", delegator.Bytes(), delegate, revokedAt)
+	return err
+}
+
+// ReadDelegationRevocation - returns the time of the last revocation of the delegations from the delegator to the
+// delegate, or errutil.ErrNotFound
+func ReadDelegationRevocation(ctx context.Context, db *sql.DB, delegator gethcommon.Address, delegate []byte) (uint64, error) {
+	var revokedAt uint64
+	err := db.QueryRowContext(ctx, "select revoked_at from delegation_revocation where delegator=? and delegate=?", delegator.Bytes(), delegate).Scan(&revokedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, errutil.ErrNotFound
+		}
+		return 0, err
+	}
+	return revokedAt, nil
+}
@@ -38,8 +38,8 @@ const (
 )
 
 func WriteEventType(ctx context.Context, dbTX *sql.Tx, et *EventType) (uint64, error) {
-	res, err := dbTX.ExecContext(ctx, "insert into event_type (contract, event_sig, auto_visibility,auto_public, config_public, topic1_can_view, topic2_can_view, topic3_can_view, sender_can_view) values (?, ?, ?, ?, ?, ?, ?, ?, ?)",
-		et.Contract.Id, et.EventSignature.Bytes(), et.AutoVisibility, et.AutoPublic, et.ConfigPublic, et.Topic1CanView, et.Topic2CanView, et.Topic3CanView, et.SenderCanView)
+	res, err := dbTX.ExecContext(ctx, "insert into event_type (contract, event_sig, auto_visibility,auto_public, config_public, topic1_can_view, topic2_can_view, topic3_can_view, sender_can_view, contract_can_view) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		et.Contract.Id, et.EventSignature.Bytes(), et.AutoVisibility, et.AutoPublic, et.ConfigPublic, et.Topic1CanView, et.Topic2CanView, et.Topic3CanView, et.SenderCanView, et.ContractCanView)
 	if err != nil {
 		return 0, err
 	}
@@ -53,9 +53,9 @@ func WriteEventType(ctx context.Context, dbTX *sql.Tx, et *EventType) (uint64, e
 func ReadEventType(ctx context.Context, dbTX *sql.Tx, contract *Contract, eventSignature gethcommon.Hash) (*EventType, error) {
 	et := EventType{Contract: contract}
 	err := dbTX.QueryRowContext(ctx,
-		"select id, event_sig, auto_visibility, auto_public, config_public, topic1_can_view, topic2_can_view, topic3_can_view, sender_can_view from event_type where contract=? and event_sig=?",
+		"select id, event_sig, auto_visibility, auto_public, config_public, topic1_can_view, topic2_can_view, topic3_can_view, sender_can_view, contract_can_view from event_type where contract=? and event_sig=?",
 		contract.Id, eventSignature.Bytes(),
-	).Scan(&et.Id, &et.EventSignature, &et.AutoVisibility, &et.AutoPublic, &et.ConfigPublic, &et.Topic1CanView, &et.Topic2CanView, &et.Topic3CanView, &et.SenderCanView)
+	).Scan(&et.Id, &et.EventSignature, &et.AutoVisibility, &et.AutoPublic, &et.ConfigPublic, &et.Topic1CanView, &et.Topic2CanView, &et.Topic3CanView, &et.SenderCanView, &et.ContractCanView)
 	if errors.Is(err, sql.ErrNoRows) {
 		// make sure the error is converted to obscuro-wide not found error
 		return nil, errutil.ErrNotFound
@@ -109,7 +109,28 @@ func WriteEventLog(ctx context.Context, dbTX *sql.Tx, eventTypeId uint64, userTo
 	return err
 }
 
-func FilterLogs(ctx context.Context, db *sql.DB, requestingAccount *gethcommon.Address, fromBlock, toBlock *big.Int, batchHash *common.L2BatchHash, addresses []gethcommon.Address, topics [][]gethcommon.Hash) ([]*types.Log, error) {
+func FilterLogs(ctx context.Context, db *sql.DB, requestingAccounts []*gethcommon.Address, fromBlock, toBlock *big.Int, batchHash *common.L2BatchHash, addresses []gethcommon.Address, topics [][]gethcommon.Hash) ([]*types.Log, error) {
+	query, queryParams, err := logsFilterQuery(fromBlock, toBlock, batchHash, addresses, topics)
+	if err != nil {
+		return nil, err
+	}
+	_, logs, err := loadReceiptsAndEventLogs(ctx, db, requestingAccounts, query, queryParams, false)
+	return logs, err
+}
+
+// FilterContractVisibleLogs - returns all the logs matching the filter whose visibility is decided by the contract.
+// The caller must filter the result by calling `canView` on the contract.
+func FilterContractVisibleLogs(ctx context.Context, db *sql.DB, fromBlock, toBlock *big.Int, batchHash *common.L2BatchHash, addresses []gethcommon.Address, topics [][]gethcommon.Hash) ([]*types.Log, error) {
+	query, queryParams, err := logsFilterQuery(fromBlock, toBlock, batchHash, addresses, topics)
+	if err != nil {
+		return nil, err
+	}
+	query += " AND et.contract_can_view=true"
+	_, logs, err := loadReceiptsAndEventLogs(ctx, db, nil, query, queryParams, false)
+	return logs, err
+}
+
+func logsFilterQuery(fromBlock, toBlock *big.Int, batchHash *common.L2BatchHash, addresses []gethcommon.Address, topics [][]gethcommon.Hash) (string, []any, error) {
 	queryParams := []any{}
 	query := ""
 
@@ -135,7 +156,7 @@ func FilterLogs(ctx context.Context, db *sql.DB, requestingAccount *gethcommon.A
 		}
 	}
 	if len(topics) > 4 {
-		return nil, fmt.Errorf("invalid filter. Too many topics")
+		return "", nil, fmt.Errorf("invalid filter. Too many topics")
 	}
 
 	for i := 0; i < len(topics); i++ {
@@ -152,8 +173,7 @@ func FilterLogs(ctx context.Context, db *sql.DB, requestingAccount *gethcommon.A
 		}
 	}
 
-	_, logs, err := loadReceiptsAndEventLogs(ctx, db, requestingAccount, query, queryParams, false)
-	return logs, err
+	return query, queryParams, nil
 }
 
 func DebugGetLogs(ctx context.Context, db *sql.DB, fromBlock *big.Int, toBlock *big.Int, address gethcommon.Address, eventSig gethcommon.Hash) ([]*common.DebugLogVisibility, error) {
@@ -303,8 +323,9 @@ func onRowWithReceipt(rows *sql.Rows) (*core.InternalReceipt, error) {
 // utility function that knows how to load relevant logs from the database together with a receipt
 // returns either receipts with logs, or only logs
 // this complexity is necessary to avoid executing multiple queries.
+// the requesting accounts are the account of the viewing key and the accounts that delegated read access to it.
 // todo always pass in the actual batch hashes because of reorgs, or make sure to clean up log entries from discarded batches
-func loadReceiptsAndEventLogs(ctx context.Context, db *sql.DB, requestingAccount *gethcommon.Address, whereCondition string, whereParams []any, withReceipts bool) ([]*core.InternalReceipt, []*types.Log, error) {
+func loadReceiptsAndEventLogs(ctx context.Context, db *sql.DB, requestingAccounts []*gethcommon.Address, whereCondition string, whereParams []any, withReceipts bool) ([]*core.InternalReceipt, []*types.Log, error) {
 	logsQuery := " et.event_sig, t1.topic, t2.topic, t3.topic, datablob, log_idx, b.hash, b.height, curr_tx.hash, curr_tx.idx, c.address "
 	receiptQuery := " rec.post_state, rec.status, rec.cumulative_gas_used, rec.effective_gas_price, rec.created_contract_address, tx_sender.address, tx_contr.address, curr_tx.type "
 
@@ -317,15 +338,15 @@ func loadReceiptsAndEventLogs(ctx context.Context, db *sql.DB, requestingAccount
 
 	var queryParams []any
 
-	if requestingAccount != nil {
+	if len(requestingAccounts) > 0 {
 		// Add log visibility rules
-		logsVisibQuery, logsVisibParams := logsVisibilityQuery(requestingAccount, withReceipts)
+		logsVisibQuery, logsVisibParams := logsVisibilityQuery(requestingAccounts, withReceipts)
 		query += logsVisibQuery
 		queryParams = append(queryParams, logsVisibParams...)
 
 		// add receipt visibility rules
 		if withReceipts {
-			receiptsVisibQuery, receiptsVisibParams := receiptsVisibilityQuery(requestingAccount)
+			receiptsVisibQuery, receiptsVisibParams := receiptsVisibilityQuery(requestingAccounts)
 			query += receiptsVisibQuery
 			queryParams = append(queryParams, receiptsVisibParams...)
 		}
@@ -334,14 +355,14 @@ func loadReceiptsAndEventLogs(ctx context.Context, db *sql.DB, requestingAccount
 	query += whereCondition
 	queryParams = append(queryParams, whereParams...)
 
-	if withReceipts && requestingAccount != nil {
+	if withReceipts && len(requestingAccounts) > 0 {
 		// there is a corner case when a receipt has logs, but none are visible to the requester
 		query += " UNION ALL "
 		query += " select null, null, null, null, null, null, b.hash, b.height, curr_tx.hash, curr_tx.idx, null, " + receiptQuery
 		query += baseReceiptJoin
 		query += " where b.is_canonical=true "
-		query += " AND tx_sender.address = ? "
-		queryParams = append(queryParams, requestingAccount.Bytes())
+		query += " AND tx_sender.address " + accountsIn(requestingAccounts)
+		queryParams = append(queryParams, accountParams(requestingAccounts)...)
 		query += whereCondition
 		queryParams = append(queryParams, whereParams...)
 	}
@@ -450,19 +471,20 @@ func onRowWithEventLogAndReceipt(rows *sql.Rows, withReceipts bool) (*core.Inter
 	return nil, &l, nil
 }
 
-func receiptsVisibilityQuery(requestingAccount *gethcommon.Address) (string, []any) {
+func receiptsVisibilityQuery(requestingAccounts []*gethcommon.Address) (string, []any) {
 	// the visibility rules for the receipt:
 	// - the sender can query
 	// - anyone can query if the contract is transparent
 	// - anyone who can view an event log should also be able to view the receipt
-	query := " AND ( (e.id IS NOT NULL) OR (tx_sender.address = ?) OR (tx_contr.transparent=true) )"
-	queryParams := []any{requestingAccount.Bytes()}
-	return query, queryParams
+	query := " AND ( (e.id IS NOT NULL) OR (tx_sender.address " + accountsIn(requestingAccounts) + ") OR (tx_contr.transparent=true) )"
+	return query, accountParams(requestingAccounts)
 }
 
 // this function encodes the event log visibility rules
-func logsVisibilityQuery(requestingAccount *gethcommon.Address, withReceipts bool) (string, []any) {
-	acc := requestingAccount.Bytes()
+// an event log is visible if it is visible to any of the requesting accounts
+func logsVisibilityQuery(requestingAccounts []*gethcommon.Address, withReceipts bool) (string, []any) {
+	in := accountsIn(requestingAccounts)
+	acc := accountParams(requestingAccounts)
 
 	visibParams := make([]any, 0)
 
@@ -479,30 +501,43 @@ func logsVisibilityQuery(requestingAccount *gethcommon.Address, withReceipts boo
 	visibQuery += " OR (et.config_public=true) "
 
 	// For event logs that have no explicit configuration, an event is visible by all account owners whose addresses are used in any topic
-	visibQuery += " OR (et.auto_visibility=true AND (et.auto_public=true OR eoa1.address=? OR eoa2.address=? OR eoa3.address=?)) "
-	visibParams = append(visibParams, acc)
-	visibParams = append(visibParams, acc)
-	visibParams = append(visibParams, acc)
+	visibQuery += " OR (et.auto_visibility=true AND (et.auto_public=true OR eoa1.address " + in + " OR eoa2.address " + in + " OR eoa3.address " + in + ")) "
+	visibParams = append(visibParams, acc...)
+	visibParams = append(visibParams, acc...)
+	visibParams = append(visibParams, acc...)
 
 	// Configured events that are not public specify explicitly which event topics are addresses empowered to view that event
 	visibQuery += " OR (" +
 		"et.auto_visibility=false AND et.config_public=false AND " +
 		"  (" +
-		"       (et.topic1_can_view AND eoa1.address=?) " +
-		"    OR (et.topic2_can_view AND eoa2.address=?) " +
-		"    OR (et.topic3_can_view AND eoa3.address=?)" +
-		"    OR (et.sender_can_view AND tx_sender.address=?)" +
+		"       (et.topic1_can_view AND eoa1.address " + in + ") " +
+		"    OR (et.topic2_can_view AND eoa2.address " + in + ") " +
+		"    OR (et.topic3_can_view AND eoa3.address " + in + ")" +
+		"    OR (et.sender_can_view AND tx_sender.address " + in + ")" +
 		"  )" +
 		")"
-	visibParams = append(visibParams, acc)
-	visibParams = append(visibParams, acc)
-	visibParams = append(visibParams, acc)
-	visibParams = append(visibParams, acc)
+	visibParams = append(visibParams, acc...)
+	visibParams = append(visibParams, acc...)
+	visibParams = append(visibParams, acc...)
+	visibParams = append(visibParams, acc...)
 
 	visibQuery += ") "
 	return visibQuery, visibParams
 }
 
+// accountsIn - the "in" clause matching any of the accounts
+func accountsIn(accounts []*gethcommon.Address) string {
+	return "IN (" + repeat("?", ",", len(accounts)) + ")"
+}
+
+func accountParams(accounts []*gethcommon.Address) []any {
+	params := make([]any, len(accounts))
+	for i, account := range accounts {
+		params[i] = account.Bytes()
+	}
+	return params
+}
+
 func WriteEoa(ctx context.Context, dbTX *sql.Tx, sender gethcommon.Address) (uint64, error) {
 	insert := "insert into externally_owned_account (address) values (?)"
 	res, err := dbTX.ExecContext(ctx, insert, sender.Bytes())
@@ -546,6 +581,50 @@ func WriteContractConfig(ctx context.Context, dbTX *sql.Tx, contractAddress geth
 	return &v, nil
 }
 
+// WritePublicStorageSlots - stores the storage slots that the contract declared as public
+func WritePublicStorageSlots(ctx context.Context, dbTX *sql.Tx, contractId uint64, slots []gethcommon.Hash, mappingSlots []gethcommon.Hash) error {
+	insert := "insert into contract_public_slot (contract, slot, is_mapping) values (?,?,?)"
+	for _, slot := range slots {
+		if _, err := dbTX.ExecContext(ctx, insert, contractId, slot.Bytes(), false); err != nil {
+			return err
+		}
+	}
+	for _, slot := range mappingSlots {
+		if _, err := dbTX.ExecContext(ctx, insert, contractId, slot.Bytes(), true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadPublicStorageSlots - returns the storage slots and the mapping base slots that the contract declared as public
+func ReadPublicStorageSlots(ctx context.Context, db *sql.DB, contractAddress gethcommon.Address) ([]gethcommon.Hash, []gethcommon.Hash, error) {
+	return readPublicStorageSlots(ctx, db, "select s.slot, s.is_mapping from contract_public_slot s join contract c on s.contract=c.id where c.address=? order by s.id", contractAddress.Bytes())
+}
+
+func readPublicStorageSlots(ctx context.Context, db *sql.DB, query string, args ...any) ([]gethcommon.Hash, []gethcommon.Hash, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var slots, mappingSlots []gethcommon.Hash
+	for rows.Next() {
+		var slot []byte
+		var isMapping bool
+		if err := rows.Scan(&slot, &isMapping); err != nil {
+			return nil, nil, err
+		}
+		if isMapping {
+			mappingSlots = append(mappingSlots, byteArrayToHash(slot))
+		} else {
+			slots = append(slots, byteArrayToHash(slot))
+		}
+	}
+	return slots, mappingSlots, rows.Err()
+}
+
 func ReadContractByAddress(ctx context.Context, dbTx *sql.Tx, addr gethcommon.Address) (*Contract, error) {
 	row := dbTx.QueryRowContext(ctx, "select c.id, c.address, c.auto_visibility, c.transparent, eoa.address from contract c join externally_owned_account eoa on c.creator=eoa.id where c.address = ?", addr.Bytes())
 
@@ -562,6 +641,75 @@ func ReadContractByAddress(ctx context.Context, dbTx *sql.Tx, addr gethcommon.Ad
 	return &c, nil
 }
 
+// ReadContractsCreatedAtHeight - returns the visibility configs of the contracts created by the transactions of the batch
+// height, grouped by the hash of the creating transaction. Only the explicitly configured event types are included,
+// because the automatic ones are recreated when the first event is stored.
+func ReadContractsCreatedAtHeight(ctx context.Context, db *sql.DB, height uint64) (map[gethcommon.Hash]map[gethcommon.Address]*core.ContractVisibilityConfig, error) {
+	rows, err := db.QueryContext(ctx, "select c.id, c.address, c.auto_visibility, c.transparent, curr_tx.hash from contract c join tx curr_tx on c.tx=curr_tx.id where curr_tx.batch_height=?", height)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type createdContract struct {
+		id      uint64
+		address gethcommon.Address
+		txHash  gethcommon.Hash
+		cfg     *core.ContractVisibilityConfig
+	}
+	contracts := make([]*createdContract, 0)
+	for rows.Next() {
+		c := &createdContract{cfg: &core.ContractVisibilityConfig{}}
+		if err := rows.Scan(&c.id, &c.address, &c.cfg.AutoConfig, &c.cfg.Transparent, &c.txHash); err != nil {
+			return nil, err
+		}
+		contracts = append(contracts, c)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	// the rows are closed before running the other queries, because the connection might not support concurrent queries
+	rows.Close()
+
+	result := make(map[gethcommon.Hash]map[gethcommon.Address]*core.ContractVisibilityConfig)
+	for _, c := range contracts {
+		if !c.cfg.AutoConfig {
+			c.cfg.EventConfigs, err = readConfiguredEventTypes(ctx, db, c.id)
+			if err != nil {
+				return nil, err
+			}
+			c.cfg.PublicSlots, c.cfg.PublicMappingSlots, err = readPublicStorageSlots(ctx, db, "select slot, is_mapping from contract_public_slot where contract=? order by id", c.id)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if result[c.txHash] == nil {
+			result[c.txHash] = make(map[gethcommon.Address]*core.ContractVisibilityConfig)
+		}
+		result[c.txHash][c.address] = c.cfg
+	}
+	return result, nil
+}
+
+func readConfiguredEventTypes(ctx context.Context, db *sql.DB, contractId uint64) (map[gethcommon.Hash]*core.EventVisibilityConfig, error) {
+	rows, err := db.QueryContext(ctx, "select event_sig, config_public, topic1_can_view, topic2_can_view, topic3_can_view, sender_can_view, contract_can_view from event_type where contract=? and auto_visibility=false", contractId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[gethcommon.Hash]*core.EventVisibilityConfig)
+	for rows.Next() {
+		var eventSig []byte
+		cfg := &core.EventVisibilityConfig{}
+		if err := rows.Scan(&eventSig, &cfg.Public, &cfg.Topic1CanView, &cfg.Topic2CanView, &cfg.Topic3CanView, &cfg.SenderCanView, &cfg.ContractCanView); err != nil {
+			return nil, err
+		}
+		result[byteArrayToHash(eventSig)] = cfg
+	}
+	return result, rows.Err()
+}
+
 func byteArrayToHash(b []byte) gethcommon.Hash {
 	result := gethcommon.Hash{}
 	result.SetBytes(b)
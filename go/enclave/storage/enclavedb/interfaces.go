@@ -43,6 +43,7 @@ type EventType struct {
 	ConfigPublic                                bool
 	Topic1CanView, Topic2CanView, Topic3CanView *bool
 	SenderCanView                               *bool
+	ContractCanView                             *bool // the visibility is decided at query time by the `canView` function of the contract
 }
 
 func (et EventType) IsPublic() bool {
@@ -12,6 +12,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/measure"
+	"github.com/ten-protocol/go-ten/go/common/privacy"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 	"github.com/ten-protocol/go-ten/go/enclave/storage/enclavedb"
 )
@@ -72,17 +73,25 @@ func (es *eventsStorage) storeNewContractWithEventTypeConfigs(ctx context.Contex
 		return err
 	}
 
+	if len(cfg.PublicSlots) > 0 || len(cfg.PublicMappingSlots) > 0 {
+		err = enclavedb.WritePublicStorageSlots(ctx, dbTX, c.Id, cfg.PublicSlots, cfg.PublicMappingSlots)
+		if err != nil {
+			return fmt.Errorf("could not write public storage slots. cause %w", err)
+		}
+	}
+
 	// create the event types for the events that were configured
 	for eventSig, eventCfg := range cfg.EventConfigs {
 		_, err = enclavedb.WriteEventType(ctx, dbTX, &enclavedb.EventType{
-			Contract:       c,
-			EventSignature: eventSig,
-			AutoVisibility: eventCfg.AutoConfig,
-			ConfigPublic:   eventCfg.Public,
-			Topic1CanView:  eventCfg.Topic1CanView,
-			Topic2CanView:  eventCfg.Topic2CanView,
-			Topic3CanView:  eventCfg.Topic3CanView,
-			SenderCanView:  eventCfg.SenderCanView,
+			Contract:        c,
+			EventSignature:  eventSig,
+			AutoVisibility:  eventCfg.AutoConfig,
+			ConfigPublic:    eventCfg.Public,
+			Topic1CanView:   eventCfg.Topic1CanView,
+			Topic2CanView:   eventCfg.Topic2CanView,
+			Topic3CanView:   eventCfg.Topic3CanView,
+			SenderCanView:   eventCfg.SenderCanView,
+			ContractCanView: eventCfg.ContractCanView,
 		})
 		if err != nil {
 			return fmt.Errorf("could not write event type. cause %w", err)
@@ -336,6 +345,22 @@ func (es *eventsStorage) ReadContract(ctx context.Context, addr gethcommon.Addre
 	})
 }
 
+// ReadStorageDisclosure - the public slots are written together with the contract, so they are only read, and cached,
+// after the contract is found
+func (es *eventsStorage) ReadStorageDisclosure(ctx context.Context, addr gethcommon.Address) (*privacy.StorageDisclosure, error) {
+	defer es.logDuration("readStorageDisclosure", measure.NewStopwatch())
+	return es.cachingService.ReadStorageDisclosure(ctx, addr, func(v any) (*privacy.StorageDisclosure, error) {
+		if _, err := es.ReadContract(ctx, addr); err != nil {
+			return nil, err
+		}
+		slots, mappingSlots, err := enclavedb.ReadPublicStorageSlots(ctx, es.db.GetSQLDB(), addr)
+		if err != nil {
+			return nil, err
+		}
+		return privacy.NewStorageDisclosure(slots, mappingSlots), nil
+	})
+}
+
 func (es *eventsStorage) findTopic(ctx context.Context, dbTX *sql.Tx, topic []byte, eventTypeId uint64) (*enclavedb.EventTopic, error) {
 	defer es.logDuration("findTopic", measure.NewStopwatch())
 	return es.cachingService.ReadEventTopic(ctx, topic, eventTypeId, func(v any) (*enclavedb.EventTopic, error) {
@@ -0,0 +1,10 @@
+-- the L2 transactions submitted through the management contract, which must be included in a batch
+create table if not exists tendb.forced_tx
+(
+    id      INTEGER AUTO_INCREMENT,
+    hash    binary(32) NOT NULL,
+    content mediumblob NOT NULL,
+    block   INTEGER    NOT NULL,
+    INDEX (block),
+    primary key (id)
+);
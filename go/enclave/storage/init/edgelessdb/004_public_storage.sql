@@ -0,0 +1,10 @@
+-- the storage slots that private contracts declared as public, so they can be read by everyone via eth_getStorageAt
+create table if not exists tendb.contract_public_slot
+(
+    id         INTEGER AUTO_INCREMENT,
+    contract   INTEGER    NOT NULL,
+    slot       binary(32) NOT NULL,
+    is_mapping boolean    NOT NULL,
+    INDEX (contract),
+    primary key (id)
+);
@@ -0,0 +1,8 @@
+-- the revocations of the read access delegated to viewing keys (see viewingkey.Delegation)
+create table if not exists tendb.delegation_revocation
+(
+    delegator  binary(20) NOT NULL,
+    delegate   binary(20) NOT NULL,
+    revoked_at INTEGER    NOT NULL,
+    primary key (delegator, delegate)
+);
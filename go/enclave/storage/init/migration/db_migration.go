@@ -28,11 +28,13 @@ func DBMigration(db *sql.DB, sqlFiles embed.FS, logger gethlog.Logger) error {
 	maxMigration := int64(len(migrationFiles))
 
 	var maxDB int64
+	versionExists := true
 	config, err := enclavedb.FetchConfig(context.Background(), db, currentMigrationVersionKey)
 	if err != nil {
 		// first time there is no entry, so 001 was executed already ( triggered at launch/manifest time )
 		if errors.Is(err, errutil.ErrNotFound) {
 			maxDB = 1
+			versionExists = false
 		} else {
 			return err
 		}
@@ -47,17 +49,19 @@ func DBMigration(db *sql.DB, sqlFiles embed.FS, logger gethlog.Logger) error {
 		if err != nil {
 			return err
 		}
-		err = executeMigration(db, string(content), i)
+		// the version is the number of executed migrations, so the next run starts with the following file
+		err = executeMigration(db, string(content), i+1, versionExists)
 		if err != nil {
 			return fmt.Errorf("unable to execute migration for %s - %w", migrationFiles[i].Name(), err)
 		}
+		versionExists = true
 		logger.Info("Successfully executed", "file", migrationFiles[i].Name(), "index", i)
 	}
 
 	return nil
 }
 
-func executeMigration(db *sql.DB, content string, migrationOrder int64) error {
+func executeMigration(db *sql.DB, content string, migrationOrder int64, versionExists bool) error {
 	tx, err := db.Begin()
 	if err != nil {
 		return err
@@ -68,7 +72,12 @@ func executeMigration(db *sql.DB, content string, migrationOrder int64) error {
 		return err
 	}
 
-	_, err = enclavedb.WriteConfigToTx(context.Background(), tx, currentMigrationVersionKey, big.NewInt(migrationOrder).Bytes())
+	// the version is inserted by the first migration, and updated by the following ones
+	if versionExists {
+		_, err = enclavedb.UpdateConfigToTx(context.Background(), tx, currentMigrationVersionKey, big.NewInt(migrationOrder).Bytes())
+	} else {
+		_, err = enclavedb.WriteConfigToTx(context.Background(), tx, currentMigrationVersionKey, big.NewInt(migrationOrder).Bytes())
+	}
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,9 @@
+-- the L2 transactions submitted through the management contract, which must be included in a batch
+create table if not exists forced_tx
+(
+    id      INTEGER PRIMARY KEY AUTOINCREMENT,
+    hash    binary(32) NOT NULL,
+    content mediumblob NOT NULL,
+    block   INTEGER    NOT NULL REFERENCES block
+);
+create index if not exists IDX_FORCED_TX_BLOCK on forced_tx (block);
@@ -0,0 +1,2 @@
+-- events configured to be visible to the accounts approved by the `canView` function of the contract
+alter table event_type add column contract_can_view boolean;
@@ -0,0 +1,10 @@
+-- the storage slots that private contracts declared as public, so they can be read by everyone via eth_getStorageAt
+create table if not exists contract_public_slot
+(
+    id         INTEGER PRIMARY KEY AUTOINCREMENT,
+    contract   INTEGER    NOT NULL REFERENCES contract,
+    slot       binary(32) NOT NULL,
+    --     the slot is the base slot of a mapping, whose entries can be read by providing the key
+    is_mapping boolean    NOT NULL
+);
+create index IDX_CONTRACT_PUBLIC_SLOT on contract_public_slot (contract);
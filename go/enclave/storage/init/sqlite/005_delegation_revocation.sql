@@ -0,0 +1,9 @@
+-- the revocations of the read access delegated to viewing keys (see viewingkey.Delegation)
+create table if not exists delegation_revocation
+(
+    delegator  binary(20) NOT NULL,
+    delegate   binary(20) NOT NULL,
+    --     the delegations to the delegate which start at or before this unix timestamp are revoked
+    revoked_at INTEGER    NOT NULL,
+    primary key (delegator, delegate)
+);
@@ -0,0 +1,26 @@
+package sqlite
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	enclaveconfig "github.com/ten-protocol/go-ten/go/enclave/config"
+)
+
+func TestReopenExistingDB(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "enclave.db")
+	config := enclaveconfig.EnclaveConfig{RPCTimeout: time.Second}
+
+	// the migrations run when the database is created, and must not run again when it is reopened
+	for i := 0; i < 3; i++ {
+		db, err := CreateTemporarySQLiteDB(dbPath, "", config, gethlog.New())
+		if err != nil {
+			t.Fatalf("could not open the database (attempt %d). Cause: %s", i, err)
+		}
+		if err = db.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
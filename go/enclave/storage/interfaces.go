@@ -6,6 +6,7 @@ import (
 	"io"
 	"math/big"
 
+	"github.com/ten-protocol/go-ten/go/common/privacy"
 	"github.com/ten-protocol/go-ten/go/enclave/storage/enclavedb"
 
 	"github.com/ethereum/go-ethereum/triedb"
@@ -92,13 +93,29 @@ type SharedSecretStorage interface {
 	FetchSecret(ctx context.Context) (*crypto.SharedEnclaveSecret, error)
 	// StoreSecret stores a secret in the enclave
 	StoreSecret(ctx context.Context, secret crypto.SharedEnclaveSecret) error
+	// FetchSecretEpochs returns the rotations of the secret, in order
+	FetchSecretEpochs(ctx context.Context) ([]crypto.SecretEpoch, error)
+	// StoreSecretEpoch stores a rotation of the secret
+	StoreSecretEpoch(ctx context.Context, epoch crypto.SecretEpoch) error
+	// FetchPendingSecretEpoch returns the secret generated by this enclave for an epoch which was requested but not yet distributed
+	FetchPendingSecretEpoch(ctx context.Context, epoch uint64) (*crypto.SecretEpoch, error)
+	// StorePendingSecretEpoch stores the secret generated by this enclave for a requested epoch, before it is distributed
+	StorePendingSecretEpoch(ctx context.Context, epoch crypto.SecretEpoch) error
+	// FetchSecretDistribution returns the verified distribution of the secret of an epoch seen on the L1
+	FetchSecretDistribution(ctx context.Context, epoch uint64) (*common.SecretDistribution, error)
+	// StoreSecretDistribution stores a verified distribution seen on the L1, until this enclave applies it
+	StoreSecretDistribution(ctx context.Context, distribution *common.SecretDistribution) error
+	// FetchSecretEpochL1Height returns the height of the L1 block that carried the distribution of the secret of an epoch
+	FetchSecretEpochL1Height(ctx context.Context, epoch uint64) (uint64, error)
+	// StoreSecretEpochL1Height stores the height of the L1 block that carried the distribution of the secret of an epoch
+	StoreSecretEpochL1Height(ctx context.Context, epoch uint64, height uint64) error
 }
 
 type TransactionStorage interface {
 	// GetTransaction - returns the positional metadata of the tx by hash
 	GetTransaction(ctx context.Context, txHash common.L2TxHash) (*types.Transaction, common.L2BatchHash, uint64, uint64, error)
-	// GetFilteredInternalReceipt - returns the receipt of a tx with event logs visible to the requester
-	GetFilteredInternalReceipt(ctx context.Context, txHash common.L2TxHash, requester *gethcommon.Address, syntheticTx bool) (*core.InternalReceipt, error)
+	// GetFilteredInternalReceipt - returns the receipt of a tx with event logs visible to any of the requesting accounts
+	GetFilteredInternalReceipt(ctx context.Context, txHash common.L2TxHash, requestingAccounts []*gethcommon.Address, syntheticTx bool) (*core.InternalReceipt, error)
 	ExistsTransactionReceipt(ctx context.Context, txHash common.L2TxHash) (bool, error)
 }
 
@@ -107,6 +124,7 @@ type AttestationStorage interface {
 	StoreNewEnclave(ctx context.Context, enclaveId common.EnclaveID, key *ecdsa.PublicKey) error
 	StoreNodeType(ctx context.Context, enclaveId common.EnclaveID, nodeType common.NodeType) error
 	GetSequencerEnclaveIDs(ctx context.Context) ([]common.EnclaveID, error)
+	GetAttestedEnclaves(ctx context.Context) ([]*AttestedEnclave, error)
 }
 
 type CrossChainMessagesStorage interface {
@@ -115,6 +133,12 @@ type CrossChainMessagesStorage interface {
 
 	StoreValueTransfers(ctx context.Context, blockHash common.L1BlockHash, transfers common.ValueTransferEvents) error
 	GetL1Transfers(ctx context.Context, blockHash common.L1BlockHash) (common.ValueTransferEvents, error)
+
+	// StoreForcedTransactions - stores the L2 transactions submitted through the management contract in the L1 block
+	StoreForcedTransactions(ctx context.Context, blockHash common.L1BlockHash, txs []*common.L2Tx) error
+	// FetchCanonicalForcedTransactions - returns the forced transactions submitted in the canonical L1 blocks between the
+	// two heights (inclusive)
+	FetchCanonicalForcedTransactions(ctx context.Context, fromHeight uint64, toHeight uint64) ([]*common.L2Tx, error)
 }
 
 type EnclaveKeyStorage interface {
@@ -127,6 +151,37 @@ type SystemContractAddressesStorage interface {
 	GetSystemContractAddresses(ctx context.Context) (common.SystemContractAddresses, error)
 }
 
+// FraudReportStorage - keeps the last divergence detected by the validator, so that the sequencer stays halted after a restart
+type FraudReportStorage interface {
+	StoreFraudReport(ctx context.Context, report *common.FraudReport) error
+	// GetFraudReport returns errutil.ErrNotFound if no divergence was detected
+	GetFraudReport(ctx context.Context) (*common.FraudReport, error)
+}
+
+// DelegationRevocationStorage - keeps the revocations of the read access delegated to viewing keys
+type DelegationRevocationStorage interface {
+	// RevokeDelegations revokes the delegations from the delegator to the delegate which start at or before `revokedAt`
+	RevokeDelegations(ctx context.Context, delegator gethcommon.Address, delegate []byte, revokedAt uint64) error
+	// FetchDelegationRevocation returns the time of the last revocation, or errutil.ErrNotFound
+	FetchDelegationRevocation(ctx context.Context, delegator gethcommon.Address, delegate []byte) (uint64, error)
+}
+
+// StateSnapshotStorage - reads and writes the data included in the state snapshots used to bootstrap new enclaves
+type StateSnapshotStorage interface {
+	// ExportState passes every trie node and contract code of the state to the callbacks
+	ExportState(root common.StateRoot, onNode func(blob []byte) error, onCode func(code []byte) error) error
+	// WriteStateNodes writes trie nodes and contract codes, which are keyed by their hash
+	WriteStateNodes(trieNodes [][]byte, codes [][]byte) error
+	// CheckState returns an error if any trie node or contract code of the state is missing
+	CheckState(root common.StateRoot) error
+	// FetchBatchSyntheticTransactions returns the synthetic transactions created when the batch was executed
+	FetchBatchSyntheticTransactions(ctx context.Context, height uint64) ([]*core.TxWithSender, error)
+	// FetchBatchReceipts returns the unfiltered receipts of the canonical batch
+	FetchBatchReceipts(ctx context.Context, seqNo uint64) ([]*core.InternalReceipt, error)
+	// FetchContractsCreatedAtHeight returns the visibility configs of the contracts created at the batch height, by tx hash
+	FetchContractsCreatedAtHeight(ctx context.Context, height uint64) (map[gethcommon.Hash]map[gethcommon.Address]*core.ContractVisibilityConfig, error)
+}
+
 // Storage is the enclave's interface for interacting with the enclave's datastore
 type Storage interface {
 	BlockResolver
@@ -139,6 +194,9 @@ type Storage interface {
 	EnclaveKeyStorage
 	ScanStorage
 	SystemContractAddressesStorage
+	FraudReportStorage
+	StateSnapshotStorage
+	DelegationRevocationStorage
 	io.Closer
 
 	// HealthCheck returns whether the storage is deemed healthy or not
@@ -147,11 +205,19 @@ type Storage interface {
 	// FilterLogs - applies the properties the relevancy checks for the requestingAccount to all the stored log events
 	// nil values will be ignored. Make sure to set all fields to the right values before calling this function
 	// the blockHash should always be nil.
-	FilterLogs(ctx context.Context, requestingAccount *gethcommon.Address, fromBlock, toBlock *big.Int, blockHash *common.L2BatchHash, addresses []gethcommon.Address, topics [][]gethcommon.Hash) ([]*types.Log, error)
+	FilterLogs(ctx context.Context, requestingAccounts []*gethcommon.Address, fromBlock, toBlock *big.Int, blockHash *common.L2BatchHash, addresses []gethcommon.Address, topics [][]gethcommon.Hash) ([]*types.Log, error)
+
+	// FilterContractVisibleLogs - returns the unsorted log events matching the filter, whose visibility is decided by
+	// the `canView` function of the contract. The caller is responsible for the visibility checks.
+	FilterContractVisibleLogs(ctx context.Context, fromBlock, toBlock *big.Int, blockHash *common.L2BatchHash, addresses []gethcommon.Address, topics [][]gethcommon.Hash) ([]*types.Log, error)
 
 	// DebugGetLogs returns logs for a given tx hash without any constraints - should only be used for debug purposes
 	DebugGetLogs(ctx context.Context, from *big.Int, to *big.Int, address gethcommon.Address, eventSig gethcommon.Hash) ([]*common.DebugLogVisibility, error)
 
+	// CommitBatchState - writes the state of an executed batch to the database or retains it in memory, depending on
+	// the state retention mode
+	CommitBatchState(seqNo uint64, root common.StateRoot) error
+
 	// TrieDB - return the underlying trie database
 	TrieDB() *triedb.Database
 
@@ -159,6 +225,9 @@ type Storage interface {
 	StateDB() state.Database
 
 	ReadContract(ctx context.Context, address gethcommon.Address) (*enclavedb.Contract, error)
+	// ReadStorageDisclosure - returns the storage slots that the contract declared as public, or errutil.ErrNotFound if
+	// the contract does not exist
+	ReadStorageDisclosure(ctx context.Context, address gethcommon.Address) (*privacy.StorageDisclosure, error)
 	ReadEventType(ctx context.Context, contractAddress gethcommon.Address, eventSignature gethcommon.Hash) (*enclavedb.EventType, error)
 }
 
@@ -167,4 +236,8 @@ type ScanStorage interface {
 	GetTransactionsPerAddress(ctx context.Context, address *gethcommon.Address, pagination *common.QueryPagination) ([]*core.InternalReceipt, error)
 
 	CountTransactionsPerAddress(ctx context.Context, addr *gethcommon.Address) (uint64, error)
+
+	// ReadBatchTxPrices returns the headers of the canonical batches between the heights (inclusive), with the gas used
+	// and the effective gas price of their paid transactions
+	ReadBatchTxPrices(ctx context.Context, fromHeight uint64, toHeight uint64) ([]*enclavedb.BatchTxPrices, error)
 }
@@ -0,0 +1,171 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/triedb"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	enclaveconfig "github.com/ten-protocol/go-ten/go/enclave/config"
+)
+
+// defaultRetainedBatches - the number of recent batches whose state is kept when the state is pruned, unless configured
+const defaultRetainedBatches = 1024
+
+// retainedState - the state root of an executed batch, referenced in the trie database while it is retained
+type retainedState struct {
+	seqNo uint64
+	root  gethcommon.Hash
+}
+
+// stateRetention decides which batch states are written to the database.
+//
+// In archive mode, the state of every batch is committed, so the state of any batch can be queried.
+// In pruned mode (similar to the "full" gc mode of geth), the tries of the last `retainedBatches` batches are kept in
+// memory and reference counted. When a batch falls out of the window its root is dereferenced, and the trie nodes
+// which are no longer referenced by any retained root are garbage collected without ever reaching the database.
+// Only the state of the batches that end a rollup (the checkpoints), periodic flushes, and whatever has to be flushed
+// to keep the memory usage under the limit are written to the database.
+// After an unclean shutdown, the state of the batches executed since the last flush is missing. It is rebuilt on startup
+// by replaying the batches on top of the last state found in the database (see restoreStateDBCache).
+type stateRetention struct {
+	trieDB          *triedb.Database
+	pruned          bool
+	retainedBatches uint64
+	dirtyLimit      gethcommon.StorageSize // the size of the in-memory trie nodes above which the oldest nodes are flushed
+	flushInterval   time.Duration          // the maximum time the state is kept in memory only, bounding the replay after a crash
+
+	mu                 sync.Mutex
+	retained           []retainedState     // ordered from the oldest to the newest batch
+	pendingCheckpoints map[uint64]struct{} // the checkpoints of the rollups stored before their last batch was executed
+	lastFlush          time.Time
+	logger             gethlog.Logger
+}
+
+func newStateRetention(trieDB *triedb.Database, config *enclaveconfig.EnclaveConfig, logger gethlog.Logger) (*stateRetention, error) {
+	r := &stateRetention{
+		trieDB:             trieDB,
+		dirtyLimit:         gethcommon.StorageSize(defaultCacheConfig.TrieDirtyLimit) * 1024 * 1024,
+		flushInterval:      defaultCacheConfig.TrieTimeLimit,
+		pendingCheckpoints: make(map[uint64]struct{}),
+		lastFlush:          time.Now(),
+		logger:             logger,
+	}
+	if config == nil {
+		return r, nil
+	}
+	switch config.StateRetention {
+	case "", enclaveconfig.StateRetentionArchive:
+	case enclaveconfig.StateRetentionPruned:
+		r.pruned = true
+		r.retainedBatches = config.RetainedBatches
+		if r.retainedBatches == 0 {
+			r.retainedBatches = defaultRetainedBatches
+		}
+	default:
+		return nil, fmt.Errorf("unknown state retention mode: %s", config.StateRetention)
+	}
+	return r, nil
+}
+
+// commit - called with the state root of every executed batch, after the state was committed to the trie database
+func (r *stateRetention) commit(seqNo uint64, root gethcommon.Hash) error {
+	if !r.pruned {
+		return r.trieDB.Commit(root, false)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.trieDB.Reference(root, gethcommon.Hash{}); err != nil {
+		return fmt.Errorf("could not reference state root of batch %d. Cause: %w", seqNo, err)
+	}
+	r.retained = append(r.retained, retainedState{seqNo: seqNo, root: root})
+
+	// flush the oldest trie nodes if the memory limit was reached
+	if _, nodes, _ := r.trieDB.Size(); nodes > r.dirtyLimit {
+		if err := r.trieDB.Cap(r.dirtyLimit - ethdb.IdealBatchSize); err != nil {
+			return fmt.Errorf("could not flush trie nodes. Cause: %w", err)
+		}
+	}
+
+	_, checkpoint := r.pendingCheckpoints[seqNo]
+	for pending := range r.pendingCheckpoints {
+		if pending <= seqNo {
+			delete(r.pendingCheckpoints, pending)
+		}
+	}
+	if checkpoint || time.Since(r.lastFlush) > r.flushInterval {
+		if err := r.flush(root); err != nil {
+			return err
+		}
+	}
+
+	// garbage collect the batches that are no longer retained
+	for uint64(len(r.retained)) > r.retainedBatches {
+		if err := r.trieDB.Dereference(r.retained[0].root); err != nil {
+			return fmt.Errorf("could not dereference state root of batch %d. Cause: %w", r.retained[0].seqNo, err)
+		}
+		r.retained = r.retained[1:]
+	}
+	return nil
+}
+
+// checkpoint - makes sure the state of the batch that ends a rollup is written to the database, so it survives pruning
+func (r *stateRetention) checkpoint(seqNo uint64) error {
+	if !r.pruned {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, s := range r.retained {
+		if s.seqNo == seqNo {
+			return r.flush(s.root)
+		}
+	}
+	if len(r.retained) == 0 || seqNo > r.retained[len(r.retained)-1].seqNo {
+		// the batch was not executed yet
+		r.pendingCheckpoints[seqNo] = struct{}{}
+		return nil
+	}
+	// the state was either pruned or already flushed by the memory limit
+	r.logger.Warn("State of rollup checkpoint is no longer retained in memory", log.BatchSeqNoKey, seqNo)
+	return nil
+}
+
+// close - writes the state of the newest batch to the database, so that no batches have to be replayed after a restart
+func (r *stateRetention) close() error {
+	if !r.pruned {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.retained) == 0 {
+		return nil
+	}
+	return r.flush(r.retained[len(r.retained)-1].root)
+}
+
+func (r *stateRetention) flush(root gethcommon.Hash) error {
+	if err := r.trieDB.Commit(root, false); err != nil {
+		return fmt.Errorf("could not flush state root %s. Cause: %w", root, err)
+	}
+	r.lastFlush = time.Now()
+	return nil
+}
+
+// isPruned - true if the error was caused by the trie nodes of a state that was pruned
+func (r *stateRetention) isPruned(err error) bool {
+	var missingNode *trie.MissingNodeError
+	return r.pruned && errors.As(err, &missingNode)
+}
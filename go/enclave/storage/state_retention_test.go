@@ -0,0 +1,103 @@
+package storage
+
+import (
+	"math/big"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/triedb"
+	"github.com/holiman/uint256"
+	enclaveconfig "github.com/ten-protocol/go-ten/go/enclave/config"
+)
+
+func TestPrunedStateRetention(t *testing.T) {
+	diskDB := rawdb.NewMemoryDatabase()
+	trieDB := triedb.NewDatabase(diskDB, trieDBConfig)
+	stateCache := state.NewDatabaseWithNodeDB(rawdb.NewMemoryDatabase(), trieDB)
+	retention, err := newStateRetention(trieDB, &enclaveconfig.EnclaveConfig{
+		StateRetention:  enclaveconfig.StateRetentionPruned,
+		RetainedBatches: 2,
+	}, gethlog.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the batch 2 ends a rollup
+	if err = retention.checkpoint(2); err != nil {
+		t.Fatal(err)
+	}
+
+	roots := make([]gethcommon.Hash, 6)
+	for seqNo := uint64(1); seqNo < uint64(len(roots)); seqNo++ {
+		stateDB, err := state.New(roots[seqNo-1], stateCache, nil)
+		if err != nil {
+			t.Fatalf("state of batch %d should be retained: %s", seqNo-1, err)
+		}
+		// every batch changes the balance of a new account, so every batch has a different root
+		stateDB.AddBalance(gethcommon.BigToAddress(big.NewInt(int64(seqNo))), uint256.NewInt(seqNo), tracing.BalanceChangeUnspecified)
+		roots[seqNo], err = stateDB.Commit(seqNo, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = retention.commit(seqNo, roots[seqNo]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for seqNo, root := range roots {
+		_, err := state.New(root, stateCache, nil)
+		switch seqNo {
+		case 0, 2, 4, 5:
+			// the empty state, the checkpoint and the last two batches
+			if err != nil {
+				t.Errorf("state of batch %d should be retained: %s", seqNo, err)
+			}
+		default:
+			if err == nil || !retention.isPruned(err) {
+				t.Errorf("state of batch %d should be pruned, got error: %v", seqNo, err)
+			}
+		}
+	}
+
+	// after an unclean shutdown, only the checkpoint was written, so the last batches have to be replayed on top of it
+	crashed := state.NewDatabaseWithNodeDB(rawdb.NewMemoryDatabase(), triedb.NewDatabase(diskDB, trieDBConfig))
+	if _, err := state.New(roots[2], crashed, nil); err != nil {
+		t.Errorf("state of the checkpoint should be persisted: %s", err)
+	}
+	if _, err := state.New(roots[5], crashed, nil); err == nil {
+		t.Error("state of batch 5 should not be persisted before the shutdown")
+	}
+
+	// after a restart, only the state written to the database is available
+	if err = retention.close(); err != nil {
+		t.Fatal(err)
+	}
+	restarted := state.NewDatabaseWithNodeDB(rawdb.NewMemoryDatabase(), triedb.NewDatabase(diskDB, trieDBConfig))
+	for _, seqNo := range []int{2, 5} {
+		if _, err := state.New(roots[seqNo], restarted, nil); err != nil {
+			t.Errorf("state of batch %d should be persisted: %s", seqNo, err)
+		}
+	}
+	if _, err := state.New(roots[4], restarted, nil); err == nil {
+		t.Error("state of batch 4 should not be persisted")
+	}
+}
+
+func TestStateRetentionConfig(t *testing.T) {
+	trieDB := triedb.NewDatabase(rawdb.NewMemoryDatabase(), trieDBConfig)
+	_, err := newStateRetention(trieDB, &enclaveconfig.EnclaveConfig{StateRetention: "light"}, gethlog.New())
+	if err == nil {
+		t.Fatal("expected an error for an unknown retention mode")
+	}
+	retention, err := newStateRetention(trieDB, &enclaveconfig.EnclaveConfig{StateRetention: enclaveconfig.StateRetentionPruned}, gethlog.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retention.retainedBatches != defaultRetainedBatches {
+		t.Fatalf("expected the default number of retained batches, got %d", retention.retainedBatches)
+	}
+}
@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/measure"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/enclavedb"
+)
+
+func (s *storageImpl) ExportState(root common.StateRoot, onNode func(blob []byte) error, onCode func(code []byte) error) error {
+	defer s.logDuration("ExportState", measure.NewStopwatch())
+
+	// identical storage tries share their nodes, so every node is exported only once
+	seen := make(map[gethcommon.Hash]struct{})
+	err := s.walkState(root,
+		func(hash gethcommon.Hash, blob []byte) error {
+			if _, ok := seen[hash]; ok {
+				return nil
+			}
+			seen[hash] = struct{}{}
+			return onNode(blob)
+		},
+		func(codeHash gethcommon.Hash) error {
+			code := rawdb.ReadCode(s.db, codeHash)
+			if len(code) == 0 {
+				return fmt.Errorf("missing code %s", codeHash)
+			}
+			return onCode(code)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("could not export state %s. Cause: %w", root, err)
+	}
+	return nil
+}
+
+func (s *storageImpl) WriteStateNodes(trieNodes [][]byte, codes [][]byte) error {
+	defer s.logDuration("WriteStateNodes", measure.NewStopwatch())
+
+	// the nodes and codes are keyed by their hash, so writing them can't corrupt the existing state
+	batch := s.db.NewBatch()
+	for _, node := range trieNodes {
+		rawdb.WriteLegacyTrieNode(batch, crypto.Keccak256Hash(node), node)
+		if err := flushIfFull(batch); err != nil {
+			return err
+		}
+	}
+	for _, code := range codes {
+		rawdb.WriteCode(batch, crypto.Keccak256Hash(code), code)
+		if err := flushIfFull(batch); err != nil {
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("could not write state. Cause: %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) CheckState(root common.StateRoot) error {
+	defer s.logDuration("CheckState", measure.NewStopwatch())
+
+	// the state is only usable if every node and code reachable from the root is stored
+	err := s.walkState(root, nil, func(codeHash gethcommon.Hash) error {
+		if len(rawdb.ReadCode(s.db, codeHash)) == 0 {
+			return fmt.Errorf("missing code %s", codeHash)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("state %s is incomplete. Cause: %w", root, err)
+	}
+	return nil
+}
+
+func flushIfFull(batch ethdb.Batch) error {
+	if batch.ValueSize() < ethdb.IdealBatchSize {
+		return nil
+	}
+	if err := batch.Write(); err != nil {
+		return fmt.Errorf("could not write state. Cause: %w", err)
+	}
+	batch.Reset()
+	return nil
+}
+
+// walkState - iterates over the account trie and all the storage tries of the state, calling `onNode` for every trie
+// node which is not embedded in its parent and `onCode` for every contract code.
+// Fails if any of the nodes is missing from the database.
+func (s *storageImpl) walkState(root common.StateRoot, onNode func(hash gethcommon.Hash, blob []byte) error, onCode func(codeHash gethcommon.Hash) error) error {
+	trieDB := s.stateCache.TrieDB()
+	accountTrie, err := trie.NewStateTrie(trie.StateTrieID(root), trieDB)
+	if err != nil {
+		return err
+	}
+	storageRoots := make(map[gethcommon.Hash]struct{})
+	codeHashes := make(map[gethcommon.Hash]struct{})
+
+	visit := func(t *trie.StateTrie, onLeaf func(key []byte, value []byte) error) error {
+		it, err := t.NodeIterator(nil)
+		if err != nil {
+			return err
+		}
+		for it.Next(true) {
+			if it.Hash() != (gethcommon.Hash{}) && onNode != nil {
+				if err := onNode(it.Hash(), it.NodeBlob()); err != nil {
+					return err
+				}
+			}
+			if it.Leaf() && onLeaf != nil {
+				if err := onLeaf(it.LeafKey(), it.LeafBlob()); err != nil {
+					return err
+				}
+			}
+		}
+		return it.Error()
+	}
+
+	return visit(accountTrie, func(key []byte, value []byte) error {
+		var account types.StateAccount
+		if err := rlp.DecodeBytes(value, &account); err != nil {
+			return fmt.Errorf("could not decode account. Cause: %w", err)
+		}
+		if account.Root != types.EmptyRootHash {
+			if _, ok := storageRoots[account.Root]; !ok {
+				storageRoots[account.Root] = struct{}{}
+				storageTrie, err := trie.NewStateTrie(trie.StorageTrieID(root, gethcommon.BytesToHash(key), account.Root), trieDB)
+				if err != nil {
+					return err
+				}
+				if err := visit(storageTrie, nil); err != nil {
+					return err
+				}
+			}
+		}
+		codeHash := gethcommon.BytesToHash(account.CodeHash)
+		if codeHash != types.EmptyCodeHash {
+			if _, ok := codeHashes[codeHash]; !ok {
+				codeHashes[codeHash] = struct{}{}
+				return onCode(codeHash)
+			}
+		}
+		return nil
+	})
+}
+
+func (s *storageImpl) FetchBatchSyntheticTransactions(ctx context.Context, height uint64) ([]*core.TxWithSender, error) {
+	defer s.logDuration("FetchBatchSyntheticTransactions", measure.NewStopwatch())
+	return enclavedb.ReadBatchSyntheticTransactions(ctx, s.db.GetSQLDB(), height)
+}
+
+func (s *storageImpl) FetchBatchReceipts(ctx context.Context, seqNo uint64) ([]*core.InternalReceipt, error) {
+	defer s.logDuration("FetchBatchReceipts", measure.NewStopwatch())
+	return enclavedb.ReadBatchReceipts(ctx, s.db.GetSQLDB(), seqNo)
+}
+
+func (s *storageImpl) FetchContractsCreatedAtHeight(ctx context.Context, height uint64) (map[gethcommon.Hash]map[gethcommon.Address]*core.ContractVisibilityConfig, error) {
+	defer s.logDuration("FetchContractsCreatedAtHeight", measure.NewStopwatch())
+	return enclavedb.ReadContractsCreatedAtHeight(ctx, s.db.GetSQLDB(), height)
+}
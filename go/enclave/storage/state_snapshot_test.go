@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/holiman/uint256"
+	enclaveconfig "github.com/ten-protocol/go-ten/go/enclave/config"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/init/sqlite"
+)
+
+func TestExportImportState(t *testing.T) {
+	source := newTestStorage(t)
+	target := newTestStorage(t)
+
+	stateDB, err := source.EmptyStateDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	eoa := gethcommon.HexToAddress("0x1")
+	contract := gethcommon.HexToAddress("0x2")
+	slot := gethcommon.HexToHash("0x3")
+	code := []byte{0x60, 0x00, 0x60, 0x00, 0xf3}
+	stateDB.AddBalance(eoa, uint256.NewInt(100), tracing.BalanceChangeUnspecified)
+	stateDB.SetCode(contract, code)
+	stateDB.SetState(contract, slot, gethcommon.HexToHash("0x4"))
+	root, err := stateDB.Commit(1, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = source.CommitBatchState(1, root); err != nil {
+		t.Fatal(err)
+	}
+
+	var trieNodes, codes [][]byte
+	err = source.ExportState(root,
+		func(blob []byte) error {
+			trieNodes = append(trieNodes, blob)
+			return nil
+		},
+		func(code []byte) error {
+			codes = append(codes, code)
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a state with missing nodes is rejected
+	if err = target.WriteStateNodes(trieNodes[1:], codes); err != nil {
+		t.Fatal(err)
+	}
+	if err = target.CheckState(root); err == nil {
+		t.Fatal("expected an error when checking an incomplete state")
+	}
+
+	if err = target.WriteStateNodes(trieNodes[:1], nil); err != nil {
+		t.Fatal(err)
+	}
+	if err = target.CheckState(root); err != nil {
+		t.Fatal(err)
+	}
+	imported, err := state.New(root, target.StateDB(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported.GetBalance(eoa).Uint64() != 100 {
+		t.Errorf("unexpected balance %s", imported.GetBalance(eoa))
+	}
+	if !bytes.Equal(imported.GetCode(contract), code) {
+		t.Errorf("unexpected code %x", imported.GetCode(contract))
+	}
+	if imported.GetState(contract, slot) != gethcommon.HexToHash("0x4") {
+		t.Errorf("unexpected storage value %s", imported.GetState(contract, slot))
+	}
+}
+
+func newTestStorage(t *testing.T) *storageImpl {
+	config := enclaveconfig.EnclaveConfig{UseInMemoryDB: true, RPCTimeout: time.Second}
+	logger := gethlog.New()
+	db, err := sqlite.CreateTemporarySQLiteDB("", sqliteCfg, config, logger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewStorage(db, NewCacheService(logger, true), &config, nil, logger)
+	t.Cleanup(func() { _ = s.Close() })
+	return s.(*storageImpl)
+}
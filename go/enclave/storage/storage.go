@@ -15,6 +15,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/triedb/hashdb"
 	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/privacy"
 	enclaveconfig "github.com/ten-protocol/go-ten/go/enclave/config"
 
 	"github.com/ethereum/go-ethereum/triedb"
@@ -45,6 +46,11 @@ const (
 	masterSeedCfg              = "MASTER_SEED"
 	enclaveKeyCfg              = "ENCLAVE_KEY"
 	systemContractAddressesCfg = "SYSTEM_CONTRACT_ADDRESSES"
+	fraudReportCfg             = "FRAUD_REPORT"
+	secretEpochCfgPrefix       = "SECRET_EPOCH_"         // followed by the epoch number
+	pendingSecretEpochPrefix   = "PENDING_SECRET_EPOCH_" // followed by the epoch number
+	secretDistributionPrefix   = "SECRET_DISTRIBUTION_"  // followed by the epoch number
+	secretEpochL1HeightPrefix  = "SECRET_EPOCH_HEIGHT_"  // followed by the epoch number
 )
 
 type AttestedEnclave struct {
@@ -59,10 +65,11 @@ type storageImpl struct {
 	cachingService *CacheService
 	eventsStorage  *eventsStorage
 
-	stateCache  state.Database
-	chainConfig *params.ChainConfig
-	config      *enclaveconfig.EnclaveConfig
-	logger      gethlog.Logger
+	stateCache     state.Database
+	stateRetention *stateRetention
+	chainConfig    *params.ChainConfig
+	config         *enclaveconfig.EnclaveConfig
+	logger         gethlog.Logger
 }
 
 func NewStorageFromConfig(config *enclaveconfig.EnclaveConfig, cachingService *CacheService, chainConfig *params.ChainConfig, logger gethlog.Logger) Storage {
@@ -96,9 +103,15 @@ func NewStorage(backingDB enclavedb.EnclaveDB, cachingService *CacheService, con
 
 	stateDB := state.NewDatabaseWithNodeDB(backingDB, triedb)
 
+	stateRetention, err := newStateRetention(triedb, config, logger)
+	if err != nil {
+		logger.Crit("Invalid state retention config", log.ErrKey, err)
+	}
+
 	return &storageImpl{
 		db:             backingDB,
 		stateCache:     stateDB,
+		stateRetention: stateRetention,
 		chainConfig:    chainConfig,
 		config:         config,
 		cachingService: cachingService,
@@ -115,7 +128,14 @@ func (s *storageImpl) StateDB() state.Database {
 	return s.stateCache
 }
 
+func (s *storageImpl) CommitBatchState(seqNo uint64, root common.StateRoot) error {
+	return s.stateRetention.commit(seqNo, root)
+}
+
 func (s *storageImpl) Close() error {
+	if err := s.stateRetention.close(); err != nil {
+		s.logger.Error("Could not flush the retained state", log.ErrKey, err)
+	}
 	return s.db.GetSQLDB().Close()
 }
 
@@ -368,6 +388,163 @@ func (s *storageImpl) FetchSecret(ctx context.Context) (*crypto.SharedEnclaveSec
 	return &ss, nil
 }
 
+func (s *storageImpl) StoreSecretEpoch(ctx context.Context, epoch crypto.SecretEpoch) error {
+	defer s.logDuration("StoreSecretEpoch", measure.NewStopwatch())
+	enc, err := rlp.EncodeToBytes(epoch)
+	if err != nil {
+		return fmt.Errorf("could not encode secret epoch. Cause: %w", err)
+	}
+	dbTx, err := s.db.NewDBTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("could not create DB transaction - %w", err)
+	}
+	defer dbTx.Rollback()
+	_, err = enclavedb.WriteConfig(ctx, dbTx, secretEpochCfg(epoch.Epoch), enc)
+	if err != nil {
+		return fmt.Errorf("could not store secret epoch %d in DB. Cause: %w", epoch.Epoch, err)
+	}
+	return dbTx.Commit()
+}
+
+// FetchSecretEpochs - returns the rotations of the secret in order, and should only be called during startup
+func (s *storageImpl) FetchSecretEpochs(ctx context.Context) ([]crypto.SecretEpoch, error) {
+	defer s.logDuration("FetchSecretEpochs", measure.NewStopwatch())
+
+	var epochs []crypto.SecretEpoch
+	for epochNo := crypto.GenesisSecretEpoch + 1; ; epochNo++ {
+		cfg, err := enclavedb.FetchConfig(ctx, s.db.GetSQLDB(), secretEpochCfg(epochNo))
+		if err != nil {
+			if errors.Is(err, errutil.ErrNotFound) {
+				return epochs, nil
+			}
+			return nil, err
+		}
+		var epoch crypto.SecretEpoch
+		if err := rlp.DecodeBytes(cfg, &epoch); err != nil {
+			return nil, fmt.Errorf("could not decode secret epoch %d. Cause: %w", epochNo, err)
+		}
+		epochs = append(epochs, epoch)
+	}
+}
+
+func (s *storageImpl) StorePendingSecretEpoch(ctx context.Context, epoch crypto.SecretEpoch) error {
+	defer s.logDuration("StorePendingSecretEpoch", measure.NewStopwatch())
+	enc, err := rlp.EncodeToBytes(epoch)
+	if err != nil {
+		return fmt.Errorf("could not encode secret epoch. Cause: %w", err)
+	}
+	dbTx, err := s.db.NewDBTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("could not create DB transaction - %w", err)
+	}
+	defer dbTx.Rollback()
+	_, err = enclavedb.WriteConfig(ctx, dbTx, fmt.Sprintf("%s%d", pendingSecretEpochPrefix, epoch.Epoch), enc)
+	if err != nil {
+		return fmt.Errorf("could not store pending secret epoch %d in DB. Cause: %w", epoch.Epoch, err)
+	}
+	return dbTx.Commit()
+}
+
+func (s *storageImpl) FetchPendingSecretEpoch(ctx context.Context, epochNo uint64) (*crypto.SecretEpoch, error) {
+	defer s.logDuration("FetchPendingSecretEpoch", measure.NewStopwatch())
+	cfg, err := enclavedb.FetchConfig(ctx, s.db.GetSQLDB(), fmt.Sprintf("%s%d", pendingSecretEpochPrefix, epochNo))
+	if err != nil {
+		return nil, err
+	}
+	var epoch crypto.SecretEpoch
+	if err := rlp.DecodeBytes(cfg, &epoch); err != nil {
+		return nil, fmt.Errorf("could not decode pending secret epoch %d. Cause: %w", epochNo, err)
+	}
+	return &epoch, nil
+}
+
+func (s *storageImpl) StoreSecretDistribution(ctx context.Context, distribution *common.SecretDistribution) error {
+	defer s.logDuration("StoreSecretDistribution", measure.NewStopwatch())
+	cfgKey := fmt.Sprintf("%s%d", secretDistributionPrefix, distribution.Epoch)
+	// the first distribution of an epoch is kept, like its secret
+	_, err := enclavedb.FetchConfig(ctx, s.db.GetSQLDB(), cfgKey)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, errutil.ErrNotFound) {
+		return fmt.Errorf("could not fetch secret distribution %d. Cause: %w", distribution.Epoch, err)
+	}
+	enc, err := rlp.EncodeToBytes(distribution)
+	if err != nil {
+		return fmt.Errorf("could not encode secret distribution. Cause: %w", err)
+	}
+	dbTx, err := s.db.NewDBTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("could not create DB transaction - %w", err)
+	}
+	defer dbTx.Rollback()
+	_, err = enclavedb.WriteConfig(ctx, dbTx, cfgKey, enc)
+	if err != nil {
+		return fmt.Errorf("could not store secret distribution %d in DB. Cause: %w", distribution.Epoch, err)
+	}
+	return dbTx.Commit()
+}
+
+func (s *storageImpl) FetchSecretDistribution(ctx context.Context, epochNo uint64) (*common.SecretDistribution, error) {
+	defer s.logDuration("FetchSecretDistribution", measure.NewStopwatch())
+	cfg, err := enclavedb.FetchConfig(ctx, s.db.GetSQLDB(), fmt.Sprintf("%s%d", secretDistributionPrefix, epochNo))
+	if err != nil {
+		return nil, err
+	}
+	var distribution common.SecretDistribution
+	if err := rlp.DecodeBytes(cfg, &distribution); err != nil {
+		return nil, fmt.Errorf("could not decode secret distribution %d. Cause: %w", epochNo, err)
+	}
+	return &distribution, nil
+}
+
+func (s *storageImpl) StoreSecretEpochL1Height(ctx context.Context, epochNo uint64, height uint64) error {
+	defer s.logDuration("StoreSecretEpochL1Height", measure.NewStopwatch())
+	cfgKey := fmt.Sprintf("%s%d", secretEpochL1HeightPrefix, epochNo)
+	_, err := enclavedb.FetchConfig(ctx, s.db.GetSQLDB(), cfgKey)
+	exists := err == nil
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return fmt.Errorf("could not fetch the L1 height of secret epoch %d. Cause: %w", epochNo, err)
+	}
+	enc, err := rlp.EncodeToBytes(height)
+	if err != nil {
+		return fmt.Errorf("could not encode L1 height. Cause: %w", err)
+	}
+
+	dbTx, err := s.db.NewDBTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("could not create DB transaction - %w", err)
+	}
+	defer dbTx.Rollback()
+	// the distribution can be re-included at another height after an L1 reorg
+	if exists {
+		_, err = enclavedb.UpdateConfigToTx(ctx, dbTx, cfgKey, enc)
+	} else {
+		_, err = enclavedb.WriteConfig(ctx, dbTx, cfgKey, enc)
+	}
+	if err != nil {
+		return fmt.Errorf("could not store the L1 height of secret epoch %d in DB. Cause: %w", epochNo, err)
+	}
+	return dbTx.Commit()
+}
+
+func (s *storageImpl) FetchSecretEpochL1Height(ctx context.Context, epochNo uint64) (uint64, error) {
+	defer s.logDuration("FetchSecretEpochL1Height", measure.NewStopwatch())
+	cfg, err := enclavedb.FetchConfig(ctx, s.db.GetSQLDB(), fmt.Sprintf("%s%d", secretEpochL1HeightPrefix, epochNo))
+	if err != nil {
+		return 0, err
+	}
+	var height uint64
+	if err := rlp.DecodeBytes(cfg, &height); err != nil {
+		return 0, fmt.Errorf("could not decode the L1 height of secret epoch %d. Cause: %w", epochNo, err)
+	}
+	return height, nil
+}
+
+func secretEpochCfg(epoch uint64) string {
+	return fmt.Sprintf("%s%d", secretEpochCfgPrefix, epoch)
+}
+
 func (s *storageImpl) IsAncestor(ctx context.Context, block *types.Header, maybeAncestor *types.Header) bool {
 	defer s.logDuration("IsAncestor", measure.NewStopwatch())
 	if bytes.Equal(maybeAncestor.Hash().Bytes(), block.Hash().Bytes()) {
@@ -419,6 +596,9 @@ func (s *storageImpl) CreateStateDB(ctx context.Context, batchHash common.L2Batc
 
 	statedb, err := state.New(batch.Root, s.stateCache, nil)
 	if err != nil {
+		if s.stateRetention.isPruned(err) {
+			return nil, fmt.Errorf("state of batch %d is not retained. Cause: %w", batch.SequencerOrderNo, errutil.ErrStatePruned)
+		}
 		return nil, fmt.Errorf("could not create state DB for batch: %d. Cause: %w", batch.SequencerOrderNo, err)
 	}
 	return statedb, nil
@@ -438,12 +618,12 @@ func (s *storageImpl) GetTransaction(ctx context.Context, txHash gethcommon.Hash
 	return enclavedb.ReadTransaction(ctx, s.db.GetSQLDB(), txHash)
 }
 
-func (s *storageImpl) GetFilteredInternalReceipt(ctx context.Context, txHash common.L2TxHash, requester *gethcommon.Address, syntheticTx bool) (*core.InternalReceipt, error) {
+func (s *storageImpl) GetFilteredInternalReceipt(ctx context.Context, txHash common.L2TxHash, requestingAccounts []*gethcommon.Address, syntheticTx bool) (*core.InternalReceipt, error) {
 	defer s.logDuration("GetFilteredInternalReceipt", measure.NewStopwatch())
-	if !syntheticTx && requester == nil {
+	if !syntheticTx && len(requestingAccounts) == 0 {
 		return nil, errors.New("requester address is required for non-synthetic transactions")
 	}
-	return enclavedb.ReadReceipt(ctx, s.db.GetSQLDB(), txHash, requester)
+	return enclavedb.ReadReceipt(ctx, s.db.GetSQLDB(), txHash, requestingAccounts)
 }
 
 func (s *storageImpl) ExistsTransactionReceipt(ctx context.Context, txHash common.L2TxHash) (bool, error) {
@@ -468,6 +648,23 @@ func (s *storageImpl) GetEnclavePubKey(ctx context.Context, enclaveId common.Enc
 	})
 }
 
+func (s *storageImpl) GetAttestedEnclaves(ctx context.Context) ([]*AttestedEnclave, error) {
+	defer s.logDuration("GetAttestedEnclaves", measure.NewStopwatch())
+	enclaveIDs, keys, err := enclavedb.FetchAttestedEnclaves(ctx, s.db.GetSQLDB())
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the attested enclaves. Cause: %w", err)
+	}
+	enclaves := make([]*AttestedEnclave, len(enclaveIDs))
+	for i := range enclaveIDs {
+		publicKey, err := gethcrypto.DecompressPubkey(keys[i])
+		if err != nil {
+			return nil, fmt.Errorf("could not parse key of enclave %s from db. Cause: %w", enclaveIDs[i], err)
+		}
+		enclaves[i] = &AttestedEnclave{PubKey: publicKey, EnclaveID: &enclaveIDs[i]}
+	}
+	return enclaves, nil
+}
+
 func (s *storageImpl) StoreNodeType(ctx context.Context, enclaveId common.EnclaveID, nodeType common.NodeType) error {
 	defer s.logDuration("StoreNodeType", measure.NewStopwatch())
 	dbTx, err := s.db.NewDBTransaction(ctx)
@@ -742,6 +939,29 @@ func (s *storageImpl) GetL1Transfers(ctx context.Context, blockHash common.L1Blo
 	return enclavedb.FetchL1Messages[common.ValueTransferEvent](ctx, s.db.GetSQLDB(), blockHash, true)
 }
 
+func (s *storageImpl) StoreForcedTransactions(ctx context.Context, blockHash common.L1BlockHash, txs []*common.L2Tx) error {
+	defer s.logDuration("StoreForcedTransactions", measure.NewStopwatch())
+	dbtx, err := s.db.NewDBTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("could not create DB transaction - %w", err)
+	}
+	defer dbtx.Rollback()
+	blockId, err := enclavedb.GetBlockId(ctx, dbtx, blockHash)
+	if err != nil {
+		return fmt.Errorf("could not get block id - %w", err)
+	}
+	err = enclavedb.WriteForcedTxs(ctx, dbtx, blockId, txs)
+	if err != nil {
+		return fmt.Errorf("could not write forced transactions - %w", err)
+	}
+	return dbtx.Commit()
+}
+
+func (s *storageImpl) FetchCanonicalForcedTransactions(ctx context.Context, fromHeight uint64, toHeight uint64) ([]*common.L2Tx, error) {
+	defer s.logDuration("FetchCanonicalForcedTransactions", measure.NewStopwatch())
+	return enclavedb.FetchCanonicalForcedTxs(ctx, s.db.GetSQLDB(), fromHeight, toHeight)
+}
+
 func (s *storageImpl) StoreEnclaveKey(ctx context.Context, enclaveKey []byte) error {
 	defer s.logDuration("StoreEnclaveKey", measure.NewStopwatch())
 	if len(enclaveKey) == 0 {
@@ -786,6 +1006,11 @@ func (s *storageImpl) StoreRollup(ctx context.Context, rollup *common.ExtRollup,
 	if err := dbTx.Commit(); err != nil {
 		return fmt.Errorf("could not write rollup to storage. Cause: %w", err)
 	}
+
+	// the state at the end of every rollup is kept even when the state is pruned
+	if err := s.stateRetention.checkpoint(rollup.Header.LastBatchSeqNo); err != nil {
+		return fmt.Errorf("could not checkpoint the state of the rollup. Cause: %w", err)
+	}
 	return nil
 }
 
@@ -806,14 +1031,14 @@ func (s *storageImpl) DebugGetLogs(ctx context.Context, from *big.Int, to *big.I
 
 func (s *storageImpl) FilterLogs(
 	ctx context.Context,
-	requestingAccount *gethcommon.Address,
+	requestingAccounts []*gethcommon.Address,
 	fromBlock, toBlock *big.Int,
 	blockHash *common.L2BatchHash,
 	addresses []gethcommon.Address,
 	topics [][]gethcommon.Hash,
 ) ([]*types.Log, error) {
 	defer s.logDuration("FilterLogs", measure.NewStopwatch())
-	logs, err := enclavedb.FilterLogs(ctx, s.db.GetSQLDB(), requestingAccount, fromBlock, toBlock, blockHash, addresses, topics)
+	logs, err := enclavedb.FilterLogs(ctx, s.db.GetSQLDB(), requestingAccounts, fromBlock, toBlock, blockHash, addresses, topics)
 	if err != nil {
 		return nil, err
 	}
@@ -828,6 +1053,17 @@ func (s *storageImpl) FilterLogs(
 	return logs, nil
 }
 
+func (s *storageImpl) FilterContractVisibleLogs(
+	ctx context.Context,
+	fromBlock, toBlock *big.Int,
+	blockHash *common.L2BatchHash,
+	addresses []gethcommon.Address,
+	topics [][]gethcommon.Hash,
+) ([]*types.Log, error) {
+	defer s.logDuration("FilterContractVisibleLogs", measure.NewStopwatch())
+	return enclavedb.FilterContractVisibleLogs(ctx, s.db.GetSQLDB(), fromBlock, toBlock, blockHash, addresses, topics)
+}
+
 func (s *storageImpl) GetContractCount(ctx context.Context) (*big.Int, error) {
 	defer s.logDuration("GetContractCount", measure.NewStopwatch())
 	return enclavedb.ReadContractCreationCount(ctx, s.db.GetSQLDB())
@@ -853,6 +1089,11 @@ func (s *storageImpl) CountTransactionsPerAddress(ctx context.Context, address *
 	return enclavedb.CountTransactionsPerAddress(ctx, s.db.GetSQLDB(), address)
 }
 
+func (s *storageImpl) ReadBatchTxPrices(ctx context.Context, fromHeight uint64, toHeight uint64) ([]*enclavedb.BatchTxPrices, error) {
+	defer s.logDuration("ReadBatchTxPrices", measure.NewStopwatch())
+	return enclavedb.ReadBatchTxPrices(ctx, s.db.GetSQLDB(), fromHeight, toHeight)
+}
+
 func (s *storageImpl) readOrWriteEOA(ctx context.Context, dbTX *sql.Tx, addr gethcommon.Address) (*uint64, error) {
 	defer s.logDuration("readOrWriteEOA", measure.NewStopwatch())
 	return s.cachingService.ReadEOA(ctx, addr, func(v any) (*uint64, error) {
@@ -875,6 +1116,10 @@ func (s *storageImpl) ReadContract(ctx context.Context, address gethcommon.Addre
 	return s.eventsStorage.ReadContract(ctx, address)
 }
 
+func (s *storageImpl) ReadStorageDisclosure(ctx context.Context, address gethcommon.Address) (*privacy.StorageDisclosure, error) {
+	return s.eventsStorage.ReadStorageDisclosure(ctx, address)
+}
+
 func (s *storageImpl) ReadEventType(ctx context.Context, contractAddress gethcommon.Address, eventSignature gethcommon.Hash) (*enclavedb.EventType, error) {
 	dbTx, err := s.db.NewDBTransaction(ctx)
 	if err != nil {
@@ -925,6 +1170,76 @@ func (s *storageImpl) GetSystemContractAddresses(ctx context.Context) (common.Sy
 	return addresses, nil
 }
 
+func (s *storageImpl) StoreFraudReport(ctx context.Context, report *common.FraudReport) error {
+	defer s.logDuration("StoreFraudReport", measure.NewStopwatch())
+
+	reportBytes, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("could not marshal fraud report - %w", err)
+	}
+	_, err = enclavedb.FetchConfig(ctx, s.db.GetSQLDB(), fraudReportCfg)
+	exists := err == nil
+	if err != nil && !errors.Is(err, errutil.ErrNotFound) {
+		return fmt.Errorf("could not fetch fraud report - %w", err)
+	}
+
+	dbTx, err := s.db.NewDBTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("could not create DB transaction - %w", err)
+	}
+	defer dbTx.Rollback()
+
+	if exists {
+		_, err = enclavedb.UpdateConfigToTx(ctx, dbTx, fraudReportCfg, reportBytes)
+	} else {
+		_, err = enclavedb.WriteConfig(ctx, dbTx, fraudReportCfg, reportBytes)
+	}
+	if err != nil {
+		return fmt.Errorf("could not write fraud report - %w", err)
+	}
+
+	if err := dbTx.Commit(); err != nil {
+		return fmt.Errorf("could not commit fraud report - %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) GetFraudReport(ctx context.Context) (*common.FraudReport, error) {
+	defer s.logDuration("GetFraudReport", measure.NewStopwatch())
+	reportBytes, err := enclavedb.FetchConfig(ctx, s.db.GetSQLDB(), fraudReportCfg)
+	if err != nil {
+		return nil, err
+	}
+	var report common.FraudReport
+	if err := json.Unmarshal(reportBytes, &report); err != nil {
+		return nil, fmt.Errorf("could not unmarshal fraud report - %w", err)
+	}
+	return &report, nil
+}
+
+func (s *storageImpl) RevokeDelegations(ctx context.Context, delegator gethcommon.Address, delegate []byte, revokedAt uint64) error {
+	defer s.logDuration("RevokeDelegations", measure.NewStopwatch())
+
+	dbTx, err := s.db.NewDBTransaction(ctx)
+	if err != nil {
+		return fmt.Errorf("could not create DB transaction - %w", err)
+	}
+	defer dbTx.Rollback()
+
+	if err := enclavedb.WriteDelegationRevocation(ctx, dbTx, delegator, delegate, revokedAt); err != nil {
+		return fmt.Errorf("could not write delegation revocation - %w", err)
+	}
+	if err := dbTx.Commit(); err != nil {
+		return fmt.Errorf("could not commit delegation revocation - %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchDelegationRevocation(ctx context.Context, delegator gethcommon.Address, delegate []byte) (uint64, error) {
+	defer s.logDuration("FetchDelegationRevocation", measure.NewStopwatch())
+	return enclavedb.ReadDelegationRevocation(ctx, s.db.GetSQLDB(), delegator, delegate)
+}
+
 func (s *storageImpl) GetSequencerEnclaveIDs(ctx context.Context) ([]common.EnclaveID, error) {
 	defer s.logDuration("GetSequencerEnclaveIDs", measure.NewStopwatch())
 
@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/storage/enclavedb"
+)
+
+func TestReadStorageDisclosure(t *testing.T) {
+	s := newTestStorage(t)
+	ctx := context.Background()
+	contract := gethcommon.HexToAddress("0x2")
+	publicSlot := gethcommon.HexToHash("0x3")
+	mappingSlot := gethcommon.HexToHash("0x4")
+	key := gethcommon.HexToHash("0x5")
+
+	// the disclosure of a missing contract must not be cached as empty
+	if _, err := s.ReadStorageDisclosure(ctx, contract); !errors.Is(err, errutil.ErrNotFound) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
+	dbTX, err := s.db.NewDBTransaction(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender := gethcommon.HexToAddress("0x1")
+	creator, err := enclavedb.WriteEoa(ctx, dbTX, sender)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the creating transaction is the first one stored, with id 1
+	tx := &core.TxWithSender{Tx: types.NewTx(&types.LegacyTx{}), Sender: &sender}
+	if err = enclavedb.WriteTransactions(ctx, dbTX, []*core.TxWithSender{tx}, 1, false, []uint64{creator}, []*uint64{nil}, 0); err != nil {
+		t.Fatal(err)
+	}
+	transparent := false
+	cfg := &core.ContractVisibilityConfig{
+		Transparent:        &transparent,
+		PublicSlots:        []gethcommon.Hash{publicSlot},
+		PublicMappingSlots: []gethcommon.Hash{mappingSlot},
+	}
+	if err = s.eventsStorage.storeNewContractWithEventTypeConfigs(ctx, dbTX, contract, &creator, cfg, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err = dbTX.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	disclosure, err := s.ReadStorageDisclosure(ctx, contract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := disclosure.DisclosedSlot(publicSlot, nil); !ok {
+		t.Error("public slot should be disclosed")
+	}
+	if _, ok := disclosure.DisclosedSlot(mappingSlot, &key); !ok {
+		t.Error("mapping entry should be disclosed")
+	}
+	if _, ok := disclosure.DisclosedSlot(mappingSlot, nil); ok {
+		t.Error("the base slot of a mapping should not be disclosed")
+	}
+}
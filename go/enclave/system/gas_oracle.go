@@ -0,0 +1,201 @@
+package system
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/asm"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ten-protocol/go-ten/contracts/generated/GasOracle"
+	"github.com/ten-protocol/go-ten/go/enclave/gas"
+)
+
+// GasOracleName - the name under which the GasOracle is registered with the other system contracts
+const GasOracleName = "GasOracle"
+
+// GasOracleAddress - the GasOracle is a predeploy. Its code is installed at this address in the system contracts
+// genesis batch instead of being deployed by the SystemDeployer.
+var GasOracleAddress = gethcommon.HexToAddress("0x7e00000000000000000000000000000000000001")
+
+// the storage slots of the GasOracle system contract. They must match the layout of GasOracle.sol
+var (
+	gasOracleL1BlockNumberSlot = gethcommon.BigToHash(big.NewInt(0))
+	gasOracleL1BaseFeeSlot     = gethcommon.BigToHash(big.NewInt(1))
+	gasOracleL1BlobBaseFeeSlot = gethcommon.BigToHash(big.NewInt(2))
+	// whether the L1 cost considers the blobs, so the quotes of the contract follow the activation height of the blob
+	// cost like the charges of the enclave
+	gasOracleBlobCostActiveSlot = gethcommon.BigToHash(big.NewInt(3))
+)
+
+// gasOracleCode - the runtime code of the GasOracle. It implements GasOracle.sol by hand, so the predeploy does not
+// depend on the output of the solidity compiler, which would change the genesis state whenever the compiler changes.
+// TestGasOracleCodeMatchesTheSolidity checks that it behaves like the compiled GasOracle.sol.
+var gasOracleCode = compileGasOracle()
+
+// the assembly of the GasOracle. The selectors of the functions are filled in from the ABI of the binding, and the blob
+// sizes from the parameters of the L1.
+const gasOracleAsm = `
+	CALLVALUE
+	JUMPI @fail
+	PUSH 0
+	CALLDATALOAD
+	PUSH 0xe0
+	SHR
+	DUP1
+	PUSH %s
+	EQ
+	JUMPI @l1BlockNumber
+	DUP1
+	PUSH %s
+	EQ
+	JUMPI @l1BaseFee
+	DUP1
+	PUSH %s
+	EQ
+	JUMPI @l1BlobBaseFee
+	PUSH %s
+	EQ
+	JUMPI @l1Cost
+fail:
+	PUSH 0
+	DUP1
+	REVERT
+l1BlockNumber:
+	POP
+	PUSH 0
+	SLOAD
+	JUMP @ret
+l1BaseFee:
+	POP
+	PUSH 1
+	SLOAD
+	JUMP @ret
+l1BlobBaseFee:
+	POP
+	PUSH 2
+	SLOAD
+	JUMP @ret
+ret:
+	PUSH 0
+	MSTORE
+	PUSH 0x20
+	PUSH 0
+	RETURN
+l1Cost:
+	;; the argument must be present and fit in 64 bits
+	PUSH 0x24
+	CALLDATASIZE
+	LT
+	JUMPI @fail
+	PUSH 4
+	CALLDATALOAD
+	DUP1
+	PUSH 0x40
+	SHR
+	JUMPI @fail
+	;; reduced size
+	PUSH 90
+	MUL
+	PUSH 100
+	SWAP1
+	DIV
+	;; calldata cost
+	DUP1
+	PUSH 16
+	MUL
+	PUSH 1
+	SLOAD
+	MUL
+	;; blob cost, unless the blob base fee is zero or the blob cost is not active
+	PUSH 2
+	SLOAD
+	DUP1
+	ISZERO
+	JUMPI @calldataCost
+	PUSH 3
+	SLOAD
+	PUSH 0xff
+	AND
+	ISZERO
+	JUMPI @calldataCost
+	;; pro-rata share of the blob gas
+	DUP3
+	PUSH %d
+	MUL
+	PUSH %d
+	SWAP1
+	DIV
+	MUL
+	DUP2
+	DUP2
+	LT
+	JUMPI @blobCost
+calldataCost:
+	POP
+	JUMP @ret
+blobCost:
+	JUMP @ret
+`
+
+func compileGasOracle() []byte {
+	gasOracleABI, err := abi.JSON(strings.NewReader(GasOracle.GasOracleMetaData.ABI))
+	if err != nil {
+		panic(err)
+	}
+	selector := func(method string) string {
+		return fmt.Sprintf("0x%x", gasOracleABI.Methods[method].ID)
+	}
+	src := fmt.Sprintf(gasOracleAsm, selector("l1BlockNumber"), selector("l1BaseFee"), selector("l1BlobBaseFee"), selector("l1Cost"),
+		params.BlobTxBlobGasPerBlob, gas.BlobCapacity)
+
+	compiler := asm.NewCompiler(false)
+	compiler.Feed(asm.Lex([]byte(src), false))
+	code, errs := compiler.Compile()
+	if len(errs) > 0 {
+		panic(fmt.Sprintf("could not compile the GasOracle. Cause: %v", errs))
+	}
+	return gethcommon.FromHex(code)
+}
+
+// IsGasOracleActive - whether the GasOracle is installed and updated in the batch at `height`. The networks which
+// were running before the GasOracle was introduced activate it at a later height, so the existing batches can be
+// replayed.
+func IsGasOracleActive(height uint64, activationHeight uint64) bool {
+	return height >= activationHeight
+}
+
+// DeployGasOracle - installs the GasOracle predeploy. Called in the system contracts genesis batch, or in the first
+// batch of the activation height.
+func DeployGasOracle(stateDB *state.StateDB) {
+	stateDB.CreateAccount(GasOracleAddress)
+	stateDB.CreateContract(GasOracleAddress)
+	stateDB.SetNonce(GasOracleAddress, 1)
+	stateDB.SetCode(GasOracleAddress, gasOracleCode)
+}
+
+// UpdateGasOracle - publishes the prices of the L1 block of the batch to the GasOracle system contract, from the
+// activation height of the GasOracle. The prices are the ones charged by the gas oracle of the enclave for the batch.
+// The prices are written directly into the storage of the contract rather than through a synthetic transaction, so the
+// update does not add a receipt to every batch and the transactions of the batch see the prices from the start.
+func (s *systemContractCallbacks) UpdateGasOracle(stateDB *state.StateDB, batchHeight uint64, prices *gas.L1Prices) {
+	if !IsGasOracleActive(batchHeight, s.gasOracleActivationHeight) {
+		return
+	}
+	// the networks which activate the GasOracle after the genesis of the system contracts install it in the first batch
+	// of the activation height
+	if stateDB.GetCodeSize(GasOracleAddress) == 0 {
+		DeployGasOracle(stateDB)
+	}
+	stateDB.SetState(GasOracleAddress, gasOracleL1BlockNumberSlot, gethcommon.BigToHash(prices.BlockNumber))
+	stateDB.SetState(GasOracleAddress, gasOracleL1BaseFeeSlot, gethcommon.BigToHash(prices.BaseFee))
+	stateDB.SetState(GasOracleAddress, gasOracleL1BlobBaseFeeSlot, gethcommon.BigToHash(prices.BlobBaseFee))
+	blobCostActive := gethcommon.Hash{}
+	if prices.BlobCostActive {
+		blobCostActive = gethcommon.BigToHash(big.NewInt(1))
+	}
+	stateDB.SetState(GasOracleAddress, gasOracleBlobCostActiveSlot, blobCostActive)
+}
@@ -0,0 +1,280 @@
+package system
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethcore "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+	"github.com/ten-protocol/go-ten/contracts/generated/GasOracle"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/gas"
+)
+
+var (
+	testSysContractsOwner = gethcommon.HexToAddress("0x1000000000000000000000000000000000000001")
+	testGasOracleABI, _   = abi.JSON(strings.NewReader(GasOracle.GasOracleMetaData.ABI))
+)
+
+func TestGasOracleIsDeployedAtGenesis(t *testing.T) {
+	stateDB, evm := newTestEVM(t)
+	callbacks := runSysContractGenesis(t, stateDB, evm, 0)
+
+	if callbacks.GasOracle() == nil || *callbacks.GasOracle() != GasOracleAddress {
+		t.Fatalf("gas oracle not registered: %v", callbacks.GasOracle())
+	}
+	if callbacks.PublicCallbackHandler() == nil {
+		t.Fatalf("the system contracts deployed by the SystemDeployer were not registered")
+	}
+
+	excessBlobGas := uint64(10_000_000)
+	l1Block := &types.Header{Number: big.NewInt(1234), BaseFee: big.NewInt(20_000_000_000), ExcessBlobGas: &excessBlobGas}
+	callbacks.UpdateGasOracle(stateDB, 2, gas.NewGasOracle(0).L1Prices(l1Block, 2))
+
+	prices := readGasOraclePrices(t, evm)
+	if prices[0].Uint64() != 1234 || prices[1].Cmp(l1Block.BaseFee) != 0 {
+		t.Fatalf("unexpected prices: %v", prices)
+	}
+	blobBaseFee := prices[2]
+	if blobBaseFee.Sign() <= 0 {
+		t.Fatalf("unexpected blob base fee: %v", blobBaseFee)
+	}
+
+	// 1000 bytes are charged the pro-rata share of the blob they use (900 * 131072 / 126976 blob gas), which is cheaper
+	// than the calldata
+	expected := new(big.Int).Mul(big.NewInt(929), blobBaseFee)
+	if cost := callGasOracle(t, evm, "l1Cost", big.NewInt(1000)); cost.Cmp(expected) != 0 {
+		t.Fatalf("unexpected l1 cost. want %v, got %v", expected, cost)
+	}
+}
+
+func TestGasOracleChargesCalldataWithoutBlobs(t *testing.T) {
+	stateDB, evm := newTestEVM(t)
+	callbacks := runSysContractGenesis(t, stateDB, evm, 0)
+
+	callbacks.UpdateGasOracle(stateDB, 2, gas.NewGasOracle(0).L1Prices(&types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(7)}, 2))
+
+	expected := big.NewInt(900 * 16 * 7)
+	if cost := callGasOracle(t, evm, "l1Cost", big.NewInt(1000)); cost.Cmp(expected) != 0 {
+		t.Fatalf("unexpected l1 cost. want %v, got %v", expected, cost)
+	}
+
+	// sizes which don't fit in 64 bits revert
+	data, _ := testGasOracleABI.Pack("l1Cost", new(big.Int).Lsh(big.NewInt(1), 64))
+	if _, _, err := evm.Call(vm.AccountRef(testSysContractsOwner), GasOracleAddress, data, 100_000, uint256.NewInt(0)); err == nil {
+		t.Fatalf("expected the call to revert")
+	}
+}
+
+// the networks which were running before the GasOracle was introduced install it at the activation height
+func TestGasOracleIsInstalledAtTheActivationHeight(t *testing.T) {
+	stateDB, evm := newTestEVM(t)
+	const activationHeight = 10
+	callbacks := runSysContractGenesis(t, stateDB, evm, activationHeight)
+	if callbacks.GasOracle() != nil || stateDB.GetCodeSize(GasOracleAddress) != 0 {
+		t.Fatalf("gas oracle installed before the activation height")
+	}
+
+	l1Block := &types.Header{Number: big.NewInt(1234), BaseFee: big.NewInt(7)}
+	callbacks.UpdateGasOracle(stateDB, activationHeight-1, gas.L1PricesOf(l1Block))
+	if stateDB.GetCodeSize(GasOracleAddress) != 0 {
+		t.Fatalf("gas oracle installed before the activation height")
+	}
+
+	callbacks.UpdateGasOracle(stateDB, activationHeight, gas.L1PricesOf(l1Block))
+	if prices := readGasOraclePrices(t, evm); prices[0].Uint64() != 1234 || prices[1].Uint64() != 7 {
+		t.Fatalf("unexpected prices: %v", prices)
+	}
+}
+
+// the GasOracle quotes the L1 cost with the pricing mode charged by the enclave, on both sides of the activation height
+// of the blob cost
+func TestGasOracleQuotesFollowTheBlobCostActivationHeight(t *testing.T) {
+	stateDB, evm := newTestEVM(t)
+	callbacks := runSysContractGenesis(t, stateDB, evm, 0)
+	const blobCostActivationHeight = 10
+	oracle := gas.NewGasOracle(blobCostActivationHeight)
+	excessBlobGas := uint64(0)
+	l1Block := &types.Header{Number: big.NewInt(1234), BaseFee: big.NewInt(7), ExcessBlobGas: &excessBlobGas}
+
+	// only the calldata is charged before the activation height, even though the blobs are cheaper
+	callbacks.UpdateGasOracle(stateDB, blobCostActivationHeight-1, oracle.L1Prices(l1Block, blobCostActivationHeight-1))
+	expected := big.NewInt(900 * 16 * 7)
+	if cost := callGasOracle(t, evm, "l1Cost", big.NewInt(1000)); cost.Cmp(expected) != 0 {
+		t.Fatalf("unexpected l1 cost before the activation height. want %v, got %v", expected, cost)
+	}
+
+	callbacks.UpdateGasOracle(stateDB, blobCostActivationHeight, oracle.L1Prices(l1Block, blobCostActivationHeight))
+	expected = new(big.Int).Mul(big.NewInt(929), readGasOraclePrices(t, evm)[2])
+	if cost := callGasOracle(t, evm, "l1Cost", big.NewInt(1000)); cost.Cmp(expected) != 0 {
+		t.Fatalf("unexpected l1 cost from the activation height. want %v, got %v", expected, cost)
+	}
+}
+
+// the hand-written code of the GasOracle must behave like GasOracle.sol compiled by solc, for every function and every
+// storage slot. The test is skipped when solc is not installed
+func TestGasOracleCodeMatchesTheSolidity(t *testing.T) {
+	stateDB, evm := newTestEVM(t)
+	DeployGasOracle(stateDB)
+	solidityAddress := gethcommon.HexToAddress("0x7e00000000000000000000000000000000000002")
+	stateDB.CreateAccount(solidityAddress)
+	stateDB.CreateContract(solidityAddress)
+	stateDB.SetCode(solidityAddress, compileGasOracleSolidity(t))
+	stateDB.AddBalance(testSysContractsOwner, uint256.NewInt(1), tracing.BalanceChangeUnspecified)
+
+	var calls [][]byte
+	for _, method := range testGasOracleABI.Methods {
+		if len(method.Inputs) > 0 {
+			continue
+		}
+		calls = append(calls, method.ID)
+	}
+	maxUint64 := new(big.Int).SetUint64(^uint64(0))
+	for _, size := range []*big.Int{
+		big.NewInt(0), big.NewInt(1), big.NewInt(1000), big.NewInt(gas.BlobCapacity), big.NewInt(10 * gas.BlobCapacity),
+		maxUint64, new(big.Int).Add(maxUint64, big.NewInt(1)), new(big.Int).Lsh(big.NewInt(1), 255),
+	} {
+		data, err := testGasOracleABI.Pack("l1Cost", size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		calls = append(calls, data)
+	}
+	// the malformed calls
+	calls = append(calls, nil, []byte{1, 2, 3}, []byte{0xde, 0xad, 0xbe, 0xef}, testGasOracleABI.Methods["l1Cost"].ID)
+
+	for _, prices := range [][4]int64{
+		{0, 0, 0, 0},
+		{1234, 7, 1, 1},
+		{1234, 7, 1, 0},
+		{1234, 7, 1, 0x100},
+		{1234, 20_000_000_000, 0, 1},
+		{1234, 1, 100, 1},
+		{1234, 1_000_000_000_000_000, 1_000_000_000_000_000, 1},
+	} {
+		for i, slot := range []gethcommon.Hash{gasOracleL1BlockNumberSlot, gasOracleL1BaseFeeSlot, gasOracleL1BlobBaseFeeSlot, gasOracleBlobCostActiveSlot} {
+			stateDB.SetState(GasOracleAddress, slot, gethcommon.BigToHash(big.NewInt(prices[i])))
+			stateDB.SetState(solidityAddress, slot, gethcommon.BigToHash(big.NewInt(prices[i])))
+		}
+		for _, data := range calls {
+			for _, value := range []uint64{0, 1} {
+				ret, _, err := evm.Call(vm.AccountRef(testSysContractsOwner), GasOracleAddress, data, 100_000, uint256.NewInt(value))
+				expectedRet, _, expectedErr := evm.Call(vm.AccountRef(testSysContractsOwner), solidityAddress, data, 100_000, uint256.NewInt(value))
+				if (err == nil) != (expectedErr == nil) || (err == nil && !bytes.Equal(ret, expectedRet)) {
+					t.Fatalf("prices %v, call %x, value %d: got %x (%v), solidity returned %x (%v)", prices, data, value, ret, err, expectedRet, expectedErr)
+				}
+			}
+		}
+	}
+}
+
+// compileGasOracleSolidity - returns the runtime code of GasOracle.sol compiled by solc
+func compileGasOracleSolidity(t *testing.T) []byte {
+	solc, err := exec.LookPath("solc")
+	if err != nil {
+		t.Skip("solc is not installed")
+	}
+	out, err := exec.Command(solc, "--combined-json", "bin-runtime", "../../../contracts/src/system/GasOracle.sol").Output()
+	if err != nil {
+		t.Fatalf("could not compile GasOracle.sol: %v", err)
+	}
+	var compiled struct {
+		Contracts map[string]struct {
+			BinRuntime string `json:"bin-runtime"`
+		} `json:"contracts"`
+	}
+	if err = json.Unmarshal(out, &compiled); err != nil {
+		t.Fatal(err)
+	}
+	for name, contract := range compiled.Contracts {
+		if strings.HasSuffix(name, ":GasOracle") {
+			return gethcommon.FromHex(contract.BinRuntime)
+		}
+	}
+	t.Fatalf("GasOracle not found in the output of solc")
+	return nil
+}
+
+func newTestEVM(t *testing.T) (*state.StateDB, *vm.EVM) {
+	stateDB, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	random := gethcommon.Hash{}
+	blockCtx := vm.BlockContext{
+		CanTransfer: gethcore.CanTransfer,
+		Transfer:    gethcore.Transfer,
+		GetHash:     func(uint64) gethcommon.Hash { return gethcommon.Hash{} },
+		BlockNumber: big.NewInt(int64(common.L2SysContractGenesisSeqNo)),
+		Time:        1,
+		Difficulty:  big.NewInt(0),
+		BaseFee:     big.NewInt(0),
+		BlobBaseFee: big.NewInt(0),
+		GasLimit:    30_000_000,
+		Random:      &random,
+	}
+	return stateDB, vm.NewEVM(blockCtx, vm.TxContext{GasPrice: big.NewInt(0)}, stateDB, params.AllDevChainProtocolChanges, vm.Config{NoBaseFee: true})
+}
+
+// runSysContractGenesis - executes the SystemDeployer and installs the GasOracle like the system contracts genesis batch,
+// and returns the callbacks initialised from the resulting receipt
+func runSysContractGenesis(t *testing.T, stateDB *state.StateDB, evm *vm.EVM, gasOracleActivationHeight uint64) *systemContractCallbacks {
+	tx, err := SystemDeployerInitTransaction(gethlog.New(), testSysContractsOwner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateDB.SetTxContext(tx.Hash(), 0)
+	if _, _, _, err = evm.Create(vm.AccountRef(testSysContractsOwner), tx.Data(), tx.Gas(), uint256.NewInt(0)); err != nil {
+		t.Fatalf("system deployer failed: %v", err)
+	}
+	const genesisHeight = 1
+	if IsGasOracleActive(genesisHeight, gasOracleActivationHeight) {
+		DeployGasOracle(stateDB)
+	}
+
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful, Logs: stateDB.GetLogs(tx.Hash(), 0, gethcommon.Hash{})}
+	batch := &core.Batch{Header: &common.BatchHeader{Number: big.NewInt(genesisHeight), SequencerOrderNo: big.NewInt(int64(common.L2SysContractGenesisSeqNo))}}
+	addresses, err := verifyAndDeriveAddresses(batch, receipt, gasOracleActivationHeight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &systemContractCallbacks{systemAddresses: addresses, gasOracleActivationHeight: gasOracleActivationHeight, logger: gethlog.New()}
+}
+
+func readGasOraclePrices(t *testing.T, evm *vm.EVM) []*big.Int {
+	return []*big.Int{
+		callGasOracle(t, evm, "l1BlockNumber"),
+		callGasOracle(t, evm, "l1BaseFee"),
+		callGasOracle(t, evm, "l1BlobBaseFee"),
+	}
+}
+
+func callGasOracle(t *testing.T, evm *vm.EVM, method string, args ...interface{}) *big.Int {
+	data, err := testGasOracleABI.Pack(method, args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ret, _, err := evm.Call(vm.AccountRef(testSysContractsOwner), GasOracleAddress, data, 100_000, uint256.NewInt(0))
+	if err != nil {
+		t.Fatalf("calling %s failed: %v", method, err)
+	}
+	res, err := testGasOracleABI.Unpack(method, ret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res[0].(*big.Int)
+}
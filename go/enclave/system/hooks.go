@@ -2,10 +2,12 @@ package system
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"strings"
 
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/log"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -18,6 +20,7 @@ import (
 	"github.com/ten-protocol/go-ten/contracts/generated/ZenBase"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
+	"github.com/ten-protocol/go-ten/go/enclave/gas"
 	"github.com/ten-protocol/go-ten/go/enclave/storage"
 )
 
@@ -32,6 +35,7 @@ type SystemContractCallbacks interface {
 	PublicCallbackHandler() *gethcommon.Address
 	TransactionPostProcessor() *gethcommon.Address
 	SystemContractsUpgrader() *gethcommon.Address
+	GasOracle() *gethcommon.Address
 	PublicSystemContracts() map[string]*gethcommon.Address
 	// Initialization
 	Initialize(batch *core.Batch, receipts types.Receipt, msgBusManager SystemContractsInitializable) error
@@ -40,6 +44,7 @@ type SystemContractCallbacks interface {
 	// Usage
 	CreateOnBatchEndTransaction(ctx context.Context, stateDB *state.StateDB, results core.TxExecResults) (*types.Transaction, error)
 	CreatePublicCallbackHandlerTransaction(ctx context.Context, stateDB *state.StateDB) (*types.Transaction, error)
+	UpdateGasOracle(stateDB *state.StateDB, batchHeight uint64, prices *gas.L1Prices)
 
 	// VerifyOnBlockReceipt - used for debugging
 	VerifyOnBlockReceipt(transactions common.L2Transactions, receipt *types.Receipt) (bool, error)
@@ -54,17 +59,19 @@ type systemContractCallbacks struct {
 	storage                          storage.Storage
 	systemAddresses                  common.SystemContractAddresses
 	systemContractsUpgrader          *gethcommon.Address
+	gasOracleActivationHeight        uint64
 
 	logger gethlog.Logger
 }
 
-func NewSystemContractCallbacks(storage storage.Storage, upgrader *gethcommon.Address, logger gethlog.Logger) SystemContractCallbacks {
+func NewSystemContractCallbacks(storage storage.Storage, upgrader *gethcommon.Address, gasOracleActivationHeight uint64, logger gethlog.Logger) SystemContractCallbacks {
 	return &systemContractCallbacks{
 		transactionsPostProcessorAddress: nil,
 		logger:                           logger,
 		storage:                          storage,
 		systemAddresses:                  make(common.SystemContractAddresses),
 		systemContractsUpgrader:          upgrader,
+		gasOracleActivationHeight:        gasOracleActivationHeight,
 	}
 }
 
@@ -80,6 +87,10 @@ func (s *systemContractCallbacks) PublicCallbackHandler() *gethcommon.Address {
 	return s.systemAddresses["PublicCallbacks"]
 }
 
+func (s *systemContractCallbacks) GasOracle() *gethcommon.Address {
+	return s.systemAddresses[GasOracleName]
+}
+
 func (s *systemContractCallbacks) PublicSystemContracts() map[string]*gethcommon.Address {
 	return s.systemAddresses
 }
@@ -125,12 +136,22 @@ func (s *systemContractCallbacks) StoreSystemContractAddresses(addresses common.
 func (s *systemContractCallbacks) Initialize(batch *core.Batch, receipt types.Receipt, msgBusManager SystemContractsInitializable) error {
 	s.logger.Info("Initialize: Starting initialization of system contracts", "batchSeqNo", batch.SeqNo())
 
-	addresses, err := verifyAndDeriveAddresses(batch, &receipt)
+	addresses, err := verifyAndDeriveAddresses(batch, &receipt, s.gasOracleActivationHeight)
 	if err != nil {
 		s.logger.Error("Initialize: Failed verifying and deriving addresses", "error", err)
 		return fmt.Errorf("failed verifying and deriving addresses %w", err)
 	}
 
+	// the genesis of the system contracts is replayed after an unclean shutdown, when its state was not persisted
+	stored, err := s.storage.GetSystemContractAddresses(context.Background())
+	if err == nil {
+		s.logger.Info("Initialize: System contract addresses already stored", "addresses", stored)
+		return s.initializeRequiredAddresses(stored, msgBusManager)
+	}
+	if !errors.Is(err, errutil.ErrNotFound) {
+		return fmt.Errorf("failed fetching system contract addresses %w", err)
+	}
+
 	if err := s.StoreSystemContractAddresses(addresses); err != nil {
 		s.logger.Error("Initialize: Failed storing system contract addresses", "error", err)
 		return fmt.Errorf("failed storing system contract addresses %w", err)
@@ -140,7 +161,7 @@ func (s *systemContractCallbacks) Initialize(batch *core.Batch, receipt types.Re
 	return s.initializeRequiredAddresses(addresses, msgBusManager)
 }
 
-func verifyAndDeriveAddresses(batch *core.Batch, receipt *types.Receipt) (common.SystemContractAddresses, error) {
+func verifyAndDeriveAddresses(batch *core.Batch, receipt *types.Receipt, gasOracleActivationHeight uint64) (common.SystemContractAddresses, error) {
 	if batch.SeqNo().Uint64() != common.L2SysContractGenesisSeqNo {
 		return nil, fmt.Errorf("batch is not genesis")
 	}
@@ -149,6 +170,11 @@ func verifyAndDeriveAddresses(batch *core.Batch, receipt *types.Receipt) (common
 	if err != nil {
 		return nil, fmt.Errorf("failed deriving addresses %w", err)
 	}
+	// the GasOracle is not deployed by the SystemDeployer, but installed at a fixed address in the same batch, unless
+	// the network activates it later
+	if IsGasOracleActive(batch.NumberU64(), gasOracleActivationHeight) {
+		addresses[GasOracleName] = &GasOracleAddress
+	}
 
 	return addresses, nil
 }
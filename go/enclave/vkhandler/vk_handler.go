@@ -1,12 +1,18 @@
 package vkhandler //nolint:typecheck
 
 import (
+	"bytes"
+	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"io"
+	"sync/atomic"
+	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/viewingkey"
 	"gitlab.com/NebulousLabs/fastrand"
 
@@ -22,6 +28,15 @@ type AuthenticatedViewingKey struct {
 	AccountAddress *gethcommon.Address
 	ecdsaKey       *ecies.PublicKey
 	UserID         []byte
+	Delegator      *gethcommon.Address // the account that delegated read access to this viewing key, if any
+	delegation     *viewingkey.Delegation
+	revoked        atomic.Bool // set when the delegator revoked the delegation
+}
+
+// DelegationRevocations - the revocations of the delegations recorded by the enclave
+type DelegationRevocations interface {
+	// FetchDelegationRevocation returns the time of the last revocation, or errutil.ErrNotFound
+	FetchDelegationRevocation(ctx context.Context, delegator gethcommon.Address, delegate []byte) (uint64, error)
 }
 
 func VerifyViewingKey(rpcVK *viewingkey.RPCSignedViewingKey, chainID int64) (*AuthenticatedViewingKey, error) {
@@ -47,9 +62,64 @@ func VerifyViewingKey(rpcVK *viewingkey.RPCSignedViewingKey, chainID int64) (*Au
 	}
 
 	rvk.AccountAddress = recoveredAccountAddress
+
+	// 3. Authenticate the delegation
+	if rpcVK.Delegation != nil {
+		if !bytes.Equal(rpcVK.Delegation.Delegate, rvk.UserID) {
+			return nil, fmt.Errorf("the delegation was issued for a different viewing key")
+		}
+		delegator, err := viewingkey.CheckDelegationSignature(rpcVK.Delegation, chainID)
+		if err != nil {
+			return nil, err
+		}
+		rvk.Delegator = delegator
+		rvk.delegation = rpcVK.Delegation
+	}
 	return rvk, nil
 }
 
+// CheckRevocation - revokes the delegation of the viewing key if the delegator revoked the delegations to this viewing
+// key after the start of the delegation. A revocation can't be undone, but the delegator can sign a new delegation
+// which starts after the revocation.
+func (vk *AuthenticatedViewingKey) CheckRevocation(ctx context.Context, revocations DelegationRevocations) error {
+	if vk.Delegator == nil || vk.revoked.Load() {
+		return nil
+	}
+	revokedAt, err := revocations.FetchDelegationRevocation(ctx, *vk.Delegator, vk.UserID)
+	if err != nil {
+		if errors.Is(err, errutil.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("could not fetch delegation revocation. Cause: %w", err)
+	}
+	if vk.delegation.NotBefore <= revokedAt {
+		vk.revoked.Store(true)
+	}
+	return nil
+}
+
+// CanRead - true if the viewing key was signed by the account, or if the account delegated read access
+// to the viewing key and the delegation is active
+func (vk *AuthenticatedViewingKey) CanRead(account gethcommon.Address) bool {
+	if *vk.AccountAddress == account {
+		return true
+	}
+	return vk.isDelegationActive() && *vk.Delegator == account
+}
+
+// ReadableAccounts - the accounts whose private data can currently be read with this viewing key
+func (vk *AuthenticatedViewingKey) ReadableAccounts() []*gethcommon.Address {
+	accounts := []*gethcommon.Address{vk.AccountAddress}
+	if vk.isDelegationActive() {
+		accounts = append(accounts, vk.Delegator)
+	}
+	return accounts
+}
+
+func (vk *AuthenticatedViewingKey) isDelegationActive() bool {
+	return vk.Delegator != nil && !vk.revoked.Load() && vk.delegation.IsActive(time.Now())
+}
+
 // checkViewingKeyAndRecoverAddress checks the signature and recovers the address from the viewing key
 func checkViewingKeyAndRecoverAddress(vk *AuthenticatedViewingKey, chainID int64) (*gethcommon.Address, error) {
 	// get userID from viewingKey public key
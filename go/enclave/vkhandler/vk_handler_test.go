@@ -1,15 +1,21 @@
 package vkhandler
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/wallet"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/ecies"
 	"github.com/stretchr/testify/assert"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/viewingkey"
 )
 
@@ -144,3 +150,96 @@ func TestVerifyViewingKey(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyDelegatedViewingKey(t *testing.T) {
+	accountKey, _, _, account := generateRandomUserKeys()
+	auditorKey, _, _, auditor := generateRandomUserKeys()
+	accountWallet := wallet.NewInMemoryWalletFromPK(big.NewInt(chainID), accountKey, gethlog.New())
+	auditorWallet := wallet.NewInMemoryWalletFromPK(big.NewInt(chainID), auditorKey, gethlog.New())
+
+	auditorVK, err := viewingkey.GenerateViewingKeyForWallet(auditorWallet)
+	assert.NoError(t, err)
+	otherVK, err := viewingkey.GenerateViewingKeyForWallet(auditorWallet)
+	assert.NoError(t, err)
+
+	verify := func(vk *viewingkey.ViewingKey, delegation *viewingkey.Delegation) (*AuthenticatedViewingKey, error) {
+		return VerifyViewingKey(&viewingkey.RPCSignedViewingKey{
+			PublicKey:               vk.PublicKey,
+			SignatureWithAccountKey: vk.SignatureWithAccountKey,
+			SignatureType:           vk.SignatureType,
+			Delegation:              delegation,
+		}, chainID)
+	}
+
+	active, err := viewingkey.GenerateDelegation(accountWallet, auditorVK, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	authVK, err := verify(auditorVK, active)
+	assert.NoError(t, err)
+	assert.Equal(t, auditor, *authVK.AccountAddress)
+	assert.Equal(t, account, *authVK.Delegator)
+	assert.True(t, authVK.CanRead(auditor))
+	assert.True(t, authVK.CanRead(account))
+	assert.Len(t, authVK.ReadableAccounts(), 2)
+
+	// the delegation can't be used with another viewing key
+	_, err = verify(otherVK, active)
+	assert.Error(t, err)
+
+	// the delegation can't be altered
+	tampered := *active
+	tampered.NotAfter += 1000
+	authVK, err = verify(auditorVK, &tampered)
+	assert.NoError(t, err)
+	assert.NotEqual(t, account, *authVK.Delegator)
+
+	// an expired delegation doesn't grant access
+	expired, err := viewingkey.GenerateDelegation(accountWallet, auditorVK, time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	authVK, err = verify(auditorVK, expired)
+	assert.NoError(t, err)
+	assert.False(t, authVK.CanRead(account))
+	assert.Len(t, authVK.ReadableAccounts(), 1)
+}
+
+type testRevocations map[gethcommon.Address]uint64
+
+func (r testRevocations) FetchDelegationRevocation(_ context.Context, delegator gethcommon.Address, _ []byte) (uint64, error) {
+	revokedAt, ok := r[delegator]
+	if !ok {
+		return 0, errutil.ErrNotFound
+	}
+	return revokedAt, nil
+}
+
+func TestRevokeDelegation(t *testing.T) {
+	accountKey, _, _, account := generateRandomUserKeys()
+	auditorKey, _, _, _ := generateRandomUserKeys()
+	accountWallet := wallet.NewInMemoryWalletFromPK(big.NewInt(chainID), accountKey, gethlog.New())
+	auditorWallet := wallet.NewInMemoryWalletFromPK(big.NewInt(chainID), auditorKey, gethlog.New())
+	auditorVK, err := viewingkey.GenerateViewingKeyForWallet(auditorWallet)
+	assert.NoError(t, err)
+
+	start := time.Now().Add(-time.Hour)
+	delegation, err := viewingkey.GenerateDelegation(accountWallet, auditorVK, start, time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	verify := func() *AuthenticatedViewingKey {
+		authVK, err := VerifyViewingKey(&viewingkey.RPCSignedViewingKey{
+			PublicKey:               auditorVK.PublicKey,
+			SignatureWithAccountKey: auditorVK.SignatureWithAccountKey,
+			SignatureType:           auditorVK.SignatureType,
+			Delegation:              delegation,
+		}, chainID)
+		assert.NoError(t, err)
+		return authVK
+	}
+
+	// the delegator revoked an earlier delegation, before this one started
+	authVK := verify()
+	assert.NoError(t, authVK.CheckRevocation(context.Background(), testRevocations{account: uint64(start.Unix()) - 1}))
+	assert.True(t, authVK.CanRead(account))
+
+	authVK = verify()
+	assert.NoError(t, authVK.CheckRevocation(context.Background(), testRevocations{account: uint64(time.Now().Unix())}))
+	assert.False(t, authVK.CanRead(account))
+	assert.Len(t, authVK.ReadableAccounts(), 1)
+}
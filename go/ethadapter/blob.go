@@ -3,7 +3,9 @@ package ethadapter
 import (
 	"bytes"
 	"fmt"
+	"math/big"
 
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core/types"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -22,6 +24,38 @@ const (
 	MaxBlobBytes   = 32 * 4096
 )
 
+// NextBlobBaseFee returns the blob base fee of the block following `head`, or nil if the L1 does not support blobs
+func NextBlobBaseFee(head *types.Header) *big.Int {
+	if head.ExcessBlobGas == nil || head.BlobGasUsed == nil {
+		return nil
+	}
+	return eip4844.CalcBlobFee(eip4844.CalcExcessBlobGas(*head.ExcessBlobGas, *head.BlobGasUsed))
+}
+
+// BlobsCost returns the cost of publishing the data in blobs at the given blob base fee
+func BlobsCost(data []byte, blobBaseFee *big.Int) (*big.Int, error) {
+	blobs, err := EncodeBlobs(data)
+	if err != nil {
+		return nil, err
+	}
+	blobGas := new(big.Int).SetUint64(uint64(len(blobs)) * params.BlobTxBlobGasPerBlob)
+	return blobGas.Mul(blobGas, blobBaseFee), nil
+}
+
+// CalldataCost returns the cost of publishing the data in the calldata at the given base fee
+func CalldataCost(data []byte, baseFee *big.Int) *big.Int {
+	var gas uint64
+	for _, b := range data {
+		if b == 0 {
+			gas += params.TxDataZeroGas
+		} else {
+			gas += params.TxDataNonZeroGasEIP2028
+		}
+	}
+	cost := new(big.Int).SetUint64(gas)
+	return cost.Mul(cost, baseFee)
+}
+
 // MakeSidecar builds & returns the BlobTxSidecar and corresponding blob hashes from the raw blob
 // data.
 func MakeSidecar(blobs []*kzg4844.Blob) (*types.BlobTxSidecar, []gethcommon.Hash, error) {
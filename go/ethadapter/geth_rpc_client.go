@@ -8,7 +8,6 @@ import (
 	"math/big"
 	"time"
 
-	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/holiman/uint256"
 
@@ -18,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	lru "github.com/hashicorp/golang-lru/v2"
 
@@ -196,6 +196,17 @@ func (e *gethRPCClient) BlockNumber() (uint64, error) {
 	return e.client.BlockNumber(ctx)
 }
 
+func (e *gethRPCClient) FinalizedBlockNumber() (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
+	defer cancel()
+
+	header, err := e.client.HeaderByNumber(ctx, big.NewInt(int64(rpc.FinalizedBlockNumber)))
+	if err != nil {
+		return 0, err
+	}
+	return header.Number.Uint64(), nil
+}
+
 func (e *gethRPCClient) BlockByNumber(n *big.Int) (*types.Block, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), e.timeout)
 	defer cancel()
@@ -360,9 +371,9 @@ func (e *gethRPCClient) prepareBlobTxToRetry(ctx context.Context, txData types.T
 	} else if head.BaseFee == nil {
 		return nil, fmt.Errorf("txmgr does not support pre-london blocks that do not have a base fee")
 	}
-	var blobBaseFee *big.Int
-	if head.ExcessBlobGas != nil {
-		blobBaseFee = eip4844.CalcBlobFee(*head.ExcessBlobGas)
+	blobBaseFee := NextBlobBaseFee(head)
+	if blobBaseFee == nil {
+		return nil, fmt.Errorf("the L1 does not support blob transactions")
 	}
 	blobFeeCap := calcBlobFeeCap(blobBaseFee, retryNumber)
 
@@ -425,8 +436,9 @@ func connect(rpcURL string, connectionTimeout time.Duration) (*ethclient.Client,
 	return c, err
 }
 
-// calcBlobFeeCap computes a suggested blob fee cap that is twice the current header's blob base fee
-// value, with a minimum value of minBlobTxFee. It also doubles the blob fee cap based on the retry number.
+// calcBlobFeeCap computes a suggested blob fee cap that is twice the blob base fee of the next block, with a minimum
+// value of minBlobTxFee. It also doubles the blob fee cap for each retry, up to _maxRetryPriceIncreases times, since the
+// L1 mempool only accepts a replacement blob transaction if its blob fee cap is at least twice the previous one.
 func calcBlobFeeCap(blobBaseFee *big.Int, retryNumber int) *big.Int {
 	// Base calculation: twice the current blob base fee
 	blobFeeCap := new(big.Int).Mul(blobBaseFee, big.NewInt(2))
@@ -437,8 +449,9 @@ func calcBlobFeeCap(blobBaseFee *big.Int, retryNumber int) *big.Int {
 	}
 
 	// Double the blob fee cap for each retry attempt
-	if retryNumber > 0 {
-		multiplier := new(big.Int).Exp(big.NewInt(2), big.NewInt(int64(retryNumber)), nil)
+	retries := min(retryNumber, _maxRetryPriceIncreases)
+	if retries > 0 {
+		multiplier := new(big.Int).Exp(big.NewInt(_retryPriceMultiplier), big.NewInt(int64(retries)), nil)
 		blobFeeCap.Mul(blobFeeCap, multiplier)
 	}
 
@@ -17,6 +17,7 @@ import (
 // todo (#1617) - some of these methods are composed calls that should be decoupled in the future (ie: BlocksBetween or IsBlockAncestor)
 type EthClient interface {
 	BlockNumber() (uint64, error)                                                 // retrieves the number of the head block
+	FinalizedBlockNumber() (uint64, error)                                        // retrieves the number of the latest finalized block
 	BlockByHash(id gethcommon.Hash) (*types.Block, error)                         // retrieves a block given a hash
 	BlockByNumber(n *big.Int) (*types.Block, error)                               // retrieves a block given a number - returns head block if n is nil
 	SendTransaction(signedTx *types.Transaction) error                            // issues an ethereum transaction (expects signed tx)
@@ -4,6 +4,7 @@ import "github.com/ten-protocol/go-ten/contracts/generated/ManagementContract"
 
 const (
 	AddRollupMethod                = "AddRollup"
+	AddCalldataRollupMethod        = "AddCalldataRollup"
 	RespondSecretMethod            = "RespondNetworkSecret"
 	RequestSecretMethod            = "RequestNetworkSecret"
 	InitializeSecretMethod         = "InitializeNetworkSecret" //#nosec
@@ -11,6 +12,8 @@ const (
 	GetImportantContractKeysMethod = "GetImportantContractKeys"
 	SetImportantContractsMethod    = "SetImportantContractAddress"
 	GetImportantAddressMethod      = "importantContractAddresses"
+	ReportFraudMethod              = "ReportFraud"
+	DistributeSecretMethod         = "DistributeNetworkSecret"
 )
 
 var MgmtContractABI = ManagementContract.ManagementContractMetaData.ABI
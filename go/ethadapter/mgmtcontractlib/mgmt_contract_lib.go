@@ -26,9 +26,12 @@ const methodBytesLen = 4
 type MgmtContractLib interface {
 	IsMock() bool
 	CreateBlobRollup(t *common.L1RollupTx) (types.TxData, error)
+	CreateCalldataRollup(t *common.L1RollupTx) (types.TxData, error)
 	CreateRequestSecret(tx *common.L1RequestSecretTx) types.TxData
 	CreateRespondSecret(tx *common.L1RespondSecretTx, verifyAttester bool) types.TxData
 	CreateInitializeSecret(tx *common.L1InitializeSecretTx) types.TxData
+	CreateFraudReport(report *common.FraudReport) (types.TxData, error)
+	CreateSecretDistribution(distribution *common.SecretDistribution) (types.TxData, error)
 
 	// DecodeTx receives a *types.Transaction and converts it to a common.L1Transaction
 	DecodeTx(tx *types.Transaction) common.L1TenTransaction
@@ -76,12 +79,14 @@ func (c *contractLibImpl) GetContractAddr() *gethcommon.Address {
 }
 
 func (c *contractLibImpl) DecodeTx(tx *types.Transaction) common.L1TenTransaction {
-	if tx.To() == nil || tx.To().Hex() != c.addr.Hex() || len(tx.Data()) == 0 {
+	if tx.To() == nil || tx.To().Hex() != c.addr.Hex() || len(tx.Data()) < methodBytesLen {
 		return nil
 	}
 	method, err := c.contractABI.MethodById(tx.Data()[:methodBytesLen])
 	if err != nil {
-		panic(err)
+		// e.g. the fraud reports and the forced transactions, which are not decoded into L1 transactions
+		c.logger.Debug("Ignoring call to a method missing from the management contract ABI", log.TxKey, tx.Hash())
+		return nil
 	}
 
 	contractCallData := map[string]interface{}{}
@@ -94,6 +99,8 @@ func (c *contractLibImpl) DecodeTx(tx *types.Transaction) common.L1TenTransactio
 		} else {
 			return nil
 		}
+	case AddCalldataRollupMethod:
+		return c.unpackCalldataRollupTx(tx, method)
 	case RespondSecretMethod:
 		return c.unpackRespondSecretTx(tx, method, contractCallData)
 
@@ -110,6 +117,14 @@ func (c *contractLibImpl) DecodeTx(tx *types.Transaction) common.L1TenTransactio
 			return nil
 		}
 		return tx
+
+	case DistributeSecretMethod:
+		tx, err := c.unpackSecretDistributionTx(tx, method, contractCallData)
+		if err != nil {
+			c.logger.Warn("could not unpack secret distribution tx", log.ErrKey, err)
+			return nil
+		}
+		return tx
 	}
 
 	return nil
@@ -117,21 +132,7 @@ func (c *contractLibImpl) DecodeTx(tx *types.Transaction) common.L1TenTransactio
 
 // CreateBlobRollup creates a BlobTx, encoding the rollup data into blobs.
 func (c *contractLibImpl) CreateBlobRollup(t *common.L1RollupTx) (types.TxData, error) {
-	decodedRollup, err := common.DecodeRollup(t.Rollup)
-	if err != nil {
-		panic(err)
-	}
-
-	metaRollup := ManagementContract.StructsMetaRollup{
-		Hash:               decodedRollup.Hash(),
-		Signature:          decodedRollup.Header.Signature,
-		LastSequenceNumber: big.NewInt(int64(decodedRollup.Header.LastBatchSeqNo)),
-	}
-
-	crossChain := ManagementContract.StructsHeaderCrossChainData{
-		Messages: convertCrossChainMessages(decodedRollup.Header.CrossChainMessages),
-	}
-
+	metaRollup, crossChain := rollupArgs(t)
 	data, err := c.contractABI.Pack(
 		AddRollupMethod,
 		metaRollup,
@@ -161,6 +162,39 @@ func (c *contractLibImpl) CreateBlobRollup(t *common.L1RollupTx) (types.TxData,
 	}, nil
 }
 
+// CreateCalldataRollup creates a LegacyTx, which publishes the rollup data in the calldata.
+func (c *contractLibImpl) CreateCalldataRollup(t *common.L1RollupTx) (types.TxData, error) {
+	metaRollup, crossChain := rollupArgs(t)
+	data, err := c.contractABI.Pack(AddCalldataRollupMethod, metaRollup, crossChain, []byte(t.Rollup))
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack rollup: %w", err)
+	}
+
+	return &types.LegacyTx{
+		To:   c.addr,
+		Data: data,
+	}, nil
+}
+
+// rollupArgs - the arguments of the management contract methods adding a rollup
+func rollupArgs(t *common.L1RollupTx) (ManagementContract.StructsMetaRollup, ManagementContract.StructsHeaderCrossChainData) {
+	decodedRollup, err := common.DecodeRollup(t.Rollup)
+	if err != nil {
+		panic(err)
+	}
+
+	metaRollup := ManagementContract.StructsMetaRollup{
+		Hash:               decodedRollup.Hash(),
+		Signature:          decodedRollup.Header.Signature,
+		LastSequenceNumber: big.NewInt(int64(decodedRollup.Header.LastBatchSeqNo)),
+	}
+
+	crossChain := ManagementContract.StructsHeaderCrossChainData{
+		Messages: convertCrossChainMessages(decodedRollup.Header.CrossChainMessages),
+	}
+	return metaRollup, crossChain
+}
+
 func (c *contractLibImpl) CreateRequestSecret(tx *common.L1RequestSecretTx) types.TxData {
 	data, err := c.contractABI.Pack(RequestSecretMethod, base64EncodeToString(tx.Attestation))
 	if err != nil {
@@ -207,6 +241,44 @@ func (c *contractLibImpl) CreateInitializeSecret(tx *common.L1InitializeSecretTx
 	}
 }
 
+func (c *contractLibImpl) CreateFraudReport(report *common.FraudReport) (types.TxData, error) {
+	data, err := c.contractABI.Pack(
+		ReportFraudMethod,
+		report.BatchSeqNo,
+		report.BatchHash,
+		report.ExpectedStateRoot,
+		report.ReceivedStateRoot,
+		report.Sequencer,
+		report.EnclaveID,
+		report.Signature,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack the fraud report. Cause: %w", err)
+	}
+	return &types.LegacyTx{
+		To:   c.addr,
+		Data: data,
+	}, nil
+}
+
+func (c *contractLibImpl) CreateSecretDistribution(distribution *common.SecretDistribution) (types.TxData, error) {
+	data, err := c.contractABI.Pack(
+		DistributeSecretMethod,
+		new(big.Int).SetUint64(distribution.Epoch),
+		distribution.AttesterID,
+		distribution.EnclaveIDs,
+		distribution.Secrets,
+		distribution.Signature,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not pack the secret distribution. Cause: %w", err)
+	}
+	return &types.LegacyTx{
+		To:   c.addr,
+		Data: data,
+	}, nil
+}
+
 func (c *contractLibImpl) GetHostAddressesMsg() (ethereum.CallMsg, error) {
 	data, err := c.contractABI.Pack(GetHostAddressesMethod)
 	if err != nil {
@@ -340,6 +412,20 @@ func (c *contractLibImpl) unpackInitSecretTx(tx *types.Transaction, method *abi.
 	}
 }
 
+func (c *contractLibImpl) unpackCalldataRollupTx(tx *types.Transaction, method *abi.Method) *common.L1RollupTx {
+	args, err := method.Inputs.Unpack(tx.Data()[methodBytesLen:])
+	if err != nil {
+		c.logger.Warn("could not unpack calldata rollup tx", log.TxKey, tx.Hash(), log.ErrKey, err)
+		return nil
+	}
+	rollup, ok := args[2].([]byte)
+	if !ok {
+		c.logger.Warn("could not read rollup from calldata rollup tx", log.TxKey, tx.Hash())
+		return nil
+	}
+	return &common.L1RollupTx{Rollup: rollup}
+}
+
 func (c *contractLibImpl) unpackRequestSecretTx(tx *types.Transaction, method *abi.Method, contractCallData map[string]interface{}) *common.L1RequestSecretTx {
 	err := method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[methodBytesLen:])
 	if err != nil {
@@ -429,6 +515,42 @@ func (c *contractLibImpl) unpackSetImportantContractsTx(tx *types.Transaction, m
 	}, nil
 }
 
+func (c *contractLibImpl) unpackSecretDistributionTx(tx *types.Transaction, method *abi.Method, contractCallData map[string]interface{}) (*common.SecretDistribution, error) {
+	err := method.Inputs.UnpackIntoMap(contractCallData, tx.Data()[methodBytesLen:])
+	if err != nil {
+		return nil, fmt.Errorf("could not unpack transaction. Cause: %w", err)
+	}
+
+	epoch, ok := contractCallData["epoch"].(*big.Int)
+	if !ok || !epoch.IsUint64() {
+		return nil, fmt.Errorf("could not decode epoch data")
+	}
+	attesterID, ok := contractCallData["attesterID"].(gethcommon.Address)
+	if !ok {
+		return nil, fmt.Errorf("could not decode attesterID data")
+	}
+	enclaveIDs, ok := contractCallData["enclaveIDs"].([]gethcommon.Address)
+	if !ok {
+		return nil, fmt.Errorf("could not decode enclaveIDs data")
+	}
+	secrets, ok := contractCallData["encryptedSecrets"].([][]byte)
+	if !ok {
+		return nil, fmt.Errorf("could not decode encryptedSecrets data")
+	}
+	signature, ok := contractCallData["signature"].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("could not decode signature data")
+	}
+
+	return &common.SecretDistribution{
+		Epoch:      epoch.Uint64(),
+		AttesterID: attesterID,
+		EnclaveIDs: enclaveIDs,
+		Secrets:    secrets,
+		Signature:  signature,
+	}, nil
+}
+
 // base64EncodeToString encodes a byte array to a string
 func base64EncodeToString(bytes []byte) string {
 	return base64.StdEncoding.EncodeToString(bytes)
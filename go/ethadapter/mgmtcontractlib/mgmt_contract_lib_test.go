@@ -0,0 +1,80 @@
+package mgmtcontractlib
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+func TestCreateFraudReport(t *testing.T) {
+	addr := gethcommon.HexToAddress("0x1234")
+	lib := NewMgmtContractLib(&addr, gethlog.New())
+	report := &common.FraudReport{
+		BatchSeqNo:        42,
+		BatchHash:         gethcommon.HexToHash("0x01"),
+		ExpectedStateRoot: gethcommon.HexToHash("0x02"),
+		ReceivedStateRoot: gethcommon.HexToHash("0x03"),
+		Sequencer:         gethcommon.HexToAddress("0x04"),
+		EnclaveID:         gethcommon.HexToAddress("0x05"),
+		Signature:         []byte{6, 7, 8},
+	}
+
+	txData, err := lib.CreateFraudReport(report)
+	require.NoError(t, err)
+	tx, ok := txData.(*types.LegacyTx)
+	require.True(t, ok)
+	require.Equal(t, addr, *tx.To)
+
+	// the selector must match the function of the management contract
+	selector := crypto.Keccak256([]byte("ReportFraud(uint64,bytes32,bytes32,bytes32,address,address,bytes)"))[:4]
+	require.Equal(t, selector, tx.Data[:4])
+
+	contractABI, err := abi.JSON(strings.NewReader(MgmtContractABI))
+	require.NoError(t, err)
+	args, err := contractABI.Methods[ReportFraudMethod].Inputs.Unpack(tx.Data[4:])
+	require.NoError(t, err)
+	require.Equal(t, report.BatchSeqNo, args[0])
+	require.Equal(t, [32]byte(report.BatchHash), args[1])
+	require.Equal(t, report.Sequencer, args[4])
+	require.Equal(t, report.Signature, args[6])
+
+	// the hosts must ignore the published reports when processing the L1 blocks
+	require.Nil(t, lib.DecodeTx(types.NewTx(tx)))
+}
+
+func TestCreateCalldataRollup(t *testing.T) {
+	addr := gethcommon.HexToAddress("0x1234")
+	lib := NewMgmtContractLib(&addr, gethlog.New())
+	rollup := &common.ExtRollup{
+		Header: &common.RollupHeader{
+			Signature:      []byte{1, 2, 3},
+			LastBatchSeqNo: 42,
+		},
+		CalldataRollupHeader: []byte{4, 5, 6},
+		BatchPayloads:        []byte{7, 8, 9},
+	}
+	encodedRollup, err := common.EncodeRollup(rollup)
+	require.NoError(t, err)
+
+	txData, err := lib.CreateCalldataRollup(&common.L1RollupTx{Rollup: encodedRollup})
+	require.NoError(t, err)
+	tx, ok := txData.(*types.LegacyTx)
+	require.True(t, ok)
+	require.Equal(t, addr, *tx.To)
+
+	// the selector must match the function of the management contract
+	selector := crypto.Keccak256([]byte("AddCalldataRollup((bytes32,bytes,uint256),((address,uint64,uint32,uint32,bytes,uint8)[]),bytes)"))[:4]
+	require.Equal(t, selector, tx.Data[:4])
+
+	// the hosts and the enclaves read the rollup from the calldata
+	decoded, ok := lib.DecodeTx(types.NewTx(tx)).(*common.L1RollupTx)
+	require.True(t, ok)
+	require.Equal(t, encodedRollup, decoded.Rollup)
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"math/big"
 	"time"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -41,6 +42,16 @@ type HostConfig struct {
 	L1BlockTime time.Duration
 	// CrossChainInterval - The interval at which the host will check for new cross chain data to submit
 	CrossChainInterval time.Duration
+	// MinGasPrice is the minimum priority fee accepted by the mempool of the enclaves
+	MinGasPrice *big.Int
+	// BaseFee is the minimum base fee of the batches
+	BaseFee *big.Int
+	// GasBatchTarget is the gas used by a batch above which the base fee of the next batch increases
+	GasBatchTarget uint64
+	// GasBaseFeeActivationHeight is the batch height from which the base fee is adjusted
+	GasBaseFeeActivationHeight uint64
+	// FraudReportQuorum is the number of distinct validator enclaves whose fraud reports published to the L1 halt a sequencer
+	FraudReportQuorum uint64
 
 	/////
 	// NODE CONFIG
@@ -80,6 +91,8 @@ type HostConfig struct {
 	ClientRPCPortWS uint64
 	// Host on which to handle client RPC requests
 	ClientRPCHost string
+	// Whether to serve the archived blobs on the HTTP port, through the beacon API blob sidecars endpoint
+	BlobArchiveEnabled bool
 	// Addresses on which to connect to the node's enclaves (HA setups may have multiple)
 	EnclaveRPCAddresses []string
 	// P2PBindAddress is the address where the P2P server is bound to
@@ -108,6 +121,8 @@ type HostConfig struct {
 	DebugNamespaceEnabled bool
 	// Whether p2p is enabled or not
 	IsInboundP2PDisabled bool
+	// ResumeSequencers are the sequencers whose halt after a fraud report is lifted when the host starts
+	ResumeSequencers []gethcommon.Address
 }
 
 func HostConfigFromTenConfig(tenCfg *config.TenConfig) *HostConfig {
@@ -131,6 +146,12 @@ func HostConfigFromTenConfig(tenCfg *config.TenConfig) *HostConfig {
 		RollupInterval:     tenCfg.Network.Rollup.Interval,
 		MaxRollupSize:      tenCfg.Network.Rollup.MaxSize,
 		CrossChainInterval: tenCfg.Network.CrossChain.Interval,
+		MinGasPrice:        tenCfg.Network.Gas.MinGasPrice,
+		BaseFee:            tenCfg.Network.Gas.BaseFee,
+		GasBatchTarget:     tenCfg.Network.Gas.BatchTarget,
+
+		GasBaseFeeActivationHeight: tenCfg.Network.Gas.BaseFeeActivationHeight,
+		FraudReportQuorum:          tenCfg.Network.Sequencer.FraudReportQuorum,
 
 		LogLevel: tenCfg.Host.Log.Level,
 		LogPath:  tenCfg.Host.Log.Path,
@@ -144,10 +165,13 @@ func HostConfigFromTenConfig(tenCfg *config.TenConfig) *HostConfig {
 		HasClientRPCWebsockets: tenCfg.Host.RPC.EnableWS,
 		ClientRPCPortWS:        tenCfg.Host.RPC.WSPort,
 		ClientRPCHost:          tenCfg.Host.RPC.Address,
+		BlobArchiveEnabled:     tenCfg.Host.RPC.EnableBlobArchive,
 
 		EnclaveRPCAddresses: tenCfg.Host.Enclave.RPCAddresses,
 		EnclaveRPCTimeout:   tenCfg.Host.Enclave.RPCTimeout,
 
+		ResumeSequencers: toAddresses(tenCfg.Host.Fraud.ResumeSequencers),
+
 		IsInboundP2PDisabled: tenCfg.Host.P2P.IsDisabled,
 		P2PBindAddress:       tenCfg.Host.P2P.BindAddress,
 		P2PConnectionTimeout: tenCfg.Host.P2P.Timeout,
@@ -164,3 +188,15 @@ func HostConfigFromTenConfig(tenCfg *config.TenConfig) *HostConfig {
 		DebugNamespaceEnabled: tenCfg.Host.Debug.EnableDebugNamespace,
 	}
 }
+
+func toAddresses(hexAddresses []string) []gethcommon.Address {
+	addresses := make([]gethcommon.Address, 0, len(hexAddresses))
+	for _, hexAddress := range hexAddresses {
+		// the empty lists are passed as an empty string in the environment variables
+		if hexAddress == "" {
+			continue
+		}
+		addresses = append(addresses, gethcommon.HexToAddress(hexAddress))
+	}
+	return addresses
+}
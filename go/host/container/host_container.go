@@ -19,6 +19,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/host/p2p"
 	"github.com/ten-protocol/go-ten/go/host/rpc/clientapi"
 	"github.com/ten-protocol/go-ten/go/host/rpc/enclaverpc"
+	"github.com/ten-protocol/go-ten/go/host/storage"
 	"github.com/ten-protocol/go-ten/go/wallet"
 	"github.com/ten-protocol/go-ten/lib/gethfork/rpc"
 
@@ -28,11 +29,10 @@ import (
 )
 
 const (
-	APIVersion1       = "1.0"
-	APINamespaceTen   = "ten"
-	APINamespaceScan  = "scan"
-	APINamespaceTest  = "test"
-	APINamespaceDebug = "debug"
+	APIVersion1      = "1.0"
+	APINamespaceTen  = "ten"
+	APINamespaceScan = "scan"
+	APINamespaceTest = "test"
 )
 
 type HostContainer struct {
@@ -144,23 +144,25 @@ func NewHostContainerFromConfig(cfg *hostconfig.HostConfig, logger gethlog.Logge
 		Host:       cfg.ClientRPCHost,
 	}, logger)
 
+	// the host storage is also the local archive of the blobs fetched from the beacon chain
+	hostStorage := storage.NewHostStorageFromConfig(cfg, logger)
 	mgmtContractLib := mgmtcontractlib.NewMgmtContractLib(&cfg.ManagementContractAddress, logger)
 	beaconClient := ethadapter.NewBeaconHTTPClient(new(http.Client), cfg.L1BeaconUrl)
 	// we can add more fallback clients as they become available
 	beaconFallback := ethadapter.NewBeaconHTTPClient(new(http.Client), cfg.L1BlobArchiveUrl)
-	blobResolver := l1.NewBlobResolver(ethadapter.NewL1BeaconClient(beaconClient, beaconFallback))
+	blobResolver := l1.NewBlobResolver(ethadapter.NewL1BeaconClient(beaconClient, beaconFallback), hostStorage, logger)
 	contractAddresses := map[l1.ContractType][]gethcommon.Address{
 		l1.MgmtContract: {cfg.ManagementContractAddress},
 		l1.MsgBus:       {cfg.MessageBusAddress},
 	}
 	l1Data := l1.NewL1DataService(l1Client, logger, mgmtContractLib, blobResolver, contractAddresses)
-	return NewHostContainer(cfg, services, aggP2P, l1Client, l1Data, enclaveClients, mgmtContractLib, ethWallet, rpcServer, logger, metricsService, blobResolver)
+	return NewHostContainer(cfg, services, aggP2P, l1Client, l1Data, enclaveClients, mgmtContractLib, ethWallet, rpcServer, logger, metricsService, blobResolver, hostStorage)
 }
 
 // NewHostContainer builds a host container with dependency injection rather than from config.
 // Useful for testing etc. (want to be able to pass in logger, and also have option to mock out dependencies)
-func NewHostContainer(cfg *hostconfig.HostConfig, services *host.ServicesRegistry, p2p hostcommon.P2PHostService, l1Client ethadapter.EthClient, l1Repo hostcommon.L1RepoService, enclaveClients []common.Enclave, contractLib mgmtcontractlib.MgmtContractLib, hostWallet wallet.Wallet, rpcServer node.Server, logger gethlog.Logger, metricsService *metrics.Service, blobResolver l1.BlobResolver) *HostContainer {
-	h := host.NewHost(cfg, services, p2p, l1Client, l1Repo, enclaveClients, hostWallet, contractLib, logger, metricsService.Registry(), blobResolver)
+func NewHostContainer(cfg *hostconfig.HostConfig, services *host.ServicesRegistry, p2p hostcommon.P2PHostService, l1Client ethadapter.EthClient, l1Repo hostcommon.L1RepoService, enclaveClients []common.Enclave, contractLib mgmtcontractlib.MgmtContractLib, hostWallet wallet.Wallet, rpcServer node.Server, logger gethlog.Logger, metricsService *metrics.Service, blobResolver l1.BlobResolver, hostStorage storage.Storage) *HostContainer {
+	h := host.NewHost(cfg, services, p2p, l1Client, l1Repo, enclaveClients, hostWallet, contractLib, logger, metricsService.Registry(), blobResolver, hostStorage)
 
 	hostContainer := &HostContainer{
 		host:           h,
@@ -195,16 +197,10 @@ func NewHostContainer(cfg *hostconfig.HostConfig, services *host.ServicesRegistr
 				Service:   clientapi.NewTestAPI(hostContainer),
 			},
 		})
-
-		if cfg.DebugNamespaceEnabled {
-			rpcServer.RegisterAPIs([]rpc.API{
-				{
-					Namespace: APINamespaceDebug,
-					Service:   clientapi.NewNetworkDebug(h),
-				},
-			})
-		}
 		services.RegisterService(hostcommon.FilterAPIServiceName, filterAPI.NewHeadsService)
 	}
+	if cfg.HasClientRPCHTTP && cfg.BlobArchiveEnabled {
+		rpcServer.RegisterRoutes(clientapi.NewBlobArchiveRoutes(hostStorage, logger))
+	}
 	return hostContainer
 }
@@ -1,6 +1,7 @@
 package enclave
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
@@ -9,6 +10,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 	hostconfig "github.com/ten-protocol/go-ten/go/host/config"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/host"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/retry"
+	"github.com/ten-protocol/go-ten/go/common/signature"
 	"github.com/ten-protocol/go-ten/go/host/l1"
 )
 
@@ -40,6 +43,9 @@ const (
 
 	// when we have submitted request to L1 for the secret, how long do we wait for an answer before we retry
 	_maxWaitForSecretResponse = 2 * time.Minute
+
+	// the maximum number of revelation periods whose keys are requested from the enclave in one go
+	_maxRevealedPeriodsPerLoop = 100
 )
 
 // This private interface enforces the services that the guardian depends on
@@ -73,6 +79,7 @@ type Guardian struct {
 	crossChainInterval time.Duration
 	l1StartHash        gethcommon.Hash
 	maxRollupSize      uint64
+	mgmtContractAddr   gethcommon.Address
 
 	hostInterrupter *stopcontrol.StopControl // host hostInterrupter so we can stop quickly
 	running         atomic.Bool
@@ -98,6 +105,7 @@ func NewGuardian(cfg *hostconfig.HostConfig, hostData host.Identity, serviceLoca
 		maxRollupSize:      cfg.MaxRollupSize,
 		blockTime:          cfg.L1BlockTime,
 		crossChainInterval: cfg.CrossChainInterval,
+		mgmtContractAddr:   cfg.ManagementContractAddress,
 		storage:            storage,
 		hostInterrupter:    interrupter,
 		logger:             logger,
@@ -150,6 +158,8 @@ func (g *Guardian) Start() error {
 	// start streaming data from the enclave
 	go g.streamEnclaveData()
 
+	go g.periodicKeyRevelation()
+
 	return nil
 }
 
@@ -487,6 +497,10 @@ func (g *Guardian) submitL1Block(block *common.L1Block, isLatest bool) (bool, er
 			// this is most common when we are returning to a previous fork and the enclave has already seen some of the blocks on it
 			// note: logging this because we don't expect it to happen often and would like visibility on that.
 			g.logger.Info("L1 block already processed by enclave, trying the next block", "block", block.Hash())
+			// the block is canonical again, so are the rollups it contains
+			if err := g.storage.AddBlock(block.Header()); err != nil {
+				g.logger.Error("Could not add block to host db.", log.ErrKey, err)
+			}
 			nextHeight := big.NewInt(0).Add(block.Number(), big.NewInt(1))
 			nextCanonicalBlock, err := g.sl.L1Data().FetchBlockByHeight(nextHeight)
 			if err != nil {
@@ -494,12 +508,15 @@ func (g *Guardian) submitL1Block(block *common.L1Block, isLatest bool) (bool, er
 			}
 			return g.submitL1Block(nextCanonicalBlock, isLatest)
 		}
+		g.handleFraudReport()
 		// something went wrong, return error and let the main loop check status and try again when appropriate
 		return false, errors.Wrap(err, "could not submit L1 block to enclave")
 	}
 	// successfully processed block, update the state
 	g.state.OnProcessedBlock(block.Hash())
+	g.haltReportedSequencers(processedData)
 	g.processL1BlockTransactions(block, rollupTxs, syncContracts)
+	g.updateRollupFinality()
 
 	if err != nil {
 		return false, fmt.Errorf("submitted block to enclave but could not store the block processing result. Cause: %w", err)
@@ -510,6 +527,11 @@ func (g *Guardian) submitL1Block(block *common.L1Block, isLatest bool) (bool, er
 	if err != nil {
 		g.logger.Error("Failed to publish response to secret request", log.ErrKey, err)
 	}
+	if resp.SecretDistribution != nil {
+		if err := g.sl.L1Publisher().PublishSecretDistribution(resp.SecretDistribution); err != nil {
+			g.logger.Error("Failed to publish the secret distribution", log.ErrKey, err)
+		}
+	}
 	return true, nil
 }
 
@@ -551,6 +573,18 @@ func (g *Guardian) processL1BlockTransactions(block *common.L1Block, rollupTxs [
 	}
 }
 
+// updateRollupFinality marks the rollups published in finalized L1 blocks, which makes the batches they contain finalized
+func (g *Guardian) updateRollupFinality() {
+	finalizedHeight, err := g.sl.L1Data().FetchFinalizedBlockNumber()
+	if err != nil {
+		g.logger.Warn("Could not fetch the finalized L1 block.", log.ErrKey, err)
+		return
+	}
+	if err := g.storage.MarkRollupsFinalized(finalizedHeight); err != nil {
+		g.logger.Error("Could not mark the rollups as finalized.", log.ErrKey, err)
+	}
+}
+
 func (g *Guardian) publishSharedSecretResponses(scrtResponses []*common.ProducedSecretResponse) error {
 	for _, scrtResponse := range scrtResponses {
 		// todo (#1624) - implement proper protocol so only one host responds to this secret requests initially
@@ -570,10 +604,14 @@ func (g *Guardian) publishSharedSecretResponses(scrtResponses []*common.Produced
 }
 
 func (g *Guardian) submitL2Batch(ctx context.Context, batch *common.ExtBatch) error {
+	if err := g.checkSequencerNotHalted(batch); err != nil {
+		return err
+	}
 	g.submitDataLock.Lock()
 	err := g.enclaveClient.SubmitBatch(ctx, batch)
 	g.submitDataLock.Unlock()
 	if err != nil {
+		g.handleFraudReport()
 		// something went wrong, return error and let the main loop check status and try again when appropriate
 		return errors.Wrap(err, "could not submit L2 batch to enclave")
 	}
@@ -582,6 +620,115 @@ func (g *Guardian) submitL2Batch(ctx context.Context, batch *common.ExtBatch) er
 	return nil
 }
 
+// handleFraudReport - called when the enclave fails to process data, to check whether a batch signed by the sequencer
+// diverged from the batch computed by the enclave. If it did, the report signed by the enclave is published to the L1
+// and the batches of that sequencer are not accepted anymore
+func (g *Guardian) handleFraudReport() {
+	if g.hostData.IsSequencer {
+		return
+	}
+	report, err := g.enclaveClient.GetFraudReport(context.Background())
+	if err != nil {
+		g.logger.Warn("Could not fetch fraud report from the enclave", log.ErrKey, err)
+		return
+	}
+	if report == nil {
+		return
+	}
+
+	isNew, err := g.sl.Enclaves().HaltSequencer(report)
+	if err != nil {
+		g.logger.Error("Could not halt the sequencer after a fraud report", log.ErrKey, err)
+		return
+	}
+	if !isNew {
+		return
+	}
+
+	g.logger.Error("Halting batch acceptance from sequencer after a fraud report", "sequencer", report.Sequencer,
+		log.BatchSeqNoKey, report.BatchSeqNo, log.BatchHashKey, report.BatchHash)
+	err = g.sl.L1Publisher().PublishFraudReport(report)
+	if err != nil {
+		g.logger.Error("Failed to publish fraud report", log.ErrKey, err)
+	}
+}
+
+// haltReportedSequencers - the fraud reports published to the L1 halt the reported sequencers once a quorum of
+// validators reported them, so that this host stops accepting their batches, and stops producing batches if its enclave
+// was reported. A validator doesn't count the reports contradicted by the execution of its own enclave.
+func (g *Guardian) haltReportedSequencers(processed *common.ProcessedL1Data) {
+	for _, report := range fraudReportsOf(processed, g.mgmtContractAddr, g.logger) {
+		if g.isContradictedByEnclave(report) {
+			g.logger.Warn("Ignoring fraud report contradicted by the execution of the enclave", "sequencer", report.Sequencer,
+				"validator", report.EnclaveID, log.BatchSeqNoKey, report.BatchSeqNo, log.BatchHashKey, report.BatchHash)
+			continue
+		}
+		halted, err := g.sl.Enclaves().AddFraudReport(report)
+		if err != nil {
+			g.logger.Error("Could not store the fraud report", log.ErrKey, err)
+			continue
+		}
+		if halted {
+			g.logger.Error("Halting batch acceptance from sequencer after a quorum of fraud reports on the L1", "sequencer", report.Sequencer,
+				"validator", report.EnclaveID, log.BatchSeqNoKey, report.BatchSeqNo, log.BatchHashKey, report.BatchHash)
+		}
+	}
+}
+
+// fraudReportsOf - the fraud reports published to the L1 in the processed block. Only the events emitted by the
+// management contract are read, because the other contracts called by the same transaction can emit events with the
+// same signature, and invent the reporting validators.
+func fraudReportsOf(processed *common.ProcessedL1Data, mgmtContractAddr gethcommon.Address, logger gethlog.Logger) []*common.FraudReport {
+	var reports []*common.FraudReport
+	for _, txData := range processed.GetEvents(common.FraudReportTx) {
+		for _, l := range txData.Receipt.Logs {
+			if l.Address != mgmtContractAddr || len(l.Topics) == 0 || l.Topics[0] != crosschain.FraudReportedID {
+				continue
+			}
+			report, err := crosschain.DecodeFraudReported(l)
+			if err != nil {
+				logger.Error("Could not decode fraud report event", log.TxKey, l.TxHash, log.ErrKey, err)
+				continue
+			}
+			reports = append(reports, report)
+		}
+	}
+	return reports
+}
+
+// isContradictedByEnclave - true if the enclave of this validator executed the reported batch without finding a
+// divergence. The enclave of the sequencer produced the batches, so it can't contradict the reports.
+func (g *Guardian) isContradictedByEnclave(report *common.FraudReport) bool {
+	if g.hostData.IsSequencer {
+		return false
+	}
+	head := g.state.GetEnclaveL2Head()
+	if head == nil || head.Uint64() < report.BatchSeqNo {
+		// the enclave did not execute the batch yet
+		return false
+	}
+	batch, err := g.enclaveClient.GetBatchBySeqNo(context.Background(), report.BatchSeqNo)
+	if err != nil {
+		g.logger.Warn("Could not fetch the reported batch from the enclave", log.BatchSeqNoKey, report.BatchSeqNo, log.ErrKey, err)
+		return false
+	}
+	return batch.Hash() == report.BatchHash
+}
+
+// checkSequencerNotHalted - returns an error if the batch was signed by a sequencer that was reported for fraud
+func (g *Guardian) checkSequencerNotHalted(batch *common.ExtBatch) error {
+	// the signature is copied because the recovery modifies it
+	signer, err := signature.RecoverAddress(batch.Hash().Bytes(), bytes.Clone(batch.Header.Signature))
+	if err != nil {
+		// the batch can't be attributed to a sequencer, and it is rejected by the enclave, which verifies the signature
+		return nil
+	}
+	if g.sl.Enclaves().IsSequencerHalted(*signer) {
+		return fmt.Errorf("batch acceptance from sequencer %s is halted after a fraud report", signer)
+	}
+	return nil
+}
+
 func (g *Guardian) periodicBatchProduction() {
 	defer g.logger.Info("Stopping batch production")
 
@@ -603,6 +750,10 @@ func (g *Guardian) periodicBatchProduction() {
 				g.logger.Debug("Skipping batch production because L1 is not up to date")
 				continue
 			}
+			if g.sl.Enclaves().IsSequencerHalted(*g.enclaveID) {
+				g.logger.Warn("Skipping batch production because the sequencer was reported for fraud")
+				continue
+			}
 			g.logger.Debug("Create batch")
 			// if maxBatchInterval is set higher than batchInterval then we are happy to skip creating batches when there is no data
 			// (up to a maximum time of maxBatchInterval)
@@ -715,6 +866,74 @@ func (g *Guardian) periodicBundleSubmission() {
 	}
 }
 
+// periodicKeyRevelation asks the enclave for the DA keys of the next revelation period, and publishes them once the
+// enclave reveals them. The first period is the one of the earliest rollup.
+func (g *Guardian) periodicKeyRevelation() {
+	defer g.logger.Info("Stopping DA key revelation")
+
+	revelationTicker := time.NewTicker(g.blockTime)
+	defer revelationTicker.Stop()
+
+	for {
+		select {
+		case <-revelationTicker.C:
+			if !g.state.IsUpToDate() {
+				continue
+			}
+			for i := 0; i < _maxRevealedPeriodsPerLoop; i++ {
+				revealed, err := g.revealNextPeriod()
+				if err != nil {
+					g.logger.Warn("Failed to reveal DA keys", log.ErrKey, err)
+				}
+				if !revealed {
+					break
+				}
+			}
+		case <-g.hostInterrupter.Done():
+			return
+		}
+	}
+}
+
+// revealNextPeriod returns true if the keys of a new period were published
+func (g *Guardian) revealNextPeriod() (bool, error) {
+	var period uint64
+	latest, err := g.storage.FetchLatestRevealedPeriod()
+	switch {
+	case err == nil:
+		period = latest + 1
+	case errors.Is(err, errutil.ErrNotFound):
+		firstRollup, err := g.storage.FetchEarliestRollupHeader()
+		if err != nil {
+			if errors.Is(err, errutil.ErrNotFound) {
+				return false, nil
+			}
+			return false, fmt.Errorf("could not fetch the earliest rollup. Cause: %w", err)
+		}
+		period = firstRollup.RevelationPeriod
+	default:
+		return false, fmt.Errorf("could not fetch the latest revealed period. Cause: %w", err)
+	}
+
+	hostL1Head := g.state.GetHostL1Head()
+	if hostL1Head == gethutil.EmptyHash {
+		return false, nil
+	}
+	keys, sysErr := g.enclaveClient.RevealDAKeys(context.Background(), period, hostL1Head)
+	if sysErr != nil {
+		return false, fmt.Errorf("could not reveal the keys of period %d. Cause: %w", period, sysErr)
+	}
+	if len(keys) == 0 {
+		// the revelation delay of the period has not elapsed yet
+		return false, nil
+	}
+	if err := g.storage.AddRevealedDAKeys(keys); err != nil {
+		return false, fmt.Errorf("could not store the keys of period %d. Cause: %w", period, err)
+	}
+	g.logger.Info("Published revealed DA keys", "period", period)
+	return true, nil
+}
+
 func (g *Guardian) streamEnclaveData() {
 	defer g.logger.Info("Stopping enclave data stream")
 	g.logger.Info("Starting L2 update stream from enclave")
@@ -826,6 +1045,10 @@ func (g *Guardian) getRollupsAndContractAddrTxs(processed common.ProcessedL1Data
 	syncContracts = false
 
 	for _, txData := range processed.GetEvents(common.RollupTx) {
+		if len(txData.Blobs) == 0 {
+			// published in the calldata
+			continue
+		}
 		encodedRlp, err := ethadapter.DecodeBlobs(txData.Blobs)
 		if err != nil {
 			g.logger.Crit("could not decode blobs.", log.ErrKey, err)
@@ -837,6 +1060,7 @@ func (g *Guardian) getRollupsAndContractAddrTxs(processed common.ProcessedL1Data
 		}
 		rollupTxs = append(rollupTxs, rlp)
 	}
+	rollupTxs = append(rollupTxs, g.sl.L1Publisher().FindCalldataRollupTxs(processed.GetEvents(common.RollupTx))...)
 
 	// if any contracts have been updated then we need to resync
 	if len(processed.GetEvents(common.SetImportantContractsTx)) > 0 {
@@ -0,0 +1,44 @@
+package enclave
+
+import (
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/enclave/crosschain"
+)
+
+func TestFraudReportsAreOnlyReadFromTheManagementContract(t *testing.T) {
+	mgmtContractAddr := gethcommon.HexToAddress("0x10")
+	sequencer := gethcommon.HexToAddress("0x01")
+	fraudReported := func(emitter gethcommon.Address, validator gethcommon.Address) *types.Log {
+		data, err := crosschain.MgmtContractABI.Events["FraudReported"].Inputs.NonIndexed().Pack(
+			uint64(1), gethcommon.Hash{1}, gethcommon.Hash{2}, gethcommon.Hash{3})
+		require.NoError(t, err)
+		return &types.Log{
+			Address: emitter,
+			Topics:  []gethcommon.Hash{crosschain.FraudReportedID, gethcommon.BytesToHash(sequencer.Bytes()), gethcommon.BytesToHash(validator.Bytes())},
+			Data:    data,
+		}
+	}
+
+	// a contract relays a genuine report, and emits reports of invented validators alongside it
+	processed := &common.ProcessedL1Data{}
+	processed.AddEvent(common.FraudReportTx, &common.L1TxData{
+		Transaction: types.NewTx(&types.LegacyTx{}),
+		Receipt: &types.Receipt{Logs: []*types.Log{
+			fraudReported(mgmtContractAddr, gethcommon.HexToAddress("0x02")),
+			fraudReported(gethcommon.HexToAddress("0x20"), gethcommon.HexToAddress("0x03")),
+			fraudReported(gethcommon.HexToAddress("0x20"), gethcommon.HexToAddress("0x04")),
+		}},
+	})
+
+	reports := fraudReportsOf(processed, mgmtContractAddr, gethlog.New())
+	require.Len(t, reports, 1)
+	require.Equal(t, sequencer, reports[0].Sequencer)
+	require.Equal(t, gethcommon.HexToAddress("0x02"), reports[0].EnclaveID)
+	require.Equal(t, uint64(1), reports[0].BatchSeqNo)
+}
@@ -2,6 +2,7 @@ package enclave
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"sync"
@@ -12,6 +13,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/host"
 	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/host/storage"
 	"github.com/ten-protocol/go-ten/go/responses"
 	"github.com/ten-protocol/go-ten/lib/gethfork/rpc"
 )
@@ -31,20 +33,48 @@ type Service struct {
 	activeSequencerID *common.EnclaveID
 	haLock            sync.Mutex // lock for failover operations: evicting an enclave, promoting a standby to active, etc.
 
+	// the sequencers reported for fraud, whose batches are not produced, accepted or gossiped anymore
+	storage           storage.Storage
+	haltedSequencers  map[common.EnclaveID]bool
+	haltLock          sync.RWMutex
+	fraudReportQuorum uint64             // the number of distinct validators whose L1 reports halt a sequencer
+	resumeSequencers  []common.EnclaveID // the sequencers whose halt is lifted by the operator on start
+
 	running atomic.Bool
 	logger  gethlog.Logger
 }
 
-func NewService(hostData host.Identity, serviceLocator enclaveServiceLocator, enclaveGuardians []*Guardian, logger gethlog.Logger) *Service {
+func NewService(hostData host.Identity, serviceLocator enclaveServiceLocator, enclaveGuardians []*Guardian, storage storage.Storage, fraudReportQuorum uint64, resumeSequencers []common.EnclaveID, logger gethlog.Logger) *Service {
 	return &Service{
-		hostData:         hostData,
-		sl:               serviceLocator,
-		enclaveGuardians: enclaveGuardians,
-		logger:           logger,
+		hostData:          hostData,
+		sl:                serviceLocator,
+		enclaveGuardians:  enclaveGuardians,
+		storage:           storage,
+		haltedSequencers:  map[common.EnclaveID]bool{},
+		fraudReportQuorum: max(fraudReportQuorum, 1),
+		resumeSequencers:  resumeSequencers,
+		logger:            logger,
 	}
 }
 
 func (e *Service) Start() error {
+	// the operator lifts the halts caused by wrong reports, e.g. after a validator bug, by restarting the host
+	for _, sequencer := range e.resumeSequencers {
+		e.logger.Warn("Lifting the halt of the sequencer after a fraud report", "sequencer", sequencer)
+		if err := e.storage.ResumeSequencer(sequencer); err != nil {
+			return fmt.Errorf("could not resume sequencer %s. Cause: %w", sequencer, err)
+		}
+	}
+	// the halts survive restarts, so they are loaded before the guardians start processing data
+	halted, err := e.storage.FetchHaltedSequencers()
+	if err != nil {
+		return fmt.Errorf("could not fetch the halted sequencers. Cause: %w", err)
+	}
+	for _, sequencer := range halted {
+		e.logger.Warn("Sequencer is halted after a fraud report", "sequencer", sequencer)
+		e.haltedSequencers[sequencer] = true
+	}
+
 	e.running.Store(true)
 	for _, guardian := range e.enclaveGuardians {
 		if err := guardian.Start(); err != nil {
@@ -91,6 +121,12 @@ func (e *Service) HealthStatus(ctx context.Context) host.HealthStatus {
 		}
 	}
 
+	e.haltLock.RLock()
+	if len(e.haltedSequencers) > 0 {
+		errors = append(errors, fmt.Errorf("batch acceptance halted after a fraud report"))
+	}
+	e.haltLock.RUnlock()
+
 	// empty error msg means healthy
 	return &host.GroupErrsHealthStatus{Errors: errors}
 }
@@ -108,6 +144,63 @@ func (e *Service) LookupBatchBySeqNo(ctx context.Context, seqNo *big.Int) (*comm
 	return client.GetBatchBySeqNo(ctx, seqNo.Uint64())
 }
 
+func (e *Service) HaltSequencer(report *common.FraudReport) (bool, error) {
+	isNew, err := e.addFraudReport(report)
+	if err != nil || !isNew {
+		return false, err
+	}
+	return e.halt(report.Sequencer)
+}
+
+func (e *Service) AddFraudReport(report *common.FraudReport) (bool, error) {
+	isNew, err := e.addFraudReport(report)
+	if err != nil || !isNew {
+		return false, err
+	}
+	reporters, err := e.storage.CountFraudReporters(report.Sequencer)
+	if err != nil {
+		return false, fmt.Errorf("could not count the fraud reporters. Cause: %w", err)
+	}
+	if reporters < e.fraudReportQuorum {
+		e.logger.Warn("Sequencer was reported for fraud on the L1, waiting for a quorum of reports", "sequencer", report.Sequencer,
+			"validator", report.EnclaveID, "reporters", reporters, "quorum", e.fraudReportQuorum)
+		return false, nil
+	}
+	return e.halt(report.Sequencer)
+}
+
+// addFraudReport - returns false if the report was already known, including the reports resolved by the operator
+func (e *Service) addFraudReport(report *common.FraudReport) (bool, error) {
+	err := e.storage.AddFraudReport(report)
+	if errors.Is(err, errutil.ErrAlreadyExists) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("could not store fraud report. Cause: %w", err)
+	}
+	return true, nil
+}
+
+// halt - returns false if the sequencer was already halted
+func (e *Service) halt(sequencer common.EnclaveID) (bool, error) {
+	if err := e.storage.HaltSequencer(sequencer); err != nil {
+		return false, err
+	}
+	e.haltLock.Lock()
+	defer e.haltLock.Unlock()
+	if e.haltedSequencers[sequencer] {
+		return false, nil
+	}
+	e.haltedSequencers[sequencer] = true
+	return true, nil
+}
+
+func (e *Service) IsSequencerHalted(sequencer common.EnclaveID) bool {
+	e.haltLock.RLock()
+	defer e.haltLock.RUnlock()
+	return e.haltedSequencers[sequencer]
+}
+
 func (e *Service) GetEnclaveClient() common.Enclave {
 	// for now we always return first guardian's enclave client
 	// in future be good to load balance and failover but need to improve subscribe/unsubscribe (unsubscribe from same enclave)
@@ -0,0 +1,54 @@
+package enclave
+
+import (
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	hostconfig "github.com/ten-protocol/go-ten/go/host/config"
+	"github.com/ten-protocol/go-ten/go/host/storage"
+)
+
+func TestFraudReportsHaltTheSequencerWithAQuorum(t *testing.T) {
+	logger := gethlog.New()
+	cfg := &hostconfig.HostConfig{ID: gethcommon.BytesToAddress([]byte("fraud")), UseInMemoryDB: true}
+	hostStorage := storage.NewHostStorageFromConfig(cfg, logger)
+	service := NewService(host.Identity{}, nil, nil, hostStorage, 2, nil, logger)
+
+	sequencer := gethcommon.HexToAddress("0x01")
+	report := func(seqNo uint64, validator string) *common.FraudReport {
+		return &common.FraudReport{BatchSeqNo: seqNo, Sequencer: sequencer, EnclaveID: gethcommon.HexToAddress(validator)}
+	}
+
+	// the reports of the same validator count once
+	for _, r := range []*common.FraudReport{report(1, "0x02"), report(2, "0x02")} {
+		halted, err := service.AddFraudReport(r)
+		require.NoError(t, err)
+		require.False(t, halted)
+	}
+	require.False(t, service.IsSequencerHalted(sequencer))
+
+	halted, err := service.AddFraudReport(report(1, "0x03"))
+	require.NoError(t, err)
+	require.True(t, halted)
+	require.True(t, service.IsSequencerHalted(sequencer))
+
+	// once the operator resumes the sequencer, the resolved reports don't halt it again
+	require.NoError(t, hostStorage.ResumeSequencer(sequencer))
+	service = NewService(host.Identity{}, nil, nil, hostStorage, 2, nil, logger)
+	halted, err = service.HaltSequencer(report(1, "0x03"))
+	require.NoError(t, err)
+	require.False(t, halted)
+	halted, err = service.AddFraudReport(report(3, "0x02"))
+	require.NoError(t, err)
+	require.False(t, halted)
+	require.False(t, service.IsSequencerHalted(sequencer))
+
+	// the independent re-execution by an enclave of this host halts the sequencer without a quorum
+	halted, err = service.HaltSequencer(report(3, "0x04"))
+	require.NoError(t, err)
+	require.True(t, halted)
+}
@@ -175,6 +175,12 @@ func (s *StateTracker) GetEnclaveL1Head() gethcommon.Hash {
 	return s.enclaveL1Head
 }
 
+func (s *StateTracker) GetHostL1Head() gethcommon.Hash {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	return s.hostL1Head
+}
+
 func (s *StateTracker) GetEnclaveL2Head() *big.Int {
 	s.m.RLock()
 	defer s.m.RUnlock()
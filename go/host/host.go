@@ -59,8 +59,7 @@ func (bl batchListener) HandleBatch(batch *common.ExtBatch) {
 	bl.newHeads <- batch.Header
 }
 
-func NewHost(config *hostconfig.HostConfig, hostServices *ServicesRegistry, p2p hostcommon.P2PHostService, ethClient ethadapter.EthClient, l1Repo hostcommon.L1RepoService, enclaveClients []common.Enclave, ethWallet wallet.Wallet, mgmtContractLib mgmtcontractlib.MgmtContractLib, logger gethlog.Logger, regMetrics gethmetrics.Registry, blobResolver l1.BlobResolver) hostcommon.Host {
-	hostStorage := storage.NewHostStorageFromConfig(config, logger)
+func NewHost(config *hostconfig.HostConfig, hostServices *ServicesRegistry, p2p hostcommon.P2PHostService, ethClient ethadapter.EthClient, l1Repo hostcommon.L1RepoService, enclaveClients []common.Enclave, ethWallet wallet.Wallet, mgmtContractLib mgmtcontractlib.MgmtContractLib, logger gethlog.Logger, regMetrics gethmetrics.Registry, blobResolver l1.BlobResolver, hostStorage storage.Storage) hostcommon.Host {
 	hostIdentity := hostcommon.NewIdentity(config)
 	host := &host{
 		// config
@@ -94,7 +93,7 @@ func NewHost(config *hostconfig.HostConfig, hostServices *ServicesRegistry, p2p
 		enclGuardians = append(enclGuardians, enclGuardian)
 	}
 
-	enclService := enclave.NewService(hostIdentity, hostServices, enclGuardians, logger)
+	enclService := enclave.NewService(hostIdentity, hostServices, enclGuardians, hostStorage, config.FraudReportQuorum, config.ResumeSequencers, logger)
 	l2Repo := l2.NewBatchRepository(config, hostServices, hostStorage, logger)
 	subsService := events.NewLogEventManager(hostServices, logger)
 	l2Repo.SubscribeValidatedBatches(batchListener{newHeads: host.newHeads})
@@ -2,40 +2,122 @@ package l1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
+	gethlog "github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto/kzg4844"
 
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/host/storage"
 )
 
 // BlobResolver is an interface for fetching blobs
 type BlobResolver interface {
 	// FetchBlobs Fetches the blob data using beacon chain APIs
 	FetchBlobs(ctx context.Context, b *types.Header, hashes []gethcommon.Hash) ([]*kzg4844.Blob, error)
-	// StoreBlobs is used to store blobs for the in-memory testing nodes
+	// StoreBlobs stores the blobs published in a slot, so they can be fetched later
 	StoreBlobs(slot uint64, blobs []*kzg4844.Blob) error
 }
 
+// beaconBlobResolver - fetches the blobs from the beacon chain and keeps a copy of them in the local archive, so that
+// the rollups remain recoverable after the beacon nodes prune them
 type beaconBlobResolver struct {
 	beaconClient *ethadapter.L1BeaconClient
+	archive      storage.BlobArchive
+	logger       gethlog.Logger
 }
 
-func NewBlobResolver(beaconClient *ethadapter.L1BeaconClient) BlobResolver {
-	return &beaconBlobResolver{beaconClient: beaconClient}
+func NewBlobResolver(beaconClient *ethadapter.L1BeaconClient, archive storage.BlobArchive, logger gethlog.Logger) BlobResolver {
+	return &beaconBlobResolver{beaconClient: beaconClient, archive: archive, logger: logger}
 }
 
 func (r *beaconBlobResolver) FetchBlobs(ctx context.Context, b *types.Header, hashes []gethcommon.Hash) ([]*kzg4844.Blob, error) {
-	blobs, err := r.beaconClient.FetchBlobs(ctx, b, hashes)
+	blobs, err := r.fetchArchivedBlobs(hashes)
+	if err == nil {
+		return blobs, nil
+	}
+	if !errors.Is(err, errutil.ErrNotFound) {
+		r.logger.Warn("Could not read the blobs from the local archive", log.ErrKey, err)
+	}
+
+	sidecars, err := r.beaconClient.GetBlobSidecars(ctx, b, hashes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch blobs from beacon client: %w", err)
 	}
+	// no hashes were provided, create slice of all hashes from sidecars
+	if len(hashes) == 0 {
+		hashes = make([]gethcommon.Hash, len(sidecars))
+		for i, sidecar := range sidecars {
+			hashes[i] = ethadapter.KZGToVersionedHash(kzg4844.Commitment(sidecar.KZGCommitment))
+		}
+	}
+	blobs, err = ethadapter.BlobsFromSidecars(sidecars, hashes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify blobs from beacon client: %w", err)
+	}
+
+	// failing to archive the blobs does not prevent processing the block, they can still be fetched from the beacon
+	// chain until they are pruned
+	if err := r.archiveSidecars(ctx, b, sidecars); err != nil {
+		r.logger.Warn("Could not store the blobs in the local archive", log.BlockHashKey, b.Hash(), log.ErrKey, err)
+	}
 	return blobs, nil
 }
 
-func (r *beaconBlobResolver) StoreBlobs(_ uint64, _ []*kzg4844.Blob) error {
-	panic("provided by the ethereum consensus layer")
+// StoreBlobs - computes the commitments and proofs of the blobs, and stores them in the local archive
+func (r *beaconBlobResolver) StoreBlobs(slot uint64, blobs []*kzg4844.Blob) error {
+	sidecars := make([]*ethadapter.BlobSidecar, len(blobs))
+	for i, blob := range blobs {
+		commitment, err := kzg4844.BlobToCommitment(blob)
+		if err != nil {
+			return fmt.Errorf("failed to compute commitment: %w", err)
+		}
+		proof, err := kzg4844.ComputeBlobProof(blob, commitment)
+		if err != nil {
+			return fmt.Errorf("failed to compute proof: %w", err)
+		}
+		sidecars[i] = &ethadapter.BlobSidecar{
+			Blob:          *blob,
+			Index:         ethadapter.Uint64String(i),
+			KZGCommitment: ethadapter.Bytes48(commitment),
+			KZGProof:      ethadapter.Bytes48(proof),
+		}
+	}
+	return r.archive.AddBlobSidecars(slot, sidecars)
+}
+
+// fetchArchivedBlobs - returns errutil.ErrNotFound unless all the blobs are in the archive. The blobs are verified
+// against their commitments before being returned
+func (r *beaconBlobResolver) fetchArchivedBlobs(hashes []gethcommon.Hash) ([]*kzg4844.Blob, error) {
+	if len(hashes) == 0 {
+		// the slot is required to look up all the blobs of a block
+		return nil, errutil.ErrNotFound
+	}
+	sidecars := make([]*ethadapter.BlobSidecar, len(hashes))
+	for i, hash := range hashes {
+		sidecar, err := r.archive.FetchBlobSidecar(hash)
+		if err != nil {
+			return nil, err
+		}
+		sidecars[i] = sidecar
+	}
+	return ethadapter.BlobsFromSidecars(sidecars, hashes)
+}
+
+func (r *beaconBlobResolver) archiveSidecars(ctx context.Context, b *types.Header, sidecars []*ethadapter.BlobSidecar) error {
+	slotFn, err := r.beaconClient.GetTimeToSlot(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get time to slot function: %w", err)
+	}
+	slot, err := slotFn(b.Time)
+	if err != nil {
+		return fmt.Errorf("error in converting b.Time to slot: %w", err)
+	}
+	return r.archive.AddBlobSidecars(slot, sidecars)
 }
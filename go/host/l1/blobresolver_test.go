@@ -8,7 +8,10 @@ import (
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/ethadapter"
 )
 
@@ -20,7 +23,7 @@ const (
 func TestBlobResolver(t *testing.T) {
 	beaconClient := ethadapter.NewBeaconHTTPClient(new(http.Client), "https://docs-demo.quiknode.pro/")
 	fallback := ethadapter.NewArchivalHTTPClient(new(http.Client), "https://api.ethernow.xyz")
-	blobResolver := NewBlobResolver(ethadapter.NewL1BeaconClient(beaconClient, fallback))
+	blobResolver := NewBlobResolver(ethadapter.NewL1BeaconClient(beaconClient, fallback), newInMemBlobArchive(), gethlog.New())
 
 	// this will convert to slot 5 which will return 404 from the quicknode api, causing the fallback to be used
 	b := &types.Header{
@@ -32,6 +35,30 @@ func TestBlobResolver(t *testing.T) {
 	require.Len(t, blobs, 2)
 }
 
+func TestBlobResolverServesArchivedBlobs(t *testing.T) {
+	// the beacon node is unreachable, so the blobs can only be served from the archive
+	beaconClient := ethadapter.NewBeaconHTTPClient(new(http.Client), "http://127.0.0.1:0")
+	blobResolver := NewBlobResolver(ethadapter.NewL1BeaconClient(beaconClient), newInMemBlobArchive(), gethlog.New())
+
+	blobs := []*kzg4844.Blob{{1, 2, 3}, {4, 5, 6}}
+	require.NoError(t, blobResolver.StoreBlobs(10, blobs))
+
+	hashes := make([]gethcommon.Hash, len(blobs))
+	for i, blob := range blobs {
+		commitment, err := kzg4844.BlobToCommitment(blob)
+		require.NoError(t, err)
+		hashes[i] = ethadapter.KZGToVersionedHash(commitment)
+	}
+
+	// the blobs are returned in the order of the requested hashes
+	fetched, err := blobResolver.FetchBlobs(context.Background(), &types.Header{}, []gethcommon.Hash{hashes[1], hashes[0]})
+	require.NoError(t, err)
+	require.Equal(t, []*kzg4844.Blob{blobs[1], blobs[0]}, fetched)
+
+	_, err = blobResolver.FetchBlobs(context.Background(), &types.Header{}, []gethcommon.Hash{gethcommon.HexToHash(vHash1)})
+	require.Error(t, err)
+}
+
 // TestSepoliaBlobResolver checks the public node sepolia beacon APIs work as expected
 func TestSepoliaBlobResolver(t *testing.T) {
 	t.Skipf("Test will occasionally not pass due to the time window landing on a block with no blobs")
@@ -39,7 +66,7 @@ func TestSepoliaBlobResolver(t *testing.T) {
 	beaconClient := ethadapter.NewBeaconHTTPClient(new(http.Client), "https://ethereum-sepolia-beacon-api.publicnode.com")
 	// l1_blob_archive_url for sepolia
 	fallback := ethadapter.NewBeaconHTTPClient(new(http.Client), "https://eth-beacon-chain-sepolia.drpc.org/rest/")
-	blobResolver := NewBlobResolver(ethadapter.NewL1BeaconClient(beaconClient, fallback))
+	blobResolver := NewBlobResolver(ethadapter.NewL1BeaconClient(beaconClient, fallback), newInMemBlobArchive(), gethlog.New())
 
 	// this is a moving point in time so we can't compare hashes or be certain there will be blobs in the block
 	// create block with timestamp 30 days ago relative to current time
@@ -50,3 +77,39 @@ func TestSepoliaBlobResolver(t *testing.T) {
 	_, err := blobResolver.FetchBlobs(context.Background(), historicalBlock, []gethcommon.Hash{})
 	require.NoError(t, err)
 }
+
+type inMemBlobArchive struct {
+	sidecars map[gethcommon.Hash]*ethadapter.BlobSidecar
+	slots    map[uint64][]*ethadapter.BlobSidecar
+}
+
+func newInMemBlobArchive() *inMemBlobArchive {
+	return &inMemBlobArchive{
+		sidecars: make(map[gethcommon.Hash]*ethadapter.BlobSidecar),
+		slots:    make(map[uint64][]*ethadapter.BlobSidecar),
+	}
+}
+
+func (a *inMemBlobArchive) AddBlobSidecars(slot uint64, sidecars []*ethadapter.BlobSidecar) error {
+	for _, sidecar := range sidecars {
+		a.sidecars[ethadapter.KZGToVersionedHash(kzg4844.Commitment(sidecar.KZGCommitment))] = sidecar
+	}
+	a.slots[slot] = append(a.slots[slot], sidecars...)
+	return nil
+}
+
+func (a *inMemBlobArchive) FetchBlobSidecar(versionedHash gethcommon.Hash) (*ethadapter.BlobSidecar, error) {
+	sidecar, found := a.sidecars[versionedHash]
+	if !found {
+		return nil, errutil.ErrNotFound
+	}
+	return sidecar, nil
+}
+
+func (a *inMemBlobArchive) FetchBlobSidecarsBySlot(slot uint64) ([]*ethadapter.BlobSidecar, error) {
+	sidecars, found := a.slots[slot]
+	if !found {
+		return nil, errutil.ErrNotFound
+	}
+	return sidecars, nil
+}
@@ -191,6 +191,14 @@ func (r *DataService) GetTenRelevantTransactions(block *common.L1Block) (*common
 			processed.AddEvent(common.SecretRequestTx, txData)
 		case crosschain.NetworkSecretRespondedID:
 			processed.AddEvent(common.SecretResponseTx, txData)
+		case crosschain.NetworkSecretRotationRequestedID:
+			processed.AddEvent(common.SecretRotationRequestTx, txData)
+		case crosschain.NetworkSecretRotatedID:
+			processed.AddEvent(common.SecretRotationTx, txData)
+		case crosschain.ForcedTransactionSubmittedID:
+			processed.AddEvent(common.ForcedTransactionTx, txData)
+		case crosschain.FraudReportedID:
+			processed.AddEvent(common.FraudReportTx, txData)
 		default:
 			r.logger.Warn("Unknown log topic", "topic", l.Topics[0], "txHash", l.TxHash)
 		}
@@ -271,6 +279,9 @@ func (r *DataService) processManagementContractTx(txData *common.L1TxData, proce
 				txData.Blobs = blobs
 				processed.AddEvent(common.RollupTx, txData)
 			}
+		case *common.L1RollupTx:
+			// the rollup was published in the calldata, so there are no blobs to fetch
+			processed.AddEvent(common.RollupTx, txData)
 		default:
 			// this should never happen since the specific events should always decode into one of these types
 			r.logger.Error("Unknown tx type", "txHash", txData.Transaction.Hash().Hex())
@@ -332,6 +343,10 @@ func (r *DataService) FetchBlockByHeight(height *big.Int) (*types.Block, error)
 	return r.ethClient.BlockByNumber(height)
 }
 
+func (r *DataService) FetchFinalizedBlockNumber() (uint64, error) {
+	return r.ethClient.FinalizedBlockNumber()
+}
+
 // getEnclaveIdFromLog gets the enclave ID from the log topic
 func getEnclaveIdFromLog(log types.Log) (gethcommon.Address, error) {
 	if len(log.Topics) != 1 {
@@ -27,6 +27,10 @@ import (
 	"github.com/ten-protocol/go-ten/go/wallet"
 )
 
+// maxCalldataRollupSize - the L1 mempool rejects transactions larger than 128KB, so bigger rollups are always published in
+// blobs
+const maxCalldataRollupSize = 120 * 1024
+
 type Publisher struct {
 	hostData        host.Identity
 	hostWallet      wallet.Wallet // Wallet used to issue ethereum transactions
@@ -102,6 +106,30 @@ func (p *Publisher) Start() error {
 	return nil
 }
 
+// IsAttestedEnclave returns true if the enclave was attested by the management contract
+func (p *Publisher) IsAttestedEnclave(enclaveID gethcommon.Address) (bool, error) {
+	if p.mgmtContractLib.IsMock() {
+		return false, fmt.Errorf("enclave registry unavailable for mocked environments")
+	}
+	managementCtr, err := ManagementContract.NewManagementContract(*p.mgmtContractLib.GetContractAddr(), p.ethClient.EthClient())
+	if err != nil {
+		return false, fmt.Errorf("unable to instantiate management contract client. Cause: %w", err)
+	}
+	return managementCtr.Attested(&bind.CallOpts{}, enclaveID)
+}
+
+// IsSequencerEnclave returns true if the enclave is permissioned as a sequencer by the management contract
+func (p *Publisher) IsSequencerEnclave(enclaveID gethcommon.Address) (bool, error) {
+	if p.mgmtContractLib.IsMock() {
+		return false, fmt.Errorf("enclave registry unavailable for mocked environments")
+	}
+	managementCtr, err := ManagementContract.NewManagementContract(*p.mgmtContractLib.GetContractAddr(), p.ethClient.EthClient())
+	if err != nil {
+		return false, fmt.Errorf("unable to instantiate management contract client. Cause: %w", err)
+	}
+	return managementCtr.IsSequencerEnclave(&bind.CallOpts{}, enclaveID)
+}
+
 func (p *Publisher) GetBundleRangeFromManagementContract(lastRollupNumber *big.Int, lastRollupUID gethcommon.Hash) (*gethcommon.Hash, *big.Int, *big.Int, error) {
 	if p.mgmtContractLib.IsMock() {
 		return nil, nil, nil, fmt.Errorf("bundle publishing unavailable for mocked environments")
@@ -224,6 +252,42 @@ func (p *Publisher) PublishSecretResponse(secretResponse *common.ProducedSecretR
 	return nil
 }
 
+func (p *Publisher) PublishFraudReport(report *common.FraudReport) error {
+	fraudReportTx, err := p.mgmtContractLib.CreateFraudReport(report)
+	if err != nil {
+		return err
+	}
+	p.logger.Info("Broadcasting fraud report L1 tx.", log.BatchSeqNoKey, report.BatchSeqNo, "sequencer", report.Sequencer)
+
+	// fire-and-forget (track the receipt asynchronously)
+	go func() {
+		err := p.publishTransaction(fraudReportTx)
+		if err != nil {
+			p.logger.Error("Could not broadcast fraud report L1 tx", log.ErrKey, err)
+		}
+	}()
+
+	return nil
+}
+
+func (p *Publisher) PublishSecretDistribution(distribution *common.SecretDistribution) error {
+	distributionTx, err := p.mgmtContractLib.CreateSecretDistribution(distribution)
+	if err != nil {
+		return err
+	}
+	p.logger.Info("Broadcasting secret distribution L1 tx.", "epoch", distribution.Epoch, "recipients", len(distribution.EnclaveIDs))
+
+	// fire-and-forget (track the receipt asynchronously)
+	go func() {
+		err := p.publishTransaction(distributionTx)
+		if err != nil {
+			p.logger.Error("Could not broadcast secret distribution L1 tx", log.ErrKey, err)
+		}
+	}()
+
+	return nil
+}
+
 // FindSecretResponseTx will attempt to decode the transactions passed in
 func (p *Publisher) FindSecretResponseTx(processed []*common.L1TxData) []*common.L1RespondSecretTx {
 	secretRespTxs := make([]*common.L1RespondSecretTx, 0)
@@ -267,12 +331,13 @@ func (p *Publisher) PublishRollup(producedRollup *common.ExtRollup) {
 		p.logger.Trace("Sending transaction to publish rollup", "rollup_header", headerLog, log.RollupHashKey, producedRollup.Header.Hash(), "batches_len", len(producedRollup.BatchPayloads))
 	}
 
-	rollupBlobTx, err := p.mgmtContractLib.CreateBlobRollup(tx)
+	rollupTx, err := p.createRollupTx(tx)
 	if err != nil {
-		p.logger.Error("Could not create rollup blobs", log.RollupHashKey, producedRollup.Hash(), log.ErrKey, err)
+		p.logger.Error("Could not create rollup tx", log.RollupHashKey, producedRollup.Hash(), log.ErrKey, err)
+		return
 	}
 
-	err = p.publishTransaction(rollupBlobTx)
+	err = p.publishTransaction(rollupTx)
 	if err != nil {
 		p.logger.Error("Could not issue rollup tx", log.RollupHashKey, producedRollup.Hash(), log.ErrKey, err)
 	} else {
@@ -281,6 +346,44 @@ func (p *Publisher) PublishRollup(producedRollup *common.ExtRollup) {
 	// TODO publish rollup to archive service if not already done
 }
 
+// createRollupTx - the rollup is published in blobs, unless publishing it in the calldata is cheaper at the current L1
+// prices and the rollup is small enough to fit in a transaction
+func (p *Publisher) createRollupTx(tx *common.L1RollupTx) (types.TxData, error) {
+	if len(tx.Rollup) > maxCalldataRollupSize {
+		return p.mgmtContractLib.CreateBlobRollup(tx)
+	}
+	head, err := p.ethClient.FetchHeadBlock()
+	if err != nil {
+		p.logger.Warn("Could not fetch the L1 prices, publishing the rollup in blobs", log.ErrKey, err)
+		return p.mgmtContractLib.CreateBlobRollup(tx)
+	}
+	blobBaseFee := ethadapter.NextBlobBaseFee(head.Header())
+	if head.BaseFee() == nil || blobBaseFee == nil {
+		return p.mgmtContractLib.CreateBlobRollup(tx)
+	}
+	blobsCost, err := ethadapter.BlobsCost(tx.Rollup, blobBaseFee)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode rollup in blobs. Cause: %w", err)
+	}
+	calldataCost := ethadapter.CalldataCost(tx.Rollup, head.BaseFee())
+	if calldataCost.Cmp(blobsCost) < 0 {
+		p.logger.Info("Publishing rollup in the calldata", "calldata_cost", calldataCost, "blobs_cost", blobsCost)
+		return p.mgmtContractLib.CreateCalldataRollup(tx)
+	}
+	return p.mgmtContractLib.CreateBlobRollup(tx)
+}
+
+// FindCalldataRollupTxs returns the rollups published in the calldata of the transactions
+func (p *Publisher) FindCalldataRollupTxs(processed []*common.L1TxData) []*common.L1RollupTx {
+	var rollupTxs []*common.L1RollupTx
+	for _, tx := range processed {
+		if rollupTx, ok := p.mgmtContractLib.DecodeTx(tx.Transaction).(*common.L1RollupTx); ok {
+			rollupTxs = append(rollupTxs, rollupTx)
+		}
+	}
+	return rollupTxs
+}
+
 func (p *Publisher) PublishCrossChainBundle(bundle *common.ExtCrossChainBundle, rollupNum *big.Int, forkID gethcommon.Hash) error {
 	if p.mgmtContractLib.IsMock() {
 		return nil
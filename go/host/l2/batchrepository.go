@@ -141,10 +141,7 @@ func (r *Repository) HandleBatchRequest(requesterID string, fromSeqNo *big.Int)
 		batches = append(batches, batch)
 		nextSeqNum = nextSeqNum.Add(nextSeqNum, big.NewInt(1))
 	}
-	if len(batches) == 0 {
-		return // nothing to send
-	}
-
+	// an empty response is still sent, so the requester can ask another peer without waiting for a timeout
 	err := r.sl.P2P().RespondToBatchRequest(requesterID, batches)
 	if err != nil {
 		r.logger.Warn("unable to send batches to peer", "peer", requesterID, log.ErrKey, err)
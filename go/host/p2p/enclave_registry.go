@@ -0,0 +1,162 @@
+package p2p
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/signature"
+)
+
+var (
+	_registeredCacheTTL   = 5 * time.Minute  // how long a positive answer from the management contract is trusted
+	_unregisteredCacheTTL = 30 * time.Second // unregistered enclaves are re-checked more often, since they might register soon
+	_registryCacheSize    = 1024             // the max number of cached answers per kind, so random enclave IDs can't exhaust the memory
+	_maxL1LookupsPerSec   = 10               // bounds the queries to the L1 triggered by unknown enclave IDs
+
+	errTooManyLookups = errors.New("too many enclave lookups in the management contract")
+)
+
+// registryCache - the answers of the management contract for one kind of permission. The registered and the
+// unregistered enclaves are cached separately, so that peers presenting random enclave IDs can't evict the registered ones.
+type registryCache struct {
+	registered   lru.BasicLRU[common.EnclaveID, time.Time]
+	unregistered lru.BasicLRU[common.EnclaveID, time.Time]
+}
+
+func newRegistryCache() *registryCache {
+	return &registryCache{
+		registered:   lru.NewBasicLRU[common.EnclaveID, time.Time](_registryCacheSize),
+		unregistered: lru.NewBasicLRU[common.EnclaveID, time.Time](_registryCacheSize),
+	}
+}
+
+// get - returns the cached answer, and false if there is no valid answer
+func (c *registryCache) get(enclaveID common.EnclaveID) (bool, bool) {
+	if checkedAt, found := c.registered.Get(enclaveID); found && time.Since(checkedAt) < _registeredCacheTTL {
+		return true, true
+	}
+	if checkedAt, found := c.unregistered.Get(enclaveID); found && time.Since(checkedAt) < _unregisteredCacheTTL {
+		return false, true
+	}
+	return false, false
+}
+
+func (c *registryCache) add(enclaveID common.EnclaveID, registered bool) {
+	if registered {
+		c.unregistered.Remove(enclaveID)
+		c.registered.Add(enclaveID, time.Now())
+		return
+	}
+	c.registered.Remove(enclaveID)
+	c.unregistered.Add(enclaveID, time.Now())
+}
+
+// enclaveRegistry caches the enclave permissions recorded in the management contract, so that the L1 is not queried
+// for every P2P connection or batch
+type enclaveRegistry struct {
+	lock       sync.Mutex
+	attested   *registryCache
+	sequencers *registryCache
+
+	// the L1 lookups in the current one second window
+	lookupWindowStart time.Time
+	lookupsInWindow   int
+
+	sl p2pServiceLocator
+}
+
+func newEnclaveRegistry(sl p2pServiceLocator) *enclaveRegistry {
+	return &enclaveRegistry{
+		attested:   newRegistryCache(),
+		sequencers: newRegistryCache(),
+		sl:         sl,
+	}
+}
+
+// verifyEndorsement returns the ID of the enclave which endorsed the P2P key and address, if that enclave is attested
+func (r *enclaveRegistry) verifyEndorsement(p2pPubKey []byte, p2pAddress string, endorsement []byte) (*common.EnclaveID, error) {
+	// the signature is copied because the recovery modifies it
+	enclaveID, err := signature.RecoverAddress(signature.P2PEndorsementHash(p2pPubKey, p2pAddress).Bytes(), bytes.Clone(endorsement))
+	if err != nil {
+		return nil, fmt.Errorf("invalid endorsement. Cause: %w", err)
+	}
+	attested, err := r.isAttested(*enclaveID)
+	if err != nil {
+		return nil, err
+	}
+	if !attested {
+		return nil, fmt.Errorf("enclave %s is not attested in the management contract", enclaveID)
+	}
+	return enclaveID, nil
+}
+
+// verifyBatchSignature checks that the batch was signed by an enclave permissioned as a sequencer, which was not
+// reported for fraud
+func (r *enclaveRegistry) verifyBatchSignature(batch *common.ExtBatch) error {
+	// the signature is copied because the recovery modifies it
+	signer, err := signature.RecoverAddress(batch.Hash().Bytes(), bytes.Clone(batch.Header.Signature))
+	if err != nil {
+		return fmt.Errorf("invalid signature for batch %s. Cause: %w", batch.Hash(), err)
+	}
+	isSequencer, err := r.isSequencer(*signer)
+	if err != nil {
+		return err
+	}
+	if !isSequencer {
+		return fmt.Errorf("batch %s was signed by enclave %s, which is not a sequencer", batch.Hash(), signer)
+	}
+	if r.sl.Enclaves().IsSequencerHalted(*signer) {
+		return fmt.Errorf("batch %s was signed by sequencer %s, which is halted after a fraud report", batch.Hash(), signer)
+	}
+	return nil
+}
+
+func (r *enclaveRegistry) isAttested(enclaveID common.EnclaveID) (bool, error) {
+	return r.lookup(r.attested, enclaveID, r.sl.L1Publisher().IsAttestedEnclave)
+}
+
+func (r *enclaveRegistry) isSequencer(enclaveID common.EnclaveID) (bool, error) {
+	return r.lookup(r.sequencers, enclaveID, r.sl.L1Publisher().IsSequencerEnclave)
+}
+
+func (r *enclaveRegistry) lookup(cache *registryCache, enclaveID common.EnclaveID, fetch func(common.EnclaveID) (bool, error)) (bool, error) {
+	r.lock.Lock()
+	registered, found := cache.get(enclaveID)
+	if !found && !r.allowLookup() {
+		r.lock.Unlock()
+		return false, errTooManyLookups
+	}
+	r.lock.Unlock()
+
+	if found {
+		return registered, nil
+	}
+
+	registered, err := fetch(enclaveID)
+	if err != nil {
+		return false, fmt.Errorf("could not check enclave %s in the management contract. Cause: %w", enclaveID, err)
+	}
+
+	r.lock.Lock()
+	cache.add(enclaveID, registered)
+	r.lock.Unlock()
+	return registered, nil
+}
+
+// allowLookup - must be called with the lock held
+func (r *enclaveRegistry) allowLookup() bool {
+	if time.Since(r.lookupWindowStart) >= time.Second {
+		r.lookupWindowStart = time.Now()
+		r.lookupsInWindow = 0
+	}
+	if r.lookupsInWindow >= _maxL1LookupsPerSec {
+		return false
+	}
+	r.lookupsInWindow++
+	return true
+}
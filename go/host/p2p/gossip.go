@@ -0,0 +1,334 @@
+package p2p
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/common/log"
+)
+
+/*
+Batches are propagated by gossip rather than sent by the sequencer to every validator.
+
+When a validator registers with the sequencer, the sequencer replies with a random sample of the other registered
+validators. The validator registers with those peers as well, up to _maxGossipPeers, so the validators form a mesh.
+Every registration is answered with an accept or a reject. A validator whose pool is full rejects the registration, and
+the rejected validator tries another peer from the sample. A peer is only relied upon once it has accepted.
+
+The sequencer sends each live batch to a random set of _gossipFanout validators. Each validator relays the live batches
+it has not seen before to its own peers, after checking they were signed by a sequencer enclave. The hashes of the
+batches seen recently are cached, so each batch is handled and relayed at most once by each node.
+
+Missing batches are requested from a random peer. A peer which doesn't have the range responds with no batches, and the
+request is then sent to the sequencer. The request is also sent to the sequencer if the peer doesn't respond within
+_catchUpTimeout.
+*/
+
+var (
+	_maxGossipPeers       = 8    // the number of peers a validator relays batches to
+	_gossipFanout         = 4    // the number of validators the sequencer sends each live batch to
+	_seenBatchesCacheSize = 1024 // the number of recent batch hashes remembered for deduplication
+	_catchUpTimeout       = 5 * time.Second
+	_registrationTimeout  = 5 * time.Second // a gossip peer which doesn't reply to the registration in time is replaced
+)
+
+// catchUpRequest - a batch request sent to a gossip peer
+type catchUpRequest struct {
+	fromSeqNo *big.Int
+	peer      string
+}
+
+// peersMsg - sent by the sequencer to a validator which registers, to introduce other validators
+type peersMsg struct {
+	Addresses []string
+}
+
+// registrationReplyMsg - the answer to a registration for broadcasts
+type registrationReplyMsg struct {
+	Accepted bool
+}
+
+// filterUnseen - records the batches as seen and returns the ones which were not seen before
+func (p *Service) filterUnseen(batches []*common.ExtBatch) []*common.ExtBatch {
+	p.seenBatchesMutex.Lock()
+	defer p.seenBatchesMutex.Unlock()
+
+	unseen := make([]*common.ExtBatch, 0, len(batches))
+	for _, batch := range batches {
+		hash := batch.Hash()
+		if p.seenBatches.Contains(hash) {
+			continue
+		}
+		p.seenBatches.Add(hash, struct{}{})
+		unseen = append(unseen, batch)
+	}
+	return unseen
+}
+
+// relayBatches - forwards live batches to the gossip peers, except to the peer they were received from
+func (p *Service) relayBatches(batches []*common.ExtBatch, receivedFrom string) {
+	if p.isSequencer || len(batches) == 0 {
+		return
+	}
+	encodedBatchMsg, err := rlp.EncodeToBytes(host.BatchMsg{Batches: batches, IsLive: true})
+	if err != nil {
+		p.logger.Error("Could not encode batches to relay", log.ErrKey, err)
+		return
+	}
+	msg := message{Sender: p.ourPublicAddress, Type: msgTypeBatches, Contents: encodedBatchMsg}
+	if err := p.broadcast(msg, p.samplePeers(_maxGossipPeers, receivedFrom)); err != nil {
+		p.logger.Warn("Could not relay batches to peers", log.ErrKey, err)
+	}
+}
+
+// handleRegistration - adds the peer to the broadcast pool, and replies whether it was accepted. Validators keep a
+// bounded pool, while the sequencer accepts every validator and introduces them to each other.
+func (p *Service) handleRegistration(peerAddress string) {
+	if peerAddress == p.ourPublicAddress {
+		return
+	}
+	p.peerAddressesMutex.Lock()
+	_, known := p.peerAddresses[peerAddress]
+	accepted := p.isSequencer || known || len(p.peerAddresses) < _maxGossipPeers
+	if accepted {
+		p.peerAddresses[peerAddress] = 0
+	}
+	p.peerAddressesMutex.Unlock()
+
+	go func() {
+		p.replyToRegistration(peerAddress, accepted)
+		if p.isSequencer {
+			p.sendPeers(peerAddress)
+		}
+	}()
+}
+
+func (p *Service) replyToRegistration(to string, accepted bool) {
+	encodedReply, err := rlp.EncodeToBytes(&registrationReplyMsg{Accepted: accepted})
+	if err != nil {
+		p.logger.Error("Could not encode registration reply using RLP", log.ErrKey, err)
+		return
+	}
+	msg := message{Sender: p.ourPublicAddress, Type: msgTypeRegistrationReply, Contents: encodedReply}
+	if err := p.send(msg, to); err != nil {
+		p.logger.Debug("Could not reply to registration", "peer", to, log.ErrKey, err)
+	}
+}
+
+// sendPeers - sends a random sample of the registered validators to a newly registered one
+func (p *Service) sendPeers(to string) {
+	addresses := p.samplePeers(_maxGossipPeers, to)
+	if len(addresses) == 0 {
+		return
+	}
+	encodedPeers, err := rlp.EncodeToBytes(&peersMsg{Addresses: addresses})
+	if err != nil {
+		p.logger.Error("Could not encode peers using RLP", log.ErrKey, err)
+		return
+	}
+	msg := message{Sender: p.ourPublicAddress, Type: msgTypePeers, Contents: encodedPeers}
+	if err := p.send(msg, to); err != nil {
+		p.logger.Debug("Could not send peers to validator", "peer", to, log.ErrKey, err)
+	}
+}
+
+// handlePeers - records the validators introduced by the sequencer as candidates, and registers with them until the
+// gossip pool is full
+func (p *Service) handlePeers(encodedPeers []byte) {
+	var peers peersMsg
+	if err := rlp.DecodeBytes(encodedPeers, &peers); err != nil {
+		p.logger.Warn("unable to decode peers received from sequencer", log.ErrKey, err)
+		return
+	}
+
+	p.peerAddressesMutex.Lock()
+	candidates := make([]string, 0, len(peers.Addresses))
+	for _, address := range peers.Addresses {
+		if address == p.ourPublicAddress || address == p.getSequencer() {
+			continue
+		}
+		candidates = append(candidates, address)
+	}
+	p.peerCandidates = candidates
+	p.peerAddressesMutex.Unlock()
+
+	p.registerWithCandidates()
+}
+
+// handleRegistrationReply - a gossip peer which accepted the registration is added to the pool. When it rejected it, we
+// register with another candidate instead.
+func (p *Service) handleRegistrationReply(encodedReply []byte, from string) {
+	var reply registrationReplyMsg
+	if err := rlp.DecodeBytes(encodedReply, &reply); err != nil {
+		p.logger.Warn("unable to decode registration reply", log.ErrKey, err)
+		return
+	}
+
+	p.peerAddressesMutex.Lock()
+	// the replies of the sequencer, and the late replies of the peers which timed out, are ignored
+	if !p.pendingPeers[from] {
+		p.peerAddressesMutex.Unlock()
+		return
+	}
+	delete(p.pendingPeers, from)
+	if reply.Accepted && len(p.peerAddresses) < _maxGossipPeers {
+		p.peerAddresses[from] = 0
+	}
+	p.peerAddressesMutex.Unlock()
+
+	if !reply.Accepted {
+		p.logger.Debug("Gossip peer rejected the registration", "peer", from)
+		p.registerWithCandidates()
+	}
+}
+
+// registerWithCandidates - registers with the next candidates, while the accepted and the pending peers don't fill the pool
+func (p *Service) registerWithCandidates() {
+	for {
+		p.peerAddressesMutex.Lock()
+		if len(p.peerCandidates) == 0 || len(p.peerAddresses)+len(p.pendingPeers) >= _maxGossipPeers {
+			p.peerAddressesMutex.Unlock()
+			return
+		}
+		address := p.peerCandidates[0]
+		p.peerCandidates = p.peerCandidates[1:]
+		_, known := p.peerAddresses[address]
+		if known || p.pendingPeers[address] {
+			p.peerAddressesMutex.Unlock()
+			continue
+		}
+		p.pendingPeers[address] = true
+		p.peerAddressesMutex.Unlock()
+
+		go func(address string) {
+			if err := p.registerWith(address); err != nil {
+				p.logger.Debug("Could not register with gossip peer", "peer", address, log.ErrKey, err)
+				p.dropPendingPeer(address)
+				return
+			}
+			time.AfterFunc(_registrationTimeout, func() {
+				if p.stopControl.IsStopping() {
+					return
+				}
+				p.dropPendingPeer(address)
+			})
+		}(address)
+	}
+}
+
+// dropPendingPeer - the registration with the peer failed or wasn't answered, so another candidate is tried
+func (p *Service) dropPendingPeer(address string) {
+	p.peerAddressesMutex.Lock()
+	pending := p.pendingPeers[address]
+	delete(p.pendingPeers, address)
+	p.peerAddressesMutex.Unlock()
+	if pending {
+		p.registerWithCandidates()
+	}
+}
+
+// samplePeers - returns up to n random addresses from the broadcast pool, excluding the given addresses
+func (p *Service) samplePeers(n int, exclude ...string) []string {
+	p.peerAddressesMutex.RLock()
+	addresses := make([]string, 0, len(p.peerAddresses))
+	for address := range p.peerAddresses {
+		if !slices.Contains(exclude, address) {
+			addresses = append(addresses, address)
+		}
+	}
+	p.peerAddressesMutex.RUnlock()
+
+	rand.Shuffle(len(addresses), func(i, j int) { addresses[i], addresses[j] = addresses[j], addresses[i] })
+	if len(addresses) > n {
+		addresses = addresses[:n]
+	}
+	return addresses
+}
+
+// catchUpPeer - missing batches are requested from a random gossip peer, or from the sequencer if there is none
+func (p *Service) catchUpPeer() string {
+	peers := p.samplePeers(1)
+	if len(peers) == 0 {
+		return p.getSequencer()
+	}
+	return peers[0]
+}
+
+// startCatchUp - records the request sent to the peer, and re-sends it to the sequencer if the peer doesn't respond in time
+func (p *Service) startCatchUp(fromSeqNo *big.Int, peer string) *catchUpRequest {
+	request := &catchUpRequest{fromSeqNo: fromSeqNo, peer: peer}
+	p.catchUpMutex.Lock()
+	p.catchUp = request
+	p.catchUpMutex.Unlock()
+
+	time.AfterFunc(_catchUpTimeout, func() {
+		if p.stopControl.IsStopping() {
+			return
+		}
+		p.fallbackToSequencer(request)
+	})
+	return request
+}
+
+// completeCatchUp - the peer responded with the batches
+func (p *Service) completeCatchUp(from string) {
+	p.catchUpMutex.Lock()
+	defer p.catchUpMutex.Unlock()
+	if p.catchUp != nil && p.catchUp.peer == from {
+		p.catchUp = nil
+	}
+}
+
+// handleEmptyBatchResponse - the peer didn't have the requested range, so the request is sent to the sequencer
+func (p *Service) handleEmptyBatchResponse(from string) {
+	p.catchUpMutex.Lock()
+	request := p.catchUp
+	p.catchUpMutex.Unlock()
+
+	if request == nil || request.peer != from {
+		return
+	}
+	p.fallbackToSequencer(request)
+}
+
+// fallbackToSequencer - sends the request to the sequencer, unless it was already completed or replaced by a newer one
+func (p *Service) fallbackToSequencer(request *catchUpRequest) {
+	p.catchUpMutex.Lock()
+	if p.catchUp != request {
+		p.catchUpMutex.Unlock()
+		return
+	}
+	p.catchUp = nil
+	p.catchUpMutex.Unlock()
+
+	p.logger.Debug("Peer could not serve the batch request, requesting from sequencer", "peer", request.peer, log.BatchSeqNoKey, request.fromSeqNo)
+	if err := p.sendBatchRequest(request.fromSeqNo, p.getSequencer()); err != nil {
+		p.logger.Warn("Could not request batches from sequencer", log.ErrKey, err)
+	}
+}
+
+func (p *Service) sendBatchRequest(fromSeqNo *big.Int, to string) error {
+	batchRequest := &common.BatchRequest{
+		Requester: p.ourPublicAddress,
+		FromSeqNo: fromSeqNo,
+	}
+	encodedBatchRequest, err := rlp.EncodeToBytes(batchRequest)
+	if err != nil {
+		return fmt.Errorf("could not encode batch request using RLP. Cause: %w", err)
+	}
+	msg := message{Sender: p.ourPublicAddress, Type: msgTypeBatchRequest, Contents: encodedBatchRequest}
+	return p.send(msg, to)
+}
+
+// registerWith - asks the peer to send us its broadcasts
+func (p *Service) registerWith(address string) error {
+	// note: contents are not read, but p2p server expects message contents to be non-empty
+	msg := message{Sender: p.ourPublicAddress, Type: msgTypeRegisterForBroadcasts, Contents: []byte{1}}
+	return p.send(msg, address)
+}
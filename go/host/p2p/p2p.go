@@ -1,12 +1,15 @@
 package p2p
 
 import (
+	"bufio"
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io"
 	"math/big"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -17,6 +20,8 @@ import (
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 	hostconfig "github.com/ten-protocol/go-ten/go/host/config"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	gethmetrics "github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -32,9 +37,11 @@ const (
 	msgTypeBatches
 	msgTypeBatchRequest
 	msgTypeRegisterForBroadcasts
+	msgTypePeers
+	msgTypeRegistrationReply
 	// bounds for msgType validation (must update if adding new type)
 	_minMsgType = msgTypeTx
-	_maxMsgType = msgTypeRegisterForBroadcasts
+	_maxMsgType = msgTypeRegistrationReply
 )
 
 var (
@@ -46,9 +53,28 @@ var (
 // A P2P message's type.
 type msgType uint8
 
+func (t msgType) String() string {
+	switch t {
+	case msgTypeTx:
+		return "tx"
+	case msgTypeBatches:
+		return "batches"
+	case msgTypeBatchRequest:
+		return "batchrequest"
+	case msgTypeRegisterForBroadcasts:
+		return "register"
+	case msgTypePeers:
+		return "peers"
+	case msgTypeRegistrationReply:
+		return "registrationreply"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
 // Associates an encoded message to its type.
 type message struct {
-	Sender   string // todo (#1619) - this needs to be authed in the future
+	Sender   string // the P2P address of the sender. The receiver replaces it with the address authenticated by the transport.
 	Type     msgType
 	Contents []byte
 }
@@ -56,6 +82,7 @@ type message struct {
 type p2pServiceLocator interface {
 	L1Publisher() host.L1Publisher
 	L2Repo() host.L2BatchRepository
+	Enclaves() host.EnclaveService
 }
 
 // NewSocketP2PLayer - returns the Socket implementation of the P2P
@@ -73,13 +100,19 @@ func NewSocketP2PLayer(config *hostconfig.HostConfig, serviceLocator p2pServiceL
 		ourPublicAddress: config.P2PPublicAddress,
 		sequencerAddress: config.SequencerP2PAddress,
 		peerAddresses:    make(map[string]int),
+		pendingPeers:     make(map[string]bool),
 		p2pTimeout:       config.P2PConnectionTimeout,
 
 		peerAddressesMutex: sync.RWMutex{},
+		enclaveRegistry:    newEnclaveRegistry(serviceLocator),
+		seenBatches:        lru.NewBasicLRU[gethcommon.Hash, struct{}](_seenBatchesCacheSize),
+		peerConns:          make(map[string]*peerConn),
+		inboundConns:       make(map[net.Conn]struct{}),
 
 		// monitoring
 		peerTracker:     newPeerTracker(),
 		metricsRegistry: metricReg,
+		metrics:         newP2PMetrics(metricReg),
 		logger:          logger,
 
 		isIncomingP2PDisabled: config.IsInboundP2PDisabled,
@@ -99,21 +132,38 @@ type Service struct {
 	isSequencer      bool
 	ourBindAddress   string
 	ourPublicAddress string
-	peerAddresses    map[string]int // map of peer addresses to the number of times they have failed to send a message
+	peerAddresses    map[string]int // map of peer addresses to the number of times they have failed to send a message (bounded by _maxGossipPeers for validators)
 	p2pTimeout       time.Duration
 
 	peerTracker           *peerTracker
 	metricsRegistry       gethmetrics.Registry
+	metrics               *p2pMetrics
 	logger                gethlog.Logger
 	peerAddressesMutex    sync.RWMutex
 	isIncomingP2PDisabled bool
 	sequencerAddress      string
-	lastReceivedBroadcast time.Time // if this gets stale then validators will re-register for broadcasts
+	lastReceivedBroadcast atomic.Int64 // unix nanoseconds. If this gets stale then validators will re-register for broadcasts
+
+	identity        *identity // the TLS certificate of this host, endorsed by the enclave
+	identityMutex   sync.Mutex
+	enclaveRegistry *enclaveRegistry
+
+	seenBatches      lru.BasicLRU[gethcommon.Hash, struct{}] // the hashes of the recent batches, used to deduplicate the gossip
+	seenBatchesMutex sync.Mutex
+	catchUp          *catchUpRequest // the batch request sent to a peer, which is re-sent to the sequencer if the peer can't serve it
+	pendingPeers     map[string]bool // the gossip peers we registered with, which haven't replied yet (guarded by peerAddressesMutex)
+	peerCandidates   []string        // the validators introduced by the sequencer, tried when a gossip peer rejects us (guarded by peerAddressesMutex)
+	catchUpMutex     sync.Mutex
+
+	peerConns         map[string]*peerConn // the long-lived outbound connections, by peer address
+	peerConnsMutex    sync.Mutex
+	inboundConns      map[net.Conn]struct{}
+	inboundConnsMutex sync.Mutex
 }
 
 func (p *Service) Start() error {
 	// We listen for P2P connections.
-	listener, err := net.Listen("tcp", p.ourBindAddress)
+	listener, err := tls.Listen(tcp, p.ourBindAddress, p.serverTLSConfig())
 	if err != nil {
 		return fmt.Errorf("could not listen for P2P connections on %s: %w", p.ourBindAddress, err)
 	}
@@ -143,7 +193,9 @@ func (p *Service) Stop() error {
 	if p.listener != nil {
 		// todo immediately shutting down the listener seems to impact other hosts shutdown process
 		time.Sleep(time.Second)
-		return p.listener.Close()
+		err := p.listener.Close()
+		p.closeConnections()
+		return err
 	}
 	return nil
 }
@@ -202,7 +254,8 @@ func (p *Service) BroadcastBatches(batches []*common.ExtBatch) error {
 	}
 
 	msg := message{Sender: p.ourPublicAddress, Type: msgTypeBatches, Contents: encodedBatchMsg}
-	return p.broadcast(msg)
+	// the validators relay the batches to each other, so the sequencer only sends them to a few of them
+	return p.broadcast(msg, p.samplePeers(_gossipFanout))
 }
 
 func (p *Service) RequestBatchesFromSequencer(fromSeqNo *big.Int) error {
@@ -212,29 +265,27 @@ func (p *Service) RequestBatchesFromSequencer(fromSeqNo *big.Int) error {
 	if p.isSequencer {
 		return errors.New("sequencer cannot request batches from itself")
 	}
-	batchRequest := &common.BatchRequest{
-		Requester: p.ourPublicAddress,
-		FromSeqNo: fromSeqNo,
-	}
-	defer core.LogMethodDuration(p.logger, measure.NewStopwatch(), "Requested batches from sequencer", "fromSeqNo", batchRequest.FromSeqNo)
+	peer := p.catchUpPeer()
+	defer core.LogMethodDuration(p.logger, measure.NewStopwatch(), "Requested batches from peer", "fromSeqNo", fromSeqNo, "peer", peer)
 
-	encodedBatchRequest, err := rlp.EncodeToBytes(batchRequest)
-	if err != nil {
-		return fmt.Errorf("could not encode batch request using RLP. Cause: %w", err)
+	if peer == p.getSequencer() {
+		return p.sendBatchRequest(fromSeqNo, peer)
 	}
-
-	msg := message{Sender: p.ourPublicAddress, Type: msgTypeBatchRequest, Contents: encodedBatchRequest}
-	// todo (#718) - allow missing batches to be requested from peers other than sequencer?
-	return p.send(msg, p.getSequencer())
+	request := p.startCatchUp(fromSeqNo, peer)
+	// an unreachable peer must not hold up the catch-up, which falls back to the sequencer after _catchUpTimeout
+	go func() {
+		if err := p.sendBatchRequest(fromSeqNo, peer); err != nil {
+			p.logger.Debug("Could not request batches from peer", "peer", peer, log.ErrKey, err)
+			p.fallbackToSequencer(request)
+		}
+	}()
+	return nil
 }
 
 func (p *Service) RespondToBatchRequest(requestID string, batches []*common.ExtBatch) error {
 	if p.isIncomingP2PDisabled {
 		return nil
 	}
-	if !p.isSequencer {
-		return errors.New("only sequencer can respond to batch requests")
-	}
 	batchMsg := &host.BatchMsg{
 		Batches: batches,
 		IsLive:  false,
@@ -250,6 +301,7 @@ func (p *Service) RespondToBatchRequest(requestID string, batches []*common.ExtB
 }
 
 // RegisterForBroadcasts - called by validators to register with the sequencer for broadcasts of batch data etc.
+// The sequencer responds with other validators to register with, which relay the batches (see gossip.go)
 // Validators will call this again if they stop receiving broadcasts for a period of _maxWaitWithoutBroadcast
 // Sequencer will evict validators from the broadcast pool if sending fails _maxPeerFailures times
 func (p *Service) RegisterForBroadcasts() error {
@@ -259,9 +311,7 @@ func (p *Service) RegisterForBroadcasts() error {
 	if p.isSequencer {
 		return errors.New("sequencer cannot register for broadcasts")
 	}
-	// note: contents are not read, but p2p server expects message contents to be non-empty
-	msg := message{Sender: p.ourPublicAddress, Type: msgTypeRegisterForBroadcasts, Contents: []byte{1}}
-	return p.send(msg, p.getSequencer())
+	return p.registerWith(p.getSequencer())
 }
 
 // HealthCheck returns whether the p2p is considered healthy
@@ -305,24 +355,61 @@ func (p *Service) handleConnections() {
 	}
 }
 
-// Receives and decodes a P2P message, and pushes it to the correct channel.
+// Authenticates the peer, then receives and decodes the P2P messages it sends over the connection, and pushes them to
+// the correct channel.
 func (p *Service) handle(conn net.Conn) {
-	if conn != nil {
-		defer conn.Close()
+	if conn == nil {
+		return
 	}
+	defer conn.Close()
+	defer p.trackInbound(conn)()
 
-	encodedMsg, err := io.ReadAll(conn)
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		p.logger.Error("Received a P2P connection which is not TLS")
+		return
+	}
+	// the peer has a limited time to authenticate
+	_ = tlsConn.SetDeadline(time.Now().Add(p.p2pTimeout))
+	if err := tlsConn.Handshake(); err != nil {
+		p.logger.Debug("Failed TLS handshake with peer", "remote", conn.RemoteAddr(), log.ErrKey, err)
+		return
+	}
+	reader := bufio.NewReader(tlsConn)
+	peer, err := p.authenticatePeer(tlsConn, reader)
 	if err != nil {
-		p.logger.Debug("Failed to read message from peer", log.ErrKey, err)
+		p.logger.Warn("Rejected P2P connection from unregistered peer", "remote", conn.RemoteAddr(), log.ErrKey, err)
 		return
 	}
+	_ = tlsConn.SetDeadline(time.Time{})
 
+	for !p.stopControl.IsStopping() {
+		frameType, encodedMsg, err := readFrame(reader)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !p.stopControl.IsStopping() {
+				p.logger.Debug("Failed to read message from peer", "peerEnclaveID", peer.enclaveID, log.ErrKey, err)
+			}
+			return
+		}
+		p.handleMsg(frameType, encodedMsg, peer)
+	}
+}
+
+// Decodes a P2P message and pushes it to the correct channel.
+func (p *Service) handleMsg(frameType msgType, encodedMsg []byte, peer *peerIdentity) {
 	msg := message{}
-	err = rlp.DecodeBytes(encodedMsg, &msg)
+	err := rlp.DecodeBytes(encodedMsg, &msg)
 	if err != nil {
 		p.logger.Debug("Failed to decode message received from peer: ", log.ErrKey, err)
 		return
 	}
+	// the size of the frame was checked against the limit of its type
+	if msg.Type != frameType {
+		p.logger.Debug("Message type does not match its frame", "peerEnclaveID", peer.enclaveID, "frameType", frameType, "msgType", msg.Type)
+		return
+	}
+	// the responses and the registrations must only use the address endorsed by the enclave of the peer
+	msg.Sender = peer.address
 
 	switch msg.Type {
 	case msgTypeTx:
@@ -346,50 +433,61 @@ func (p *Service) handle(conn net.Conn) {
 			// nothing to send to subscribers
 			break
 		}
-		// todo - check the batch signature
-		p.lastReceivedBroadcast = time.Now()
+		if err := p.verifyBatches(batchMsg.Batches); err != nil {
+			p.logger.Warn("rejected batches received from peer", "peerEnclaveID", peer.enclaveID, log.ErrKey, err)
+			// nothing to send to subscribers
+			break
+		}
+		batches := batchMsg.Batches
+		if batchMsg.IsLive {
+			p.lastReceivedBroadcast.Store(time.Now().UnixNano())
+			// the same live batches are received from several peers, they are handled and relayed only once
+			batches = p.filterUnseen(batches)
+			if len(batches) == 0 {
+				break
+			}
+			go p.relayBatches(batches, msg.Sender)
+		} else if len(batches) == 0 {
+			go p.handleEmptyBatchResponse(msg.Sender)
+			break
+		} else {
+			p.completeCatchUp(msg.Sender)
+		}
 		for _, batchSubs := range p.batchSubscribers.Subscribers() {
-			go batchSubs.HandleBatches(batchMsg.Batches, batchMsg.IsLive)
+			go batchSubs.HandleBatches(batches, batchMsg.IsLive)
 		}
 	case msgTypeBatchRequest:
-		if !p.isSequencer {
-			p.logger.Error("received batch request from peer, but not a sequencer node")
-			return
-		}
 		// this is an incoming request, p2p service is responsible for finding the response and returning it
-		go p.handleBatchRequest(msg.Contents)
+		go p.handleBatchRequest(msg.Contents, msg.Sender)
 	case msgTypeRegisterForBroadcasts:
-		if !p.isSequencer {
-			p.logger.Error("received register for broadcasts from peer, but not a sequencer node")
+		p.handleRegistration(msg.Sender)
+	case msgTypePeers:
+		if p.isSequencer {
+			p.logger.Error("received peers from peer, but this is a sequencer node")
+			return
+		}
+		go p.handlePeers(msg.Contents)
+	case msgTypeRegistrationReply:
+		if p.isSequencer {
+			p.logger.Error("received registration reply from peer, but this is a sequencer node")
 			return
 		}
-		// add the peer to the list of peers
-		p.peerAddressesMutex.Lock()
-		p.peerAddresses[msg.Sender] = 0
-		p.peerAddressesMutex.Unlock()
+		go p.handleRegistrationReply(msg.Contents, msg.Sender)
 	}
 	p.peerTracker.receivedPeerMsg(msg.Sender)
 }
 
-// Broadcasts a message to all peers.
-func (p *Service) broadcast(msg message) error {
+// Broadcasts a message to the given peers.
+func (p *Service) broadcast(msg message, addresses []string) error {
 	msgEncoded, err := rlp.EncodeToBytes(msg)
 	if err != nil {
 		return fmt.Errorf("could not encode message to send to peers. Cause: %w", err)
 	}
 
-	// clone currently known addresses
-	p.peerAddressesMutex.RLock()
-	currentAddresses := make([]string, len(p.peerAddresses))
-	for address := range p.peerAddresses {
-		currentAddresses = append(currentAddresses, address)
-	}
-	p.peerAddressesMutex.RUnlock()
-
-	for _, address := range currentAddresses {
+	for _, address := range addresses {
 		closureAddr := address
 		go func() {
-			err := p.sendBytesWithRetry(closureAddr, msgEncoded)
+			err := p.sendBytesWithRetry(closureAddr, msg.Type, msgEncoded)
 			if err != nil {
 				p.logger.Debug("Could not send message to peer", "peer", closureAddr, log.ErrKey, err)
 
@@ -407,7 +505,9 @@ func (p *Service) broadcast(msg message) error {
 			} else {
 				// if message was sent successfully, reset failure count
 				p.peerAddressesMutex.Lock()
-				p.peerAddresses[closureAddr] = 0
+				if _, ok := p.peerAddresses[closureAddr]; ok {
+					p.peerAddresses[closureAddr] = 0
+				}
 				p.peerAddressesMutex.Unlock()
 			}
 		}()
@@ -433,7 +533,7 @@ func (p *Service) send(msg message, to string) error {
 	if err != nil {
 		return fmt.Errorf("could not encode message to send to sequencer. Cause: %w", err)
 	}
-	err = p.sendBytesWithRetry(to, msgEncoded)
+	err = p.sendBytesWithRetry(to, msg.Type, msgEncoded)
 	if err != nil {
 		return err
 	}
@@ -442,29 +542,32 @@ func (p *Service) send(msg message, to string) error {
 
 // Sends the bytes to the provided address.
 // Until introducing libp2p (or equivalent), we have a simple retry
-func (p *Service) sendBytesWithRetry(address string, msgEncoded []byte) error {
+func (p *Service) sendBytesWithRetry(address string, msgType msgType, msgEncoded []byte) error {
 	// retry for about 2 seconds
 	err := retry.Do(func() error {
-		return p.sendBytes(address, msgEncoded)
+		return p.sendBytes(address, msgType, msgEncoded)
 	}, retry.NewDoublingBackoffStrategy(100*time.Millisecond, 5))
 	return err
 }
 
-// Sends the bytes to the provided address.
-func (p *Service) sendBytes(address string, tx []byte) error {
-	conn, err := net.DialTimeout(tcp, address, p.p2pTimeout)
-	if conn != nil {
-		defer conn.Close()
+// Queues the bytes to be sent to the provided address, connecting to the peer and authenticating it first if needed.
+func (p *Service) sendBytes(address string, msgType msgType, msgEncoded []byte) error {
+	if len(msgEncoded) > maxFrameSize(msgType) {
+		return retry.FailFast(fmt.Errorf("could not send message of %d bytes to peer %s. Cause: %w", len(msgEncoded), address, errFrameTooLarge))
 	}
+	pc, err := p.getPeerConn(address)
 	if err != nil {
-		p.logger.Debug(fmt.Sprintf("could not connect to peer on address %s", address), log.ErrKey, err)
 		return err
 	}
+	return p.enqueue(pc, outboundFrame{msgType: msgType, payload: msgEncoded, result: make(chan error, 1)})
+}
 
-	_, err = conn.Write(tx)
-	if err != nil {
-		p.logger.Debug(fmt.Sprintf("could not send message to peer on address %s", address), log.ErrKey, err)
-		return err
+// verifyBatches - the batches must be signed by an enclave permissioned as a sequencer in the management contract
+func (p *Service) verifyBatches(batches []*common.ExtBatch) error {
+	for _, batch := range batches {
+		if err := p.enclaveRegistry.verifyBatchSignature(batch); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -473,7 +576,8 @@ func (p *Service) getSequencer() string {
 	return p.sequencerAddress
 }
 
-func (p *Service) handleBatchRequest(encodedBatchRequest common.EncodedBatchRequest) {
+// handleBatchRequest - the response is sent to the authenticated address of the peer, not to the requester in the request
+func (p *Service) handleBatchRequest(encodedBatchRequest common.EncodedBatchRequest, from string) {
 	var batchRequest *common.BatchRequest
 	err := rlp.DecodeBytes(encodedBatchRequest, &batchRequest)
 	if err != nil {
@@ -483,7 +587,7 @@ func (p *Service) handleBatchRequest(encodedBatchRequest common.EncodedBatchRequ
 
 	// todo (@matt) should this response be synchronous?
 	for _, requestHandler := range p.batchReqHandlers.Subscribers() {
-		go requestHandler.HandleBatchRequest(batchRequest.Requester, batchRequest.FromSeqNo)
+		go requestHandler.HandleBatchRequest(from, batchRequest.FromSeqNo)
 	}
 }
 
@@ -496,7 +600,7 @@ func (p *Service) EnsureSubscribedToSequencer() {
 		case <-p.stopControl.Done():
 			return // host is stopping
 		case <-time.After(_maxWaitWithoutBroadcast / 2):
-			if time.Since(p.lastReceivedBroadcast) > _maxWaitWithoutBroadcast {
+			if time.Since(time.Unix(0, p.lastReceivedBroadcast.Load())) > _maxWaitWithoutBroadcast {
 				p.logger.Info("No broadcast received from sequencer, re-registering.")
 				err := p.RegisterForBroadcasts()
 				if err != nil {
@@ -0,0 +1,491 @@
+package p2p
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"io"
+	"math/big"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/host"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/common/signature"
+	hostconfig "github.com/ten-protocol/go-ten/go/host/config"
+)
+
+var testLogger = log.New("p2p", int(gethlog.LevelError), log.SysOut)
+
+// a valid endorsement from an attested enclave is accepted, anything else is rejected
+func TestEnclaveRegistry_VerifyEndorsement(t *testing.T) {
+	attested := newTestEnclave(t)
+	unknown := newTestEnclave(t)
+	l1 := newTestPublisher()
+	l1.attest(attested.id)
+	registry := newEnclaveRegistry(&testServiceLocator{publisher: l1})
+
+	p2pKey := []byte("p2p key")
+	endorsement, _ := attested.EndorseP2PKey(context.Background(), p2pKey, "host:10000")
+	id, err := registry.verifyEndorsement(p2pKey, "host:10000", endorsement)
+	require.NoError(t, err)
+	require.Equal(t, attested.id, *id)
+
+	// the endorsement is for a different key
+	_, err = registry.verifyEndorsement([]byte("other key"), "host:10000", endorsement)
+	require.Error(t, err)
+
+	// the endorsement is for a different address
+	_, err = registry.verifyEndorsement(p2pKey, "attacker:10000", endorsement)
+	require.Error(t, err)
+
+	// the enclave is not registered
+	endorsement, _ = unknown.EndorseP2PKey(context.Background(), p2pKey, "host:10000")
+	_, err = registry.verifyEndorsement(p2pKey, "host:10000", endorsement)
+	require.Error(t, err)
+}
+
+// unknown enclave IDs can't trigger an unbounded number of L1 lookups, and don't evict the registered enclaves
+func TestEnclaveRegistry_BoundedLookups(t *testing.T) {
+	attested := newTestEnclave(t)
+	l1 := newTestPublisher()
+	l1.attest(attested.id)
+	registry := newEnclaveRegistry(&testServiceLocator{publisher: l1})
+
+	registered, err := registry.isAttested(attested.id)
+	require.NoError(t, err)
+	require.True(t, registered)
+
+	// the first lookup was for the attested enclave
+	var lookupErr error
+	for i := 1; i < _maxL1LookupsPerSec; i++ {
+		_, lookupErr = registry.isAttested(newTestEnclave(t).id)
+		if lookupErr != nil {
+			break
+		}
+	}
+	require.NoError(t, lookupErr)
+	_, err = registry.isAttested(newTestEnclave(t).id)
+	require.ErrorIs(t, err, errTooManyLookups)
+	require.Equal(t, _maxL1LookupsPerSec, l1.callCount())
+
+	// the cached answers are still served
+	registered, err = registry.isAttested(attested.id)
+	require.NoError(t, err)
+	require.True(t, registered)
+}
+
+// only batches signed by a sequencer enclave are accepted, and the management contract answers are cached
+func TestEnclaveRegistry_VerifyBatchSignature(t *testing.T) {
+	sequencer := newTestEnclave(t)
+	validator := newTestEnclave(t)
+	l1 := newTestPublisher()
+	l1.attest(sequencer.id)
+	l1.attest(validator.id)
+	l1.grantSequencer(sequencer.id)
+	sl := &testServiceLocator{publisher: l1, halted: map[common.EnclaveID]bool{}}
+	registry := newEnclaveRegistry(sl)
+
+	require.NoError(t, registry.verifyBatchSignature(sequencer.signBatch(t, 1)))
+	require.NoError(t, registry.verifyBatchSignature(sequencer.signBatch(t, 2)))
+	require.Equal(t, 1, l1.callCount())
+
+	require.Error(t, registry.verifyBatchSignature(validator.signBatch(t, 3)))
+	require.Error(t, registry.verifyBatchSignature(&common.ExtBatch{Header: &common.BatchHeader{Number: big.NewInt(4)}}))
+
+	// the batches of a sequencer reported for fraud are not accepted or gossiped anymore
+	sl.halted[sequencer.id] = true
+	require.Error(t, registry.verifyBatchSignature(sequencer.signBatch(t, 5)))
+}
+
+// messages are delivered between registered peers, and refused by peers which are not registered
+func TestService_OnlyRegisteredPeersCommunicate(t *testing.T) {
+	sequencerEnclave := newTestEnclave(t)
+	validatorEnclave := newTestEnclave(t)
+	intruderEnclave := newTestEnclave(t)
+
+	l1 := newTestPublisher()
+	l1.attest(sequencerEnclave.id)
+	l1.attest(validatorEnclave.id)
+	l1.grantSequencer(sequencerEnclave.id)
+
+	sequencer := newTestService(common.ActiveSequencer, "", sequencerEnclave, l1)
+	require.NoError(t, sequencer.Start())
+	defer sequencer.Stop() //nolint:errcheck
+	sequencerAddress := sequencer.listener.Addr().String()
+
+	received := make(chan common.EncryptedTx, 3)
+	sequencer.SubscribeForTx(txHandler(func(tx common.EncryptedTx) { received <- tx }))
+
+	// the messages are sent in order over a single connection
+	validator := newTestService(common.Validator, sequencerAddress, validatorEnclave, l1)
+	txs := []common.EncryptedTx{common.EncryptedTx("tx1"), common.EncryptedTx("tx2"), common.EncryptedTx("tx3")}
+	for _, tx := range txs {
+		require.NoError(t, validator.SendTxToSequencer(tx))
+	}
+	for _, expected := range txs {
+		select {
+		case tx := <-received:
+			require.Equal(t, expected, tx)
+		case <-time.After(5 * time.Second):
+			t.Fatal("tx from registered peer was not received")
+		}
+	}
+	require.Len(t, validator.peerConns, 1)
+
+	// the sequencer drops the messages of an unregistered peer
+	intruder := newTestService(common.Validator, sequencerAddress, intruderEnclave, l1)
+	_ = intruder.sendBytes(sequencerAddress, msgTypeTx, []byte("intrusion"))
+	select {
+	case <-received:
+		t.Fatal("tx from unregistered peer was received")
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	// nothing is sent to an unregistered peer
+	fakeSequencer := newTestService(common.ActiveSequencer, "", intruderEnclave, l1)
+	require.NoError(t, fakeSequencer.Start())
+	defer fakeSequencer.Stop() //nolint:errcheck
+	require.Error(t, validator.sendBytes(fakeSequencer.listener.Addr().String(), msgTypeTx, []byte("tx")))
+}
+
+// the sequencer sends a live batch to a single validator, which relays it to the other one, and duplicates are dropped
+func TestService_GossipBatches(t *testing.T) {
+	defaultFanout := _gossipFanout
+	_gossipFanout = 1
+	defer func() { _gossipFanout = defaultFanout }()
+
+	sequencerEnclave := newTestEnclave(t)
+	l1 := newTestPublisher()
+	l1.attest(sequencerEnclave.id)
+	l1.grantSequencer(sequencerEnclave.id)
+
+	sequencerAddress := freeAddress(t)
+	sequencer := newTestService(common.ActiveSequencer, "", sequencerEnclave, l1, sequencerAddress)
+	require.NoError(t, sequencer.Start())
+	defer sequencer.Stop() //nolint:errcheck
+
+	received := make(chan *common.ExtBatch, 10)
+	validators := make([]*Service, 2)
+	for i := range validators {
+		enclave := newTestEnclave(t)
+		l1.attest(enclave.id)
+		validators[i] = newTestService(common.Validator, sequencerAddress, enclave, l1, freeAddress(t))
+		validators[i].SubscribeForBatches(batchHandler(func(batches []*common.ExtBatch) {
+			for _, b := range batches {
+				received <- b
+			}
+		}))
+		require.NoError(t, validators[i].Start())
+		defer validators[i].Stop() //nolint:errcheck
+	}
+
+	// the second validator is introduced to the first one by the sequencer
+	require.Eventually(t, func() bool {
+		return len(validators[0].samplePeers(_maxGossipPeers)) == 1 && len(validators[1].samplePeers(_maxGossipPeers)) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	batch := sequencerEnclave.signBatch(t, 1)
+	require.NoError(t, sequencer.BroadcastBatches([]*common.ExtBatch{batch}))
+	require.NoError(t, sequencer.BroadcastBatches([]*common.ExtBatch{batch}))
+	for i := 0; i < len(validators); i++ {
+		select {
+		case b := <-received:
+			require.Equal(t, batch.Hash(), b.Hash())
+		case <-time.After(5 * time.Second):
+			t.Fatal("batch was not gossiped to every validator")
+		}
+	}
+	select {
+	case <-received:
+		t.Fatal("duplicate batch was not dropped")
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
+// a validator whose pool is full rejects the registration, and the registering validator moves on to another candidate
+func TestService_RejectedRegistrationTriesNextPeer(t *testing.T) {
+	defaultMaxPeers := _maxGossipPeers
+	_maxGossipPeers = 1
+	defer func() { _maxGossipPeers = defaultMaxPeers }()
+
+	l1 := newTestPublisher()
+	// the sequencer is not running, the validators are introduced to each other directly
+	sequencerAddress := freeAddress(t)
+	validators := make([]*Service, 3)
+	for i := range validators {
+		enclave := newTestEnclave(t)
+		l1.attest(enclave.id)
+		validators[i] = newTestService(common.Validator, sequencerAddress, enclave, l1, freeAddress(t))
+		require.NoError(t, validators[i].Start())
+		defer validators[i].Stop() //nolint:errcheck
+	}
+	registering, full, available := validators[0], validators[1], validators[2]
+	full.peerAddressesMutex.Lock()
+	full.peerAddresses[freeAddress(t)] = 0
+	full.peerAddressesMutex.Unlock()
+
+	encodedPeers, err := rlp.EncodeToBytes(&peersMsg{Addresses: []string{full.ourPublicAddress, available.ourPublicAddress}})
+	require.NoError(t, err)
+	registering.handlePeers(encodedPeers)
+
+	require.Eventually(t, func() bool {
+		peers := registering.samplePeers(_maxGossipPeers)
+		return len(peers) == 1 && peers[0] == available.ourPublicAddress
+	}, 5*time.Second, 50*time.Millisecond)
+	require.NotContains(t, full.samplePeers(_maxGossipPeers+1), registering.ourPublicAddress)
+	require.Contains(t, available.samplePeers(_maxGossipPeers), registering.ourPublicAddress)
+	registering.peerAddressesMutex.RLock()
+	defer registering.peerAddressesMutex.RUnlock()
+	require.Empty(t, registering.pendingPeers)
+}
+
+// a catch-up request is re-sent to the sequencer when the chosen peer never answers, and answered at the authenticated address
+func TestService_CatchUpFallsBackToSequencer(t *testing.T) {
+	defaultTimeout := _catchUpTimeout
+	_catchUpTimeout = 200 * time.Millisecond
+	defer func() { _catchUpTimeout = defaultTimeout }()
+
+	sequencerEnclave := newTestEnclave(t)
+	l1 := newTestPublisher()
+	l1.attest(sequencerEnclave.id)
+	l1.grantSequencer(sequencerEnclave.id)
+
+	sequencerAddress := freeAddress(t)
+	sequencer := newTestService(common.ActiveSequencer, "", sequencerEnclave, l1, sequencerAddress)
+	requests := make(chan string, 10)
+	sequencer.SubscribeForBatchRequests(batchRequestHandler(func(requestID string, _ *big.Int) {
+		requests <- requestID
+	}))
+	require.NoError(t, sequencer.Start())
+	defer sequencer.Stop() //nolint:errcheck
+
+	validatorEnclave := newTestEnclave(t)
+	l1.attest(validatorEnclave.id)
+	validatorAddress := freeAddress(t)
+	validator := newTestService(common.Validator, sequencerAddress, validatorEnclave, l1, validatorAddress)
+	require.NoError(t, validator.Start())
+	defer validator.Stop() //nolint:errcheck
+
+	// a peer which accepts connections but never answers
+	silentPeer, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer silentPeer.Close()
+	go func() {
+		for {
+			conn, err := silentPeer.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+	validator.peerAddressesMutex.Lock()
+	validator.peerAddresses[silentPeer.Addr().String()] = 0
+	validator.peerAddressesMutex.Unlock()
+
+	require.NoError(t, validator.RequestBatchesFromSequencer(big.NewInt(1)))
+	select {
+	case requester := <-requests:
+		require.Equal(t, validatorAddress, requester)
+	case <-time.After(5 * time.Second):
+		t.Fatal("batch request was not re-sent to the sequencer")
+	}
+}
+
+// frames are read back as written, and frames over the maximum size of their type are refused on both sides
+func TestFrames(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeFrame(&buf, msgTypeTx, []byte("first")))
+	require.NoError(t, writeFrame(&buf, msgTypeBatches, []byte("second")))
+
+	reader := bufio.NewReader(&buf)
+	for _, expected := range []struct {
+		msgType msgType
+		payload string
+	}{{msgTypeTx, "first"}, {msgTypeBatches, "second"}} {
+		frameType, frame, err := readFrame(reader)
+		require.NoError(t, err)
+		require.Equal(t, expected.msgType, frameType)
+		require.Equal(t, expected.payload, string(frame))
+	}
+	_, _, err := readFrame(reader)
+	require.ErrorIs(t, err, io.EOF)
+
+	defaultMaxFrameSize := _maxFrameSize
+	_maxFrameSize = 4
+	defer func() { _maxFrameSize = defaultMaxFrameSize }()
+	require.ErrorIs(t, writeFrame(&buf, msgTypeBatches, []byte("too large")), errFrameTooLarge)
+
+	buf.Reset()
+	buf.Write([]byte{0, 0, 0, 5, byte(msgTypeBatches), 1, 2, 3, 4, 5})
+	_, _, err = readFrame(bufio.NewReader(&buf))
+	require.ErrorIs(t, err, errFrameTooLarge)
+
+	// the limit depends on the message type
+	buf.Reset()
+	buf.Write([]byte{0, 0x01, 0, 1, byte(msgTypeRegisterForBroadcasts)})
+	_, _, err = readFrame(bufio.NewReader(&buf))
+	require.ErrorIs(t, err, errFrameTooLarge)
+
+	// the announced size is not allocated before the payload arrives
+	buf.Reset()
+	buf.Write([]byte{0, 0, 0, 2, byte(msgTypeTx), 1})
+	_, _, err = readFrame(bufio.NewReader(&buf))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func newTestService(nodeType common.NodeType, sequencerAddress string, enclave *testEnclave, l1 *testPublisher, address ...string) *Service {
+	p2pAddress := "127.0.0.1:0"
+	if len(address) > 0 {
+		p2pAddress = address[0]
+	}
+	cfg := &hostconfig.HostConfig{
+		NodeType:             nodeType,
+		P2PBindAddress:       p2pAddress,
+		P2PPublicAddress:     p2pAddress,
+		SequencerP2PAddress:  sequencerAddress,
+		P2PConnectionTimeout: time.Second,
+		IsInboundP2PDisabled: false,
+	}
+	return NewSocketP2PLayer(cfg, &testServiceLocator{publisher: l1, enclave: enclave}, testLogger, nil)
+}
+
+// freeAddress - the public address of a node must be known before it starts, to register with its peers
+func freeAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	return listener.Addr().String()
+}
+
+type batchHandler func(batches []*common.ExtBatch)
+
+func (h batchHandler) HandleBatches(batches []*common.ExtBatch, _ bool) {
+	h(batches)
+}
+
+type batchRequestHandler func(requestID string, fromSeqNo *big.Int)
+
+func (h batchRequestHandler) HandleBatchRequest(requestID string, fromSeqNo *big.Int) {
+	h(requestID, fromSeqNo)
+}
+
+type txHandler func(tx common.EncryptedTx)
+
+func (h txHandler) HandleTransaction(tx common.EncryptedTx) {
+	h(tx)
+}
+
+type testEnclave struct {
+	common.Enclave
+	key *ecdsa.PrivateKey
+	id  common.EnclaveID
+}
+
+func newTestEnclave(t *testing.T) *testEnclave {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return &testEnclave{key: key, id: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+func (e *testEnclave) EndorseP2PKey(_ context.Context, p2pPubKey []byte, p2pAddress string) ([]byte, common.SystemError) {
+	sig, err := signature.Sign(signature.P2PEndorsementHash(p2pPubKey, p2pAddress).Bytes(), e.key)
+	if err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+func (e *testEnclave) signBatch(t *testing.T, number int64) *common.ExtBatch {
+	batch := &common.ExtBatch{Header: &common.BatchHeader{Number: big.NewInt(number)}}
+	sig, err := signature.Sign(batch.Header.Hash().Bytes(), e.key)
+	require.NoError(t, err)
+	batch.Header.Signature = sig
+	return batch
+}
+
+type testPublisher struct {
+	host.L1Publisher
+	lock       sync.Mutex
+	attested   map[common.EnclaveID]bool
+	sequencers map[common.EnclaveID]bool
+	calls      int
+}
+
+func newTestPublisher() *testPublisher {
+	return &testPublisher{attested: map[common.EnclaveID]bool{}, sequencers: map[common.EnclaveID]bool{}}
+}
+
+func (p *testPublisher) attest(id common.EnclaveID) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.attested[id] = true
+}
+
+func (p *testPublisher) grantSequencer(id common.EnclaveID) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.sequencers[id] = true
+}
+
+func (p *testPublisher) callCount() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.calls
+}
+
+func (p *testPublisher) IsAttestedEnclave(id gethcommon.Address) (bool, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.calls++
+	return p.attested[id], nil
+}
+
+func (p *testPublisher) IsSequencerEnclave(id gethcommon.Address) (bool, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.calls++
+	return p.sequencers[id], nil
+}
+
+type testEnclaveService struct {
+	host.EnclaveService
+	enclave common.Enclave
+	halted  map[common.EnclaveID]bool
+}
+
+func (s *testEnclaveService) IsSequencerHalted(sequencer common.EnclaveID) bool {
+	return s.halted[sequencer]
+}
+
+func (s *testEnclaveService) GetEnclaveClient() common.Enclave {
+	return s.enclave
+}
+
+type testServiceLocator struct {
+	publisher *testPublisher
+	enclave   *testEnclave
+	halted    map[common.EnclaveID]bool
+}
+
+func (l *testServiceLocator) L1Publisher() host.L1Publisher {
+	return l.publisher
+}
+
+func (l *testServiceLocator) L2Repo() host.L2BatchRepository {
+	return nil
+}
+
+func (l *testServiceLocator) Enclaves() host.EnclaveService {
+	return &testEnclaveService{enclave: l.enclave, halted: l.halted}
+}
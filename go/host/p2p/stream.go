@@ -0,0 +1,281 @@
+package p2p
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	gethmetrics "github.com/ethereum/go-ethereum/metrics"
+	"github.com/ten-protocol/go-ten/go/common/log"
+)
+
+/*
+Messages are sent over long-lived connections, as frames: a 4-byte big-endian length and the 1-byte message type,
+followed by the RLP-encoded message. Each message type has its own maximum size, and the payload is read as it arrives,
+so a peer can't make the host allocate more than it actually sends.
+
+Each peer we send to has a single outbound connection with a bounded queue of frames, written by a dedicated goroutine.
+When the queue is full the sender waits up to the P2P timeout, so a slow peer slows down its senders instead of
+growing the memory of the host. The sender waits for the frame to be written, so failed writes are retried and count
+towards the eviction of the peer. The connection is dropped when a write fails or the peer closes it, and is
+re-established by the next send.
+*/
+
+const _frameHeaderSize = 5
+
+var (
+	_maxFrameSize        = 64 * 1024 * 1024 // large enough for a batch response of _maxBatchesInP2PResponse batches
+	_maxTxFrameSize      = 1024 * 1024      // an encrypted transaction, which is limited to 128KB by the mempool of the enclave
+	_maxControlFrameSize = 64 * 1024        // the batch requests, the registrations and the peer lists
+	_peerSendQueueSize   = 256              // the number of frames waiting to be sent to a peer before senders are blocked
+)
+
+var errFrameTooLarge = errors.New("p2p frame exceeds the maximum size")
+
+type outboundFrame struct {
+	msgType msgType
+	payload []byte
+	result  chan error // receives the outcome of the write
+}
+
+// maxFrameSize - the largest payload accepted for the message type
+func maxFrameSize(t msgType) int {
+	switch t {
+	case msgTypeBatches:
+		return _maxFrameSize
+	case msgTypeTx:
+		return _maxTxFrameSize
+	default:
+		return _maxControlFrameSize
+	}
+}
+
+// peerConn - the outbound connection to a peer, with its queue of frames
+type peerConn struct {
+	address   string
+	conn      *tls.Conn
+	queue     chan outboundFrame
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (pc *peerConn) close() {
+	pc.closeOnce.Do(func() {
+		close(pc.closed)
+		_ = pc.conn.Close()
+	})
+}
+
+func (pc *peerConn) isClosed() bool {
+	select {
+	case <-pc.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// p2pMetrics - the depth of the send queues and the bytes sent for each message type
+type p2pMetrics struct {
+	queueDepth gethmetrics.Gauge
+	bytesSent  map[msgType]gethmetrics.Counter
+}
+
+func newP2PMetrics(registry gethmetrics.Registry) *p2pMetrics {
+	bytesSent := make(map[msgType]gethmetrics.Counter)
+	for t := _minMsgType; t <= _maxMsgType; t++ {
+		bytesSent[t] = gethmetrics.GetOrRegisterCounter(fmt.Sprintf("host/p2p/sent/%s/bytes", t), registry)
+	}
+	return &p2pMetrics{
+		queueDepth: gethmetrics.GetOrRegisterGauge("host/p2p/queue/depth", registry),
+		bytesSent:  bytesSent,
+	}
+}
+
+// writeFrame - writes the header and the payload in a single write
+func writeFrame(w io.Writer, t msgType, payload []byte) error {
+	if len(payload) > maxFrameSize(t) {
+		return errFrameTooLarge
+	}
+	frame := make([]byte, _frameHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(frame, uint32(len(payload)))
+	frame[4] = byte(t)
+	copy(frame[_frameHeaderSize:], payload)
+	_, err := w.Write(frame)
+	return err
+}
+
+// readFrame - returns io.EOF if the connection was closed between frames
+func readFrame(r *bufio.Reader) (msgType, []byte, error) {
+	header := make([]byte, _frameHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, nil, err
+	}
+	size := binary.BigEndian.Uint32(header)
+	t := msgType(header[4])
+	if t < _minMsgType || t > _maxMsgType {
+		return 0, nil, fmt.Errorf("invalid message type %d", t)
+	}
+	if size > uint32(maxFrameSize(t)) {
+		return 0, nil, errFrameTooLarge
+	}
+	// the buffer grows with the bytes received, instead of allocating the announced size upfront
+	var payload bytes.Buffer
+	if _, err := io.CopyN(&payload, r, int64(size)); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, nil, fmt.Errorf("could not read frame. Cause: %w", err)
+	}
+	return t, payload.Bytes(), nil
+}
+
+// getPeerConn - returns the open connection to the peer, or connects and authenticates it
+func (p *Service) getPeerConn(address string) (*peerConn, error) {
+	p.peerConnsMutex.Lock()
+	pc, ok := p.peerConns[address]
+	p.peerConnsMutex.Unlock()
+	if ok && !pc.isClosed() {
+		return pc, nil
+	}
+
+	newPC, err := p.dial(address)
+	if err != nil {
+		return nil, err
+	}
+
+	p.peerConnsMutex.Lock()
+	defer p.peerConnsMutex.Unlock()
+	// another sender might have connected in the meantime
+	if pc, ok := p.peerConns[address]; ok && !pc.isClosed() {
+		newPC.close()
+		return pc, nil
+	}
+	p.peerConns[address] = newPC
+	go p.writeLoop(newPC)
+	return newPC, nil
+}
+
+func (p *Service) dial(address string) (*peerConn, error) {
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: p.p2pTimeout}, tcp, address, p.clientTLSConfig())
+	if err != nil {
+		p.logger.Debug(fmt.Sprintf("could not connect to peer on address %s", address), log.ErrKey, err)
+		return nil, err
+	}
+
+	// nothing is sent to peers which are not registered
+	_ = conn.SetDeadline(time.Now().Add(p.p2pTimeout))
+	reader := bufio.NewReader(conn)
+	if _, err := p.authenticatePeer(conn, reader); err != nil {
+		_ = conn.Close()
+		p.logger.Warn(fmt.Sprintf("could not authenticate peer on address %s", address), log.ErrKey, err)
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Time{})
+
+	pc := &peerConn{
+		address: address,
+		conn:    conn,
+		queue:   make(chan outboundFrame, _peerSendQueueSize),
+		closed:  make(chan struct{}),
+	}
+	// nothing is expected from the peer on this connection, reading only detects when it is closed
+	go func() {
+		_, _ = io.Copy(io.Discard, reader)
+		p.dropPeerConn(pc)
+	}()
+	return pc, nil
+}
+
+// enqueue - waits for space in the queue of the peer, up to the P2P timeout, and then for the frame to be written
+func (p *Service) enqueue(pc *peerConn, frame outboundFrame) error {
+	select {
+	case pc.queue <- frame:
+		p.metrics.queueDepth.Inc(1)
+	case <-pc.closed:
+		return fmt.Errorf("connection to peer %s was closed", pc.address)
+	case <-time.After(p.p2pTimeout):
+		return fmt.Errorf("send queue for peer %s is full", pc.address)
+	}
+
+	select {
+	case err := <-frame.result:
+		return err
+	case <-pc.closed:
+		// the frame might have been written just before the connection was closed
+		select {
+		case err := <-frame.result:
+			return err
+		default:
+			return fmt.Errorf("connection to peer %s was closed before the message was sent", pc.address)
+		}
+	}
+}
+
+func (p *Service) writeLoop(pc *peerConn) {
+	for {
+		select {
+		case <-pc.closed:
+			// the frames which were not sent are discarded
+			p.metrics.queueDepth.Dec(int64(len(pc.queue)))
+			return
+		case frame := <-pc.queue:
+			p.metrics.queueDepth.Dec(1)
+			_ = pc.conn.SetWriteDeadline(time.Now().Add(p.p2pTimeout))
+			if err := writeFrame(pc.conn, frame.msgType, frame.payload); err != nil {
+				p.logger.Debug(fmt.Sprintf("could not send message to peer on address %s", pc.address), log.ErrKey, err)
+				p.dropPeerConn(pc)
+				frame.result <- fmt.Errorf("could not send message to peer %s. Cause: %w", pc.address, err)
+				continue
+			}
+			p.metrics.bytesSent[frame.msgType].Inc(int64(_frameHeaderSize + len(frame.payload)))
+			frame.result <- nil
+		}
+	}
+}
+
+func (p *Service) dropPeerConn(pc *peerConn) {
+	pc.close()
+	p.peerConnsMutex.Lock()
+	defer p.peerConnsMutex.Unlock()
+	if p.peerConns[pc.address] == pc {
+		delete(p.peerConns, pc.address)
+	}
+}
+
+// closeConnections - closes the outbound and inbound connections when the service stops
+func (p *Service) closeConnections() {
+	p.peerConnsMutex.Lock()
+	peerConns := make([]*peerConn, 0, len(p.peerConns))
+	for _, pc := range p.peerConns {
+		peerConns = append(peerConns, pc)
+	}
+	p.peerConnsMutex.Unlock()
+	for _, pc := range peerConns {
+		p.dropPeerConn(pc)
+	}
+
+	p.inboundConnsMutex.Lock()
+	defer p.inboundConnsMutex.Unlock()
+	for conn := range p.inboundConns {
+		_ = conn.Close()
+	}
+}
+
+func (p *Service) trackInbound(conn net.Conn) func() {
+	p.inboundConnsMutex.Lock()
+	p.inboundConns[conn] = struct{}{}
+	p.inboundConnsMutex.Unlock()
+	return func() {
+		p.inboundConnsMutex.Lock()
+		delete(p.inboundConns, conn)
+		p.inboundConnsMutex.Unlock()
+	}
+}
@@ -0,0 +1,157 @@
+package p2p
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+/*
+The P2P transport is TLS 1.3 with mutual authentication.
+
+Each host generates a TLS key when it starts, and asks its enclave to endorse it together with the public P2P address
+of the host (to sign them with the enclave key).
+After the TLS handshake, which proves that each side owns the key of its certificate, the peers exchange their
+addresses and the endorsements. A peer is accepted only if the enclave which endorsed its key is attested in the
+management contract. The responses to a peer are sent to its endorsed address, never to an address found in a message.
+*/
+
+const _maxEndorsementMsgSize = 1024
+
+// endorsementMsg - sent by both sides after the TLS handshake
+type endorsementMsg struct {
+	Address     string // the public P2P address of the host
+	Endorsement []byte // the signature of the enclave over the TLS public key and the address of the host
+}
+
+// identity - the TLS certificate of the host and the endorsement of its key and address by the enclave
+type identity struct {
+	cert        tls.Certificate
+	address     string
+	endorsement []byte
+}
+
+// peerIdentity - the authenticated identity of a peer
+type peerIdentity struct {
+	enclaveID common.EnclaveID
+	address   string // the endorsed public P2P address of the peer
+}
+
+func newIdentity(enclave common.Enclave, address string) (*identity, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate p2p key. Cause: %w", err)
+	}
+	// the certificate is self-signed, the trust comes from the endorsement of the enclave
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(10, 0, 0),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, fmt.Errorf("could not create p2p certificate. Cause: %w", err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse p2p certificate. Cause: %w", err)
+	}
+
+	endorsement, err := enclave.EndorseP2PKey(context.Background(), leaf.RawSubjectPublicKeyInfo, address)
+	if err != nil {
+		return nil, fmt.Errorf("could not get the p2p key endorsed by the enclave. Cause: %w", err)
+	}
+
+	return &identity{
+		cert: tls.Certificate{
+			Certificate: [][]byte{der},
+			PrivateKey:  key,
+			Leaf:        leaf,
+		},
+		address:     address,
+		endorsement: endorsement,
+	}, nil
+}
+
+// getIdentity - the identity is created the first time it is needed, because the enclave might not be available when
+// the P2P service starts
+func (p *Service) getIdentity() (*identity, error) {
+	p.identityMutex.Lock()
+	defer p.identityMutex.Unlock()
+	if p.identity != nil {
+		return p.identity, nil
+	}
+	id, err := newIdentity(p.sl.Enclaves().GetEnclaveClient(), p.ourPublicAddress)
+	if err != nil {
+		return nil, err
+	}
+	p.identity = id
+	return id, nil
+}
+
+func (p *Service) getCertificate() (*tls.Certificate, error) {
+	id, err := p.getIdentity()
+	if err != nil {
+		return nil, err
+	}
+	return &id.cert, nil
+}
+
+func (p *Service) serverTLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS13,
+		ClientAuth: tls.RequireAnyClientCert,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return p.getCertificate()
+		},
+	}
+}
+
+func (p *Service) clientTLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS13,
+		// the certificates are self-signed. The peer is authenticated using the endorsement of its key.
+		InsecureSkipVerify: true, //nolint:gosec
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return p.getCertificate()
+		},
+	}
+}
+
+// authenticatePeer - exchanges the endorsements over an established TLS connection and returns the ID of the enclave
+// backing the peer and the endorsed address of the peer, if the enclave is attested
+func (p *Service) authenticatePeer(conn *tls.Conn, reader *bufio.Reader) (*peerIdentity, error) {
+	id, err := p.getIdentity()
+	if err != nil {
+		return nil, err
+	}
+	if err := rlp.Encode(conn, &endorsementMsg{Address: id.address, Endorsement: id.endorsement}); err != nil {
+		return nil, fmt.Errorf("could not send endorsement. Cause: %w", err)
+	}
+
+	var peerMsg endorsementMsg
+	if err := rlp.NewStream(reader, _maxEndorsementMsgSize).Decode(&peerMsg); err != nil {
+		return nil, fmt.Errorf("could not read the endorsement of the peer. Cause: %w", err)
+	}
+
+	peerCerts := conn.ConnectionState().PeerCertificates
+	if len(peerCerts) == 0 {
+		return nil, errors.New("peer did not present a certificate")
+	}
+	enclaveID, err := p.enclaveRegistry.verifyEndorsement(peerCerts[0].RawSubjectPublicKeyInfo, peerMsg.Address, peerMsg.Endorsement)
+	if err != nil {
+		return nil, err
+	}
+	return &peerIdentity{enclaveID: *enclaveID, address: peerMsg.Address}, nil
+}
@@ -0,0 +1,61 @@
+package clientapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+	"github.com/ten-protocol/go-ten/go/host/storage"
+	"github.com/ten-protocol/go-ten/lib/gethfork/node"
+)
+
+// BlobSidecarsPath - same path as the beacon API, so that other nodes can use this node as their blob archive
+const BlobSidecarsPath = "/eth/v1/beacon/blob_sidecars/"
+
+// NewBlobArchiveRoutes returns the routes that serve the blobs archived by the host
+func NewBlobArchiveRoutes(archive storage.BlobArchive, logger gethlog.Logger) []node.Route {
+	return []node.Route{
+		{
+			Name: BlobSidecarsPath,
+			Func: blobSidecarsHandler(archive, logger),
+		},
+	}
+}
+
+// blobSidecarsHandler - serves `GET /eth/v1/beacon/blob_sidecars/{slot}`. Block roots are not supported, because the
+// archive is indexed by slot
+func blobSidecarsHandler(archive storage.BlobArchive, logger gethlog.Logger) func(resp http.ResponseWriter, req *http.Request) {
+	return func(resp http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			http.Error(resp, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		slot, err := strconv.ParseUint(strings.TrimPrefix(req.URL.Path, BlobSidecarsPath), 10, 64)
+		if err != nil {
+			http.Error(resp, "invalid slot", http.StatusBadRequest)
+			return
+		}
+
+		sidecars, err := archive.FetchBlobSidecarsBySlot(slot)
+		if err != nil {
+			if errors.Is(err, errutil.ErrNotFound) {
+				http.Error(resp, "no blob sidecars found for slot", http.StatusNotFound)
+				return
+			}
+			logger.Error("Could not read the archived blobs", "slot", slot, log.ErrKey, err)
+			http.Error(resp, "internal error", http.StatusInternalServerError)
+			return
+		}
+
+		resp.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(resp).Encode(ethadapter.APIGetBlobSidecarsResponse{Data: sidecars}); err != nil {
+			logger.Warn("Could not write the archived blobs", "slot", slot, log.ErrKey, err)
+		}
+	}
+}
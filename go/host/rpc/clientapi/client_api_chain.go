@@ -6,6 +6,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/host"
@@ -64,18 +65,24 @@ func (api *ChainAPI) GetBatchByHash(_ context.Context, hash gethcommon.Hash, _ b
 	return batchHeader, nil
 }
 
-// GasPrice is a placeholder for an RPC method required by MetaMask/Remix.
+// GasPrice returns the base fee of the next batch, which is derived from the gas used by the head batch.
 func (api *ChainAPI) GasPrice(context.Context) (*hexutil.Big, error) {
 	header, err := api.host.Storage().FetchHeadBatchHeader()
 	if err != nil {
 		return nil, err
 	}
 
-	if header.BaseFee == nil || header.BaseFee.Cmp(gethcommon.Big0) == 0 {
+	baseFee := api.nextBaseFee(header)
+	if baseFee.Cmp(gethcommon.Big0) == 0 {
 		return (*hexutil.Big)(big.NewInt(params.InitialBaseFee)), nil
 	}
+	return (*hexutil.Big)(baseFee), nil
+}
 
-	return (*hexutil.Big)(big.NewInt(0).Set(header.BaseFee)), nil
+// nextBaseFee - the base fee of the batch that will extend `header`
+func (api *ChainAPI) nextBaseFee(header *common.BatchHeader) *big.Int {
+	cfg := api.host.Config()
+	return common.CalcNextBaseFee(header, cfg.GasBatchTarget, cfg.BaseFee, cfg.GasBaseFeeActivationHeight)
 }
 
 // GetCode returns the code stored at the given address in the state for the given batch height or batch hash.
@@ -90,67 +97,95 @@ func (api *ChainAPI) GetCode(ctx context.Context, address gethcommon.Address, bl
 	return code, nil
 }
 
-func (api *ChainAPI) MaxPriorityFeePerGas(_ context.Context) (*hexutil.Big, error) {
-	// todo - implement with the gas mechanics
-	header, err := api.host.Storage().FetchHeadBatchHeader()
+// MaxPriorityFeePerGas suggests a priority fee based on the tips paid by the transactions of the recent batches.
+func (api *ChainAPI) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	minTip := api.host.Config().MinGasPrice
+	head, err := api.host.Storage().FetchHeadBatchHeader()
 	if err != nil {
-		api.logger.Error("Unable to retrieve header for fee history.", log.ErrKey, err)
+		api.logger.Error("Unable to retrieve header for the priority fee suggestion.", log.ErrKey, err)
 		return nil, fmt.Errorf("unable to retrieve MaxPriorityFeePerGas")
 	}
 
-	// just return the base fee?
-	return (*hexutil.Big)(header.BaseFee), err
+	// the suggestion falls back to the minimum tip if the fees of the transactions are not available
+	headHeight := head.Number.Uint64()
+	oldest := headHeight + 1 - min(priorityFeeSampleBatches, headHeight+1)
+	txFees, sysError := api.host.EnclaveClient().GetBatchTxFees(ctx, oldest, headHeight, []float64{priorityFeePercentile})
+	if sysError != nil {
+		api.logger.Warn("Unable to retrieve the fees of the recent batches.", log.ErrKey, sysError)
+		txFees = nil
+	}
+	return (*hexutil.Big)(suggestPriorityFee(txFees, minTip)), nil
 }
 
-// FeeHistory is a placeholder for an RPC method required by MetaMask/Remix.
-// rpc.DecimalOrHex -> []byte
-func (api *ChainAPI) FeeHistory(context.Context, string, rpc.BlockNumber, []float64) (*FeeHistoryResult, error) {
-	// todo (#1621) - return a non-dummy fee history
-	header, err := api.host.Storage().FetchHeadBatchHeader()
+// FeeHistory returns the base fees, the gas used ratios and the rewards at the requested percentiles of up to
+// common.MaxFeeHistoryBatches batches, ending with newestBlock.
+func (api *ChainAPI) FeeHistory(ctx context.Context, blockCount math.HexOrDecimal64, newestBlock rpc.BlockNumber, rewardPercentiles []float64) (*FeeHistoryResult, error) {
+	if err := common.ValidateRewardPercentiles(rewardPercentiles); err != nil {
+		return nil, err
+	}
+
+	head, err := api.host.Storage().FetchHeadBatchHeader()
 	if err != nil {
 		api.logger.Error("Unable to retrieve header for fee history.", log.ErrKey, err)
 		return nil, fmt.Errorf("unable to retrieve fee history")
 	}
-
-	batches := make([]*common.BatchHeader, 0)
-	batches = append(batches, header)
-
-	feeHist := &FeeHistoryResult{
-		OldestBlock:  (*hexutil.Big)(header.Number),
-		Reward:       [][]*hexutil.Big{},
-		BaseFee:      []*hexutil.Big{},
-		GasUsedRatio: []float64{},
+	headHeight := head.Number.Uint64()
+
+	newestHeight := headHeight
+	switch {
+	case newestBlock >= 0:
+		newestHeight = uint64(newestBlock.Int64())
+	case newestBlock == rpc.SafeBlockNumber || newestBlock == rpc.FinalizedBlockNumber:
+		newest, err := api.GetBatchByNumber(ctx, newestBlock, false)
+		if err != nil {
+			return nil, err
+		}
+		newestHeight = newest.Number.Uint64()
+	}
+	if newestHeight > headHeight {
+		return nil, fmt.Errorf("requested batch %d is beyond the head batch %d", newestHeight, headHeight)
+	}
+	if blockCount == 0 {
+		return buildFeeHistory(nil, newestHeight, nil, rewardPercentiles), nil
 	}
 
-	for _, header := range batches {
-		// 0.9 - This number represents how full the block is. As we dont have a dynamic base fee, we tell whomever is requesting that
-		// we expect the baseFee to increase, rather than decrease in order to avoid underpriced transactions.
-		feeHist.GasUsedRatio = append(feeHist.GasUsedRatio, 0.9)
-		feeHist.BaseFee = append(feeHist.BaseFee, (*hexutil.Big)(header.BaseFee))
+	// the fees of the batch following the newest one are requested as well for its base fee, unless the newest batch
+	// is the head
+	count := min(uint64(blockCount), common.MaxFeeHistoryBatches, newestHeight+1)
+	oldest := newestHeight + 1 - count
+	txFees, sysError := api.host.EnclaveClient().GetBatchTxFees(ctx, oldest, min(newestHeight+1, headHeight), rewardPercentiles)
+	if sysError != nil {
+		api.logger.Error(fmt.Sprintf("Enclave System Error. Function %s", "GetBatchTxFees"), log.ErrKey, sysError)
+		return nil, fmt.Errorf(responses.InternalErrMsg)
 	}
-	return feeHist, nil
-}
 
-// FeeHistoryResult is the structure returned by Geth `eth_feeHistory` API.
-type FeeHistoryResult struct {
-	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
-	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
-	BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
-	GasUsedRatio []float64        `json:"gasUsedRatio"`
+	return buildFeeHistory(txFees, newestHeight, api.nextBaseFee(head), rewardPercentiles), nil
 }
 
-// Given a batch number, returns the hash of the batch with that number.
+// Given a batch number, returns the hash of the batch with that number. The safe batch is the last batch included in
+// a rollup published to the L1, and the finalized batch is the last batch included in a rollup in a finalized L1 block.
 func (api *ChainAPI) batchNumberToBatchHash(batchNumber rpc.BlockNumber) (*gethcommon.Hash, error) {
 	// Handling the special cases first. No special handling is required for rpc.EarliestBlockNumber.
-	// note: our API currently treats all these block statuses the same for obscuro batches
-	if batchNumber == rpc.LatestBlockNumber || batchNumber == rpc.PendingBlockNumber ||
-		batchNumber == rpc.FinalizedBlockNumber || batchNumber == rpc.SafeBlockNumber {
+	switch batchNumber {
+	case rpc.LatestBlockNumber, rpc.PendingBlockNumber:
 		batchHeader, err := api.host.Storage().FetchHeadBatchHeader()
 		if err != nil {
 			return nil, err
 		}
 		batchHash := batchHeader.Hash()
 		return &batchHash, nil
+	case rpc.SafeBlockNumber:
+		seqNo, err := api.host.Storage().FetchSafeBatchSeqNo()
+		if err != nil {
+			return nil, fmt.Errorf("safe batch not found. Cause: %w", err)
+		}
+		return api.batchSeqNoToBatchHash(seqNo)
+	case rpc.FinalizedBlockNumber:
+		seqNo, err := api.host.Storage().FetchFinalizedBatchSeqNo()
+		if err != nil {
+			return nil, fmt.Errorf("finalized batch not found. Cause: %w", err)
+		}
+		return api.batchSeqNoToBatchHash(seqNo)
 	}
 	batchNumberBig := big.NewInt(batchNumber.Int64())
 	batchHash, err := api.host.Storage().FetchBatchHashByHeight(batchNumberBig)
@@ -159,3 +194,12 @@ func (api *ChainAPI) batchNumberToBatchHash(batchNumber rpc.BlockNumber) (*gethc
 	}
 	return batchHash, nil
 }
+
+func (api *ChainAPI) batchSeqNoToBatchHash(seqNo uint64) (*gethcommon.Hash, error) {
+	batch, err := api.host.Storage().FetchBatchBySeqNo(seqNo)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch batch with seq no %d. Cause: %w", seqNo, err)
+	}
+	batchHash := batch.Hash()
+	return &batchHash, nil
+}
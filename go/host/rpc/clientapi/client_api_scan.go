@@ -2,6 +2,7 @@ package clientapi
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -28,6 +29,19 @@ func (s *ScanAPI) GetTotalContractCount(ctx context.Context) (*big.Int, error) {
 	return s.host.EnclaveClient().GetTotalContractCount(ctx)
 }
 
+// GetPublicStorageAt returns the value of a storage slot that the contract made public. For the entries of a public
+// mapping, the slot is the base slot of the mapping and the key must be provided.
+func (s *ScanAPI) GetPublicStorageAt(ctx context.Context, address gethcommon.Address, slot gethcommon.Hash, mappingKey *gethcommon.Hash) (*common.PublicStorageValue, error) {
+	value, err := s.host.EnclaveClient().GetPublicStorageAt(ctx, address, slot, mappingKey)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, fmt.Errorf("storage slot %s of contract %s is not public", slot, address)
+	}
+	return value, nil
+}
+
 // GetTransaction returns the transaction given its hash.
 func (s *ScanAPI) GetTransaction(hash gethcommon.Hash) (*common.PublicTransaction, error) {
 	return s.host.Storage().FetchTransaction(hash)
@@ -117,3 +131,13 @@ func (s *ScanAPI) GetRollupBatches(rollupHash gethcommon.Hash) (*common.BatchLis
 func (s *ScanAPI) GetBatchTransactions(batchHash gethcommon.Hash) (*common.TransactionListingResponse, error) {
 	return s.host.Storage().FetchBatchTransactions(batchHash)
 }
+
+// GetExtRollup returns the encrypted rollup given its hash, as published to the L1.
+func (s *ScanAPI) GetExtRollup(rollupHash gethcommon.Hash) (*common.ExtRollup, error) {
+	return s.host.Storage().FetchExtRollup(rollupHash)
+}
+
+// GetRevealedDAKeys returns the keys of a revelation period, which can be used to decrypt its rollups.
+func (s *ScanAPI) GetRevealedDAKeys(period uint64) ([]common.RevealedDAKey, error) {
+	return s.host.Storage().FetchRevealedDAKeys(period)
+}
@@ -0,0 +1,98 @@
+package clientapi
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+const (
+	// the number of recent batches whose tips are sampled to suggest a priority fee
+	priorityFeeSampleBatches = 20
+	// the percentile of the sampled tips that is suggested (same as geth's gas price oracle)
+	priorityFeePercentile = 60
+)
+
+// FeeHistoryResult is the structure returned by Geth `eth_feeHistory` API.
+type FeeHistoryResult struct {
+	OldestBlock  *hexutil.Big     `json:"oldestBlock"`
+	Reward       [][]*hexutil.Big `json:"reward,omitempty"`
+	BaseFee      []*hexutil.Big   `json:"baseFeePerGas,omitempty"`
+	GasUsedRatio []float64        `json:"gasUsedRatio"`
+}
+
+// buildFeeHistory - the fees are computed by the enclave for consecutive batches ordered by height, up to the batch
+// following the newest one when it exists. Its base fee is the last base fee of the history, otherwise the newest batch
+// is the head and the base fee of the next batch is `headNextBaseFee`.
+func buildFeeHistory(fees []common.BatchTxFees, newestHeight uint64, headNextBaseFee *big.Int, percentiles []float64) *FeeHistoryResult {
+	result := &FeeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(new(big.Int)),
+		BaseFee:      make([]*hexutil.Big, 0, len(fees)+1),
+		GasUsedRatio: make([]float64, 0, len(fees)),
+	}
+	if len(fees) == 0 {
+		return result
+	}
+	result.OldestBlock = (*hexutil.Big)(new(big.Int).SetUint64(fees[0].Height))
+
+	nextBaseFee := headNextBaseFee
+	for _, batch := range fees {
+		if batch.Height > newestHeight {
+			nextBaseFee = batch.BaseFee
+			break
+		}
+		result.BaseFee = append(result.BaseFee, (*hexutil.Big)(batch.BaseFee))
+		result.GasUsedRatio = append(result.GasUsedRatio, gasUsedRatio(batch))
+		if len(percentiles) > 0 {
+			result.Reward = append(result.Reward, rewardsOf(batch, len(percentiles)))
+		}
+	}
+	result.BaseFee = append(result.BaseFee, (*hexutil.Big)(nextBaseFee))
+	return result
+}
+
+func gasUsedRatio(batch common.BatchTxFees) float64 {
+	if batch.GasLimit == 0 {
+		return 0
+	}
+	return float64(batch.GasUsed) / float64(batch.GasLimit)
+}
+
+// rewardsOf - batches without paid transactions have no rewards
+func rewardsOf(batch common.BatchTxFees, count int) []*hexutil.Big {
+	rewards := make([]*hexutil.Big, count)
+	for i := range rewards {
+		if i < len(batch.Rewards) {
+			rewards[i] = (*hexutil.Big)(batch.Rewards[i])
+		} else {
+			rewards[i] = (*hexutil.Big)(new(big.Int))
+		}
+	}
+	return rewards
+}
+
+// suggestPriorityFee - the fees contain the reward at priorityFeePercentile of each sampled batch. Returns the same
+// percentile of these rewards, ignoring the batches without paid transactions, but never less than the minimum tip
+// accepted by the mempool
+func suggestPriorityFee(fees []common.BatchTxFees, minTip *big.Int) *big.Int {
+	tips := make([]*big.Int, 0, len(fees))
+	for _, batch := range fees {
+		if len(batch.Rewards) > 0 {
+			tips = append(tips, batch.Rewards[0])
+		}
+	}
+
+	suggestion := new(big.Int)
+	if len(tips) > 0 {
+		sort.Slice(tips, func(i, j int) bool {
+			return tips[i].Cmp(tips[j]) < 0
+		})
+		suggestion.Set(tips[(len(tips)-1)*priorityFeePercentile/100])
+	}
+	if minTip != nil && suggestion.Cmp(minTip) < 0 {
+		suggestion.Set(minTip)
+	}
+	return suggestion
+}
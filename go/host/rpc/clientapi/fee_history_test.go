@@ -0,0 +1,59 @@
+package clientapi
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+)
+
+func testFees(height uint64, baseFee int64, gasUsed uint64, rewards ...int64) common.BatchTxFees {
+	fees := common.BatchTxFees{
+		Height:   height,
+		BaseFee:  big.NewInt(baseFee),
+		GasLimit: 1_000_000,
+		GasUsed:  gasUsed,
+	}
+	for _, r := range rewards {
+		fees.Rewards = append(fees.Rewards, big.NewInt(r))
+	}
+	return fees
+}
+
+func TestFeeHistory(t *testing.T) {
+	txFees := []common.BatchTxFees{testFees(5, 10, 500_000, 0, 1, 5), testFees(6, 20, 0), testFees(7, 30, 0)}
+
+	history := buildFeeHistory(txFees, 6, big.NewInt(40), []float64{10, 50, 90})
+	require.Equal(t, uint64(5), history.OldestBlock.ToInt().Uint64())
+	require.Equal(t, []float64{0.5, 0}, history.GasUsedRatio)
+
+	// the last base fee is the one of the batch following the newest one
+	require.Len(t, history.BaseFee, 3)
+	require.Equal(t, int64(30), history.BaseFee[2].ToInt().Int64())
+
+	require.Len(t, history.Reward, 2)
+	require.Equal(t, int64(0), history.Reward[0][0].ToInt().Int64())
+	require.Equal(t, int64(1), history.Reward[0][1].ToInt().Int64())
+	require.Equal(t, int64(5), history.Reward[0][2].ToInt().Int64())
+	// empty batches have no rewards
+	require.Equal(t, int64(0), history.Reward[1][2].ToInt().Int64())
+
+	// when the newest batch is the head, the base fee of the next batch is computed by the host
+	history = buildFeeHistory(txFees, 7, big.NewInt(40), nil)
+	require.Nil(t, history.Reward)
+	require.Equal(t, int64(40), history.BaseFee[3].ToInt().Int64())
+
+	require.Error(t, common.ValidateRewardPercentiles([]float64{50, 10}))
+	require.Error(t, common.ValidateRewardPercentiles([]float64{101}))
+	require.NoError(t, common.ValidateRewardPercentiles([]float64{0, 50, 100}))
+}
+
+func TestSuggestPriorityFee(t *testing.T) {
+	txFees := []common.BatchTxFees{testFees(1, 10, 42_000, 2), testFees(2, 10, 0), testFees(3, 10, 63_000, 4), testFees(4, 10, 21_000, 3)}
+
+	require.Equal(t, int64(3), suggestPriorityFee(txFees, big.NewInt(1)).Int64())
+	require.Equal(t, int64(4), suggestPriorityFee(txFees, big.NewInt(4)).Int64())
+	// without transactions the minimum tip is suggested
+	require.Equal(t, int64(2), suggestPriorityFee(nil, big.NewInt(2)).Int64())
+}
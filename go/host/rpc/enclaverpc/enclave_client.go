@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"time"
 
@@ -20,7 +22,6 @@ import (
 	"github.com/ten-protocol/go-ten/go/common/rpc"
 	"github.com/ten-protocol/go-ten/go/common/rpc/generated"
 	"github.com/ten-protocol/go-ten/go/common/syserr"
-	"github.com/ten-protocol/go-ten/go/common/tracers"
 	"github.com/ten-protocol/go-ten/go/responses"
 
 	"google.golang.org/grpc"
@@ -112,6 +113,76 @@ func (c *Client) ExportCrossChainData(ctx context.Context, from uint64, to uint6
 	return &bundle, nil
 }
 
+func (c *Client) GetFraudReport(ctx context.Context) (*common.FraudReport, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, c.enclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetFraudReport(timeoutCtx, &generated.GetFraudReportRequest{})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return rpc.FromFraudReportMsg(response.Report), nil
+}
+
+// ExportStateSnapshot - the snapshot can take a long time to create, so the deadline is left to the caller
+func (c *Client) ExportStateSnapshot(ctx context.Context, seqNo uint64, onChunk func(chunk []byte) error) (*common.StateSnapshot, common.SystemError) {
+	stream, err := c.protoClient.ExportStateSnapshot(ctx, &generated.ExportStateSnapshotRequest{BatchSeqNo: seqNo})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	// the chunks are streamed first, and the snapshot is sent last
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			return nil, syserr.NewRPCError(err)
+		}
+		if response.SystemError != nil {
+			return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+		}
+		if response.Snapshot != nil {
+			return rpc.FromStateSnapshotMsg(response.Snapshot), nil
+		}
+		if err := onChunk(response.Chunk); err != nil {
+			return nil, syserr.NewInternalError(fmt.Errorf("could not process state snapshot chunk. Cause: %w", err))
+		}
+	}
+}
+
+// ImportStateSnapshot - the snapshot can take a long time to import, so the deadline is left to the caller
+func (c *Client) ImportStateSnapshot(ctx context.Context, snapshot *common.StateSnapshot, nextChunk func() ([]byte, error)) common.SystemError {
+	stream, err := c.protoClient.ImportStateSnapshot(ctx)
+	if err != nil {
+		return syserr.NewRPCError(err)
+	}
+	if err := stream.Send(&generated.ImportStateSnapshotRequest{Snapshot: rpc.ToStateSnapshotMsg(snapshot)}); err != nil {
+		return syserr.NewRPCError(err)
+	}
+	for {
+		chunk, err := nextChunk()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return syserr.NewInternalError(fmt.Errorf("could not read state snapshot chunk. Cause: %w", err))
+		}
+		if err := stream.Send(&generated.ImportStateSnapshotRequest{Chunk: chunk}); err != nil {
+			// the enclave stopped reading the chunks, the reason is returned below
+			break
+		}
+	}
+	response, err := stream.CloseAndRecv()
+	if err != nil {
+		return syserr.NewRPCError(err)
+	}
+	if response.SystemError != nil {
+		return syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return nil
+}
+
 func (c *Client) StopClient() common.SystemError {
 	c.logger.Info("Closing rpc server connection.")
 	return c.connection.Close()
@@ -212,6 +283,20 @@ func (c *Client) RPCEncryptionKey(ctx context.Context) ([]byte, common.SystemErr
 	return response.RpcPubKey, nil
 }
 
+func (c *Client) EndorseP2PKey(ctx context.Context, p2pPubKey []byte, p2pAddress string) ([]byte, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, c.enclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.EndorseP2PKey(timeoutCtx, &generated.EndorseP2PKeyRequest{P2PPubKey: p2pPubKey, P2PAddress: p2pAddress})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return response.Endorsement, nil
+}
+
 func (c *Client) SubmitL1Block(ctx context.Context, processed *common.ProcessedL1Data) (*common.BlockSubmissionResponse, common.SystemError) {
 	var buffer bytes.Buffer
 	if err := processed.BlockHeader.EncodeRLP(&buffer); err != nil {
@@ -362,29 +447,6 @@ func (c *Client) CreateRollup(ctx context.Context, fromSeqNo uint64) (*common.Ex
 	return rpc.FromExtRollupMsg(response.Msg), nil
 }
 
-func (c *Client) DebugTraceTransaction(ctx context.Context, hash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, common.SystemError) {
-	timeoutCtx, cancel := context.WithTimeout(ctx, c.enclaveRPCTimeout)
-	defer cancel()
-
-	confBytes, err := json.Marshal(config)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := c.protoClient.DebugTraceTransaction(timeoutCtx, &generated.DebugTraceTransactionRequest{
-		TxHash: hash.Bytes(),
-		Config: confBytes,
-	})
-	if err != nil {
-		return nil, syserr.NewRPCError(err)
-	}
-	if response != nil && response.SystemError != nil {
-		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
-	}
-
-	return json.RawMessage(response.Msg), nil
-}
-
 func (c *Client) GetBatch(ctx context.Context, hash common.L2BatchHash) (*common.ExtBatch, common.SystemError) {
 	timeoutCtx, cancel := context.WithTimeout(ctx, c.enclaveRPCTimeout)
 	defer cancel()
@@ -420,6 +482,20 @@ func (c *Client) GetRollupData(ctx context.Context, hash common.L2RollupHash) (*
 	return rpc.FromRollupDataMsg(response.Msg)
 }
 
+func (c *Client) RevealDAKeys(ctx context.Context, period uint64, hostL1Head common.L1BlockHash) ([]common.RevealedDAKey, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, c.enclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.RevealDAKeys(timeoutCtx, &generated.RevealDAKeysRequest{Period: period, HostL1Head: hostL1Head.Bytes()})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return rpc.FromRevealedDAKeyMsgs(response.Keys), nil
+}
+
 func (c *Client) StreamL2Updates() (chan common.StreamL2UpdatesResponse, func()) {
 	// channel size is 10 to allow for some buffering but caller is expected to read immediately to avoid blocking
 	batchChan := make(chan common.StreamL2UpdatesResponse, 10)
@@ -480,6 +556,41 @@ func (c *Client) GetTotalContractCount(ctx context.Context) (*big.Int, common.Sy
 	return big.NewInt(response.Count), nil
 }
 
+func (c *Client) GetPublicStorageAt(ctx context.Context, address gethcommon.Address, slot gethcommon.Hash, mappingKey *gethcommon.Hash) (*common.PublicStorageValue, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, c.enclaveRPCTimeout)
+	defer cancel()
+
+	request := &generated.GetPublicStorageAtRequest{Address: address.Bytes(), Slot: slot.Bytes()}
+	if mappingKey != nil {
+		request.MappingKey = mappingKey.Bytes()
+	}
+	response, err := c.protoClient.GetPublicStorageAt(timeoutCtx, request)
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	if !response.IsPublic {
+		return nil, nil
+	}
+	return &common.PublicStorageValue{Slot: gethcommon.BytesToHash(response.Slot), Value: gethcommon.BytesToHash(response.Value)}, nil
+}
+
+func (c *Client) GetBatchTxFees(ctx context.Context, fromHeight uint64, toHeight uint64, rewardPercentiles []float64) ([]common.BatchTxFees, common.SystemError) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, c.enclaveRPCTimeout)
+	defer cancel()
+
+	response, err := c.protoClient.GetBatchTxFees(timeoutCtx, &generated.GetBatchTxFeesRequest{FromHeight: fromHeight, ToHeight: toHeight, RewardPercentiles: rewardPercentiles})
+	if err != nil {
+		return nil, syserr.NewRPCError(err)
+	}
+	if response != nil && response.SystemError != nil {
+		return nil, syserr.NewInternalError(fmt.Errorf("%s", response.SystemError.ErrorString))
+	}
+	return rpc.FromBatchTxFeesMsgs(response.Batches), nil
+}
+
 func (c *Client) EnclavePublicConfig(ctx context.Context) (*common.EnclavePublicConfig, common.SystemError) {
 	timeoutCtx, cancel := context.WithTimeout(ctx, c.enclaveRPCTimeout)
 	defer cancel()
@@ -0,0 +1,86 @@
+package hostdb
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+)
+
+const (
+	selectBlobSidecar        = "SELECT blob_index, commitment, proof, blob FROM blob_host WHERE versioned_hash="
+	selectBlobSidecarsBySlot = "SELECT blob_index, commitment, proof, blob FROM blob_host WHERE slot="
+)
+
+// AddBlobSidecars adds the sidecars of the blobs published in an L1 slot to the DB. Sidecars that are already stored
+// are ignored
+func AddBlobSidecars(dbtx *dbTransaction, statements *SQLStatements, slot uint64, sidecars []*ethadapter.BlobSidecar) error {
+	for _, sidecar := range sidecars {
+		versionedHash := ethadapter.KZGToVersionedHash(kzg4844.Commitment(sidecar.KZGCommitment))
+		_, err := dbtx.Tx.Exec(statements.InsertBlob, versionedHash.Bytes(), slot, uint64(sidecar.Index), sidecar.KZGCommitment[:], sidecar.KZGProof[:], sidecar.Blob[:])
+		if err != nil {
+			return fmt.Errorf("could not insert blob %s of slot %d. Cause: %w", versionedHash.Hex(), slot, err)
+		}
+	}
+	return nil
+}
+
+// GetBlobSidecar returns the sidecar of the blob with the given versioned hash
+func GetBlobSidecar(db HostDB, versionedHash gethcommon.Hash) (*ethadapter.BlobSidecar, error) {
+	query := selectBlobSidecar + db.GetSQLStatement().Placeholder
+	sidecar, err := scanBlobSidecar(db.GetSQLDB().QueryRow(query, versionedHash.Bytes()))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errutil.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to fetch blob %s: %w", versionedHash.Hex(), err)
+	}
+	return sidecar, nil
+}
+
+// GetBlobSidecarsBySlot returns the sidecars of the blobs published in the L1 slot, ordered by index
+func GetBlobSidecarsBySlot(db HostDB, slot uint64) ([]*ethadapter.BlobSidecar, error) {
+	query := selectBlobSidecarsBySlot + db.GetSQLStatement().Placeholder + " ORDER BY blob_index"
+	rows, err := db.GetSQLDB().Query(query, slot)
+	if err != nil {
+		return nil, fmt.Errorf("query execution for select blobs failed: %w", err)
+	}
+	defer rows.Close()
+
+	var sidecars []*ethadapter.BlobSidecar
+	for rows.Next() {
+		sidecar, err := scanBlobSidecar(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan blob: %w", err)
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error looping through blobs: %w", err)
+	}
+	if len(sidecars) == 0 {
+		return nil, errutil.ErrNotFound
+	}
+	return sidecars, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanBlobSidecar(row rowScanner) (*ethadapter.BlobSidecar, error) {
+	var index uint64
+	var commitment, proof, blob []byte
+	if err := row.Scan(&index, &commitment, &proof, &blob); err != nil {
+		return nil, err
+	}
+	sidecar := &ethadapter.BlobSidecar{Index: ethadapter.Uint64String(index)}
+	copy(sidecar.KZGCommitment[:], commitment)
+	copy(sidecar.KZGProof[:], proof)
+	copy(sidecar.Blob[:], blob)
+	return sidecar, nil
+}
@@ -0,0 +1,57 @@
+package hostdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
+)
+
+func TestCanStoreAndRetrieveBlobs(t *testing.T) {
+	db, err := createSQLiteDB(t)
+	if err != nil {
+		t.Fatalf("unable to initialise test db: %s", err)
+	}
+
+	sidecars := []*ethadapter.BlobSidecar{createBlobSidecar(1), createBlobSidecar(0)}
+	for i := 0; i < 2; i++ {
+		// storing the same blobs twice is not an error
+		dbtx, _ := db.NewDBTransaction()
+		if err := AddBlobSidecars(dbtx, db.GetSQLStatement(), 7, sidecars); err != nil {
+			t.Fatalf("could not store blobs. Cause: %s", err)
+		}
+		dbtx.Write()
+	}
+
+	versionedHash := ethadapter.KZGToVersionedHash(kzg4844.Commitment(sidecars[0].KZGCommitment))
+	sidecar, err := GetBlobSidecar(db, versionedHash)
+	if err != nil {
+		t.Fatalf("stored blob but could not retrieve it. Cause: %s", err)
+	}
+	if sidecar.Index != 1 || sidecar.KZGProof != sidecars[0].KZGProof || sidecar.Blob != sidecars[0].Blob {
+		t.Errorf("blob was not stored correctly")
+	}
+
+	slotSidecars, err := GetBlobSidecarsBySlot(db, 7)
+	if err != nil {
+		t.Fatalf("stored blobs but could not retrieve them by slot. Cause: %s", err)
+	}
+	if len(slotSidecars) != 2 || slotSidecars[0].Index != 0 || slotSidecars[1].Index != 1 {
+		t.Errorf("blobs of the slot were not retrieved in order")
+	}
+
+	_, err = GetBlobSidecarsBySlot(db, 8)
+	if !errors.Is(err, errutil.ErrNotFound) {
+		t.Errorf("did not store blobs for the slot but was able to retrieve them")
+	}
+}
+
+func createBlobSidecar(index uint64) *ethadapter.BlobSidecar {
+	sidecar := &ethadapter.BlobSidecar{Index: ethadapter.Uint64String(index)}
+	sidecar.Blob = kzg4844.Blob{1, byte(index)}
+	sidecar.KZGCommitment[0] = byte(index + 1)
+	sidecar.KZGProof[0] = byte(index + 2)
+	return sidecar
+}
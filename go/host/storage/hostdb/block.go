@@ -14,6 +14,8 @@ import (
 const (
 	selectBlocks = "SELECT b.id, b.hash, b.header, r.hash FROM block_host b join rollup_host r on r.compression_block=b.id ORDER BY b.id DESC "
 	selectBlock  = "SELECT id FROM block_host WHERE hash = "
+	// the processed block replaces the blocks of the previous fork from its height
+	updateCanonicalBlocks = "UPDATE block_host SET is_canonical=(hash=%s) WHERE height>=%s"
 )
 
 // AddBlock stores a block header with the given rollupHash it contains in the host DB
@@ -23,8 +25,14 @@ func AddBlock(dbtx *sql.Tx, statements *SQLStatements, b *types.Header) error {
 		return fmt.Errorf("could not encode block header. Cause: %w", err)
 	}
 
+	var height uint64
+	if b.Number != nil {
+		height = b.Number.Uint64()
+	}
+
 	_, err = dbtx.Exec(statements.InsertBlock,
 		b.Hash().Bytes(), // hash
+		height,           // l1 block height
 		header,           // l1 block header
 	)
 	if err != nil {
@@ -34,6 +42,21 @@ func AddBlock(dbtx *sql.Tx, statements *SQLStatements, b *types.Header) error {
 	return nil
 }
 
+// SetCanonicalBlock marks the block as canonical, and the blocks with the same or a greater height as not canonical.
+// The host processes the L1 blocks in order, so the blocks of a previous fork are reprocessed before being canonical again.
+func SetCanonicalBlock(dbtx *sql.Tx, statements *SQLStatements, b *types.Header) error {
+	var height uint64
+	if b.Number != nil {
+		height = b.Number.Uint64()
+	}
+	update := fmt.Sprintf(updateCanonicalBlocks, statements.GetPlaceHolder(1), statements.GetPlaceHolder(2))
+	_, err := dbtx.Exec(update, b.Hash().Bytes(), height)
+	if err != nil {
+		return fmt.Errorf("could not update the canonical blocks. Cause: %w", err)
+	}
+	return nil
+}
+
 // GetBlockId returns the block ID given the hash.
 func GetBlockId(db *sql.Tx, statements *SQLStatements, hash gethcommon.Hash) (*int64, error) {
 	query := selectBlock + statements.Placeholder
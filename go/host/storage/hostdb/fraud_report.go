@@ -0,0 +1,84 @@
+package hostdb
+
+import (
+	"fmt"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+const (
+	selectHaltedSequencers = "SELECT sequencer FROM halted_sequencer_host"
+	countReporters         = "SELECT COUNT(DISTINCT reporter) FROM fraud_report_host WHERE is_resolved=false AND sequencer="
+	deleteHalt             = "DELETE FROM halted_sequencer_host WHERE sequencer="
+	resolveFraudReports    = "UPDATE fraud_report_host SET is_resolved=true WHERE sequencer="
+)
+
+// AddFraudReport adds a fraud report to the DB, or returns errutil.ErrAlreadyExists if it was already stored
+func AddFraudReport(dbtx *dbTransaction, statements *SQLStatements, report *common.FraudReport) error {
+	res, err := dbtx.Tx.Exec(statements.InsertFraudReport, report.Hash().Bytes(), report.Sequencer.Bytes(), report.BatchSeqNo, report.EnclaveID.Bytes())
+	if err != nil {
+		return fmt.Errorf("could not insert fraud report of batch %s. Cause: %w", report.BatchHash, err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("could not read the inserted fraud reports. Cause: %w", err)
+	}
+	if rows == 0 {
+		return errutil.ErrAlreadyExists
+	}
+	return nil
+}
+
+// CountFraudReporters returns the number of distinct enclaves that reported the sequencer since its last resumption
+func CountFraudReporters(db HostDB, sequencer common.EnclaveID) (uint64, error) {
+	var count uint64
+	err := db.GetSQLDB().QueryRow(countReporters+db.GetSQLStatement().Placeholder, sequencer.Bytes()).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("could not count the reporters of sequencer %s. Cause: %w", sequencer, err)
+	}
+	return count, nil
+}
+
+// HaltSequencer records that the batches of the sequencer are not accepted anymore
+func HaltSequencer(dbtx *dbTransaction, statements *SQLStatements, sequencer common.EnclaveID) error {
+	if _, err := dbtx.Tx.Exec(statements.InsertHalt, sequencer.Bytes()); err != nil {
+		return fmt.Errorf("could not halt sequencer %s. Cause: %w", sequencer, err)
+	}
+	return nil
+}
+
+// ResumeSequencer lifts the halt of the sequencer, and resolves its fraud reports so that they don't count towards the
+// quorum anymore. The reports remain stored, so the same reports don't halt the sequencer again.
+func ResumeSequencer(dbtx *dbTransaction, statements *SQLStatements, sequencer common.EnclaveID) error {
+	if _, err := dbtx.Tx.Exec(deleteHalt+statements.Placeholder, sequencer.Bytes()); err != nil {
+		return fmt.Errorf("could not lift the halt of sequencer %s. Cause: %w", sequencer, err)
+	}
+	if _, err := dbtx.Tx.Exec(resolveFraudReports+statements.Placeholder, sequencer.Bytes()); err != nil {
+		return fmt.Errorf("could not resolve the fraud reports of sequencer %s. Cause: %w", sequencer, err)
+	}
+	return nil
+}
+
+// GetHaltedSequencers returns the sequencers that were halted after fraud reports
+func GetHaltedSequencers(db HostDB) ([]common.EnclaveID, error) {
+	rows, err := db.GetSQLDB().Query(selectHaltedSequencers)
+	if err != nil {
+		return nil, fmt.Errorf("query execution for select halted sequencers failed: %w", err)
+	}
+	defer rows.Close()
+
+	var sequencers []common.EnclaveID
+	for rows.Next() {
+		var sequencer []byte
+		if err := rows.Scan(&sequencer); err != nil {
+			return nil, fmt.Errorf("failed to scan halted sequencer: %w", err)
+		}
+		sequencers = append(sequencers, gethcommon.BytesToAddress(sequencer))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error looping through halted sequencers: %w", err)
+	}
+	return sequencers, nil
+}
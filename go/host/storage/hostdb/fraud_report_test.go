@@ -0,0 +1,77 @@
+package hostdb
+
+import (
+	"errors"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+func TestCanStoreAndRetrieveFraudReports(t *testing.T) {
+	db, err := createSQLiteDB(t)
+	if err != nil {
+		t.Fatalf("unable to initialise test db: %s", err)
+	}
+
+	halted, err := GetHaltedSequencers(db)
+	if err != nil || len(halted) != 0 {
+		t.Errorf("no fraud was reported but found halted sequencers")
+	}
+
+	sequencer := gethcommon.HexToAddress("0x04")
+	// the first validator reports two batches, the second validator reports one
+	reporters := []gethcommon.Address{gethcommon.HexToAddress("0x05"), gethcommon.HexToAddress("0x05"), gethcommon.HexToAddress("0x06")}
+	for i, reporter := range reporters {
+		report := &common.FraudReport{BatchSeqNo: uint64(i + 1), BatchHash: gethcommon.BigToHash(gethcommon.Big1), Sequencer: sequencer, EnclaveID: reporter}
+		dbtx, _ := db.NewDBTransaction()
+		if err := AddFraudReport(dbtx, db.GetSQLStatement(), report); err != nil {
+			t.Fatalf("could not store fraud report. Cause: %s", err)
+		}
+		dbtx.Write()
+	}
+
+	// the same report can be received several times, e.g. from the enclave and from the L1
+	duplicate := &common.FraudReport{BatchSeqNo: 1, BatchHash: gethcommon.BigToHash(gethcommon.Big1), Sequencer: sequencer, EnclaveID: reporters[0]}
+	dbtx, _ := db.NewDBTransaction()
+	if err := AddFraudReport(dbtx, db.GetSQLStatement(), duplicate); !errors.Is(err, errutil.ErrAlreadyExists) {
+		t.Errorf("expected the duplicate fraud report to be rejected, got %v", err)
+	}
+	dbtx.Rollback()
+
+	count, err := CountFraudReporters(db, sequencer)
+	if err != nil || count != 2 {
+		t.Errorf("expected 2 reporters, got %d", count)
+	}
+	// the reports don't halt the sequencer by themselves
+	halted, err = GetHaltedSequencers(db)
+	if err != nil || len(halted) != 0 {
+		t.Errorf("the sequencer was halted without a quorum")
+	}
+
+	dbtx, _ = db.NewDBTransaction()
+	if err := HaltSequencer(dbtx, db.GetSQLStatement(), sequencer); err != nil {
+		t.Fatalf("could not halt sequencer. Cause: %s", err)
+	}
+	dbtx.Write()
+	halted, err = GetHaltedSequencers(db)
+	if err != nil || len(halted) != 1 || halted[0] != sequencer {
+		t.Errorf("halted sequencers were not retrieved correctly")
+	}
+
+	// resuming the sequencer resolves the reports
+	dbtx, _ = db.NewDBTransaction()
+	if err := ResumeSequencer(dbtx, db.GetSQLStatement(), sequencer); err != nil {
+		t.Fatalf("could not resume sequencer. Cause: %s", err)
+	}
+	dbtx.Write()
+	halted, err = GetHaltedSequencers(db)
+	if err != nil || len(halted) != 0 {
+		t.Errorf("the sequencer is still halted after resuming it")
+	}
+	count, err = CountFraudReporters(db, sequencer)
+	if err != nil || count != 0 {
+		t.Errorf("the resolved reports still count towards the quorum, got %d reporters", count)
+	}
+}
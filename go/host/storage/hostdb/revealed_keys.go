@@ -0,0 +1,67 @@
+package hostdb
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+const (
+	selectRevealedKeys         = "SELECT period, epoch, da_key FROM revealed_da_key_host WHERE period="
+	selectLatestRevealedPeriod = "SELECT period FROM revealed_da_key_host ORDER BY period DESC LIMIT 1"
+)
+
+// AddRevealedDAKeys adds the revealed DA keys of a revelation period to the DB
+func AddRevealedDAKeys(dbtx *dbTransaction, statements *SQLStatements, keys []common.RevealedDAKey) error {
+	for _, key := range keys {
+		_, err := dbtx.Tx.Exec(statements.InsertRevealedKey, key.Period, key.Epoch, []byte(key.Key))
+		if err != nil {
+			return fmt.Errorf("could not insert revealed key of period %d and epoch %d. Cause: %w", key.Period, key.Epoch, err)
+		}
+	}
+	return nil
+}
+
+// GetRevealedDAKeys returns the revealed DA keys of the revelation period, for each secret epoch
+func GetRevealedDAKeys(db HostDB, period uint64) ([]common.RevealedDAKey, error) {
+	query := selectRevealedKeys + db.GetSQLStatement().Placeholder + " ORDER BY epoch"
+	rows, err := db.GetSQLDB().Query(query, period)
+	if err != nil {
+		return nil, fmt.Errorf("query execution for select revealed keys failed: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []common.RevealedDAKey
+	for rows.Next() {
+		var key common.RevealedDAKey
+		var daKey []byte
+		if err := rows.Scan(&key.Period, &key.Epoch, &daKey); err != nil {
+			return nil, fmt.Errorf("failed to scan revealed key: %w", err)
+		}
+		key.Key = daKey
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error looping through revealed keys: %w", err)
+	}
+	if len(keys) == 0 {
+		return nil, errutil.ErrNotFound
+	}
+	return keys, nil
+}
+
+// GetLatestRevealedPeriod returns the highest revelation period with revealed keys
+func GetLatestRevealedPeriod(db HostDB) (uint64, error) {
+	var period uint64
+	err := db.GetSQLDB().QueryRow(selectLatestRevealedPeriod).Scan(&period)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, errutil.ErrNotFound
+		}
+		return 0, fmt.Errorf("failed to fetch latest revealed period: %w", err)
+	}
+	return period, nil
+}
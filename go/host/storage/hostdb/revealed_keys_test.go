@@ -0,0 +1,51 @@
+package hostdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
+)
+
+func TestCanStoreAndRetrieveRevealedKeys(t *testing.T) {
+	db, err := createSQLiteDB(t)
+	if err != nil {
+		t.Fatalf("unable to initialise test db: %s", err)
+	}
+
+	_, err = GetLatestRevealedPeriod(db)
+	if !errors.Is(err, errutil.ErrNotFound) {
+		t.Errorf("no keys were revealed but found a revealed period")
+	}
+
+	for period := uint64(3); period <= 4; period++ {
+		keys := []common.RevealedDAKey{
+			{Epoch: 0, Period: period, Key: []byte{byte(period), 0}},
+			{Epoch: 1, Period: period, Key: []byte{byte(period), 1}},
+		}
+		dbtx, _ := db.NewDBTransaction()
+		if err := AddRevealedDAKeys(dbtx, db.GetSQLStatement(), keys); err != nil {
+			t.Fatalf("could not store revealed keys. Cause: %s", err)
+		}
+		dbtx.Write()
+	}
+
+	latest, err := GetLatestRevealedPeriod(db)
+	if err != nil || latest != 4 {
+		t.Errorf("latest revealed period was not retrieved correctly")
+	}
+
+	keys, err := GetRevealedDAKeys(db, 3)
+	if err != nil {
+		t.Fatalf("stored revealed keys but could not retrieve them. Cause: %s", err)
+	}
+	if len(keys) != 2 || keys[1].Epoch != 1 || keys[1].Period != 3 || keys[1].Key[1] != 1 {
+		t.Errorf("revealed keys were not stored correctly")
+	}
+
+	_, err = GetRevealedDAKeys(db, 5)
+	if !errors.Is(err, errutil.ErrNotFound) {
+		t.Errorf("did not store keys of the period but was able to retrieve them")
+	}
+}
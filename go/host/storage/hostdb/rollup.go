@@ -14,11 +14,15 @@ import (
 )
 
 const (
-	selectExtRollup         = "SELECT ext_rollup from rollup_host r join block_host b on r.compression_block=b.id "
-	selectLatestExtRollup   = "SELECT ext_rollup FROM rollup_host ORDER BY time_stamp DESC LIMIT 1"
-	selectLatestRollupCount = "SELECT id FROM rollup_host ORDER BY id DESC LIMIT 1"
-	selectRollupBatches     = "SELECT b.sequence, b.hash, b.height, b.ext_batch FROM rollup_host r JOIN batch_host b ON r.start_seq <= b.sequence AND r.end_seq >= b.sequence"
-	selectRollups           = "SELECT rh.id, rh.hash, rh.start_seq, rh.end_seq, rh.time_stamp, rh.ext_rollup, bh.hash FROM rollup_host rh join block_host bh on rh.compression_block=bh.id "
+	selectExtRollup           = "SELECT ext_rollup from rollup_host r join block_host b on r.compression_block=b.id "
+	selectLatestExtRollup     = "SELECT ext_rollup FROM rollup_host ORDER BY time_stamp DESC LIMIT 1"
+	selectEarliestExtRollup   = "SELECT ext_rollup FROM rollup_host ORDER BY time_stamp ASC LIMIT 1"
+	selectLatestRollupCount   = "SELECT id FROM rollup_host ORDER BY id DESC LIMIT 1"
+	selectRollupBatches       = "SELECT b.sequence, b.hash, b.height, b.ext_batch FROM rollup_host r JOIN batch_host b ON r.start_seq <= b.sequence AND r.end_seq >= b.sequence"
+	selectRollups             = "SELECT rh.id, rh.hash, rh.start_seq, rh.end_seq, rh.time_stamp, rh.ext_rollup, bh.hash FROM rollup_host rh join block_host bh on rh.compression_block=bh.id "
+	selectSafeBatchSeqNo      = "SELECT MAX(r.end_seq) FROM rollup_host r JOIN block_host b ON r.compression_block=b.id WHERE b.is_canonical=true"
+	selectFinalizedBatchSeqNo = selectSafeBatchSeqNo + " AND r.is_finalized=true"
+	updateFinalizedRollups    = "UPDATE rollup_host SET is_finalized=true WHERE is_finalized=false AND compression_block IN (SELECT id FROM block_host WHERE is_canonical=true AND height<="
 )
 
 // AddRollup adds a rollup to the DB
@@ -48,6 +52,26 @@ func AddRollup(dbtx *dbTransaction, statements *SQLStatements, rollup *common.Ex
 	return nil
 }
 
+// MarkRollupsFinalized marks the rollups published in the canonical L1 blocks up to the finalized L1 height as finalized
+func MarkRollupsFinalized(dbtx *dbTransaction, statements *SQLStatements, l1Height uint64) error {
+	_, err := dbtx.Tx.Exec(updateFinalizedRollups+statements.Placeholder+")", l1Height)
+	if err != nil {
+		return fmt.Errorf("could not mark rollups as finalized. Cause: %w", err)
+	}
+	return nil
+}
+
+// GetSafeBatchSeqNo returns the sequence number of the last batch included in a rollup published in a canonical L1 block
+func GetSafeBatchSeqNo(db HostDB) (uint64, error) {
+	return fetchMaxEndSeq(db.GetSQLDB(), selectSafeBatchSeqNo)
+}
+
+// GetFinalizedBatchSeqNo returns the sequence number of the last batch included in a rollup published in a canonical
+// and finalized L1 block
+func GetFinalizedBatchSeqNo(db HostDB) (uint64, error) {
+	return fetchMaxEndSeq(db.GetSQLDB(), selectFinalizedBatchSeqNo)
+}
+
 // GetRollupListing returns latest rollups given a pagination.
 // For example, offset 1, size 10 will return the latest 11-20 rollups.
 func GetRollupListing(db HostDB, pagination *common.QueryPagination) (*common.RollupListingResponse, error) {
@@ -122,13 +146,22 @@ func GetRollupHeaderByBlock(db HostDB, blockHash gethcommon.Hash) (*common.Rollu
 
 // GetLatestRollup returns the latest rollup ordered by timestamp
 func GetLatestRollup(db HostDB) (*common.RollupHeader, error) {
-	extRollup, err := fetchHeadRollup(db.GetSQLDB())
+	extRollup, err := fetchFirstRollup(db.GetSQLDB(), selectLatestExtRollup)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch head rollup: %w", err)
 	}
 	return extRollup.Header, nil
 }
 
+// GetEarliestRollup returns the first rollup ordered by timestamp
+func GetEarliestRollup(db HostDB) (*common.RollupHeader, error) {
+	extRollup, err := fetchFirstRollup(db.GetSQLDB(), selectEarliestExtRollup)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch earliest rollup: %w", err)
+	}
+	return extRollup.Header, nil
+}
+
 func GetRollupByHash(db HostDB, rollupHash gethcommon.Hash) (*common.PublicRollup, error) {
 	whereQuery := " WHERE rh.hash=" + db.GetSQLStatement().Placeholder
 	return fetchPublicRollup(db.GetSQLDB(), whereQuery, rollupHash.Bytes())
@@ -223,9 +256,9 @@ func fetchExtRollup(db *sql.DB, whereQuery string, args ...any) (*common.ExtRoll
 	return &rollup, nil
 }
 
-func fetchHeadRollup(db *sql.DB) (*common.ExtRollup, error) {
+func fetchFirstRollup(db *sql.DB, query string) (*common.ExtRollup, error) {
 	var extRollup []byte
-	err := db.QueryRow(selectLatestExtRollup).Scan(&extRollup)
+	err := db.QueryRow(query).Scan(&extRollup)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, errutil.ErrNotFound
@@ -241,6 +274,18 @@ func fetchHeadRollup(db *sql.DB) (*common.ExtRollup, error) {
 	return &rollup, nil
 }
 
+func fetchMaxEndSeq(db *sql.DB, query string) (uint64, error) {
+	var seqNo sql.NullInt64
+	err := db.QueryRow(query).Scan(&seqNo)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch batch sequence number - %w", err)
+	}
+	if !seqNo.Valid {
+		return 0, errutil.ErrNotFound
+	}
+	return uint64(seqNo.Int64), nil
+}
+
 func fetchTotalRollups(db *sql.DB) (*big.Int, error) {
 	var total int
 	err := db.QueryRow(selectLatestRollupCount).Scan(&total)
@@ -1,6 +1,7 @@
 package hostdb
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 	"time"
@@ -10,6 +11,7 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 )
 
 func TestCanStoreAndRetrieveRollup(t *testing.T) {
@@ -424,6 +426,87 @@ func TestGetRollupBatches(t *testing.T) {
 	}
 }
 
+func TestSafeAndFinalizedBatches(t *testing.T) {
+	db, err := createSQLiteDB(t)
+	if err != nil {
+		t.Fatalf("unable to initialise test db: %s", err)
+	}
+
+	_, err = GetSafeBatchSeqNo(db)
+	if !errors.Is(err, errutil.ErrNotFound) {
+		t.Errorf("no rollups were stored but found a safe batch")
+	}
+
+	// rollups published in the L1 blocks 10 and 20
+	addRollupInBlock(t, db, &types.Header{Number: big.NewInt(10)}, batchNumber)
+	block20 := addRollupInBlock(t, db, &types.Header{Number: big.NewInt(20)}, batchNumber+10)
+
+	safe, err := GetSafeBatchSeqNo(db)
+	if err != nil || safe != batchNumber+10 {
+		t.Errorf("the safe batch should be the last batch of the latest rollup")
+	}
+	_, err = GetFinalizedBatchSeqNo(db)
+	if !errors.Is(err, errutil.ErrNotFound) {
+		t.Errorf("no L1 block was finalized but found a finalized batch")
+	}
+
+	markRollupsFinalized(t, db, 15)
+	finalized, err := GetFinalizedBatchSeqNo(db)
+	if err != nil || finalized != batchNumber {
+		t.Errorf("the finalized batch should be the last batch of the rollup in the finalized L1 block")
+	}
+
+	// a rollup published in a block that replaces the block 20 after an L1 reorg
+	addRollupInBlock(t, db, &types.Header{Number: big.NewInt(20), Extra: []byte("fork")}, batchNumber+20)
+	safe, err = GetSafeBatchSeqNo(db)
+	if err != nil || safe != batchNumber+20 {
+		t.Errorf("the safe batch should be the last batch of the rollup on the canonical fork")
+	}
+
+	// the L1 reorgs back to the block 20, which is finalized
+	dbtx, _ := db.NewDBTransaction()
+	if err := SetCanonicalBlock(dbtx.Tx, db.GetSQLStatement(), block20); err != nil {
+		t.Fatalf("could not set the canonical block. Cause: %s", err)
+	}
+	dbtx.Write()
+	markRollupsFinalized(t, db, 25)
+
+	safe, err = GetSafeBatchSeqNo(db)
+	if err != nil || safe != batchNumber+10 {
+		t.Errorf("the rollups of blocks that are no longer canonical should not be safe")
+	}
+	finalized, err = GetFinalizedBatchSeqNo(db)
+	if err != nil || finalized != batchNumber+10 {
+		t.Errorf("the rollups of blocks that are no longer canonical should not be finalized")
+	}
+}
+
+func addRollupInBlock(t *testing.T, db HostDB, header *types.Header, lastBatch int64) *types.Header {
+	block := types.NewBlock(header, nil, nil, nil)
+	metadata := createRollupMetadata(lastBatch - 9)
+	rollup := createRollup(lastBatch)
+	dbtx, _ := db.NewDBTransaction()
+	if err := AddBlock(dbtx.Tx, db.GetSQLStatement(), block.Header()); err != nil {
+		t.Fatalf("could not store block. Cause: %s", err)
+	}
+	if err := SetCanonicalBlock(dbtx.Tx, db.GetSQLStatement(), block.Header()); err != nil {
+		t.Fatalf("could not set the canonical block. Cause: %s", err)
+	}
+	if err := AddRollup(dbtx, db.GetSQLStatement(), &rollup, &metadata, block); err != nil {
+		t.Fatalf("could not store rollup. Cause: %s", err)
+	}
+	dbtx.Write()
+	return block.Header()
+}
+
+func markRollupsFinalized(t *testing.T, db HostDB, l1Height uint64) {
+	dbtx, _ := db.NewDBTransaction()
+	if err := MarkRollupsFinalized(dbtx, db.GetSQLStatement(), l1Height); err != nil {
+		t.Fatalf("could not mark rollups as finalized. Cause: %s", err)
+	}
+	dbtx.Write()
+}
+
 func createRollup(lastBatch int64) common.ExtRollup {
 	header := common.RollupHeader{
 		LastBatchSeqNo: uint64(lastBatch),
@@ -9,6 +9,10 @@ type SQLStatements struct {
 	UpdateTxCount      string
 	InsertRollup       string
 	InsertBlock        string
+	InsertRevealedKey  string
+	InsertBlob         string
+	InsertFraudReport  string
+	InsertHalt         string
 	Pagination         string
 	Placeholder        string
 }
@@ -26,7 +30,11 @@ func SQLiteSQLStatements() *SQLStatements {
 		InsertTransactions: "INSERT INTO transaction_host (hash, b_sequence) VALUES ",
 		UpdateTxCount:      "UPDATE transaction_count SET total=? WHERE id=1",
 		InsertRollup:       "INSERT INTO rollup_host (hash, start_seq, end_seq, time_stamp, ext_rollup, compression_block) values (?,?,?,?,?,?)",
-		InsertBlock:        "INSERT INTO block_host (hash, header) values (?,?)",
+		InsertBlock:        "INSERT INTO block_host (hash, height, header, is_canonical) values (?,?,?,true)",
+		InsertRevealedKey:  "INSERT INTO revealed_da_key_host (period, epoch, da_key) values (?,?,?)",
+		InsertBlob:         "INSERT INTO blob_host (versioned_hash, slot, blob_index, commitment, proof, blob) values (?,?,?,?,?,?) ON CONFLICT (versioned_hash) DO NOTHING",
+		InsertFraudReport:  "INSERT INTO fraud_report_host (hash, sequencer, batch_seq, reporter) values (?,?,?,?) ON CONFLICT (hash) DO NOTHING",
+		InsertHalt:         "INSERT INTO halted_sequencer_host (sequencer) values (?) ON CONFLICT (sequencer) DO NOTHING",
 		Pagination:         "LIMIT ? OFFSET ?",
 		Placeholder:        "?",
 	}
@@ -38,7 +46,11 @@ func PostgresSQLStatements() *SQLStatements {
 		InsertTransactions: "INSERT INTO transaction_host (hash, b_sequence) VALUES ",
 		UpdateTxCount:      "UPDATE transaction_count SET total=$1 WHERE id=1",
 		InsertRollup:       "INSERT INTO rollup_host (hash, start_seq, end_seq, time_stamp, ext_rollup, compression_block) values ($1, $2, $3, $4, $5, $6)",
-		InsertBlock:        "INSERT INTO block_host (hash, header) VALUES ($1, $2)",
+		InsertBlock:        "INSERT INTO block_host (hash, height, header, is_canonical) VALUES ($1, $2, $3, true)",
+		InsertRevealedKey:  "INSERT INTO revealed_da_key_host (period, epoch, da_key) VALUES ($1, $2, $3)",
+		InsertBlob:         "INSERT INTO blob_host (versioned_hash, slot, blob_index, commitment, proof, blob) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (versioned_hash) DO NOTHING",
+		InsertFraudReport:  "INSERT INTO fraud_report_host (hash, sequencer, batch_seq, reporter) VALUES ($1, $2, $3, $4) ON CONFLICT (hash) DO NOTHING",
+		InsertHalt:         "INSERT INTO halted_sequencer_host (sequencer) VALUES ($1) ON CONFLICT (sequencer) DO NOTHING",
 		Pagination:         "LIMIT $1 OFFSET $2",
 		Placeholder:        "$1",
 	}
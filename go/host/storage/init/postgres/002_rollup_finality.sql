@@ -0,0 +1,7 @@
+-- the height of the L1 blocks is null for the blocks stored before the migration
+ALTER TABLE block_host ADD COLUMN IF NOT EXISTS height INT;
+ALTER TABLE block_host ADD COLUMN IF NOT EXISTS is_canonical BOOLEAN NOT NULL DEFAULT TRUE;
+CREATE INDEX IF NOT EXISTS IDX_BLOCK_HEIGHT_HOST ON block_host (height, is_canonical);
+
+ALTER TABLE rollup_host ADD COLUMN IF NOT EXISTS is_finalized BOOLEAN NOT NULL DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS IDX_ROLLUP_FINALIZED_HOST ON rollup_host (is_finalized, end_seq);
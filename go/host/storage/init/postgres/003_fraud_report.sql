@@ -0,0 +1,8 @@
+-- the fraud reports detected by the enclave or published to the L1, which halt the reported sequencers
+CREATE TABLE IF NOT EXISTS fraud_report_host
+(
+    hash           BYTEA         PRIMARY KEY,
+    sequencer      BYTEA         NOT NULL,
+    batch_seq      BIGINT        NOT NULL
+);
+CREATE INDEX IF NOT EXISTS IDX_FRAUD_SEQUENCER_HOST ON fraud_report_host (sequencer);
@@ -0,0 +1,10 @@
+-- the validator enclave that produced each report is null for the reports stored before the migration. The reports are
+-- resolved when the operator lifts the halt of the sequencer, and no longer count towards the quorum
+ALTER TABLE fraud_report_host ADD COLUMN IF NOT EXISTS reporter BYTEA;
+ALTER TABLE fraud_report_host ADD COLUMN IF NOT EXISTS is_resolved BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- the sequencers halted by a quorum of reports, or by the report of an enclave of this host
+CREATE TABLE IF NOT EXISTS halted_sequencer_host
+(
+    sequencer      BYTEA         PRIMARY KEY
+);
@@ -0,0 +1,3 @@
+-- the reports stored before the quorum was introduced halted the reported sequencers, so they stay halted until the
+-- operator resumes them. The migrations run on every start of the host, so only the unresolved reports are considered
+INSERT INTO halted_sequencer_host (sequencer) SELECT DISTINCT sequencer FROM fraud_report_host WHERE reporter IS NULL AND is_resolved = false ON CONFLICT DO NOTHING;
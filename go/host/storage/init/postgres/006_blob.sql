@@ -0,0 +1,11 @@
+-- the blobs of the rollups stored by the host, so they can be served after the beacon nodes have pruned them
+CREATE TABLE IF NOT EXISTS blob_host
+(
+    versioned_hash BYTEA         PRIMARY KEY,
+    slot           BIGINT        NOT NULL,
+    blob_index     INT           NOT NULL,
+    commitment     BYTEA         NOT NULL,
+    proof          BYTEA         NOT NULL,
+    blob           BYTEA         NOT NULL
+);
+CREATE INDEX IF NOT EXISTS IDX_BLOB_SLOT_HOST ON blob_host (slot, blob_index);
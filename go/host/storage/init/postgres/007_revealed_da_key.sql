@@ -0,0 +1,8 @@
+-- the DA keys revealed by the enclave once their revelation period is over, so anyone can decrypt the old rollups
+CREATE TABLE IF NOT EXISTS revealed_da_key_host
+(
+    period      BIGINT        NOT NULL,
+    epoch       BIGINT        NOT NULL,
+    da_key      BYTEA         NOT NULL,
+    PRIMARY KEY (period, epoch)
+);
@@ -0,0 +1,7 @@
+-- the height of the L1 blocks is null for the blocks stored before the migration
+alter table block_host add column height int;
+alter table block_host add column is_canonical boolean NOT NULL default true;
+create index IDX_BLOCK_HEIGHT_HOST on block_host (height, is_canonical);
+
+alter table rollup_host add column is_finalized boolean NOT NULL default false;
+create index IDX_ROLLUP_FINALIZED_HOST on rollup_host (is_finalized, end_seq);
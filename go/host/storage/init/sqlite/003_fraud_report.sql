@@ -0,0 +1,8 @@
+-- the fraud reports detected by the enclave or published to the L1, which halt the reported sequencers
+create table if not exists fraud_report_host
+(
+    hash           binary(32) primary key,
+    sequencer      binary(20) NOT NULL,
+    batch_seq      int        NOT NULL
+);
+create index if not exists IDX_FRAUD_SEQUENCER_HOST on fraud_report_host (sequencer);
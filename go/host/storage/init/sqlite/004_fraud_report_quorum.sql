@@ -0,0 +1,10 @@
+-- the validator enclave that produced each report is null for the reports stored before the migration. The reports are
+-- resolved when the operator lifts the halt of the sequencer, and no longer count towards the quorum
+alter table fraud_report_host add column reporter binary(20);
+alter table fraud_report_host add column is_resolved boolean NOT NULL default false;
+
+-- the sequencers halted by a quorum of reports, or by the report of an enclave of this host
+create table if not exists halted_sequencer_host
+(
+    sequencer      binary(20) primary key
+);
@@ -0,0 +1,3 @@
+-- the reports stored before the quorum was introduced halted the reported sequencers, so they stay halted until the
+-- operator resumes them. The migrations run on every start of the host, so only the unresolved reports are considered
+insert or ignore into halted_sequencer_host (sequencer) select distinct sequencer from fraud_report_host where reporter is null and is_resolved = false;
@@ -0,0 +1,11 @@
+-- the blobs of the rollups stored by the host, so they can be served after the beacon nodes have pruned them
+create table if not exists blob_host
+(
+    versioned_hash binary(32) primary key,
+    slot           int        NOT NULL,
+    blob_index     int        NOT NULL,
+    commitment     binary(48) NOT NULL,
+    proof          binary(48) NOT NULL,
+    blob           mediumblob NOT NULL
+);
+create index if not exists IDX_BLOB_SLOT_HOST on blob_host (slot, blob_index);
@@ -0,0 +1,8 @@
+-- the DA keys revealed by the enclave once their revelation period is over, so anyone can decrypt the old rollups
+create table if not exists revealed_da_key_host
+(
+    period         int        NOT NULL,
+    epoch          int        NOT NULL,
+    da_key         binary(32) NOT NULL,
+    primary key (period, epoch)
+);
@@ -12,10 +12,7 @@ import (
 	_ "github.com/mattn/go-sqlite3" // this imports the sqlite driver to make the sql.Open() connection work
 )
 
-const (
-	tempDirName = "ten-persistence"
-	initFile    = "001_init.sql"
-)
+const tempDirName = "ten-persistence"
 
 //go:embed *.sql
 var sqlFiles embed.FS
@@ -39,22 +36,29 @@ func CreateTemporarySQLiteHostDB(dbPath string, dbOptions string) (*sql.DB, erro
 	// Sqlite fails with table locks when there are multiple connections
 	db.SetMaxOpenConns(1)
 
-	err = initialiseDB(db, initFile)
+	err = initialiseDB(db)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't initialise db - %w", err)
 	}
 	return db, nil
 }
 
-func initialiseDB(db *sql.DB, initFile string) error {
-	sqlInitFile, err := sqlFiles.ReadFile(initFile)
+// initialiseDB - runs the init file followed by the migrations, in the order of their names
+func initialiseDB(db *sql.DB) error {
+	files, err := sqlFiles.ReadDir(".")
 	if err != nil {
 		return err
 	}
+	for _, file := range files {
+		sqlFile, err := sqlFiles.ReadFile(file.Name())
+		if err != nil {
+			return err
+		}
 
-	_, err = db.Exec(string(sqlInitFile))
-	if err != nil {
-		return fmt.Errorf("failed to initialise sqlite %s - %w", sqlInitFile, err)
+		_, err = db.Exec(string(sqlFile))
+		if err != nil {
+			return fmt.Errorf("failed to initialise sqlite %s - %w", file.Name(), err)
+		}
 	}
 	return nil
 }
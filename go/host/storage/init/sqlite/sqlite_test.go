@@ -0,0 +1,66 @@
+package sqlite
+
+import (
+	"database/sql"
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// the backfill of the halted sequencers runs on every start of the host, and must not halt again the sequencers resumed
+// by the operator, nor the sequencers reported by fewer validators than the quorum
+func TestResumedSequencerStaysResumedAfterRestart(t *testing.T) {
+	db, err := CreateTemporarySQLiteHostDB("", "mode=memory")
+	if err != nil {
+		t.Fatalf("unable to initialise test db: %s", err)
+	}
+	legacySequencer := gethcommon.HexToAddress("0x01")
+	reportedSequencer := gethcommon.HexToAddress("0x02")
+	mustExec(t, db, "insert into fraud_report_host (hash, sequencer, batch_seq) values (?,?,?)", gethcommon.Hash{1}.Bytes(), legacySequencer.Bytes(), 1)
+	mustExec(t, db, "insert into fraud_report_host (hash, sequencer, batch_seq, reporter) values (?,?,?,?)", gethcommon.Hash{2}.Bytes(), reportedSequencer.Bytes(), 1, gethcommon.HexToAddress("0x03").Bytes())
+
+	restart := func() {
+		backfill, err := sqlFiles.ReadFile("005_fraud_report_halt_backfill.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+		mustExec(t, db, string(backfill))
+	}
+
+	// the sequencer reported before the quorum was introduced stays halted
+	restart()
+	if halted := haltedSequencers(t, db); len(halted) != 1 || halted[0] != legacySequencer {
+		t.Fatalf("unexpected halted sequencers: %v", halted)
+	}
+
+	// the operator resumes it, like hostdb.ResumeSequencer
+	mustExec(t, db, "delete from halted_sequencer_host where sequencer=?", legacySequencer.Bytes())
+	mustExec(t, db, "update fraud_report_host set is_resolved=true where sequencer=?", legacySequencer.Bytes())
+	restart()
+	if halted := haltedSequencers(t, db); len(halted) != 0 {
+		t.Fatalf("the resumed sequencer was halted again: %v", halted)
+	}
+}
+
+func mustExec(t *testing.T, db *sql.DB, query string, args ...any) {
+	if _, err := db.Exec(query, args...); err != nil {
+		t.Fatalf("could not execute %s. Cause: %s", query, err)
+	}
+}
+
+func haltedSequencers(t *testing.T, db *sql.DB) []gethcommon.Address {
+	rows, err := db.Query("select sequencer from halted_sequencer_host")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var sequencers []gethcommon.Address
+	for rows.Next() {
+		var sequencer []byte
+		if err := rows.Scan(&sequencer); err != nil {
+			t.Fatal(err)
+		}
+		sequencers = append(sequencers, gethcommon.BytesToAddress(sequencer))
+	}
+	return sequencers
+}
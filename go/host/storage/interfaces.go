@@ -7,11 +7,15 @@ import (
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
 )
 
 type Storage interface {
 	BatchResolver
 	BlockResolver
+	RevealedKeyResolver
+	BlobArchive
+	FraudReportResolver
 	io.Closer
 }
 
@@ -55,7 +59,7 @@ type BatchResolver interface {
 }
 
 type BlockResolver interface {
-	// AddBlock stores block data containing rollups in the host DB
+	// AddBlock stores block data containing rollups in the host DB, and marks the block as the canonical block at its height
 	AddBlock(b *types.Header) error
 	// AddRollup stores a rollup in the host DB
 	AddRollup(rollup *common.ExtRollup, metadata *common.PublicRollupMetadata, block *common.L1Block) error
@@ -71,4 +75,46 @@ type BlockResolver interface {
 	FetchRollupBySeqNo(seqNo uint64) (*common.PublicRollup, error)
 	// FetchRollupBatches returns a list of public batch data within a given rollup hash
 	FetchRollupBatches(rollupHash gethcommon.Hash) (*common.BatchListingResponse, error)
+	// FetchExtRollup returns the `ExtRollup` with the given hash
+	FetchExtRollup(rollupHash gethcommon.Hash) (*common.ExtRollup, error)
+	// FetchEarliestRollupHeader returns the first `RollupHeader`
+	FetchEarliestRollupHeader() (*common.RollupHeader, error)
+	// MarkRollupsFinalized marks the rollups published in the L1 blocks up to the finalized L1 height as finalized
+	MarkRollupsFinalized(l1Height uint64) error
+	// FetchSafeBatchSeqNo returns the seq number of the last batch included in a rollup published to the L1
+	FetchSafeBatchSeqNo() (uint64, error)
+	// FetchFinalizedBatchSeqNo returns the seq number of the last batch included in a rollup in a finalized L1 block
+	FetchFinalizedBatchSeqNo() (uint64, error)
+}
+
+type RevealedKeyResolver interface {
+	// AddRevealedDAKeys stores the DA keys revealed by the enclave for a revelation period
+	AddRevealedDAKeys(keys []common.RevealedDAKey) error
+	// FetchRevealedDAKeys returns the revealed DA keys of a revelation period
+	FetchRevealedDAKeys(period uint64) ([]common.RevealedDAKey, error)
+	// FetchLatestRevealedPeriod returns the highest revelation period with revealed keys
+	FetchLatestRevealedPeriod() (uint64, error)
+}
+
+type FraudReportResolver interface {
+	// AddFraudReport stores a fraud report, or returns errutil.ErrAlreadyExists if it was already stored
+	AddFraudReport(report *common.FraudReport) error
+	// CountFraudReporters returns the number of distinct enclaves that reported the sequencer since its last resumption
+	CountFraudReporters(sequencer common.EnclaveID) (uint64, error)
+	// HaltSequencer records that the batches of the sequencer are not accepted anymore
+	HaltSequencer(sequencer common.EnclaveID) error
+	// ResumeSequencer lifts the halt of the sequencer and resolves its fraud reports
+	ResumeSequencer(sequencer common.EnclaveID) error
+	// FetchHaltedSequencers returns the sequencers that were halted after fraud reports
+	FetchHaltedSequencers() ([]common.EnclaveID, error)
+}
+
+type BlobArchive interface {
+	// AddBlobSidecars stores the sidecars of the blobs published in an L1 slot, so that they remain available after the
+	// beacon chain prunes them
+	AddBlobSidecars(slot uint64, sidecars []*ethadapter.BlobSidecar) error
+	// FetchBlobSidecar returns the stored sidecar of the blob with the given versioned hash
+	FetchBlobSidecar(versionedHash gethcommon.Hash) (*ethadapter.BlobSidecar, error)
+	// FetchBlobSidecarsBySlot returns the stored sidecars of the blobs published in an L1 slot
+	FetchBlobSidecarsBySlot(slot uint64) ([]*ethadapter.BlobSidecar, error)
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/log"
+	"github.com/ten-protocol/go-ten/go/ethadapter"
 	hostconfig "github.com/ten-protocol/go-ten/go/host/config"
 	"github.com/ten-protocol/go-ten/go/host/storage/hostdb"
 )
@@ -77,6 +78,33 @@ func (s *storageImpl) AddRollup(rollup *common.ExtRollup, metadata *common.Publi
 	return nil
 }
 
+func (s *storageImpl) MarkRollupsFinalized(l1Height uint64) error {
+	dbtx, err := s.db.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+
+	if err := hostdb.MarkRollupsFinalized(dbtx, s.db.GetSQLStatement(), l1Height); err != nil {
+		if err := dbtx.Rollback(); err != nil {
+			return err
+		}
+		return err
+	}
+
+	if err := dbtx.Write(); err != nil {
+		return fmt.Errorf("could not commit rollup finality tx. Cause %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchSafeBatchSeqNo() (uint64, error) {
+	return hostdb.GetSafeBatchSeqNo(s.db)
+}
+
+func (s *storageImpl) FetchFinalizedBatchSeqNo() (uint64, error) {
+	return hostdb.GetFinalizedBatchSeqNo(s.db)
+}
+
 func (s *storageImpl) AddBlock(b *types.Header) error {
 	dbtx, err := s.db.NewDBTransaction()
 	if err != nil {
@@ -87,21 +115,24 @@ func (s *storageImpl) AddBlock(b *types.Header) error {
 	_, err = hostdb.GetBlockId(dbtx.Tx, s.db.GetSQLStatement(), b.Hash())
 	switch {
 	case err == nil:
-		// Block already exists
+		// Block already exists, it is canonical again after a reorg back to its fork
 		s.logger.Debug("Block already exists", "hash", b.Hash().Hex())
-		return nil
 	case !errors.Is(err, sql.ErrNoRows):
 		return fmt.Errorf("error checking block existence: %w", err)
+	default:
+		if err := hostdb.AddBlock(dbtx.Tx, s.db.GetSQLStatement(), b); err != nil {
+			if IsConstraintError(err) {
+				s.logger.Debug("Block already exists",
+					"hash", b.Hash().Hex(),
+					"error", err)
+				return nil
+			}
+			return fmt.Errorf("could not add block to host: %w", err)
+		}
 	}
 
-	if err := hostdb.AddBlock(dbtx.Tx, s.db.GetSQLStatement(), b); err != nil {
-		if IsConstraintError(err) {
-			s.logger.Debug("Block already exists",
-				"hash", b.Hash().Hex(),
-				"error", err)
-			return nil
-		}
-		return fmt.Errorf("could not add block to host: %w", err)
+	if err := hostdb.SetCanonicalBlock(dbtx.Tx, s.db.GetSQLStatement(), b); err != nil {
+		return err
 	}
 
 	if err := dbtx.Write(); err != nil {
@@ -202,6 +233,132 @@ func (s *storageImpl) FetchTransactionListing(pagination *common.QueryPagination
 	return hostdb.GetTransactionListing(s.db, pagination)
 }
 
+func (s *storageImpl) FetchExtRollup(rollupHash gethcommon.Hash) (*common.ExtRollup, error) {
+	return hostdb.GetExtRollup(s.db, rollupHash)
+}
+
+func (s *storageImpl) FetchEarliestRollupHeader() (*common.RollupHeader, error) {
+	return hostdb.GetEarliestRollup(s.db)
+}
+
+func (s *storageImpl) AddRevealedDAKeys(keys []common.RevealedDAKey) error {
+	dbtx, err := s.db.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+
+	if err := hostdb.AddRevealedDAKeys(dbtx, s.db.GetSQLStatement(), keys); err != nil {
+		if err := dbtx.Rollback(); err != nil {
+			return err
+		}
+		return fmt.Errorf("could not add revealed keys to host. Cause: %w", err)
+	}
+
+	if err := dbtx.Write(); err != nil {
+		return fmt.Errorf("could not commit revealed keys tx. Cause: %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchRevealedDAKeys(period uint64) ([]common.RevealedDAKey, error) {
+	return hostdb.GetRevealedDAKeys(s.db, period)
+}
+
+func (s *storageImpl) FetchLatestRevealedPeriod() (uint64, error) {
+	return hostdb.GetLatestRevealedPeriod(s.db)
+}
+
+func (s *storageImpl) AddFraudReport(report *common.FraudReport) error {
+	dbtx, err := s.db.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+
+	if err := hostdb.AddFraudReport(dbtx, s.db.GetSQLStatement(), report); err != nil {
+		if err := dbtx.Rollback(); err != nil {
+			return err
+		}
+		if errors.Is(err, errutil.ErrAlreadyExists) {
+			return err
+		}
+		return fmt.Errorf("could not add fraud report to host. Cause: %w", err)
+	}
+
+	if err := dbtx.Write(); err != nil {
+		return fmt.Errorf("could not commit fraud report tx. Cause: %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) CountFraudReporters(sequencer common.EnclaveID) (uint64, error) {
+	return hostdb.CountFraudReporters(s.db, sequencer)
+}
+
+func (s *storageImpl) HaltSequencer(sequencer common.EnclaveID) error {
+	dbtx, err := s.db.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+	if err := hostdb.HaltSequencer(dbtx, s.db.GetSQLStatement(), sequencer); err != nil {
+		if err := dbtx.Rollback(); err != nil {
+			return err
+		}
+		return err
+	}
+	if err := dbtx.Write(); err != nil {
+		return fmt.Errorf("could not commit halt tx. Cause: %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) ResumeSequencer(sequencer common.EnclaveID) error {
+	dbtx, err := s.db.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+	if err := hostdb.ResumeSequencer(dbtx, s.db.GetSQLStatement(), sequencer); err != nil {
+		if err := dbtx.Rollback(); err != nil {
+			return err
+		}
+		return err
+	}
+	if err := dbtx.Write(); err != nil {
+		return fmt.Errorf("could not commit resume tx. Cause: %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchHaltedSequencers() ([]common.EnclaveID, error) {
+	return hostdb.GetHaltedSequencers(s.db)
+}
+
+func (s *storageImpl) AddBlobSidecars(slot uint64, sidecars []*ethadapter.BlobSidecar) error {
+	dbtx, err := s.db.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+
+	if err := hostdb.AddBlobSidecars(dbtx, s.db.GetSQLStatement(), slot, sidecars); err != nil {
+		if err := dbtx.Rollback(); err != nil {
+			return err
+		}
+		return fmt.Errorf("could not add blobs to host. Cause: %w", err)
+	}
+
+	if err := dbtx.Write(); err != nil {
+		return fmt.Errorf("could not commit blobs tx. Cause: %w", err)
+	}
+	return nil
+}
+
+func (s *storageImpl) FetchBlobSidecar(versionedHash gethcommon.Hash) (*ethadapter.BlobSidecar, error) {
+	return hostdb.GetBlobSidecar(s.db, versionedHash)
+}
+
+func (s *storageImpl) FetchBlobSidecarsBySlot(slot uint64) ([]*ethadapter.BlobSidecar, error) {
+	return hostdb.GetBlobSidecarsBySlot(s.db, slot)
+}
+
 func (s *storageImpl) Close() error {
 	return s.db.GetSQLDB().Close()
 }
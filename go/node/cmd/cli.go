@@ -55,6 +55,7 @@ type NodeConfigCLI struct {
 	postgresDBHost          string
 	l1BeaconUrl             string
 	l1BlobArchiveUrl        string
+	isBlobArchiveEnabled    bool
 }
 
 // ParseConfigCLI returns a NodeConfigCLI based the cli params and defaults.
@@ -95,6 +96,7 @@ func ParseConfigCLI() *NodeConfigCLI {
 	postgresDBHost := flag.String(postgresDBHostFlag, "dd", flagUsageMap[postgresDBHostFlag])
 	l1BeaconUrl := flag.String(l1BeaconUrlFlag, "eth2network:126000", flagUsageMap[l1BeaconUrlFlag])
 	l1BlobArchiveUrl := flag.String(l1BlobArchiveUrlFlag, "", flagUsageMap[l1BlobArchiveUrlFlag])
+	isBlobArchiveEnabled := flag.Bool(isBlobArchiveEnabledFlag, false, flagUsageMap[isBlobArchiveEnabledFlag])
 	systemContractsUpgrader := flag.String(systemContractsUpgraderFlag, "", flagUsageMap[systemContractsUpgraderFlag])
 	flag.Parse()
 	cfg.nodeName = *nodeName
@@ -130,6 +132,7 @@ func ParseConfigCLI() *NodeConfigCLI {
 	cfg.postgresDBHost = *postgresDBHost
 	cfg.l1BeaconUrl = *l1BeaconUrl
 	cfg.l1BlobArchiveUrl = *l1BlobArchiveUrl
+	cfg.isBlobArchiveEnabled = *isBlobArchiveEnabled
 	cfg.sequencerUpgraderAddr = *systemContractsUpgrader
 
 	cfg.nodeAction = flag.Arg(0)
@@ -215,6 +218,7 @@ func NodeCLIConfigToTenConfig(cliCfg *NodeConfigCLI) *config.TenConfig {
 	tenCfg.Host.P2P.IsDisabled = cliCfg.isInboundP2PDisabled
 	tenCfg.Host.RPC.HTTPPort = uint64(cliCfg.hostHTTPPort)
 	tenCfg.Host.RPC.WSPort = uint64(cliCfg.hostWSPort)
+	tenCfg.Host.RPC.EnableBlobArchive = cliCfg.isBlobArchiveEnabled
 	tenCfg.Host.Log.Level = cliCfg.logLevel
 
 	tenCfg.Enclave.DB.UseInMemory = false                                     // these nodes always use a persistent DB
@@ -35,6 +35,7 @@ const (
 	postgresDBHostFlag          = "postgres_db_host"
 	l1BeaconUrlFlag             = "l1_beacon_url"
 	l1BlobArchiveUrlFlag        = "l1_blob_archive_url"
+	isBlobArchiveEnabledFlag    = "is_blob_archive_enabled"
 	systemContractsUpgraderFlag = "system_contracts_upgrader"
 )
 
@@ -75,6 +76,7 @@ func getFlagUsageMap() map[string]string {
 		postgresDBHostFlag:          "Host connection details for Postgres DB",
 		l1BeaconUrlFlag:             "Url for the beacon chain API",
 		l1BlobArchiveUrlFlag:        "Url for the blob archive endpoint",
+		isBlobArchiveEnabledFlag:    "Serves the blobs archived by the host on the host http port, for other nodes to use as their blob archive",
 		systemContractsUpgraderFlag: "Address of the system contracts upgrader",
 	}
 }
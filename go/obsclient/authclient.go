@@ -317,3 +317,32 @@ func (ac *AuthObsClient) GetPrivateTransactions(ctx context.Context, address *ge
 
 	return result.Receipts, result.Total, nil
 }
+
+// RevokeDelegation revokes the read access that the account of the client delegated to the viewing key with the
+// `delegate` user id (see viewingkey.Delegation). The delegations which start after the revocation remain valid.
+func (ac *AuthObsClient) RevokeDelegation(ctx context.Context, delegate []byte) error {
+	var revoked bool
+	return ac.rpcClient.CallContext(ctx, &revoked, tenrpc.ERPCRevokeDelegation, hexutil.Encode(delegate))
+}
+
+// GetSoftFinalityReceipt returns the receipt of the sequencer for a transaction sent by the account of the client, once
+// it has checked that the receipt was signed by the sequencer enclave. The receipt is best-effort, see
+// common.SoftFinalityReceipt. The request must be sent to the sequencer node, and the ID of the sequencer enclave must
+// come from a trusted source, e.g. the management contract.
+func (ac *AuthObsClient) GetSoftFinalityReceipt(ctx context.Context, txHash gethcommon.Hash, sequencerID common.EnclaveID) (*common.SoftFinalityReceipt, error) {
+	var receipt *common.SoftFinalityReceipt
+	err := ac.rpcClient.CallContext(ctx, &receipt, tenrpc.ERPCGetSoftFinalityReceipt, txHash.Hex())
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		return nil, ethereum.NotFound
+	}
+	if receipt.TxHash != txHash {
+		return nil, fmt.Errorf("receipt issued for transaction %s instead of %s", receipt.TxHash, txHash)
+	}
+	if err := VerifySoftFinalityReceipt(receipt, sequencerID); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
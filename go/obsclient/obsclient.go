@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/signature"
 	"github.com/ten-protocol/go-ten/go/rpc"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -149,6 +150,17 @@ func (oc *ObsClient) GetTotalContractCount() (int, error) {
 	return count, nil
 }
 
+// GetPublicStorageAt returns the value of a storage slot that the contract made public. The mapping key is only set
+// when reading an entry of a public mapping, in which case the slot is the base slot of the mapping.
+func (oc *ObsClient) GetPublicStorageAt(address gethcommon.Address, slot gethcommon.Hash, mappingKey *gethcommon.Hash) (*common.PublicStorageValue, error) {
+	var result common.PublicStorageValue
+	err := oc.rpcClient.Call(&result, rpc.GetPublicStorageAt, address, slot, mappingKey)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
 // GetTotalTransactionCount returns the total count of executed transactions
 func (oc *ObsClient) GetTotalTransactionCount() (int, error) {
 	var count int
@@ -268,3 +280,21 @@ func (oc *ObsClient) GetConfig() (*common.TenNetworkInfo, error) {
 	}
 	return &result, nil
 }
+
+// VerifySoftFinalityReceipt checks that the receipt was signed by the enclave with the given ID.
+func VerifySoftFinalityReceipt(receipt *common.SoftFinalityReceipt, sequencerID common.EnclaveID) error {
+	if receipt.EnclaveID != sequencerID {
+		return fmt.Errorf("receipt issued by enclave %s instead of the sequencer %s", receipt.EnclaveID, sequencerID)
+	}
+	// the signature is copied because the recovery modifies it
+	sig := make([]byte, len(receipt.Signature))
+	copy(sig, receipt.Signature)
+	signer, err := signature.RecoverAddress(receipt.Hash().Bytes(), sig)
+	if err != nil {
+		return fmt.Errorf("could not recover the signer of the receipt. Cause: %w", err)
+	}
+	if *signer != sequencerID {
+		return fmt.Errorf("receipt signed by %s instead of the sequencer %s", signer, sequencerID)
+	}
+	return nil
+}
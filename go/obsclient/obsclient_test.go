@@ -0,0 +1,46 @@
+package obsclient
+
+import (
+	"testing"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/signature"
+)
+
+func TestVerifySoftFinalityReceipt(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sequencerID := crypto.PubkeyToAddress(key.PublicKey)
+
+	receipt := &common.SoftFinalityReceipt{
+		TxHash:     gethcommon.HexToHash("0x1234"),
+		BatchSeqNo: 10,
+		EnclaveID:  sequencerID,
+	}
+	receipt.Signature, err = signature.Sign(receipt.Hash().Bytes(), key)
+	require.NoError(t, err)
+
+	assert.NoError(t, VerifySoftFinalityReceipt(receipt, sequencerID))
+	// the verification must not alter the receipt
+	assert.NoError(t, VerifySoftFinalityReceipt(receipt, sequencerID))
+
+	// a receipt from another enclave is rejected
+	assert.Error(t, VerifySoftFinalityReceipt(receipt, gethcommon.HexToAddress("0xabc")))
+
+	// the signature does not cover a later batch
+	tampered := *receipt
+	tampered.BatchSeqNo = 11
+	assert.Error(t, VerifySoftFinalityReceipt(&tampered, sequencerID))
+
+	// an enclave can't issue receipts on behalf of the sequencer
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	forged := *receipt
+	forged.Signature, err = signature.Sign(forged.Hash().Bytes(), otherKey)
+	require.NoError(t, err)
+	assert.Error(t, VerifySoftFinalityReceipt(&forged, sequencerID))
+}
@@ -19,16 +19,18 @@ const (
 	Config = "ten_config"
 	RPCKey = "ten_rpcKey"
 
-	StopHost                 = "test_stopHost"
-	SubscribeNamespace       = "ten"
-	SubscriptionTypeLogs     = "logs"
-	SubscriptionTypeNewHeads = "newHeads"
+	StopHost                            = "test_stopHost"
+	SubscribeNamespace                  = "ten"
+	SubscriptionTypeLogs                = "logs"
+	SubscriptionTypeNewHeads            = "newHeads"
+	SubscriptionTypePendingTransactions = "newPendingTransactions"
 
 	GetBatchByTx             = "scan_getBatchByTx"
 	GetLatestRollupHeader    = "scan_getLatestRollupHeader"
 	GetTotalTxCount          = "scan_getTotalTransactionCount"
 	GetTotalTxsQuery         = "scan_getTotalTransactionsQuery"
 	GetTotalContractCount    = "scan_getTotalContractCount"
+	GetPublicStorageAt       = "scan_getPublicStorageAt"
 	GetPublicTransactionData = "scan_getPublicTransactionData"
 	GetBatchListing          = "scan_getBatchListing"
 	GetBlockListing          = "scan_getBlockListing"
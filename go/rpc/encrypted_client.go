@@ -31,6 +31,7 @@ type EncRPCClient struct {
 	obscuroClient    Client
 	enclavePublicKey *ecies.PublicKey // Used to encrypt messages destined to the enclave.
 	viewingKey       *viewingkey.ViewingKey
+	onKeyRefreshed   func(enclavePublicKey []byte) // notified when the enclave key is read again, e.g. after a rotation
 	logger           gethlog.Logger
 }
 
@@ -52,6 +53,11 @@ func NewEncRPCClient(client Client, viewingKey *viewingkey.ViewingKey, enclavePu
 	return encClient, nil
 }
 
+// OnKeyRefreshed - registers a function which is called with the new enclave key when the client reads it again
+func (c *EncRPCClient) OnKeyRefreshed(f func(enclavePublicKey []byte)) {
+	c.onKeyRefreshed = f
+}
+
 func (c *EncRPCClient) BackingClient() Client {
 	return c.obscuroClient
 }
@@ -73,9 +79,9 @@ func (c *EncRPCClient) CallContext(ctx context.Context, result interface{}, meth
 
 	if rpc.IsEncryptedMethod(method) {
 		err := c.executeEncryptedCall(ctx, result, method, args...)
-		// this should only be triggered during testing
+		// the enclave key was rotated, or the backend belongs to another network (e.g. during testing)
 		if err != nil && errors.Is(err, common.FailedDecryptErr) {
-			c.logger.Warn("Reconnecting to new backend. Reading the enclave key.")
+			c.logger.Warn("The enclave could not decrypt the request. Reading the enclave key.")
 			newKey, err := ReadEnclaveKey(c.obscuroClient)
 			if err != nil {
 				return fmt.Errorf("could not refresh enclave key: %w", err)
@@ -85,6 +91,9 @@ func (c *EncRPCClient) CallContext(ctx context.Context, result interface{}, meth
 				return fmt.Errorf("failed to decompress key for RPC client: %w", err)
 			}
 			c.enclavePublicKey = ecies.ImportECDSAPublic(enclPubECDSA)
+			if c.onKeyRefreshed != nil {
+				c.onKeyRefreshed(newKey)
+			}
 			// retry with the updated key
 			return c.executeEncryptedCall(ctx, result, method, args...)
 		}
@@ -105,8 +114,10 @@ func (c *EncRPCClient) Subscribe(ctx context.Context, namespace string, ch inter
 		return c.logSubscription(ctx, namespace, ch, args...)
 	case SubscriptionTypeNewHeads:
 		return c.newHeadSubscription(ctx, namespace, ch, args...)
+	case SubscriptionTypePendingTransactions:
+		return c.pendingTransactionsSubscription(ctx, namespace, ch)
 	default:
-		return nil, fmt.Errorf("only subscriptions of type %s and %s are supported", SubscriptionTypeLogs, SubscriptionTypeNewHeads)
+		return nil, fmt.Errorf("only subscriptions of type %s, %s and %s are supported", SubscriptionTypeLogs, SubscriptionTypeNewHeads, SubscriptionTypePendingTransactions)
 	}
 }
 
@@ -126,6 +137,11 @@ func (c *EncRPCClient) executeEncryptedCall(ctx context.Context, result interfac
 		return err
 	}
 
+	// the plaintext error is converted back, so that the caller can read the enclave key again
+	if rawResult.Err != nil && *rawResult.Err == common.FailedDecryptErr.Error() {
+		return common.FailedDecryptErr
+	}
+
 	// if caller not interested in response, we're done
 	if result == nil {
 		return nil
@@ -205,6 +221,7 @@ func (c *EncRPCClient) encryptArgs(method string, args ...interface{}) ([]byte,
 		PublicKey:               c.viewingKey.PublicKey,
 		SignatureWithAccountKey: c.viewingKey.SignatureWithAccountKey,
 		SignatureType:           c.viewingKey.SignatureType,
+		Delegation:              c.viewingKey.Delegation,
 	}
 	argsWithVK := &rpc.RequestWithVk{VK: &vk, Method: method, Params: args}
 
@@ -244,17 +261,54 @@ func (c *EncRPCClient) logSubscription(ctx context.Context, namespace string, ch
 		return nil, err
 	}
 
-	encodedLogSubscription, err := json.Marshal(logSubscription)
+	return c.encryptedSubscription(ctx, namespace, logSubscription, func(encLog []byte) error {
+		return c.onMessage(encLog, outboundChannel)
+	})
+}
+
+// pendingTransactionsSubscription - the node sends the transactions of the account which become executable in the
+// mempool through the logs subscription mechanism
+func (c *EncRPCClient) pendingTransactionsSubscription(ctx context.Context, namespace string, ch interface{}) (*gethrpc.ClientSubscription, error) {
+	outboundChannel, ok := ch.(chan *types.Transaction)
+	if !ok {
+		return nil, fmt.Errorf("expected a channel of type `chan *types.Transaction`, got %T", ch)
+	}
+
+	pendingTxsSubscription, err := common.CreateAuthenticatedLogSubscriptionPayload(nil, c.viewingKey)
+	if err != nil {
+		return nil, err
+	}
+	pendingTxsSubscription.PendingTransactions = true
+
+	return c.encryptedSubscription(ctx, namespace, pendingTxsSubscription, func(encTxs []byte) error {
+		jsonTxs, err := c.decryptResponse(encTxs)
+		if err != nil {
+			return err
+		}
+		var txs []*types.Transaction
+		if err := json.Unmarshal(jsonTxs, &txs); err != nil {
+			return fmt.Errorf("could not unmarshal the pending transactions. Cause: %w", err)
+		}
+		for _, tx := range txs {
+			outboundChannel <- tx
+		}
+		return nil
+	})
+}
+
+// encryptedSubscription - subscribes to the node with the encrypted subscription, and passes the encrypted messages to onMessage
+func (c *EncRPCClient) encryptedSubscription(ctx context.Context, namespace string, sub *common.LogSubscription, onMessage func([]byte) error) (*gethrpc.ClientSubscription, error) {
+	encodedSubscription, err := json.Marshal(sub)
 	if err != nil {
 		return nil, err
 	}
 
-	encryptedParams, err := c.encryptParamBytes(encodedLogSubscription)
+	encryptedParams, err := c.encryptParamBytes(encodedSubscription)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encrypt args for subscription in namespace %s - %w", namespace, err)
 	}
 
-	// the node sends encrypted logs
+	// the node sends encrypted messages
 	inboundChannel := make(chan []byte)
 	backendSub, err := c.obscuroClient.Subscribe(ctx, namespace, inboundChannel, SubscriptionTypeLogs, encryptedParams)
 	if err != nil {
@@ -267,9 +321,7 @@ func (c *EncRPCClient) logSubscription(ctx context.Context, namespace string, ch
 	})
 	go subscription.ForwardFromChannels(
 		[]chan []byte{inboundChannel},
-		func(encLog []byte) error {
-			return c.onMessage(encLog, outboundChannel)
-		},
+		onMessage,
 		nil,
 		backendDisconnected,
 		nil,
@@ -82,6 +82,8 @@ func DefaultEnclaveConfig() *enclaveconfig.EnclaveConfig {
 		DebugNamespaceEnabled:     false,
 		MaxBatchSize:              1024 * 55,
 		MaxRollupSize:             1024 * 128,
+		RevelationPeriod:          10,
+		RevelationDelay:           20,
 		GasPaymentAddress:         gethcommon.HexToAddress("0xd6C9230053f45F873Cb66D8A02439380a37A4fbF"),
 		BaseFee:                   new(big.Int).SetUint64(params.InitialBaseFee),
 
@@ -23,18 +23,24 @@ import (
 var (
 	depositTxAddr          = datagenerator.RandomAddress()
 	rollupTxAddr           = datagenerator.RandomAddress()
+	calldataRollupTxAddr   = datagenerator.RandomAddress()
 	storeSecretTxAddr      = datagenerator.RandomAddress()
 	requestSecretTxAddr    = datagenerator.RandomAddress()
 	initializeSecretTxAddr = datagenerator.RandomAddress()
+	fraudReportTxAddr      = datagenerator.RandomAddress()
+	secretDistributionAddr = datagenerator.RandomAddress()
 	messageBusAddr         = datagenerator.RandomAddress()
 	// ContractAddresses maps contract types to their addresses
 	ContractAddresses = map[l1.ContractType][]gethcommon.Address{
 		l1.MgmtContract: {
 			depositTxAddr,
 			rollupTxAddr,
+			calldataRollupTxAddr,
 			storeSecretTxAddr,
 			requestSecretTxAddr,
 			initializeSecretTxAddr,
+			fraudReportTxAddr,
+			secretDistributionAddr,
 		},
 		l1.MsgBus: {
 			messageBusAddr,
@@ -104,6 +110,10 @@ func (m *mockContractLib) CreateBlobRollup(t *common.L1RollupTx) (types.TxData,
 	}, nil
 }
 
+func (m *mockContractLib) CreateCalldataRollup(t *common.L1RollupTx) (types.TxData, error) {
+	return encodeTx(t, calldataRollupTxAddr), nil
+}
+
 func (m *mockContractLib) CreateRequestSecret(tx *common.L1RequestSecretTx) types.TxData {
 	return encodeTx(tx, requestSecretTxAddr)
 }
@@ -116,6 +126,14 @@ func (m *mockContractLib) CreateInitializeSecret(tx *common.L1InitializeSecretTx
 	return encodeTx(tx, initializeSecretTxAddr)
 }
 
+func (m *mockContractLib) CreateFraudReport(report *common.FraudReport) (types.TxData, error) {
+	return encodeTx(report, fraudReportTxAddr), nil
+}
+
+func (m *mockContractLib) CreateSecretDistribution(distribution *common.SecretDistribution) (types.TxData, error) {
+	return encodeTx(distribution, secretDistributionAddr), nil
+}
+
 func (m *mockContractLib) GetHostAddressesMsg() (ethereum.CallMsg, error) {
 	return ethereum.CallMsg{}, nil
 }
@@ -166,6 +184,12 @@ func decodeTx(tx *types.Transaction) common.L1TenTransaction {
 		t = &common.L1RequestSecretTx{}
 	case initializeSecretTxAddr.Hex():
 		t = &common.L1InitializeSecretTx{}
+	case fraudReportTxAddr.Hex():
+		t = &common.FraudReport{}
+	case secretDistributionAddr.Hex():
+		t = &common.SecretDistribution{}
+	case calldataRollupTxAddr.Hex():
+		t = &common.L1RollupTx{}
 	default:
 		panic("unexpected type")
 	}
@@ -36,7 +36,11 @@ import (
 	"github.com/ten-protocol/go-ten/go/ethadapter/mgmtcontractlib"
 )
 
-const SecondsPerSlot = uint64(12)
+const (
+	SecondsPerSlot = uint64(12)
+	// the mock L1 has no finality gadget, so blocks are considered final once they are this deep
+	finalityDepth = uint64(5)
+)
 
 type L1Network interface {
 	// BroadcastBlock - send the block and the parent to make sure there are no gaps
@@ -212,6 +216,13 @@ func (m *Node) getRollupFromBlock(block *types.Block) *common.ExtRollup {
 				return nil
 			}
 			return r
+		case *common.L1RollupTx:
+			r, err := common.DecodeRollup(l1tx.Rollup)
+			if err != nil {
+				m.logger.Error("could not decode rollup from calldata. Cause: %w", err)
+				return nil
+			}
+			return r
 		}
 	}
 	return nil
@@ -263,6 +274,17 @@ func (m *Node) BlockNumber() (uint64, error) {
 	return blk.NumberU64(), nil
 }
 
+func (m *Node) FinalizedBlockNumber() (uint64, error) {
+	head, err := m.BlockNumber()
+	if err != nil {
+		return 0, err
+	}
+	if head < finalityDepth {
+		return 0, nil
+	}
+	return head - finalityDepth, nil
+}
+
 func (m *Node) BlockByNumber(n *big.Int) (*types.Block, error) {
 	if n.Int64() == 0 {
 		return MockGenesisBlock, nil
@@ -350,6 +372,8 @@ func (m *Node) GetLogs(fq ethereum.FilterQuery) ([]types.Log, error) {
 			topic = crosschain.NetworkSecretRequestedID
 		case initializeSecretTxAddr.Hex():
 			topic = crosschain.SequencerEnclaveGrantedEventID
+		case secretDistributionAddr.Hex():
+			topic = crosschain.NetworkSecretRotatedID
 		default:
 			continue
 		}
@@ -203,15 +203,15 @@ func (s *InMemDevNetwork) startTenGateway() {
 	// remove ws:// prefix for the gateway config
 	validatorWS = validatorWS[len("ws://"):]
 	cfg := wecommon.Config{
-		WalletExtensionHost:     "127.0.0.1",
-		WalletExtensionPortHTTP: _gwHTTPPort,
-		WalletExtensionPortWS:   _gwWSPort,
-		NodeRPCHTTPAddress:      validatorHTTP,
-		NodeRPCWebsocketAddress: validatorWS,
-		LogPath:                 "sys_out",
-		VerboseFlag:             false,
-		DBType:                  "sqlite",
-		TenChainID:              integration.TenChainID,
+		WalletExtensionHost:       "127.0.0.1",
+		WalletExtensionPortHTTP:   _gwHTTPPort,
+		WalletExtensionPortWS:     _gwWSPort,
+		NodeRPCHTTPAddresses:      []string{validatorHTTP},
+		NodeRPCWebsocketAddresses: []string{validatorWS},
+		LogPath:                   "sys_out",
+		VerboseFlag:               false,
+		DBType:                    "sqlite",
+		TenChainID:                integration.TenChainID,
 	}
 	tenGWContainer := walletextension.NewContainerFromConfig(cfg, s.logger)
 	go func() {
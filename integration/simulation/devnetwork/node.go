@@ -7,6 +7,7 @@ import (
 
 	"github.com/ten-protocol/go-ten/go/enclave/config"
 	hostconfig "github.com/ten-protocol/go-ten/go/host/config"
+	"github.com/ten-protocol/go-ten/go/host/storage"
 	"github.com/ten-protocol/go-ten/lib/gethfork/node"
 
 	gethcommon "github.com/ethereum/go-ethereum/common"
@@ -184,9 +185,10 @@ func (n *InMemNodeOperator) createHostContainer() *hostcontainer.HostContainer {
 		l1.MgmtContract: {hostConfig.ManagementContractAddress},
 		l1.MsgBus:       {hostConfig.MessageBusAddress},
 	}
-	blobResolver := l1.NewBlobResolver(ethadapter.NewL1BeaconClient(ethadapter.NewBeaconHTTPClient(new(http.Client), fmt.Sprintf("127.0.0.1:%d", n.config.L1BeaconPort))))
+	hostStorage := storage.NewHostStorageFromConfig(hostConfig, hostLogger)
+	blobResolver := l1.NewBlobResolver(ethadapter.NewL1BeaconClient(ethadapter.NewBeaconHTTPClient(new(http.Client), fmt.Sprintf("127.0.0.1:%d", n.config.L1BeaconPort))), hostStorage, hostLogger)
 	l1Data := l1.NewL1DataService(n.l1Client, n.logger, mgmtContractLib, blobResolver, contractAddresses)
-	return hostcontainer.NewHostContainer(hostConfig, svcLocator, nodeP2p, n.l1Client, l1Data, enclaveClients, mgmtContractLib, n.l1Wallet, rpcServer, hostLogger, metrics.New(false, 0, n.logger), blobResolver)
+	return hostcontainer.NewHostContainer(hostConfig, svcLocator, nodeP2p, n.l1Client, l1Data, enclaveClients, mgmtContractLib, n.l1Wallet, rpcServer, hostLogger, metrics.New(false, 0, n.logger), blobResolver, hostStorage)
 }
 
 func (n *InMemNodeOperator) createEnclaveContainer(idx int) *enclavecontainer.EnclaveContainer {
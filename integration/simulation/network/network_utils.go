@@ -14,6 +14,7 @@ import (
 	hostconfig "github.com/ten-protocol/go-ten/go/host/config"
 	hostcontainer "github.com/ten-protocol/go-ten/go/host/container"
 	"github.com/ten-protocol/go-ten/go/host/l1"
+	"github.com/ten-protocol/go-ten/go/host/storage"
 
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/common/log"
@@ -99,6 +100,8 @@ func createInMemTenNode(
 		SystemContractOwner:       gethcommon.BigToAddress(big.NewInt(1)), // Irrelevant for in-mem nodes
 		MaxBatchSize:              1024 * 55,
 		MaxRollupSize:             1024 * 128,
+		RevelationPeriod:          10,
+		RevelationDelay:           20,
 		BaseFee:                   big.NewInt(1), // todo @siliev:: fix test transaction builders so this can be different
 		GasLocalExecutionCapFlag:  params.MaxGasLimit / 2,
 		GasBatchExecutionLimit:    30_000_000,
@@ -113,7 +116,7 @@ func createInMemTenNode(
 	hostLogger := testlog.Logger().New(log.NodeIDKey, id, log.CmpKey, log.HostCmp)
 	metricsService := metrics.New(hostConfig.MetricsEnabled, hostConfig.MetricsHTTPPort, hostLogger)
 	l1Data := l1.NewL1DataService(ethClient, hostLogger, mgmtContractLib, blobResolver, ethereummock.ContractAddresses)
-	currentContainer := hostcontainer.NewHostContainer(hostConfig, host.NewServicesRegistry(hostLogger), mockP2P, ethClient, l1Data, enclaveClients, mgmtContractLib, ethWallet, nil, hostLogger, metricsService, blobResolver)
+	currentContainer := hostcontainer.NewHostContainer(hostConfig, host.NewServicesRegistry(hostLogger), mockP2P, ethClient, l1Data, enclaveClients, mgmtContractLib, ethWallet, nil, hostLogger, metricsService, blobResolver, storage.NewHostStorageFromConfig(hostConfig, hostLogger))
 
 	return currentContainer
 }
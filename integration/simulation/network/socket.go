@@ -11,7 +11,9 @@ import (
 
 	"github.com/ten-protocol/go-ten/go/common"
 	"github.com/ten-protocol/go-ten/go/config"
+	hostconfig "github.com/ten-protocol/go-ten/go/host/config"
 	"github.com/ten-protocol/go-ten/go/host/l1"
+	"github.com/ten-protocol/go-ten/go/host/storage"
 	"github.com/ten-protocol/go-ten/integration/noderunner"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -62,8 +64,10 @@ func (n *networkOfSocketNodes) Create(simParams *params.SimParams, _ *stats.Stat
 		&simParams.L1TenData.EthErc20Address,
 	)
 	beaconURL := fmt.Sprintf("127.0.0.1:%d", simParams.L1BeaconPort)
+	// the simulation reads the blobs independently of the nodes, so it has its own archive
+	blobArchive := storage.NewHostStorageFromConfig(&hostconfig.HostConfig{UseInMemoryDB: true}, testlog.Logger())
 	simParams.BlobResolver = l1.NewBlobResolver(ethadapter.NewL1BeaconClient(
-		ethadapter.NewBeaconHTTPClient(new(http.Client), beaconURL)))
+		ethadapter.NewBeaconHTTPClient(new(http.Client), beaconURL)), blobArchive, testlog.Logger())
 
 	// get the sequencer Address
 	seqPrivateKey := n.wallets.NodeWallets[0].PrivateKey()
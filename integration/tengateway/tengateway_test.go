@@ -70,8 +70,8 @@ func TestTenGateway(t *testing.T) {
 		WalletExtensionHost:            "127.0.0.1",
 		WalletExtensionPortHTTP:        startPort + integration.DefaultTenGatewayHTTPPortOffset,
 		WalletExtensionPortWS:          startPort + integration.DefaultTenGatewayWSPortOffset,
-		NodeRPCHTTPAddress:             fmt.Sprintf("127.0.0.1:%d", startPort+integration.DefaultHostRPCHTTPOffset),
-		NodeRPCWebsocketAddress:        fmt.Sprintf("127.0.0.1:%d", startPort+integration.DefaultHostRPCWSOffset),
+		NodeRPCHTTPAddresses:           []string{fmt.Sprintf("127.0.0.1:%d", startPort+integration.DefaultHostRPCHTTPOffset)},
+		NodeRPCWebsocketAddresses:      []string{fmt.Sprintf("127.0.0.1:%d", startPort+integration.DefaultHostRPCWSOffset)},
 		LogPath:                        "sys_out",
 		VerboseFlag:                    false,
 		DBType:                         "sqlite",
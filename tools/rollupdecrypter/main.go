@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/compression"
+	"github.com/ten-protocol/go-ten/go/enclave/crypto"
+)
+
+// Tool to decrypt historic rollups with the DA keys revealed by the TEN nodes once the revelation delay has elapsed.
+// The rollup and the keys are either fetched from a node, or read from JSON files (in the format returned by the
+// `scan_getExtRollup` and `scan_getRevealedDAKeys` RPC methods) so that the keys can be checked independently.
+
+const (
+	nodeURLName  = "nodeURL"
+	nodeURLUsage = "The URL of the RPC endpoint of a TEN node, used to fetch the rollup and the revealed keys."
+
+	rollupHashName  = "rollupHash"
+	rollupHashUsage = "The hash of the rollup to fetch from the node."
+
+	rollupFileName  = "rollupFile"
+	rollupFileUsage = "A JSON file containing the encrypted rollup, instead of fetching it from the node."
+
+	keysFileName  = "keysFile"
+	keysFileUsage = "A JSON file containing the revealed keys of the period of the rollup, instead of fetching them from the node."
+)
+
+// decryptedRollup - the plaintext contents of a rollup
+type decryptedRollup struct {
+	Header               *common.RollupHeader
+	CalldataRollupHeader *common.CalldataRollupHeader
+	TransactionsPerBatch [][]*common.L2Tx
+}
+
+func main() {
+	nodeURL := flag.String(nodeURLName, "", nodeURLUsage)
+	rollupHash := flag.String(rollupHashName, "", rollupHashUsage)
+	rollupFile := flag.String(rollupFileName, "", rollupFileUsage)
+	keysFile := flag.String(keysFileName, "", keysFileUsage)
+	flag.Parse()
+
+	if err := run(*nodeURL, *rollupHash, *rollupFile, *keysFile); err != nil {
+		fmt.Println("Could not decrypt rollup:", err)
+		os.Exit(1)
+	}
+}
+
+func run(nodeURL string, rollupHash string, rollupFile string, keysFile string) error {
+	var client *gethrpc.Client
+	if nodeURL != "" {
+		var err error
+		client, err = gethrpc.Dial(nodeURL)
+		if err != nil {
+			return fmt.Errorf("could not connect to node. Cause: %w", err)
+		}
+		defer client.Close()
+	}
+
+	rollup := new(common.ExtRollup)
+	switch {
+	case rollupFile != "":
+		if err := readJSONFile(rollupFile, rollup); err != nil {
+			return err
+		}
+	case client != nil && rollupHash != "":
+		if err := client.CallContext(context.Background(), rollup, "scan_getExtRollup", gethcommon.HexToHash(rollupHash)); err != nil {
+			return fmt.Errorf("could not fetch rollup. Cause: %w", err)
+		}
+	default:
+		return fmt.Errorf("either -%s or -%s and -%s must be set", rollupFileName, nodeURLName, rollupHashName)
+	}
+	if rollup.Header == nil {
+		return fmt.Errorf("rollup not found")
+	}
+
+	var keys []common.RevealedDAKey
+	switch {
+	case keysFile != "":
+		if err := readJSONFile(keysFile, &keys); err != nil {
+			return err
+		}
+	case client != nil:
+		if err := client.CallContext(context.Background(), &keys, "scan_getRevealedDAKeys", rollup.Header.RevelationPeriod); err != nil {
+			return fmt.Errorf("could not fetch the keys of period %d. Cause: %w", rollup.Header.RevelationPeriod, err)
+		}
+	default:
+		return fmt.Errorf("either -%s or -%s must be set", keysFileName, nodeURLName)
+	}
+
+	decrypted, err := decryptRollup(rollup, keys)
+	if err != nil {
+		return err
+	}
+	output, err := json.MarshalIndent(decrypted, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode decrypted rollup. Cause: %w", err)
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+func decryptRollup(rollup *common.ExtRollup, keys []common.RevealedDAKey) (*decryptedRollup, error) {
+	var key *common.RevealedDAKey
+	for i := range keys {
+		if keys[i].Epoch == rollup.Header.SecretEpoch && keys[i].Period == rollup.Header.RevelationPeriod {
+			key = &keys[i]
+		}
+	}
+	if key == nil {
+		return nil, fmt.Errorf("the key of epoch %d and period %d was not revealed", rollup.Header.SecretEpoch, rollup.Header.RevelationPeriod)
+	}
+
+	decrypted := &decryptedRollup{Header: rollup.Header, CalldataRollupHeader: new(common.CalldataRollupHeader)}
+	if err := decryptBlob(*key, rollup.CalldataRollupHeader, decrypted.CalldataRollupHeader); err != nil {
+		return nil, fmt.Errorf("could not decrypt rollup header. Cause: %w", err)
+	}
+	if err := decryptBlob(*key, rollup.BatchPayloads, &decrypted.TransactionsPerBatch); err != nil {
+		return nil, fmt.Errorf("could not decrypt rollup transactions. Cause: %w", err)
+	}
+	return decrypted, nil
+}
+
+// decryptBlob - reverses the encryption, compression and serialisation of the rollup data done by the enclave
+func decryptBlob(key common.RevealedDAKey, blob []byte, obj any) error {
+	compressed, err := crypto.DecryptWithRevealedKey(key, blob)
+	if err != nil {
+		return err
+	}
+	serialised, err := compression.NewBrotliDataCompressionService().Decompress(compressed)
+	if err != nil {
+		return err
+	}
+	return rlp.DecodeBytes(serialised, obj)
+}
+
+func readJSONFile(path string, obj any) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read %s. Cause: %w", path, err)
+	}
+	if err := json.Unmarshal(content, obj); err != nil {
+		return fmt.Errorf("could not decode %s. Cause: %w", path, err)
+	}
+	return nil
+}
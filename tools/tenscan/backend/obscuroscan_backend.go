@@ -44,6 +44,10 @@ func (b *Backend) GetTotalContractCount() (int, error) {
 	return b.obsClient.GetTotalContractCount()
 }
 
+func (b *Backend) GetPublicStorageAt(address gethcommon.Address, slot gethcommon.Hash, mappingKey *gethcommon.Hash) (*common.PublicStorageValue, error) {
+	return b.obsClient.GetPublicStorageAt(address, slot, mappingKey)
+}
+
 func (b *Backend) GetTotalTransactionCount() (int, error) {
 	return b.obsClient.GetTotalTransactionCount()
 }
@@ -35,6 +35,9 @@ func routeItems(r *gin.Engine, server *WebServer) {
 	r.GET("/items/transaction/:hash", server.getTransaction)
 	r.GET("/items/transactions/count", server.getTotalTxCount)
 	r.GET("/items/blocks/", server.getBlockListing) // Deprecated
+
+	// contracts
+	r.GET("/items/contract/:address/storage/:slot", server.getPublicStorage)
 }
 
 func (w *WebServer) getHealthStatus(c *gin.Context) {
@@ -312,3 +315,33 @@ func (w *WebServer) getConfig(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"item": config})
 }
+
+// getPublicStorage returns the value of a storage slot that the contract made public.
+// The optional "key" query parameter reads the entry of a public mapping, in which case the slot is the base slot of the mapping.
+func (w *WebServer) getPublicStorage(c *gin.Context) {
+	address := gethcommon.HexToAddress(c.Param("address"))
+	slot, ok := new(big.Int).SetString(c.Param("slot"), 0)
+	if !ok {
+		errorHandler(c, fmt.Errorf("unable to parse storage slot %s", c.Param("slot")), w.logger)
+		return
+	}
+
+	var mappingKey *gethcommon.Hash
+	if keyStr := c.Query("key"); keyStr != "" {
+		key, ok := new(big.Int).SetString(keyStr, 0)
+		if !ok {
+			errorHandler(c, fmt.Errorf("unable to parse mapping key %s", keyStr), w.logger)
+			return
+		}
+		keyHash := gethcommon.BigToHash(key)
+		mappingKey = &keyHash
+	}
+
+	value, err := w.backend.GetPublicStorageAt(address, gethcommon.BigToHash(slot), mappingKey)
+	if err != nil {
+		errorHandler(c, fmt.Errorf("unable to execute getPublicStorage request %w", err), w.logger)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"item": value})
+}
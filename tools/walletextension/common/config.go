@@ -8,8 +8,9 @@ type Config struct {
 	WalletExtensionPortHTTP int
 	WalletExtensionPortWS   int
 
-	NodeRPCHTTPAddress      string
-	NodeRPCWebsocketAddress string
+	// the addresses of the TEN nodes the gateway connects to. The HTTP and websocket addresses of a node have the same index
+	NodeRPCHTTPAddresses      []string
+	NodeRPCWebsocketAddresses []string
 
 	LogPath        string
 	DBPathOverride string // Overrides the database file location. Used in tests.
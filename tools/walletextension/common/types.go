@@ -1,6 +1,10 @@
 package common
 
 import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto/ecies"
 	"github.com/ten-protocol/go-ten/go/common/viewingkey"
 	"golang.org/x/exp/maps"
@@ -8,10 +12,23 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 )
 
-// GWSessionKey - an account key-pair registered for a user
+// GWSessionKey - an account key-pair managed by the gateway for a user, together with the restrictions enforced before
+// signing transactions with it
 type GWSessionKey struct {
 	Account    *GWAccount
 	PrivateKey *ecies.PrivateKey // the private key corresponding to the account
+	Name       string
+	Active     bool                 // the session key is active, and it can be used to sign incoming transactions
+	ExpiresAt  time.Time            // the key can't sign transactions after this time. Zero if the key does not expire
+	Targets    []GWSessionKeyTarget // the contracts the key can call. Empty if any target is allowed
+	ValueCap   *big.Int             // the maximum total value transferred by the transactions signed with the key. Nil if unlimited
+	SpentValue *big.Int             // the total value transferred by the transactions signed with the key so far
+}
+
+// GWSessionKeyTarget - a contract that a session key is allowed to call
+type GWSessionKeyTarget struct {
+	Address   common.Address  `json:"address"`
+	Selectors []hexutil.Bytes `json:"selectors"` // the 4-byte function selectors that can be called. Empty if any function can be called
 }
 
 type GWAccount struct {
@@ -22,17 +39,16 @@ type GWAccount struct {
 }
 
 type GWUser struct {
-	ID         []byte
-	Accounts   map[common.Address]*GWAccount
-	UserKey    []byte
-	SessionKey *GWSessionKey
-	ActiveSK   bool // the session key is active, and it must be used to sign all incoming transactions, and used as the preferred account
+	ID          []byte
+	Accounts    map[common.Address]*GWAccount
+	UserKey     []byte
+	SessionKeys map[common.Address]*GWSessionKey
 }
 
 func (u GWUser) AllAccounts() map[common.Address]*GWAccount {
 	res := maps.Clone(u.Accounts)
-	if u.SessionKey != nil {
-		res[*u.SessionKey.Account.Address] = u.SessionKey.Account
+	for addr, sk := range u.SessionKeys {
+		res[addr] = sk.Account
 	}
 	return res
 }
@@ -40,3 +56,65 @@ func (u GWUser) AllAccounts() map[common.Address]*GWAccount {
 func (u GWUser) GetAllAddresses() []common.Address {
 	return maps.Keys(u.AllAccounts())
 }
+
+// ActiveSessionKeys - the session keys that can be used to sign the transactions of the user
+func (u GWUser) ActiveSessionKeys() []*GWSessionKey {
+	res := make([]*GWSessionKey, 0)
+	for _, sk := range u.SessionKeys {
+		if sk.Active {
+			res = append(res, sk)
+		}
+	}
+	return res
+}
+
+// IsExpired - returns true if the key can no longer sign transactions at the given time
+func (sk *GWSessionKey) IsExpired(now time.Time) bool {
+	return !sk.ExpiresAt.IsZero() && !now.Before(sk.ExpiresAt)
+}
+
+// Info - the public details of the session key
+func (sk *GWSessionKey) Info() SessionKeyInfo {
+	info := SessionKeyInfo{
+		Address: *sk.Account.Address,
+		Name:    sk.Name,
+		Active:  sk.Active,
+		Targets: sk.Targets,
+	}
+	if !sk.ExpiresAt.IsZero() {
+		info.ExpiresAt = uint64(sk.ExpiresAt.Unix())
+	}
+	if sk.ValueCap != nil {
+		info.ValueCap = (*hexutil.Big)(sk.ValueCap)
+	}
+	if sk.SpentValue != nil {
+		info.SpentValue = (*hexutil.Big)(sk.SpentValue)
+	} else {
+		info.SpentValue = (*hexutil.Big)(big.NewInt(0))
+	}
+	return info
+}
+
+// SessionKeyParams - the restrictions of a new session key. All fields are optional
+type SessionKeyParams struct {
+	Name      string               `json:"name"`
+	ExpiresAt uint64               `json:"expiresAt"` // unix timestamp in seconds
+	Targets   []GWSessionKeyTarget `json:"targets"`
+	ValueCap  *hexutil.Big         `json:"valueCap"`
+}
+
+// SessionKeyInfo - the details of a session key returned to the user
+type SessionKeyInfo struct {
+	Address    common.Address       `json:"address"`
+	Name       string               `json:"name"`
+	Active     bool                 `json:"active"`
+	ExpiresAt  uint64               `json:"expiresAt"`
+	Targets    []GWSessionKeyTarget `json:"targets"`
+	ValueCap   *hexutil.Big         `json:"valueCap"`
+	SpentValue *hexutil.Big         `json:"spentValue"`
+}
+
+// SessionKeyAddressParams - identifies a session key of the user. When missing, the only session key of the user is used
+type SessionKeyAddressParams struct {
+	Address *common.Address `json:"address"`
+}
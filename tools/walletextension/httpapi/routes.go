@@ -12,6 +12,7 @@ import (
 	"github.com/ten-protocol/go-ten/tools/walletextension/keymanager"
 	"github.com/ten-protocol/go-ten/tools/walletextension/services"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/status-im/keycard-go/hexutils"
 
 	"github.com/ten-protocol/go-ten/go/common/log"
@@ -86,6 +87,10 @@ func NewHTTPRoutes(walletExt *services.Services) []node.Route {
 			Name: common.APIVersion1 + common.PathSessionKeys + "delete",
 			Func: httpHandler(walletExt, deleteSKRequestHandler),
 		},
+		{
+			Name: common.APIVersion1 + common.PathSessionKeys + "revoke",
+			Func: httpHandler(walletExt, revokeSKRequestHandler),
+		},
 		{
 			Name: common.APIVersion1 + common.PathSessionKeys + "list",
 			Func: httpHandler(walletExt, listSKRequestHandler),
@@ -548,43 +553,67 @@ func getMessageRequestHandler(walletExt *services.Services, conn UserConn) {
 }
 
 func listSKRequestHandler(walletExt *services.Services, conn UserConn) {
+	withUser(walletExt, conn, func(user *common.GWUser, _ []byte) ([]byte, error) {
+		return json.Marshal(walletExt.SKManager.ListSessionKeys(user))
+	})
 }
 
+// the body of the request is an optional JSON object with the restrictions of the session key
 func createSKRequestHandler(walletExt *services.Services, conn UserConn) {
-	withUser(walletExt, conn, func(user *common.GWUser) ([]byte, error) {
-		sk, err := walletExt.SKManager.CreateSessionKey(user)
+	withUser(walletExt, conn, func(user *common.GWUser, body []byte) ([]byte, error) {
+		var params *common.SessionKeyParams
+		if len(body) > 0 {
+			params = new(common.SessionKeyParams)
+			if err := json.Unmarshal(body, params); err != nil {
+				return nil, fmt.Errorf("could not unmarshal session key params: %w", err)
+			}
+		}
+		sk, err := walletExt.SKManager.CreateSessionKey(user, params)
 		if err != nil {
-			handleError(conn, walletExt.Logger(), fmt.Errorf("could not create session key: %w", err))
-			return nil, err
+			return nil, fmt.Errorf("could not create session key: %w", err)
 		}
 		return []byte(hexutils.BytesToHex(sk.Account.Address.Bytes())), nil
 	})
 }
 
 func deleteSKRequestHandler(walletExt *services.Services, conn UserConn) {
-	withUser(walletExt, conn, func(user *common.GWUser) ([]byte, error) {
-		res, err := walletExt.SKManager.DeleteSessionKey(user)
-		return []byte{boolToByte(res)}, err
-	})
+	withSessionKeyAddress(walletExt, conn, walletExt.SKManager.DeleteSessionKey)
 }
 
 func activateSKRequestHandler(walletExt *services.Services, conn UserConn) {
-	withUser(walletExt, conn, func(user *common.GWUser) ([]byte, error) {
-		res, err := walletExt.SKManager.ActivateSessionKey(user)
-		return []byte{boolToByte(res)}, err
-	})
+	withSessionKeyAddress(walletExt, conn, walletExt.SKManager.ActivateSessionKey)
 }
 
 func deactivateSKRequestHandler(walletExt *services.Services, conn UserConn) {
-	withUser(walletExt, conn, func(user *common.GWUser) ([]byte, error) {
-		res, err := walletExt.SKManager.DeactivateSessionKey(user)
+	withSessionKeyAddress(walletExt, conn, walletExt.SKManager.DeactivateSessionKey)
+}
+
+func revokeSKRequestHandler(walletExt *services.Services, conn UserConn) {
+	withSessionKeyAddress(walletExt, conn, func(user *common.GWUser, address *gethcommon.Address) (bool, error) {
+		if address == nil {
+			return false, fmt.Errorf("the address of the session key is required")
+		}
+		return walletExt.SKManager.RevokeSessionKey(user, *address)
+	})
+}
+
+// reads the optional address of the session key from the JSON body of the request ({"address": "0x..."}), and writes the result of the action
+func withSessionKeyAddress(walletExt *services.Services, conn UserConn, action func(user *common.GWUser, address *gethcommon.Address) (bool, error)) {
+	withUser(walletExt, conn, func(user *common.GWUser, body []byte) ([]byte, error) {
+		var params common.SessionKeyAddressParams
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &params); err != nil {
+				return nil, fmt.Errorf("could not unmarshal session key address: %w", err)
+			}
+		}
+		res, err := action(user, params.Address)
 		return []byte{boolToByte(res)}, err
 	})
 }
 
 // extracts the user from the request, and writes the response to the connection
-func withUser(walletExt *services.Services, conn UserConn, withUser func(user *common.GWUser) ([]byte, error)) {
-	_, err := conn.ReadRequest()
+func withUser(walletExt *services.Services, conn UserConn, withUser func(user *common.GWUser, body []byte) ([]byte, error)) {
+	body, err := conn.ReadRequest()
 	if err != nil {
 		handleError(conn, walletExt.Logger(), fmt.Errorf("error reading request: %w", err))
 		return
@@ -603,7 +632,7 @@ func withUser(walletExt *services.Services, conn UserConn, withUser func(user *c
 		return
 	}
 
-	resp, err := withUser(user)
+	resp, err := withUser(user, body)
 	if err != nil {
 		handleError(conn, walletExt.Logger(), fmt.Errorf("could not process request: %w", err))
 		return
@@ -3,6 +3,7 @@ package main
 import (
 	"flag"
 	"fmt"
+	"strings"
 	"time"
 
 	wecommon "github.com/ten-protocol/go-ten/tools/walletextension/common"
@@ -23,7 +24,7 @@ const (
 
 	nodeHostName    = "nodeHost"
 	nodeHostDefault = "erpc.sepolia-testnet.ten.xyz"
-	nodeHostUsage   = "The host on which to connect to the Obscuro node. Default: `erpc.sepolia-testnet.ten.xyz`."
+	nodeHostUsage   = "The host on which to connect to the Obscuro node. A comma-separated list of hosts routes the requests to one healthy node and fails over to the others. Default: `erpc.sepolia-testnet.ten.xyz`."
 
 	nodeHTTPPortName    = "nodePortHTTP"
 	nodeHTTPPortDefault = 80
@@ -118,12 +119,22 @@ func parseCLIArgs() wecommon.Config {
 	encryptingCertificateEnabled := flag.Bool(encryptingCertificateEnabledFlagName, encryptingCertificateEnabledFlagDefault, encryptingCertificateEnabledFlagUsage)
 	flag.Parse()
 
+	var nodeRPCHTTPAddresses, nodeRPCWebsocketAddresses []string
+	for _, host := range strings.Split(*nodeHost, ",") {
+		host = strings.TrimSpace(host)
+		if host == "" {
+			continue
+		}
+		nodeRPCHTTPAddresses = append(nodeRPCHTTPAddresses, fmt.Sprintf("%s:%d", host, *nodeHTTPPort))
+		nodeRPCWebsocketAddresses = append(nodeRPCWebsocketAddresses, fmt.Sprintf("%s:%d", host, *nodeWebsocketPort))
+	}
+
 	return wecommon.Config{
 		WalletExtensionHost:            *walletExtensionHost,
 		WalletExtensionPortHTTP:        *walletExtensionPort,
 		WalletExtensionPortWS:          *walletExtensionPortWS,
-		NodeRPCHTTPAddress:             fmt.Sprintf("%s:%d", *nodeHost, *nodeHTTPPort),
-		NodeRPCWebsocketAddress:        fmt.Sprintf("%s:%d", *nodeHost, *nodeWebsocketPort),
+		NodeRPCHTTPAddresses:           nodeRPCHTTPAddresses,
+		NodeRPCWebsocketAddresses:      nodeRPCWebsocketAddresses,
 		LogPath:                        *logPath,
 		DBPathOverride:                 *databasePath,
 		VerboseFlag:                    *verboseFlag,
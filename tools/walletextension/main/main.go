@@ -28,21 +28,19 @@ func main() {
 	fmt.Printf("Welcome to the Obscuro wallet extension. \n\n")
 	fmt.Printf("Starting with following config: \n%s\n", string(jsonConfig))
 
-	// We wait thirty seconds for a connection to the node. If we cannot establish one, we exit the program.
-	fmt.Printf("Waiting up to thirty seconds for connection to host at %s...\n", config.NodeRPCWebsocketAddress)
+	// We wait thirty seconds for a connection to one of the nodes. If we cannot establish one, we exit the program.
+	// The gateway fails over to the other nodes once they become reachable.
+	fmt.Printf("Waiting up to thirty seconds for connection to hosts at %s...\n", config.NodeRPCWebsocketAddresses)
 	counter := 30
 	for {
-		conn, err := net.Dial(tcp, config.NodeRPCWebsocketAddress)
-		if conn != nil {
-			conn.Close()
-		}
+		err := dialAnyNode(config.NodeRPCWebsocketAddresses)
 		if err == nil {
 			break
 		}
 
 		counter--
 		if counter <= 0 {
-			fmt.Printf("Exiting. Could not establish connection to host at %s. Cause: %s\n", config.NodeRPCWebsocketAddress, err)
+			fmt.Printf("Exiting. Could not establish connection to hosts at %s. Cause: %s\n", config.NodeRPCWebsocketAddresses, err)
 			return
 		}
 		time.Sleep(time.Second)
@@ -76,3 +74,19 @@ func main() {
 
 	select {}
 }
+
+// dialAnyNode - returns nil as soon as one of the nodes accepts a connection
+func dialAnyNode(addrs []string) error {
+	err := fmt.Errorf("no node address was configured")
+	for _, addr := range addrs {
+		var conn net.Conn
+		conn, err = net.Dial(tcp, addr)
+		if conn != nil {
+			conn.Close()
+		}
+		if err == nil {
+			return nil
+		}
+	}
+	return err
+}
@@ -53,6 +53,10 @@ func (api *BlockChainAPI) BlockNumber() hexutil.Uint64 {
 }
 
 func (api *BlockChainAPI) GetBalance(ctx context.Context, address gethcommon.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Big, error) {
+	blockNrOrHash, err := resolveBlockTag(ctx, api.we, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
 	return ExecAuthRPC[hexutil.Big](
 		ctx,
 		api.we,
@@ -155,6 +159,10 @@ func (api *BlockChainAPI) GetBlockByHash(ctx context.Context, hash gethcommon.Ha
 
 func (api *BlockChainAPI) GetCode(ctx context.Context, address gethcommon.Address, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
 	// todo - must be authenticated
+	blockNrOrHash, err := resolveBlockTag(ctx, api.we, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
 	resp, err := UnauthenticatedTenRPCCall[hexutil.Bytes](
 		ctx,
 		api.we,
@@ -207,19 +215,50 @@ func (api *BlockChainAPI) GetStorageAt(ctx context.Context, address gethcommon.A
 		}
 		return serialised, nil
 	case common.CreateSessionKeyCQMethod:
-		sk, err := api.we.SKManager.CreateSessionKey(user)
+		var skParams *wecommon.SessionKeyParams
+		if !isEmptyCustomQueryParams(params) {
+			skParams = new(wecommon.SessionKeyParams)
+			if err := unmarshalCustomQueryParams(params, skParams); err != nil {
+				return nil, err
+			}
+		}
+		sk, err := api.we.SKManager.CreateSessionKey(user, skParams)
 		if err != nil {
 			return nil, fmt.Errorf("unable to create session key: %w", err)
 		}
 		return sk.Account.Address.Bytes(), nil
+	case common.ListSessionKeysCQMethod:
+		return json.Marshal(api.we.SKManager.ListSessionKeys(user))
 	case common.ActivateSessionKeyCQMethod:
-		res, err := api.we.SKManager.ActivateSessionKey(user)
+		skAddress, err := extractSessionKeyAddress(params)
+		if err != nil {
+			return nil, err
+		}
+		res, err := api.we.SKManager.ActivateSessionKey(user, skAddress)
 		return []byte{boolToByte(res)}, err
 	case common.DeactivateSessionKeyCQMethod:
-		res, err := api.we.SKManager.DeactivateSessionKey(user)
+		skAddress, err := extractSessionKeyAddress(params)
+		if err != nil {
+			return nil, err
+		}
+		res, err := api.we.SKManager.DeactivateSessionKey(user, skAddress)
 		return []byte{boolToByte(res)}, err
 	case common.DeleteSessionKeyCQMethod:
-		res, err := api.we.SKManager.DeleteSessionKey(user)
+		skAddress, err := extractSessionKeyAddress(params)
+		if err != nil {
+			return nil, err
+		}
+		res, err := api.we.SKManager.DeleteSessionKey(user, skAddress)
+		return []byte{boolToByte(res)}, err
+	case common.RevokeSessionKeyCQMethod:
+		skAddress, err := extractSessionKeyAddress(params)
+		if err != nil {
+			return nil, err
+		}
+		if skAddress == nil {
+			return nil, fmt.Errorf("params must contain an 'address' field")
+		}
+		res, err := api.we.SKManager.RevokeSessionKey(user, *skAddress)
 		return []byte{boolToByte(res)}, err
 	default: // address was not a recognised custom query method address
 		resp, err := ExecAuthRPC[any](ctx, api.we, &AuthExecCfg{tryUntilAuthorised: true}, tenrpc.ERPCGetStorageAt, address, params, nil)
@@ -271,6 +310,10 @@ type (
 )
 
 func (api *BlockChainAPI) Call(ctx context.Context, args gethapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, overrides *StateOverride, blockOverrides *BlockOverrides) (hexutil.Bytes, error) {
+	blockNrOrHash, err := resolveBlockTag(ctx, api.we, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
 	resp, err := ExecAuthRPC[hexutil.Bytes](ctx, api.we, &AuthExecCfg{
 		cacheCfg: &cache.Cfg{
 			DynamicType: func() cache.Strategy {
@@ -293,6 +336,13 @@ func (api *BlockChainAPI) Call(ctx context.Context, args gethapi.TransactionArgs
 }
 
 func (api *BlockChainAPI) EstimateGas(ctx context.Context, args gethapi.TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash, overrides *StateOverride) (hexutil.Uint64, error) {
+	if blockNrOrHash != nil {
+		resolved, err := resolveBlockTag(ctx, api.we, *blockNrOrHash)
+		if err != nil {
+			return 0, err
+		}
+		blockNrOrHash = &resolved
+	}
 	resp, err := ExecAuthRPC[hexutil.Uint64](ctx, api.we, &AuthExecCfg{
 		cacheCfg: &cache.Cfg{
 			DynamicType: func() cache.Strategy {
@@ -318,6 +368,23 @@ func (api *BlockChainAPI) EstimateGas(ctx context.Context, args gethapi.Transact
 	return *resp, err
 }
 
+// resolveBlockTag - the enclave does not know which batches are safe or finalized, so these tags are resolved to the
+// number of the batch by the node before the request is forwarded
+func resolveBlockTag(ctx context.Context, w *services.Services, blockNrOrHash rpc.BlockNumberOrHash) (rpc.BlockNumberOrHash, error) {
+	number, ok := blockNrOrHash.Number()
+	if !ok || (number != rpc.SafeBlockNumber && number != rpc.FinalizedBlockNumber) {
+		return blockNrOrHash, nil
+	}
+	batch, err := UnauthenticatedTenRPCCall[common.BatchHeader](ctx, w, &cache.Cfg{Type: cache.LatestBatch}, rpc2.GetBatchByNumber, number, false)
+	if err != nil {
+		return blockNrOrHash, err
+	}
+	if batch == nil {
+		return blockNrOrHash, fmt.Errorf("%s batch not found", number)
+	}
+	return rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(batch.Number.Int64())), nil
+}
+
 func populateFrom(acct *wecommon.GWAccount, args gethapi.TransactionArgs) gethapi.TransactionArgs {
 	// clone the args
 	argsClone := cloneArgs(args)
@@ -356,19 +423,9 @@ func extractCustomQueryAddress(params any) (*gethcommon.Address, error) {
 		return nil, fmt.Errorf("params must be a json string")
 	}
 	var paramsJSON map[string]json.RawMessage
-	err := json.Unmarshal([]byte(paramsStr), &paramsJSON)
+	err := unmarshalCustomQueryParams(paramsStr, &paramsJSON)
 	if err != nil {
-		// try to base64 decode the params string and then unmarshal before giving up
-		bytesStr, err64 := base64.StdEncoding.DecodeString(paramsStr)
-		if err64 != nil {
-			// was not base64 encoded, give up
-			return nil, fmt.Errorf("unable to unmarshal params string: %w", err)
-		}
-		// was base64 encoded, try to unmarshal
-		err = json.Unmarshal(bytesStr, &paramsJSON)
-		if err != nil {
-			return nil, fmt.Errorf("unable to unmarshal params string: %w", err)
-		}
+		return nil, err
 	}
 	// Extract the RawMessage for the key "address"
 	addressRaw, ok := paramsJSON["address"]
@@ -386,6 +443,55 @@ func extractCustomQueryAddress(params any) (*gethcommon.Address, error) {
 	return &address, nil
 }
 
+// extractSessionKeyAddress - returns the optional "address" of the session key the custom query is for
+func extractSessionKeyAddress(params string) (*gethcommon.Address, error) {
+	if isEmptyCustomQueryParams(params) {
+		return nil, nil
+	}
+	var skParams wecommon.SessionKeyAddressParams
+	if err := unmarshalCustomQueryParams(params, &skParams); err != nil {
+		return nil, err
+	}
+	return skParams.Address, nil
+}
+
+// unmarshalCustomQueryParams - decodes the params json, which can also be base64 encoded
+func unmarshalCustomQueryParams(params string, obj any) error {
+	err := json.Unmarshal([]byte(params), obj)
+	if err != nil {
+		// try to base64 decode the params string and then unmarshal before giving up
+		bytesStr, err64 := base64.StdEncoding.DecodeString(params)
+		if err64 != nil {
+			// was not base64 encoded, give up
+			return fmt.Errorf("unable to unmarshal params string: %w", err)
+		}
+		// was base64 encoded, try to unmarshal
+		err = json.Unmarshal(bytesStr, obj)
+		if err != nil {
+			return fmt.Errorf("unable to unmarshal params string: %w", err)
+		}
+	}
+	return nil
+}
+
+// isEmptyCustomQueryParams - returns true for the custom queries called without params, which clients send as an
+// empty string or as an empty storage slot
+func isEmptyCustomQueryParams(params string) bool {
+	if params == "" {
+		return true
+	}
+	decoded, err := hexutil.Decode(params)
+	if err != nil {
+		return false
+	}
+	for _, b := range decoded {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // RPCMarshalHeader converts the given header to the RPC output .
 // duplicated from go-ethereum
 func RPCMarshalHeader(head *types.Header) map[string]interface{} {
@@ -2,6 +2,7 @@ package rpcapi
 
 import (
 	"context"
+	"encoding/json"
 
 	tenrpc "github.com/ten-protocol/go-ten/go/common/rpc"
 
@@ -9,9 +10,13 @@ import (
 
 	"github.com/ten-protocol/go-ten/tools/walletextension/services"
 
+	wecommon "github.com/ten-protocol/go-ten/tools/walletextension/common"
+
 	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/go/common/gethapi"
+	"github.com/ten-protocol/go-ten/go/common/tracers"
 	"github.com/ten-protocol/go-ten/lib/gethfork/rpc"
 )
 
@@ -77,3 +82,82 @@ func (api *DebugAPI) EventLogRelevancy(ctx context.Context, crit common.FilterCr
 	}
 	return *l, nil
 }
+
+// TraceTransaction - returns the trace of a transaction, using the tracer specified in the config
+// Only the sender of the transaction is authorised to trace it.
+func (api *DebugAPI) TraceTransaction(ctx context.Context, hash gethcommon.Hash, config *tracers.TraceConfig) (json.RawMessage, error) {
+	trace, err := ExecAuthRPC[json.RawMessage](
+		ctx,
+		api.we,
+		&AuthExecCfg{
+			cacheCfg: &cache.Cfg{
+				Type: cache.NoCache,
+			},
+			tryUntilAuthorised: true,
+		},
+		tenrpc.ERPCDebugTraceTransaction,
+		hash,
+		config,
+	)
+	if err != nil || trace == nil {
+		return nil, err
+	}
+	return *trace, nil
+}
+
+// TraceCall - returns the trace of a call executed on top of the state of the requested batch
+// The "from" of the call must be one of the accounts of the user.
+func (api *DebugAPI) TraceCall(ctx context.Context, args gethapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, config *tracers.TraceCallConfig) (json.RawMessage, error) {
+	trace, err := ExecAuthRPC[json.RawMessage](
+		ctx,
+		api.we,
+		&AuthExecCfg{
+			cacheCfg: &cache.Cfg{
+				Type: cache.NoCache,
+			},
+			computeFromCallback: func(user *wecommon.GWUser) *gethcommon.Address {
+				return searchFromAndData(user.GetAllAddresses(), args)
+			},
+			adjustArgs: func(acct *wecommon.GWAccount) []any {
+				argsClone := populateFrom(acct, args)
+				return []any{argsClone, blockNrOrHash, config}
+			},
+			tryAll: true,
+		},
+		tenrpc.ERPCDebugTraceCall,
+		args,
+		blockNrOrHash,
+		config,
+	)
+	if err != nil || trace == nil {
+		return nil, err
+	}
+	return *trace, nil
+}
+
+// TraceBatchByNumber - returns the traces of the transactions from the batch which were sent by the user
+// The transactions of other users are not traced.
+func (api *DebugAPI) TraceBatchByNumber(ctx context.Context, number rpc.BlockNumber, config *tracers.TraceConfig) ([]*tracers.TxTraceResult, error) {
+	traces, err := ExecAuthRPC[[]*tracers.TxTraceResult](
+		ctx,
+		api.we,
+		&AuthExecCfg{
+			cacheCfg: &cache.Cfg{
+				Type: cache.NoCache,
+			},
+			tryUntilAuthorised: true,
+		},
+		tenrpc.ERPCDebugTraceBatchByNumber,
+		number,
+		config,
+	)
+	if err != nil || traces == nil {
+		return nil, err
+	}
+	return *traces, nil
+}
+
+// TraceBlockByNumber - alias of TraceBatchByNumber, for compatibility with the ethereum tooling
+func (api *DebugAPI) TraceBlockByNumber(ctx context.Context, number rpc.BlockNumber, config *tracers.TraceConfig) ([]*tracers.TxTraceResult, error) {
+	return api.TraceBatchByNumber(ctx, number, config)
+}
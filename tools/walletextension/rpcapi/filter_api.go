@@ -7,7 +7,9 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ten-protocol/go-ten/go/common/retry"
 	rpc2 "github.com/ten-protocol/go-ten/go/common/rpc"
+	enclaverpc "github.com/ten-protocol/go-ten/go/enclave/rpc"
 	tenrpc "github.com/ten-protocol/go-ten/go/rpc"
 
 	"github.com/ten-protocol/go-ten/tools/walletextension/cache"
@@ -29,15 +31,36 @@ import (
 	"github.com/ten-protocol/go-ten/lib/gethfork/rpc"
 )
 
+// how long the gateway tries to recreate the backend subscriptions of a subscription after a failover
+const resubscribeTimeout = 2 * time.Minute
+
 type FilterAPI struct {
-	we     *services.Services
-	logger log.Logger
+	we             *services.Services
+	logger         log.Logger
+	pollingFilters *pollingFilters
 }
 
 func NewFilterAPI(we *services.Services) *FilterAPI {
-	return &FilterAPI{
-		we:     we,
-		logger: we.Logger(),
+	api := &FilterAPI{
+		we:             we,
+		logger:         we.Logger(),
+		pollingFilters: newPollingFilters(we.Logger()),
+	}
+	we.NewHeadsService.AddListener(func(head *common.BatchHeader) {
+		api.pollingFilters.addBlock(head.Hash())
+	})
+	go api.expirePollingFilters()
+	return api
+}
+
+func (api *FilterAPI) expirePollingFilters() {
+	ticker := time.NewTicker(pollingFilterTimeout)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		if api.we.IsStopping() {
+			return
+		}
+		api.pollingFilters.expire(now)
 	}
 }
 
@@ -45,13 +68,62 @@ func (api *FilterAPI) NewPendingTransactionFilter(_ *bool) rpc.ID {
 	return "not supported"
 }
 
+// NewPendingTransactions - notifies the transactions of the accounts of the user that become executable in the mempool
+// The mempool is held by the sequencer, so the backend nodes that only validate the transactions never notify them
 func (api *FilterAPI) NewPendingTransactions(ctx context.Context, fullTx *bool) (*rpc.Subscription, error) {
-	return nil, fmt.Errorf("not supported")
+	subNotifier, user, err := getUserAndNotifier(ctx, api)
+	if err != nil {
+		return nil, err
+	}
+
+	subscribe := func(ctx context.Context) (*backendSubscriptions[*types.Transaction], error) {
+		return subscribeToBackend[*types.Transaction](ctx, api, user, user.GetAllAddresses(), tenrpc.SubscriptionTypePendingTransactions)
+	}
+	backend, err := subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dedupeBuffer := NewCircularBuffer(wecommon.DeduplicationBufferSize)
+	subscription := subNotifier.CreateSubscription()
+
+	unsubscribedByClient := atomic.Bool{}
+	go forwardFromBackend(api, user, backend, subscribe, func(tx *types.Transaction) error {
+		// a transaction becomes executable again when it is re-injected after a reorg
+		uniqueTxKey := LogKey{TxHash: tx.Hash()}
+		if dedupeBuffer.Contains(uniqueTxKey) {
+			return nil
+		}
+		dedupeBuffer.Push(uniqueTxKey)
+		if fullTx == nil || !*fullTx {
+			return subNotifier.Notify(subscription.ID, tx.Hash())
+		}
+		from, err := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+		if err != nil {
+			return fmt.Errorf("could not recover the sender of the pending transaction. Cause: %w", err)
+		}
+		return subNotifier.Notify(subscription.ID, enclaverpc.NewPendingRPCTransaction(tx, from))
+	}, &unsubscribedByClient)
+
+	// handles "unsubscribe" from the user. The backend subscriptions are released by the forwarding routine
+	go subscriptioncommon.HandleUnsubscribe(subscription, func() {
+		unsubscribedByClient.Store(true)
+	})
+
+	return subscription, nil
 }
 
-func (api *FilterAPI) NewBlockFilter() rpc.ID {
-	// not implemented
-	return ""
+// NewBlockFilter - creates a filter that accumulates the hashes of the new batches until it is polled
+func (api *FilterAPI) NewBlockFilter(ctx context.Context) (rpc.ID, error) {
+	user, err := extractUserForRequest(ctx, api.we)
+	if err != nil {
+		return "", err
+	}
+	return api.pollingFilters.install(&pollingFilter{
+		filterType: blocksPollingFilter,
+		userID:     user.ID,
+		hashes:     make([]gethcommon.Hash, 0),
+	})
 }
 
 func (api *FilterAPI) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
@@ -71,6 +143,87 @@ func (api *FilterAPI) Logs(ctx context.Context, crit common.FilterCriteria) (*rp
 		return nil, err
 	}
 
+	subscribe := func(ctx context.Context) (*backendSubscriptions[types.Log], error) {
+		return api.subscribeToBackendLogs(ctx, user, crit)
+	}
+	backend, err := subscribe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dedupeBuffer := NewCircularBuffer(wecommon.DeduplicationBufferSize)
+	subscription := subNotifier.CreateSubscription()
+
+	unsubscribedByClient := atomic.Bool{}
+	go forwardFromBackend(api, user, backend, subscribe, func(log types.Log) error {
+		uniqueLogKey := LogKey{
+			BlockHash: log.BlockHash,
+			TxHash:    log.TxHash,
+			Index:     log.Index,
+		}
+
+		if !dedupeBuffer.Contains(uniqueLogKey) {
+			dedupeBuffer.Push(uniqueLogKey)
+			return subNotifier.Notify(subscription.ID, log)
+		}
+		return nil
+	}, &unsubscribedByClient)
+
+	// handles "unsubscribe" from the user. The backend subscriptions are released by the forwarding routine
+	go subscriptioncommon.HandleUnsubscribe(subscription, func() {
+		unsubscribedByClient.Store(true)
+	})
+
+	return subscription, err
+}
+
+// backendSubscriptions - the subscriptions made to the backend node with the accounts of a user, which feed a
+// subscription or a log filter of the gateway
+type backendSubscriptions[T any] struct {
+	inputChannels []chan T
+	errorChannels []<-chan error
+	subscriptions []*rpc.ClientSubscription
+	connections   []*tenrpc.EncRPCClient
+}
+
+// forwardFromBackend - passes the items received from the backend to `onItem` until `stopped` is set, the subscription
+// times out or `onItem` fails. When a backend subscription fails (e.g. the node restarted), the backend subscriptions
+// are recreated with `subscribe` on a node selected by the backend pool, so that the client subscription survives the
+// failover. The items emitted while reconnecting are not delivered.
+// Must be called as a go routine!
+func forwardFromBackend[T any](api *FilterAPI, user *wecommon.GWUser, backend *backendSubscriptions[T], subscribe func(context.Context) (*backendSubscriptions[T], error), onItem func(T) error, stopped *atomic.Bool) {
+	for {
+		unsubscribedByBackend := atomic.Bool{}
+		// handles any of the backend connections being closed
+		go subscriptioncommon.HandleUnsubscribeErrChan(backend.errorChannels, func() {
+			unsubscribedByBackend.Store(true)
+		})
+		subscriptioncommon.ForwardFromChannels(backend.inputChannels, onItem, nil, &unsubscribedByBackend, stopped, 12*time.Hour, api.logger)
+		closeConnections(api, backend)
+
+		if stopped.Load() || !unsubscribedByBackend.Load() || api.we.IsStopping() {
+			return
+		}
+
+		api.logger.Info("Backend subscription failed. Resubscribing", "user", hexutils.BytesToHex(user.ID))
+		err := retry.Do(func() error {
+			if stopped.Load() || api.we.IsStopping() {
+				return retry.FailFast(fmt.Errorf("subscription stopped"))
+			}
+			var err error
+			backend, err = subscribe(context.Background())
+			return err
+		}, retry.NewTimeoutStrategy(resubscribeTimeout, time.Second))
+		if err != nil {
+			api.logger.Info("Could not resubscribe to the backend", "user", hexutils.BytesToHex(user.ID), "err", err)
+			return
+		}
+	}
+}
+
+// subscribeToBackendLogs - subscribes to the logs matching the criteria with the accounts of the user, so that the
+// backend only returns the logs visible to these accounts
+func (api *FilterAPI) subscribeToBackendLogs(ctx context.Context, user *wecommon.GWUser, crit common.FilterCriteria) (*backendSubscriptions[types.Log], error) {
 	// determine the accounts to use for the backend subscriptions
 	candidateAddresses := user.GetAllAddresses()
 	if len(candidateAddresses) > 1 {
@@ -80,79 +233,45 @@ func (api *FilterAPI) Logs(ctx context.Context, crit common.FilterCriteria) (*rp
 			candidateAddresses = user.GetAllAddresses()
 		}
 	}
+	return subscribeToBackend[types.Log](ctx, api, user, candidateAddresses, tenrpc.SubscriptionTypeLogs, crit)
+}
 
-	backendWSConnections := make([]*tenrpc.EncRPCClient, 0)
-	inputChannels := make([]chan types.Log, 0)
-	errorChannels := make([]<-chan error, 0)
-	backendSubscriptions := make([]*rpc.ClientSubscription, 0)
-	for _, address := range candidateAddresses {
+// subscribeToBackend - makes a subscription to the backend with each of the addresses
+func subscribeToBackend[T any](ctx context.Context, api *FilterAPI, user *wecommon.GWUser, addresses []gethcommon.Address, subscriptionArgs ...any) (*backendSubscriptions[T], error) {
+	backend := &backendSubscriptions[T]{
+		inputChannels: make([]chan T, 0),
+		errorChannels: make([]<-chan error, 0),
+		subscriptions: make([]*rpc.ClientSubscription, 0),
+		connections:   make([]*tenrpc.EncRPCClient, 0),
+	}
+	for _, address := range addresses {
 		rpcWSClient, err := api.we.BackendRPC.ConnectWS(ctx, user.AllAccounts()[address])
 		if err != nil {
+			closeConnections(api, backend)
 			return nil, err
 		}
-		backendWSConnections = append(backendWSConnections, rpcWSClient)
+		backend.connections = append(backend.connections, rpcWSClient)
 
-		inCh := make(chan types.Log)
-		backendSubscription, err := rpcWSClient.Subscribe(ctx, tenrpc.SubscribeNamespace, inCh, "logs", crit)
+		inCh := make(chan T)
+		backendSubscription, err := rpcWSClient.Subscribe(ctx, tenrpc.SubscribeNamespace, inCh, subscriptionArgs...)
 		if err != nil {
-			fmt.Printf("could not connect to backend %s", err)
+			api.logger.Info("could not subscribe to the backend", "err", err)
+			closeConnections(api, backend)
 			return nil, err
 		}
 
-		inputChannels = append(inputChannels, inCh)
-		errorChannels = append(errorChannels, backendSubscription.Err())
-		backendSubscriptions = append(backendSubscriptions, backendSubscription)
+		backend.inputChannels = append(backend.inputChannels, inCh)
+		backend.errorChannels = append(backend.errorChannels, backendSubscription.Err())
+		backend.subscriptions = append(backend.subscriptions, backendSubscription)
 	}
-
-	dedupeBuffer := NewCircularBuffer(wecommon.DeduplicationBufferSize)
-	subscription := subNotifier.CreateSubscription()
-
-	unsubscribedByClient := atomic.Bool{}
-	unsubscribedByBackend := atomic.Bool{}
-	go subscriptioncommon.ForwardFromChannels(
-		inputChannels,
-		func(log types.Log) error {
-			uniqueLogKey := LogKey{
-				BlockHash: log.BlockHash,
-				TxHash:    log.TxHash,
-				Index:     log.Index,
-			}
-
-			if !dedupeBuffer.Contains(uniqueLogKey) {
-				dedupeBuffer.Push(uniqueLogKey)
-				return subNotifier.Notify(subscription.ID, log)
-			}
-			return nil
-		},
-		func() {
-			// release resources
-			api.closeConnections(backendSubscriptions, backendWSConnections)
-		}, // todo - we can implement reconnect logic here
-		&unsubscribedByBackend,
-		&unsubscribedByClient,
-		12*time.Hour,
-		api.logger,
-	)
-
-	// handles any of the backend connections being closed
-	go subscriptioncommon.HandleUnsubscribeErrChan(errorChannels, func() {
-		unsubscribedByBackend.Store(true)
-	})
-
-	// handles "unsubscribe" from the user
-	go subscriptioncommon.HandleUnsubscribe(subscription, func() {
-		unsubscribedByClient.Store(true)
-		api.closeConnections(backendSubscriptions, backendWSConnections)
-	})
-
-	return subscription, err
+	return backend, nil
 }
 
-func (api *FilterAPI) closeConnections(backendSubscriptions []*rpc.ClientSubscription, backendWSConnections []*tenrpc.EncRPCClient) {
-	for _, backendSub := range backendSubscriptions {
+func closeConnections[T any](api *FilterAPI, backend *backendSubscriptions[T]) {
+	for _, backendSub := range backend.subscriptions {
 		backendSub.Unsubscribe()
 	}
-	for _, connection := range backendWSConnections {
+	for _, connection := range backend.connections {
 		_ = api.we.BackendRPC.ReturnConnWS(connection.BackingClient())
 	}
 }
@@ -189,8 +308,60 @@ func searchForAddressInFilterCriteria(filterCriteria common.FilterCriteria, poss
 	return result
 }
 
-func (api *FilterAPI) NewFilter(crit common.FilterCriteria) (rpc.ID, error) {
-	return rpc.NewID(), rpcNotImplemented
+// NewFilter - creates a filter that accumulates the logs matching the criteria until it is polled
+// The logs are received through backend subscriptions made with the accounts of the user, like the "logs" subscriptions
+func (api *FilterAPI) NewFilter(ctx context.Context, crit common.FilterCriteria) (rpc.ID, error) {
+	audit(api.we, "start NewFilter %v", crit)
+	user, err := extractUserForRequest(ctx, api.we)
+	if err != nil {
+		return "", err
+	}
+
+	// the backend subscriptions outlive the request that created the filter
+	backend, err := api.subscribeToBackendLogs(context.Background(), user, crit)
+	if err != nil {
+		return "", err
+	}
+
+	// the backend subscriptions are released by the forwarding routine once the filter is stopped
+	stopped := atomic.Bool{}
+	filter := &pollingFilter{
+		filterType: logsPollingFilter,
+		userID:     user.ID,
+		crit:       crit,
+		logs:       make([]*types.Log, 0),
+		unsubscribe: func() {
+			stopped.Store(true)
+		},
+	}
+	id, err := api.pollingFilters.install(filter)
+	if err != nil {
+		closeConnections(api, backend)
+		return "", err
+	}
+
+	dedupeBuffer := NewCircularBuffer(wecommon.DeduplicationBufferSize)
+	go func() {
+		forwardFromBackend(api, user, backend, func(ctx context.Context) (*backendSubscriptions[types.Log], error) {
+			return api.subscribeToBackendLogs(ctx, user, crit)
+		}, func(log types.Log) error {
+			uniqueLogKey := LogKey{
+				BlockHash: log.BlockHash,
+				TxHash:    log.TxHash,
+				Index:     log.Index,
+			}
+
+			if !dedupeBuffer.Contains(uniqueLogKey) {
+				dedupeBuffer.Push(uniqueLogKey)
+				api.pollingFilters.addLog(id, &log)
+			}
+			return nil
+		}, &stopped)
+		// the filter can't receive logs anymore, so it is removed and the client will recreate it
+		api.pollingFilters.uninstall(user.ID, id)
+	}()
+
+	return id, nil
 }
 
 func (api *FilterAPI) GetLogs(ctx context.Context, crit common.FilterCriteria) ([]*types.Log, error) {
@@ -271,22 +442,32 @@ func (api *FilterAPI) GetLogs(ctx context.Context, crit common.FilterCriteria) (
 	return *res, err
 }
 
-func (api *FilterAPI) UninstallFilter(id rpc.ID) bool {
-	// not implemented
-	return false
+func (api *FilterAPI) UninstallFilter(ctx context.Context, id rpc.ID) (bool, error) {
+	user, err := extractUserForRequest(ctx, api.we)
+	if err != nil {
+		return false, err
+	}
+	return api.pollingFilters.uninstall(user.ID, id), nil
 }
 
+// GetFilterLogs - returns all the logs matching the criteria of the filter
 func (api *FilterAPI) GetFilterLogs(ctx context.Context, id rpc.ID) ([]*types.Log, error) {
-	//txRec, err := ExecAuthRPC[[]*types.Log](ctx, api.we, "GetFilterLogs", AuthExecCfg{account: args.From}, id)
-	//if txRec != nil {
-	//	return *txRec, err
-	//}
-	//return common.Hash{}, err
-
-	// not implemented
-	return nil, nil
+	user, err := extractUserForRequest(ctx, api.we)
+	if err != nil {
+		return nil, err
+	}
+	crit, err := api.pollingFilters.criteria(user.ID, id)
+	if err != nil {
+		return nil, err
+	}
+	return api.GetLogs(ctx, crit)
 }
 
-func (api *FilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
-	return nil, rpcNotImplemented
+// GetFilterChanges - returns the logs or the batch hashes received since the last poll of the filter
+func (api *FilterAPI) GetFilterChanges(ctx context.Context, id rpc.ID) (interface{}, error) {
+	user, err := extractUserForRequest(ctx, api.we)
+	if err != nil {
+		return nil, err
+	}
+	return api.pollingFilters.changes(user.ID, id)
 }
@@ -0,0 +1,190 @@
+package rpcapi
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/ten-protocol/go-ten/go/common"
+	"github.com/ten-protocol/go-ten/lib/gethfork/rpc"
+)
+
+const (
+	// filters that are not polled for this long are uninstalled (same as geth)
+	pollingFilterTimeout = 5 * time.Minute
+	// the maximum number of polling filters installed by a user at the same time
+	maxPollingFiltersPerUser = 20
+	// filters that accumulate more items than this without being polled are uninstalled, to bound the memory used
+	maxPollingFilterItems = 10_000
+)
+
+// errFilterNotFound - same error as geth, so that clients recreate the filters that expired
+var errFilterNotFound = errors.New("filter not found")
+
+type pollingFilterType int
+
+const (
+	logsPollingFilter pollingFilterType = iota
+	blocksPollingFilter
+)
+
+// pollingFilter - a filter created with eth_newFilter or eth_newBlockFilter, which accumulates the logs or the block
+// hashes between polls
+type pollingFilter struct {
+	filterType  pollingFilterType
+	userID      []byte
+	crit        common.FilterCriteria
+	lastPolled  time.Time
+	logs        []*types.Log
+	hashes      []gethcommon.Hash
+	unsubscribe func() // releases the backend resources of the filter
+}
+
+// pollingFilters - the polling filters installed on this gateway instance. Each filter can only be used by the user
+// who created it. The filters are only kept in memory, so they are not shared with the other gateway instances and are
+// lost on restart. Load balancers must route the requests of a user to the same instance (see the README)
+type pollingFilters struct {
+	mu      sync.Mutex
+	filters map[rpc.ID]*pollingFilter
+	logger  gethlog.Logger
+}
+
+func newPollingFilters(logger gethlog.Logger) *pollingFilters {
+	return &pollingFilters{
+		filters: make(map[rpc.ID]*pollingFilter),
+		logger:  logger,
+	}
+}
+
+func (pf *pollingFilters) install(filter *pollingFilter) (rpc.ID, error) {
+	pf.mu.Lock()
+	defer pf.mu.Unlock()
+
+	userFilters := 0
+	for _, f := range pf.filters {
+		if bytes.Equal(f.userID, filter.userID) {
+			userFilters++
+		}
+	}
+	if userFilters >= maxPollingFiltersPerUser {
+		return "", fmt.Errorf("too many filters. Uninstall unused filters before creating new ones (max %d)", maxPollingFiltersPerUser)
+	}
+
+	id := rpc.NewID()
+	filter.lastPolled = time.Now()
+	pf.filters[id] = filter
+	return id, nil
+}
+
+// uninstall - removes the filter if it belongs to the user. Returns false if the filter was not found
+func (pf *pollingFilters) uninstall(userID []byte, id rpc.ID) bool {
+	pf.mu.Lock()
+	filter, found := pf.filters[id]
+	if !found || !bytes.Equal(filter.userID, userID) {
+		pf.mu.Unlock()
+		return false
+	}
+	delete(pf.filters, id)
+	pf.mu.Unlock()
+
+	if filter.unsubscribe != nil {
+		filter.unsubscribe()
+	}
+	return true
+}
+
+// criteria - returns the criteria of the log filter of the user
+func (pf *pollingFilters) criteria(userID []byte, id rpc.ID) (common.FilterCriteria, error) {
+	pf.mu.Lock()
+	defer pf.mu.Unlock()
+
+	filter, found := pf.filters[id]
+	if !found || !bytes.Equal(filter.userID, userID) || filter.filterType != logsPollingFilter {
+		return common.FilterCriteria{}, errFilterNotFound
+	}
+	filter.lastPolled = time.Now()
+	return filter.crit, nil
+}
+
+// changes - returns the logs or the block hashes accumulated since the last poll
+func (pf *pollingFilters) changes(userID []byte, id rpc.ID) (interface{}, error) {
+	pf.mu.Lock()
+	defer pf.mu.Unlock()
+
+	filter, found := pf.filters[id]
+	if !found || !bytes.Equal(filter.userID, userID) {
+		return nil, errFilterNotFound
+	}
+	filter.lastPolled = time.Now()
+
+	switch filter.filterType {
+	case logsPollingFilter:
+		logs := filter.logs
+		filter.logs = make([]*types.Log, 0)
+		return logs, nil
+	case blocksPollingFilter:
+		hashes := filter.hashes
+		filter.hashes = make([]gethcommon.Hash, 0)
+		return hashes, nil
+	default:
+		return nil, errFilterNotFound
+	}
+}
+
+func (pf *pollingFilters) addLog(id rpc.ID, log *types.Log) {
+	pf.mu.Lock()
+	filter, found := pf.filters[id]
+	if !found {
+		pf.mu.Unlock()
+		return
+	}
+	filter.logs = append(filter.logs, log)
+	overflow := len(filter.logs) > maxPollingFilterItems
+	pf.mu.Unlock()
+
+	if overflow {
+		pf.logger.Info("Uninstalling log filter that was not polled", "id", id)
+		pf.uninstall(filter.userID, id)
+	}
+}
+
+func (pf *pollingFilters) addBlock(hash gethcommon.Hash) {
+	overflowing := make(map[rpc.ID]*pollingFilter)
+	pf.mu.Lock()
+	for id, filter := range pf.filters {
+		if filter.filterType != blocksPollingFilter {
+			continue
+		}
+		filter.hashes = append(filter.hashes, hash)
+		if len(filter.hashes) > maxPollingFilterItems {
+			overflowing[id] = filter
+		}
+	}
+	pf.mu.Unlock()
+
+	for id, filter := range overflowing {
+		pf.logger.Info("Uninstalling block filter that was not polled", "id", id)
+		pf.uninstall(filter.userID, id)
+	}
+}
+
+// expire - uninstalls the filters that were not polled within the timeout
+func (pf *pollingFilters) expire(now time.Time) {
+	expired := make(map[rpc.ID]*pollingFilter)
+	pf.mu.Lock()
+	for id, filter := range pf.filters {
+		if now.Sub(filter.lastPolled) > pollingFilterTimeout {
+			expired[id] = filter
+		}
+	}
+	pf.mu.Unlock()
+
+	for id, filter := range expired {
+		pf.uninstall(filter.userID, id)
+	}
+}
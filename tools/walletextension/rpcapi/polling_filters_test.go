@@ -0,0 +1,77 @@
+package rpcapi
+
+import (
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPollingFiltersAreBoundToTheirUser(t *testing.T) {
+	filters := newPollingFilters(gethlog.New())
+	alice, bob := []byte("alice"), []byte("bob")
+
+	unsubscribed := false
+	logsID, err := filters.install(&pollingFilter{filterType: logsPollingFilter, userID: alice, logs: make([]*types.Log, 0), unsubscribe: func() { unsubscribed = true }})
+	require.NoError(t, err)
+	blocksID, err := filters.install(&pollingFilter{filterType: blocksPollingFilter, userID: alice, hashes: make([]gethcommon.Hash, 0)})
+	require.NoError(t, err)
+
+	filters.addLog(logsID, &types.Log{Index: 1})
+	filters.addBlock(gethcommon.HexToHash("0x1"))
+
+	// other users can't read or remove the filters
+	_, err = filters.changes(bob, logsID)
+	require.ErrorIs(t, err, errFilterNotFound)
+	require.False(t, filters.uninstall(bob, logsID))
+
+	changes, err := filters.changes(alice, logsID)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	changes, err = filters.changes(alice, logsID)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+	changes, err = filters.changes(alice, blocksID)
+	require.NoError(t, err)
+	require.Equal(t, []gethcommon.Hash{gethcommon.HexToHash("0x1")}, changes)
+
+	_, err = filters.criteria(alice, blocksID)
+	require.ErrorIs(t, err, errFilterNotFound)
+
+	require.True(t, filters.uninstall(alice, logsID))
+	require.True(t, unsubscribed)
+	_, err = filters.changes(alice, logsID)
+	require.ErrorIs(t, err, errFilterNotFound)
+}
+
+func TestPollingFiltersLimitsAndExpiry(t *testing.T) {
+	filters := newPollingFilters(gethlog.New())
+	user := []byte("user")
+
+	ids := make([]string, 0)
+	for i := 0; i < maxPollingFiltersPerUser; i++ {
+		id, err := filters.install(&pollingFilter{filterType: blocksPollingFilter, userID: user})
+		require.NoError(t, err)
+		ids = append(ids, string(id))
+	}
+	_, err := filters.install(&pollingFilter{filterType: blocksPollingFilter, userID: user})
+	require.Error(t, err)
+	_, err = filters.install(&pollingFilter{filterType: blocksPollingFilter, userID: []byte("other user")})
+	require.NoError(t, err)
+
+	// the filters that are not polled are removed
+	filters.expire(time.Now().Add(pollingFilterTimeout + time.Second))
+	require.Empty(t, filters.filters)
+
+	// the filters that accumulate too many items are removed
+	id, err := filters.install(&pollingFilter{filterType: logsPollingFilter, userID: user})
+	require.NoError(t, err)
+	for i := 0; i <= maxPollingFilterItems; i++ {
+		filters.addLog(id, &types.Log{})
+	}
+	_, err = filters.changes(user, id)
+	require.ErrorIs(t, err, errFilterNotFound)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ten-protocol/go-ten/tools/walletextension/common"
 	"github.com/ten-protocol/go-ten/tools/walletextension/services"
 )
 
@@ -16,42 +18,66 @@ func NewSessionKeyAPI(we *services.Services) *SessionKeyAPI {
 }
 
 // Create - returns hex-encoded checksum address of the newly created SK
-func (api *SessionKeyAPI) Create(ctx context.Context) (string, error) {
+// The params are optional, and restrict what the SK can sign
+func (api *SessionKeyAPI) Create(ctx context.Context, params *common.SessionKeyParams) (string, error) {
 	user, err := extractUserForRequest(ctx, api.we)
 	if err != nil {
 		return "", err
 	}
 
-	sk, err := api.we.SKManager.CreateSessionKey(user)
+	sk, err := api.we.SKManager.CreateSessionKey(user, params)
 	if err != nil {
 		return "", fmt.Errorf("unable to create session key: %w", err)
 	}
 	return (*sk.Account.Address).Hex(), nil
 }
 
-func (api *SessionKeyAPI) Activate(ctx context.Context) (bool, error) {
+// List - returns the session keys of the user, without their private keys
+func (api *SessionKeyAPI) List(ctx context.Context) ([]common.SessionKeyInfo, error) {
+	user, err := extractUserForRequest(ctx, api.we)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.we.SKManager.ListSessionKeys(user), nil
+}
+
+// Activate - the address is optional when the user has a single SK
+func (api *SessionKeyAPI) Activate(ctx context.Context, address *gethcommon.Address) (bool, error) {
+	user, err := extractUserForRequest(ctx, api.we)
+	if err != nil {
+		return false, err
+	}
+
+	return api.we.SKManager.ActivateSessionKey(user, address)
+}
+
+// Deactivate - the address is optional when the user has a single SK
+func (api *SessionKeyAPI) Deactivate(ctx context.Context, address *gethcommon.Address) (bool, error) {
 	user, err := extractUserForRequest(ctx, api.we)
 	if err != nil {
 		return false, err
 	}
 
-	return api.we.SKManager.ActivateSessionKey(user)
+	return api.we.SKManager.DeactivateSessionKey(user, address)
 }
 
-func (api *SessionKeyAPI) Deactivate(ctx context.Context) (bool, error) {
+// Delete - the address is optional when the user has a single SK
+func (api *SessionKeyAPI) Delete(ctx context.Context, address *gethcommon.Address) (bool, error) {
 	user, err := extractUserForRequest(ctx, api.we)
 	if err != nil {
 		return false, err
 	}
 
-	return api.we.SKManager.DeactivateSessionKey(user)
+	return api.we.SKManager.DeleteSessionKey(user, address)
 }
 
-func (api *SessionKeyAPI) Delete(ctx context.Context) (bool, error) {
+// Revoke - deletes the SK immediately, even if it is active
+func (api *SessionKeyAPI) Revoke(ctx context.Context, address gethcommon.Address) (bool, error) {
 	user, err := extractUserForRequest(ctx, api.we)
 	if err != nil {
 		return false, err
 	}
 
-	return api.we.SKManager.DeleteSessionKey(user)
+	return api.we.SKManager.RevokeSessionKey(user, address)
 }
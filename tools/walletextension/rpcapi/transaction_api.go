@@ -2,7 +2,6 @@ package rpcapi
 
 import (
 	"context"
-	"fmt"
 
 	tenrpc "github.com/ten-protocol/go-ten/go/common/rpc"
 
@@ -65,6 +64,10 @@ func (s *TransactionAPI) GetRawTransactionByBlockHashAndIndex(ctx context.Contex
 }
 
 func (s *TransactionAPI) GetTransactionCount(ctx context.Context, address common.Address, blockNrOrHash gethrpc.BlockNumberOrHash) (*hexutil.Uint64, error) {
+	blockNrOrHash, err := resolveBlockTag(ctx, s.we, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
 	return ExecAuthRPC[hexutil.Uint64](
 		ctx,
 		s.we,
@@ -110,12 +113,16 @@ func (s *TransactionAPI) SendTransaction(ctx context.Context, args gethapi.Trans
 	if err != nil {
 		return common.Hash{}, err
 	}
-	if !user.ActiveSK {
-		return common.Hash{}, fmt.Errorf("please activate session key")
-	}
 
-	// when there is an active Session Key, sign all incoming transactions with that SK
-	signedTx, err := s.we.SKManager.SignTx(ctx, user, args.ToTransaction())
+	// sign the transaction with the Session Key in the "from" field, or with the only active SK of the user when
+	// "from" is not one of their SKs
+	var skAddress *common.Address
+	if args.From != nil {
+		if _, found := user.SessionKeys[*args.From]; found {
+			skAddress = args.From
+		}
+	}
+	signedTx, err := s.we.SKManager.SignTx(ctx, user, skAddress, args.ToTransaction())
 	if err != nil {
 		return common.Hash{}, err
 	}
@@ -145,12 +152,13 @@ func (s *TransactionAPI) SendRawTransaction(ctx context.Context, input hexutil.B
 
 	signedTxBlob := input
 	// when there is an active Session Key, sign all incoming transactions with that SK
-	if user.ActiveSK && user.SessionKey != nil {
+	// raw transactions don't identify the SK, so users with multiple active SKs must use eth_sendTransaction
+	if len(user.ActiveSessionKeys()) > 0 {
 		tx := new(types.Transaction)
 		if err = tx.UnmarshalBinary(input); err != nil {
 			return common.Hash{}, err
 		}
-		signedTx, err := s.we.SKManager.SignTx(ctx, user, tx)
+		signedTx, err := s.we.SKManager.SignTx(ctx, user, nil, tx)
 		if err != nil {
 			return common.Hash{}, err
 		}
@@ -1,12 +1,24 @@
 package rpcapi
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	tenrpc "github.com/ten-protocol/go-ten/go/common/rpc"
 	rpc2 "github.com/ten-protocol/go-ten/go/enclave/rpc"
+	encrpc "github.com/ten-protocol/go-ten/go/rpc"
+	wecommon "github.com/ten-protocol/go-ten/tools/walletextension/common"
 	"github.com/ten-protocol/go-ten/tools/walletextension/services"
 )
 
+const (
+	txPoolPending = "pending"
+	txPoolQueued  = "queued"
+)
+
+// TxPoolAPI - the txpool namespace, scoped to the accounts of the user. Other users' transactions are never returned
 type TxPoolAPI struct {
 	we *services.Services
 }
@@ -15,22 +27,135 @@ func NewTxPoolAPI(we *services.Services) *TxPoolAPI {
 	return &TxPoolAPI{we}
 }
 
-func (s *TxPoolAPI) Content() map[string]map[string]map[string]*rpc2.RpcTransaction {
-	// not implemented
-	return nil
+// Content - returns the pending and queued transactions of the accounts of the user, grouped by account and nonce
+func (s *TxPoolAPI) Content(ctx context.Context) (map[string]map[string]map[string]*rpc2.RpcTransaction, error) {
+	user, err := extractUserForRequest(ctx, s.we)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimitAllowed, requestUUID := s.we.RateLimiter.Allow(common.Address(user.ID))
+	defer s.we.RateLimiter.SetRequestEnd(common.Address(user.ID), requestUUID)
+	if !rateLimitAllowed {
+		return nil, fmt.Errorf("rate limit exceeded")
+	}
+
+	return userPoolContent(user, func(acct *wecommon.GWAccount) (*rpc2.PoolTransactions, error) {
+		return fetchPoolTransactions(ctx, s.we, acct)
+	})
+}
+
+// userPoolContent - groups the pool transactions of each account of the user. Only the accounts of the user are queried
+func userPoolContent(user *wecommon.GWUser, fetch func(*wecommon.GWAccount) (*rpc2.PoolTransactions, error)) (map[string]map[string]map[string]*rpc2.RpcTransaction, error) {
+	content := map[string]map[string]map[string]*rpc2.RpcTransaction{
+		txPoolPending: make(map[string]map[string]*rpc2.RpcTransaction),
+		txPoolQueued:  make(map[string]map[string]*rpc2.RpcTransaction),
+	}
+	for addr, acct := range user.AllAccounts() {
+		poolTxs, err := fetch(acct)
+		if err != nil {
+			return nil, err
+		}
+		if len(poolTxs.Pending) > 0 {
+			content[txPoolPending][addr.Hex()] = poolTxs.Pending
+		}
+		if len(poolTxs.Queued) > 0 {
+			content[txPoolQueued][addr.Hex()] = poolTxs.Queued
+		}
+	}
+	return content, nil
+}
+
+// ContentFrom - returns the pending and queued transactions of one of the accounts of the user
+func (s *TxPoolAPI) ContentFrom(ctx context.Context, addr common.Address) (map[string]map[string]*rpc2.RpcTransaction, error) {
+	user, err := extractUserForRequest(ctx, s.we)
+	if err != nil {
+		return nil, err
+	}
+	acct, found := user.AllAccounts()[addr]
+	if !found {
+		return nil, fmt.Errorf(notAuthorised)
+	}
+
+	rateLimitAllowed, requestUUID := s.we.RateLimiter.Allow(common.Address(user.ID))
+	defer s.we.RateLimiter.SetRequestEnd(common.Address(user.ID), requestUUID)
+	if !rateLimitAllowed {
+		return nil, fmt.Errorf("rate limit exceeded")
+	}
+
+	poolTxs, err := fetchPoolTransactions(ctx, s.we, acct)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]map[string]*rpc2.RpcTransaction{
+		txPoolPending: poolTxs.Pending,
+		txPoolQueued:  poolTxs.Queued,
+	}, nil
 }
 
-func (s *TxPoolAPI) ContentFrom(_ common.Address) map[string]map[string]*rpc2.RpcTransaction {
-	// not implemented
-	return nil
+// Status - returns the number of pending and queued transactions of the accounts of the user
+func (s *TxPoolAPI) Status(ctx context.Context) (map[string]hexutil.Uint, error) {
+	content, err := s.Content(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return poolStatus(content), nil
 }
 
-func (s *TxPoolAPI) Status() map[string]hexutil.Uint {
-	// not implemented
-	return nil
+func poolStatus(content map[string]map[string]map[string]*rpc2.RpcTransaction) map[string]hexutil.Uint {
+	status := make(map[string]hexutil.Uint)
+	for kind, txsPerAccount := range content {
+		count := 0
+		for _, txs := range txsPerAccount {
+			count += len(txs)
+		}
+		status[kind] = hexutil.Uint(count)
+	}
+	return status
 }
 
-func (s *TxPoolAPI) Inspect() map[string]map[string]map[string]string {
-	// not implemented
-	return nil
+// Inspect - returns a summary of the pending and queued transactions of the accounts of the user
+func (s *TxPoolAPI) Inspect(ctx context.Context) (map[string]map[string]map[string]string, error) {
+	content, err := s.Content(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return poolInspect(content), nil
+}
+
+func poolInspect(content map[string]map[string]map[string]*rpc2.RpcTransaction) map[string]map[string]map[string]string {
+	inspect := make(map[string]map[string]map[string]string)
+	for kind, txsPerAccount := range content {
+		inspect[kind] = make(map[string]map[string]string)
+		for addr, txs := range txsPerAccount {
+			inspect[kind][addr] = make(map[string]string)
+			for nonce, tx := range txs {
+				inspect[kind][addr][nonce] = inspectTx(tx)
+			}
+		}
+	}
+	return inspect
+}
+
+// inspectTx - same format as geth's txpool_inspect
+func inspectTx(tx *rpc2.RpcTransaction) string {
+	if tx.To != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+	}
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+}
+
+// fetchPoolTransactions - the mempool content changes constantly, so it is never cached
+func fetchPoolTransactions(ctx context.Context, we *services.Services, acct *wecommon.GWAccount) (*rpc2.PoolTransactions, error) {
+	return services.WithEncRPCConnection(ctx, we.BackendRPC, acct, func(rpcClient *encrpc.EncRPCClient) (*rpc2.PoolTransactions, error) {
+		timeoutContext, cancelCtx := context.WithTimeout(ctx, maximumRPCCallDuration)
+		defer cancelCtx()
+
+		var result rpc2.PoolTransactions
+		err := rpcClient.CallContext(timeoutContext, &result, tenrpc.ERPCGetPoolTransactions, acct.Address.Hex())
+		if err != nil {
+			return nil, fmt.Errorf("could not read the pool transactions. Cause: %w", err)
+		}
+		return &result, nil
+	})
 }
@@ -0,0 +1,52 @@
+package rpcapi
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	rpc2 "github.com/ten-protocol/go-ten/go/enclave/rpc"
+	wecommon "github.com/ten-protocol/go-ten/tools/walletextension/common"
+)
+
+func TestTxPoolContentOnlyQueriesTheAccountsOfTheUser(t *testing.T) {
+	alice, sessionKey, bob := common.HexToAddress("0xa"), common.HexToAddress("0xb"), common.HexToAddress("0xc")
+	to := common.HexToAddress("0xd")
+	newTx := func(nonce uint64, from common.Address) *rpc2.RpcTransaction {
+		return rpc2.NewPendingRPCTransaction(types.NewTx(&types.LegacyTx{Nonce: nonce, To: &to, Gas: 21000, GasPrice: big.NewInt(2), Value: big.NewInt(1)}), from)
+	}
+	// the transactions held by the node, which only returns them to their sender
+	node := map[common.Address]*rpc2.PoolTransactions{
+		alice:      {Pending: map[string]*rpc2.RpcTransaction{"0": newTx(0, alice), "1": newTx(1, alice)}, Queued: map[string]*rpc2.RpcTransaction{"5": newTx(5, alice)}},
+		sessionKey: {Pending: map[string]*rpc2.RpcTransaction{"0": newTx(0, sessionKey)}, Queued: map[string]*rpc2.RpcTransaction{}},
+		bob:        {Pending: map[string]*rpc2.RpcTransaction{"0": newTx(0, bob)}, Queued: map[string]*rpc2.RpcTransaction{"2": newTx(2, bob)}},
+	}
+	user := &wecommon.GWUser{
+		Accounts:    map[common.Address]*wecommon.GWAccount{alice: {Address: &alice}},
+		SessionKeys: map[common.Address]*wecommon.GWSessionKey{sessionKey: {Account: &wecommon.GWAccount{Address: &sessionKey}}},
+	}
+
+	queried := make(map[common.Address]bool)
+	content, err := userPoolContent(user, func(acct *wecommon.GWAccount) (*rpc2.PoolTransactions, error) {
+		queried[*acct.Address] = true
+		return node[*acct.Address], nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[common.Address]bool{alice: true, sessionKey: true}, queried)
+
+	require.Len(t, content[txPoolPending], 2)
+	require.Equal(t, node[alice].Pending, content[txPoolPending][alice.Hex()])
+	require.Equal(t, node[sessionKey].Pending, content[txPoolPending][sessionKey.Hex()])
+	// the accounts without queued transactions are omitted
+	require.Len(t, content[txPoolQueued], 1)
+	require.Equal(t, node[alice].Queued, content[txPoolQueued][alice.Hex()])
+
+	require.Equal(t, map[string]hexutil.Uint{txPoolPending: 3, txPoolQueued: 1}, poolStatus(content))
+
+	inspect := poolInspect(content)
+	require.NotContains(t, inspect[txPoolPending], bob.Hex())
+	require.Equal(t, to.Hex()+": 1 wei + 21000 gas × 2 wei", inspect[txPoolQueued][alice.Hex()]["5"])
+}
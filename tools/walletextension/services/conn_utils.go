@@ -1,51 +1,115 @@
 package services
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	pool "github.com/jolestar/go-commons-pool/v2"
+	hostcommon "github.com/ten-protocol/go-ten/go/common/host"
 	"github.com/ten-protocol/go-ten/go/common/log"
 	"github.com/ten-protocol/go-ten/go/common/measure"
 	"github.com/ten-protocol/go-ten/go/enclave/core"
 	tenrpc "github.com/ten-protocol/go-ten/go/rpc"
-	"github.com/ten-protocol/go-ten/lib/gethfork/rpc"
 	gethrpc "github.com/ten-protocol/go-ten/lib/gethfork/rpc"
 	wecommon "github.com/ten-protocol/go-ten/tools/walletextension/common"
 )
 
-type BackendRPC struct {
-	// the OG maintains a connection pool of rpc connections to underlying nodes
+const (
+	// todo - tweak the number of backend connections
+	poolSize = 200
+	// the interval at which the health and the head batch of the backend nodes are checked
+	healthCheckInterval = 5 * time.Second
+	// the maximum duration of the calls made to check the health of a backend node
+	healthCheckTimeout = 3 * time.Second
+	// the active node is abandoned when its head batch is further behind the most advanced healthy node
+	maxBatchLag = 10
+)
+
+// backendNode - a TEN node the gateway connects to, with its own connection pools
+type backendNode struct {
+	addrHTTP        string
 	rpcHTTPConnPool *pool.ObjectPool
 	rpcWSConnPool   *pool.ObjectPool
-	encKey          []byte
-	logger          gethlog.Logger
+	healthy         atomic.Bool
+	height          atomic.Uint64
+	// the enclave key of the node, which is read again when the shared secret is rotated
+	encKey atomic.Pointer[[]byte]
 }
 
-// todo - tweak the number of backend connections
-const poolSize = 200
+func (node *backendNode) connPool(ws bool) *pool.ObjectPool {
+	if ws {
+		return node.rpcWSConnPool
+	}
+	return node.rpcHTTPConnPool
+}
 
-func NewBackendRPC(hostAddrHTTP string, hostAddrWS string, logger gethlog.Logger) *BackendRPC {
-	factoryHTTP := pool.NewPooledObjectFactory(
-		func(context.Context) (interface{}, error) {
-			rpcClient, err := gethrpc.Dial(hostAddrHTTP)
-			if err != nil {
-				return nil, fmt.Errorf("could not create RPC client on %s. Cause: %w", hostAddrHTTP, err)
-			}
-			return rpcClient, nil
-		}, func(ctx context.Context, object *pool.PooledObject) error {
-			client := object.Object.(*gethrpc.Client)
-			client.Close()
-			return nil
-		}, nil, nil, nil)
+// borrowedConn - the node a connection was borrowed from, so that it is returned to the pool of that node
+type borrowedConn struct {
+	node *backendNode
+	ws   bool
+	// set when the connection was closed because the gateway moved away from its node
+	closed atomic.Bool
+}
+
+// BackendRPC - maintains connection pools to the backend nodes. All the requests are routed to the active node while it
+// is healthy and up-to-date, so that the users read their own writes, and are retried on the other nodes when the active
+// node can't be reached. All the nodes must belong to the same TEN network
+type BackendRPC struct {
+	nodes  []*backendNode
+	active atomic.Pointer[backendNode]
+	// the connections borrowed from the pools of the nodes
+	borrowed sync.Map
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	logger   gethlog.Logger
+}
+
+func NewBackendRPC(hostAddrsHTTP []string, hostAddrsWS []string, logger gethlog.Logger) *BackendRPC {
+	if len(hostAddrsHTTP) == 0 || len(hostAddrsHTTP) != len(hostAddrsWS) {
+		logger.Crit("each backend node requires an HTTP and a WS address", "http", hostAddrsHTTP, "ws", hostAddrsWS)
+	}
+
+	cfg := pool.NewDefaultPoolConfig()
+	cfg.MaxTotal = poolSize
+
+	backend := &BackendRPC{
+		nodes:  make([]*backendNode, len(hostAddrsHTTP)),
+		stopCh: make(chan struct{}),
+		logger: logger,
+	}
+	for i := range hostAddrsHTTP {
+		node := &backendNode{
+			addrHTTP:        hostAddrsHTTP[i],
+			rpcHTTPConnPool: pool.NewObjectPool(context.Background(), newConnFactory(hostAddrsHTTP[i]), cfg),
+			rpcWSConnPool:   pool.NewObjectPool(context.Background(), newConnFactory(hostAddrsWS[i]), cfg),
+		}
+		// nodes are assumed healthy until the first check
+		node.healthy.Store(true)
+		backend.nodes[i] = node
+	}
+
+	backend.checkNodes()
+	go backend.monitorNodes()
+	return backend
+}
 
-	factoryWS := pool.NewPooledObjectFactory(
+func newConnFactory(hostAddr string) pool.PooledObjectFactory {
+	return pool.NewPooledObjectFactory(
 		func(context.Context) (interface{}, error) {
-			rpcClient, err := gethrpc.Dial(hostAddrWS)
+			rpcClient, err := gethrpc.Dial(hostAddr)
 			if err != nil {
-				return nil, fmt.Errorf("could not create RPC client on %s. Cause: %w", hostAddrWS, err)
+				return nil, fmt.Errorf("could not create RPC client on %s. Cause: %w", hostAddr, err)
 			}
 			return rpcClient, nil
 		}, func(ctx context.Context, object *pool.PooledObject) error {
@@ -53,115 +117,344 @@ func NewBackendRPC(hostAddrHTTP string, hostAddrWS string, logger gethlog.Logger
 			client.Close()
 			return nil
 		}, nil, nil, nil)
+}
 
-	cfg := pool.NewDefaultPoolConfig()
-	cfg.MaxTotal = poolSize
+func (rpc *BackendRPC) monitorNodes() {
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			rpc.checkNodes()
+		case <-rpc.stopCh:
+			return
+		}
+	}
+}
 
-	return &BackendRPC{
-		rpcHTTPConnPool: pool.NewObjectPool(context.Background(), factoryHTTP, cfg),
-		rpcWSConnPool:   pool.NewObjectPool(context.Background(), factoryWS, cfg),
-		encKey:          readEncKey(hostAddrHTTP, logger),
-		logger:          logger,
+// checkNodes - updates the health and the height of the nodes, and reads their enclave key, which changes when the
+// shared secret is rotated
+func (rpc *BackendRPC) checkNodes() {
+	var wg sync.WaitGroup
+	for _, node := range rpc.nodes {
+		wg.Add(1)
+		go func(node *backendNode) {
+			defer wg.Done()
+			rpc.checkNode(node)
+		}(node)
 	}
+	wg.Wait()
 }
 
-func readEncKey(hostAddrHTTP string, logger gethlog.Logger) []byte {
-	// read the encryption key
-	rpcClient, err := gethrpc.Dial(hostAddrHTTP)
+func (rpc *BackendRPC) checkNode(node *backendNode) {
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+
+	err := func() error {
+		client, err := borrowConn(ctx, node.rpcHTTPConnPool)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = node.rpcHTTPConnPool.ReturnObject(context.Background(), client)
+		}()
+
+		var health hostcommon.HealthCheck
+		if err := client.CallContext(ctx, &health, tenrpc.Health); err != nil {
+			return fmt.Errorf("could not read the health. Cause: %w", err)
+		}
+		if !health.OverallHealth {
+			return fmt.Errorf("node reported unhealthy: %v", health.Errors)
+		}
+		var height hexutil.Uint64
+		if err := client.CallContext(ctx, &height, tenrpc.BatchNumber); err != nil {
+			return fmt.Errorf("could not read the head batch. Cause: %w", err)
+		}
+		node.height.Store(uint64(height))
+
+		_, err = rpc.readEncKey(node, client)
+		return err
+	}()
+
 	if err != nil {
-		logger.Crit("failed to connect to the node", "err", err)
-		return nil
+		rpc.markUnavailable(node, err)
+		return
 	}
-	defer rpcClient.Close()
-	n := 0
-	for {
-		n++
-		k, err := tenrpc.ReadEnclaveKey(rpcClient)
+	if !node.healthy.Swap(true) {
+		rpc.logger.Info("Backend node is available again", "node", node.addrHTTP)
+	}
+}
+
+// readEncKey - reads the enclave key of the node. The enclaves still accept the key of the previous epoch, so the requests
+// encrypted with it while the key is refreshed are not rejected
+func (rpc *BackendRPC) readEncKey(node *backendNode, client *gethrpc.Client) ([]byte, error) {
+	key, err := tenrpc.ReadEnclaveKey(client)
+	if err != nil {
+		return nil, fmt.Errorf("could not read the enclave key. Cause: %w", err)
+	}
+	rpc.storeEncKey(node, key)
+	return key, nil
+}
+
+func (rpc *BackendRPC) storeEncKey(node *backendNode, key []byte) {
+	previous := node.encKey.Swap(&key)
+	if previous != nil && !bytes.Equal(*previous, key) {
+		rpc.logger.Info("The enclave key was rotated", "node", node.addrHTTP)
+	}
+}
+
+// markUnavailable - the node is not used until its health check succeeds again
+func (rpc *BackendRPC) markUnavailable(node *backendNode, err error) {
+	if node.healthy.Swap(false) {
+		rpc.logger.Warn("Backend node is unavailable", "node", node.addrHTTP, log.ErrKey, err)
+		// the idle connections are likely broken (e.g. the node restarted), so they are not reused
+		node.rpcHTTPConnPool.Clear(context.Background())
+		node.rpcWSConnPool.Clear(context.Background())
+		rpc.closeWSConns(node)
+	}
+}
+
+// activate - routes the next requests to the node. The subscriptions made on the previous active node are closed, so
+// that they are made again on the new one
+func (rpc *BackendRPC) activate(node *backendNode) {
+	previous := rpc.active.Swap(node)
+	if previous != nil && previous != node {
+		rpc.logger.Info("Switched the active backend node", "from", previous.addrHTTP, "to", node.addrHTTP)
+		rpc.closeWSConns(previous)
+	}
+}
+
+// closeWSConns - closes the websocket connections borrowed from the pool of the node, which ends their subscriptions.
+// The gateway subscriptions then subscribe again through the active node
+func (rpc *BackendRPC) closeWSConns(node *backendNode) {
+	rpc.borrowed.Range(func(conn, value any) bool {
+		b := value.(*borrowedConn)
+		if b.node == node && b.ws && !b.closed.Swap(true) {
+			conn.(*gethrpc.Client).Close()
+		}
+		return true
+	})
+}
+
+// selectNodes - returns the nodes in the order in which they should be used. The active node is used first while it is
+// healthy and not lagging, followed by the healthy nodes with the most advanced head batch, and the unhealthy nodes as a
+// last resort
+func selectNodes(nodes []*backendNode, active *backendNode) []*backendNode {
+	var maxHeight uint64
+	for _, node := range nodes {
+		if node.healthy.Load() && node.height.Load() > maxHeight {
+			maxHeight = node.height.Load()
+		}
+	}
+
+	result := make([]*backendNode, 0, len(nodes))
+	if active != nil && active.healthy.Load() && active.height.Load()+maxBatchLag >= maxHeight {
+		result = append(result, active)
+	}
+	others := make([]*backendNode, 0, len(nodes))
+	for _, node := range nodes {
+		if len(result) == 0 || node != result[0] {
+			others = append(others, node)
+		}
+	}
+	sort.SliceStable(others, func(i, j int) bool {
+		if others[i].healthy.Load() != others[j].healthy.Load() {
+			return others[i].healthy.Load()
+		}
+		return others[i].height.Load() > others[j].height.Load()
+	})
+	return append(result, others...)
+}
+
+// isConnectionError - whether the call failed because the node could not be reached. Such calls are retried on another
+// node. The errors returned by the node and the timeouts are not, because the request might have been processed
+func isConnectionError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var rpcErr gethrpc.Error
+	if errors.As(err, &rpcErr) {
+		return false
+	}
+	var httpErr gethrpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= http.StatusInternalServerError
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return !netErr.Timeout()
+	}
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, gethrpc.ErrClientQuit)
+}
+
+// borrow - borrows a connection from the pool of the first node that can be reached, and makes it the active node
+func (rpc *BackendRPC) borrow(ctx context.Context, ws bool) (*gethrpc.Client, *backendNode, error) {
+	defer core.LogMethodDuration(rpc.logger, measure.NewStopwatch(), "get rpc connection")
+	var errs []error
+	for _, node := range selectNodes(rpc.nodes, rpc.active.Load()) {
+		conn, err := borrowConn(ctx, node.connPool(ws))
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, nil, err
+			}
+			rpc.markUnavailable(node, err)
+			errs = append(errs, err)
+			continue
+		}
+		rpc.borrowed.Store(conn, &borrowedConn{node: node, ws: ws})
+		rpc.activate(node)
+		return conn, node, nil
+	}
+	return nil, nil, fmt.Errorf("no backend node is available. Cause: %w", errors.Join(errs...))
+}
+
+func borrowConn(ctx context.Context, p *pool.ObjectPool) (*gethrpc.Client, error) {
+	connectionObj, err := p.BorrowObject(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch rpc connection to backend node %w", err)
+	}
+	return connectionObj.(*gethrpc.Client), nil
+}
+
+// withFailover - executes the call on the active node. When the node can't be reached, it is marked as unavailable and
+// the call is executed on the next node
+func withFailover[R any](ctx context.Context, rpc *BackendRPC, execute func(*backendNode, *gethrpc.Client) (*R, error)) (*R, error) {
+	defer core.LogMethodDuration(rpc.logger, measure.NewStopwatch(), "execute rpc call")
+	var errs []error
+	for _, node := range selectNodes(rpc.nodes, rpc.active.Load()) {
+		conn, err := borrowConn(ctx, node.rpcHTTPConnPool)
 		if err != nil {
-			logger.Warn("failed to read enc key", "err", err)
-			if n > 10 { // wait for ~1m for the backend node to spin up and respond
-				logger.Crit("failed to read enc key", "err", err)
-				return nil
+			if ctx.Err() != nil {
+				return nil, err
 			}
-			time.Sleep(time.Duration(n) * time.Second)
-		} else {
-			return k
+			rpc.markUnavailable(node, err)
+			errs = append(errs, err)
+			continue
+		}
+
+		result, err := execute(node, conn)
+		if err != nil && ctx.Err() == nil && isConnectionError(err) {
+			_ = node.rpcHTTPConnPool.InvalidateObject(context.Background(), conn)
+			rpc.markUnavailable(node, err)
+			errs = append(errs, err)
+			continue
+		}
+		if returnErr := node.rpcHTTPConnPool.ReturnObject(context.Background(), conn); returnErr != nil {
+			rpc.logger.Error("Error returning connection to pool", log.ErrKey, returnErr)
 		}
+		rpc.activate(node)
+		return result, err
 	}
+	return nil, fmt.Errorf("no backend node is available. Cause: %w", errors.Join(errs...))
 }
 
 func (rpc *BackendRPC) ConnectWS(ctx context.Context, account *wecommon.GWAccount) (*tenrpc.EncRPCClient, error) {
-	return connect(ctx, rpc.rpcWSConnPool, account, rpc.encKey, rpc.logger)
+	conn, node, err := rpc.borrow(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	encClient, err := rpc.newEncClient(node, conn, account)
+	if err != nil {
+		_ = rpc.returnConn(conn)
+		return nil, err
+	}
+	return encClient, nil
 }
 
 func (rpc *BackendRPC) ReturnConnWS(conn tenrpc.Client) error {
-	return returnConn(rpc.rpcWSConnPool, conn, rpc.logger)
+	return rpc.returnConn(conn)
 }
 
 func (rpc *BackendRPC) ConnectHttp(ctx context.Context, account *wecommon.GWAccount) (*tenrpc.EncRPCClient, error) {
-	return connect(ctx, rpc.rpcHTTPConnPool, account, rpc.encKey, rpc.logger)
+	conn, node, err := rpc.borrow(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	encClient, err := rpc.newEncClient(node, conn, account)
+	if err != nil {
+		_ = rpc.returnConn(conn)
+		return nil, err
+	}
+	return encClient, nil
 }
 
 func (rpc *BackendRPC) PlainConnectWs(ctx context.Context) (*gethrpc.Client, error) {
-	return connectPlain(ctx, rpc.rpcWSConnPool, rpc.logger)
+	conn, _, err := rpc.borrow(ctx, true)
+	return conn, err
 }
 
 func (rpc *BackendRPC) ReturnConn(conn tenrpc.Client) error {
-	return returnConn(rpc.rpcHTTPConnPool, conn, rpc.logger)
+	return rpc.returnConn(conn)
 }
 
 func (rpc *BackendRPC) Stop() {
-	rpc.rpcHTTPConnPool.Close(context.Background())
-	rpc.rpcWSConnPool.Close(context.Background())
-}
-
-func WithEncRPCConnection[R any](ctx context.Context, rpc *BackendRPC, acct *wecommon.GWAccount, execute func(*tenrpc.EncRPCClient) (*R, error)) (*R, error) {
-	rpcClient, err := connect(ctx, rpc.rpcHTTPConnPool, acct, rpc.encKey, rpc.logger)
-	if err != nil {
-		return nil, fmt.Errorf("could not connect to backed. Cause: %w", err)
+	rpc.stopOnce.Do(func() {
+		close(rpc.stopCh)
+	})
+	for _, node := range rpc.nodes {
+		node.rpcHTTPConnPool.Close(context.Background())
+		node.rpcWSConnPool.Close(context.Background())
 	}
-	defer rpc.ReturnConn(rpcClient.BackingClient())
-	return execute(rpcClient)
 }
 
-func WithPlainRPCConnection[R any](ctx context.Context, b *BackendRPC, execute func(client *rpc.Client) (*R, error)) (*R, error) {
-	connectionObj, err := connectPlain(ctx, b.rpcHTTPConnPool, b.logger)
-	if err != nil {
-		return nil, fmt.Errorf("cannot fetch rpc connection to backend node %w", err)
-	}
-	defer b.ReturnConn(connectionObj)
-	return execute(connectionObj)
+// WithEncRPCConnection - executes the encrypted call on the active node, or on another node when it can't be reached
+func WithEncRPCConnection[R any](ctx context.Context, rpc *BackendRPC, acct *wecommon.GWAccount, execute func(*tenrpc.EncRPCClient) (*R, error)) (*R, error) {
+	return withFailover(ctx, rpc, func(node *backendNode, conn *gethrpc.Client) (*R, error) {
+		rpcClient, err := rpc.newEncClient(node, conn, acct)
+		if err != nil {
+			return nil, fmt.Errorf("could not connect to backed. Cause: %w", err)
+		}
+		return execute(rpcClient)
+	})
 }
 
-func connectPlain(ctx context.Context, p *pool.ObjectPool, logger gethlog.Logger) (*rpc.Client, error) {
-	defer core.LogMethodDuration(logger, measure.NewStopwatch(), "get rpc connection")
-	connectionObj, err := p.BorrowObject(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("cannot fetch rpc connection to backend node %w", err)
-	}
-	conn := connectionObj.(*rpc.Client)
-	return conn, nil
+// WithPlainRPCConnection - executes the call on the active node, or on another node when it can't be reached
+func WithPlainRPCConnection[R any](ctx context.Context, b *BackendRPC, execute func(client *gethrpc.Client) (*R, error)) (*R, error) {
+	return withFailover(ctx, b, func(_ *backendNode, conn *gethrpc.Client) (*R, error) {
+		return execute(conn)
+	})
 }
 
-func connect(ctx context.Context, p *pool.ObjectPool, account *wecommon.GWAccount, key []byte, logger gethlog.Logger) (*tenrpc.EncRPCClient, error) {
-	defer core.LogMethodDuration(logger, measure.NewStopwatch(), "get rpc connection")
-	connectionObj, err := p.BorrowObject(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("cannot fetch rpc connection to backend node %w", err)
+// newEncClient - creates a client that encrypts the requests with the enclave key of the node. The key is read when the
+// node was not reached before, and again by the client when the enclave can't decrypt a request
+func (rpc *BackendRPC) newEncClient(node *backendNode, conn *gethrpc.Client, account *wecommon.GWAccount) (*tenrpc.EncRPCClient, error) {
+	var key []byte
+	if k := node.encKey.Load(); k != nil {
+		key = *k
+	} else {
+		var err error
+		key, err = rpc.readEncKey(node, conn)
+		if err != nil {
+			return nil, err
+		}
 	}
-	conn := connectionObj.(*rpc.Client)
-	encClient, err := wecommon.CreateEncClient(conn, key, account.Address.Bytes(), account.User.UserKey, account.Signature, account.SignatureType, logger)
+	encClient, err := wecommon.CreateEncClient(conn, key, account.Address.Bytes(), account.User.UserKey, account.Signature, account.SignatureType, rpc.logger)
 	if err != nil {
-		_ = returnConn(p, conn, logger)
 		return nil, fmt.Errorf("error creating new client, %w", err)
 	}
+	encClient.OnKeyRefreshed(func(key []byte) {
+		rpc.storeEncKey(node, key)
+	})
 	return encClient, nil
 }
 
-func returnConn(p *pool.ObjectPool, conn tenrpc.Client, logger gethlog.Logger) error {
-	err := p.ReturnObject(context.Background(), conn)
+func (rpc *BackendRPC) returnConn(conn tenrpc.Client) error {
+	value, found := rpc.borrowed.LoadAndDelete(conn)
+	if !found {
+		err := fmt.Errorf("connection was not borrowed from the backend pools")
+		rpc.logger.Error("Error returning connection to pool", log.ErrKey, err)
+		return err
+	}
+	b := value.(*borrowedConn)
+	p := b.node.connPool(b.ws)
+	var err error
+	if b.closed.Load() {
+		err = p.InvalidateObject(context.Background(), conn)
+	} else {
+		err = p.ReturnObject(context.Background(), conn)
+	}
 	if err != nil {
-		logger.Error("Error returning connection to pool", log.ErrKey, err)
+		rpc.logger.Error("Error returning connection to pool", log.ErrKey, err)
 	}
 	return err
 }
@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethlog "github.com/ethereum/go-ethereum/log"
+	"github.com/stretchr/testify/require"
+	hostcommon "github.com/ten-protocol/go-ten/go/common/host"
+	gethrpc "github.com/ten-protocol/go-ten/lib/gethfork/rpc"
+)
+
+func TestSelectNodes(t *testing.T) {
+	newNode := func(addr string, healthy bool, height uint64) *backendNode {
+		node := &backendNode{addrHTTP: addr}
+		node.healthy.Store(healthy)
+		node.height.Store(height)
+		return node
+	}
+	addrs := func(nodes []*backendNode) []string {
+		result := make([]string, len(nodes))
+		for i, node := range nodes {
+			result[i] = node.addrHTTP
+		}
+		return result
+	}
+
+	nodes := []*backendNode{
+		newNode("a", true, 95),
+		newNode("b", true, 100),
+		newNode("c", false, 200), // the height of unhealthy nodes is ignored
+		newNode("d", true, 100-maxBatchLag-1),
+	}
+
+	// without an active node, the most advanced healthy node is used first, and the unhealthy nodes last
+	require.Equal(t, []string{"b", "a", "d", "c"}, addrs(selectNodes(nodes, nil)))
+	// the active node is used while it is healthy and not lagging, even if another node is more advanced
+	require.Equal(t, []string{"a", "b", "d", "c"}, addrs(selectNodes(nodes, nodes[0])))
+	// a lagging active node is abandoned
+	require.Equal(t, []string{"b", "a", "d", "c"}, addrs(selectNodes(nodes, nodes[3])))
+
+	// when the most advanced nodes fail, the lagging node becomes eligible again
+	nodes[0].healthy.Store(false)
+	nodes[1].healthy.Store(false)
+	require.Equal(t, []string{"d", "c", "b", "a"}, addrs(selectNodes(nodes, nodes[0])))
+}
+
+// testNode - serves the methods called by the backend pool
+type testNode struct {
+	name    string
+	healthy atomic.Bool
+}
+
+func (n *testNode) Health() hostcommon.HealthCheck {
+	return hostcommon.HealthCheck{OverallHealth: n.healthy.Load()}
+}
+
+func (n *testNode) BatchNumber() hexutil.Uint64 {
+	return 100
+}
+
+func (n *testNode) RpcKey() []byte {
+	return []byte(n.name)
+}
+
+func (n *testNode) Name() string {
+	return n.name
+}
+
+// Heads - a subscription that never emits, used to check that it is closed when the gateway moves away from the node
+func (n *testNode) Heads(ctx context.Context) (*gethrpc.Subscription, error) {
+	notifier, supported := gethrpc.NotifierFromContext(ctx)
+	if !supported {
+		return nil, fmt.Errorf("subscriptions not supported")
+	}
+	return notifier.CreateSubscription(), nil
+}
+
+func startTestNode(t *testing.T, name string) (*testNode, *httptest.Server) {
+	node := &testNode{name: name}
+	node.healthy.Store(true)
+	server := gethrpc.NewServer()
+	require.NoError(t, server.RegisterName("ten", node))
+	wsHandler := server.WebsocketHandler([]string{"*"})
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upgrade") == "websocket" {
+			wsHandler.ServeHTTP(w, r)
+			return
+		}
+		server.ServeHTTP(w, r)
+	}))
+	t.Cleanup(httpServer.Close)
+	t.Cleanup(server.Stop)
+	return node, httpServer
+}
+
+func TestBackendFailover(t *testing.T) {
+	nodeA, serverA := startTestNode(t, "a")
+	_, serverB := startTestNode(t, "b")
+	backend := NewBackendRPC(
+		[]string{serverA.URL, serverB.URL},
+		[]string{"ws" + strings.TrimPrefix(serverA.URL, "http"), "ws" + strings.TrimPrefix(serverB.URL, "http")},
+		gethlog.New(),
+	)
+	defer backend.Stop()
+
+	callName := func() string {
+		name, err := WithPlainRPCConnection(context.Background(), backend, func(client *gethrpc.Client) (*string, error) {
+			var name string
+			err := client.CallContext(context.Background(), &name, "ten_name")
+			return &name, err
+		})
+		require.NoError(t, err)
+		return *name
+	}
+
+	// the calls stick to the same node, so that the users read their own writes
+	for i := 0; i < 5; i++ {
+		require.Equal(t, "a", callName())
+	}
+	require.Equal(t, []byte("a"), *backend.nodes[0].encKey.Load())
+	require.Equal(t, []byte("b"), *backend.nodes[1].encKey.Load())
+
+	conn, err := backend.PlainConnectWs(context.Background())
+	require.NoError(t, err)
+	sub, err := conn.Subscribe(context.Background(), "ten", make(chan struct{}), "heads")
+	require.NoError(t, err)
+
+	// when the active node reports unhealthy, its subscriptions are closed so that they are made on the next node
+	nodeA.healthy.Store(false)
+	backend.checkNodes()
+	select {
+	case <-sub.Err():
+	case <-time.After(5 * time.Second):
+		t.Fatal("the subscription on the unhealthy node was not closed")
+	}
+	require.NoError(t, backend.ReturnConnWS(conn))
+	require.Equal(t, "b", callName())
+
+	// the calls stay on the new active node when the previous one recovers
+	nodeA.healthy.Store(true)
+	backend.checkNodes()
+	require.Equal(t, "b", callName())
+
+	// a call that can't reach the active node is executed on the next node
+	serverB.Close()
+	require.Equal(t, "a", callName())
+	require.False(t, backend.nodes[1].healthy.Load())
+}
@@ -1,9 +1,15 @@
 package services
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
+	"sort"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
 	"github.com/ethereum/go-ethereum/core/types"
 
@@ -16,19 +22,33 @@ import (
 )
 
 // SKManager - session keys are Private Keys managed by the Gateway
-// At the moment, each user can have a single Session Key. Which is either active or inactive
-// when the SK is active, then all transactions submitted by that user will be signed with the session key
+// Each user can have multiple named Session Keys (e.g. one per device or app), each of which is either active or inactive
+// when a SK is active, then the transactions submitted by that user can be signed with it
+// Before signing, the gateway checks the restrictions of the key: the expiry time, the contracts and functions the
+// key is allowed to call, and the cap of the total value transferred with the key
 // The SK is also considered an "Account" of that user
 // when the SK is created, it signs over the VK of the user so that it can interact with a node the standard way
 // From the POV of the Ten network - a session key is a normal account key
+//
+// The methods that take the address of a key use the only key of the user when the address is nil
 type SKManager interface {
-	CreateSessionKey(user *common.GWUser) (*common.GWSessionKey, error)
-	ActivateSessionKey(user *common.GWUser) (bool, error)
-	DeactivateSessionKey(user *common.GWUser) (bool, error)
-	DeleteSessionKey(user *common.GWUser) (bool, error)
-	SignTx(ctx context.Context, user *common.GWUser, input *types.Transaction) (*types.Transaction, error)
+	CreateSessionKey(user *common.GWUser, params *common.SessionKeyParams) (*common.GWSessionKey, error)
+	ListSessionKeys(user *common.GWUser) []common.SessionKeyInfo
+	ActivateSessionKey(user *common.GWUser, address *gethcommon.Address) (bool, error)
+	DeactivateSessionKey(user *common.GWUser, address *gethcommon.Address) (bool, error)
+	DeleteSessionKey(user *common.GWUser, address *gethcommon.Address) (bool, error)
+	// RevokeSessionKey - deletes the key even if it is active. Any funds left in the account of the key are lost
+	RevokeSessionKey(user *common.GWUser, address gethcommon.Address) (bool, error)
+	// SignTx - signs the transaction with the active session key with the address "from", or with the only active
+	// session key of the user if "from" is nil
+	SignTx(ctx context.Context, user *common.GWUser, from *gethcommon.Address, input *types.Transaction) (*types.Transaction, error)
 }
 
+const (
+	maxSessionKeysPerUser = 50
+	selectorLength        = 4
+)
+
 type skManager struct {
 	storage storage.UserStorage
 	config  *common.Config
@@ -44,14 +64,19 @@ func NewSKManager(storage storage.UserStorage, config *common.Config, logger get
 }
 
 // CreateSessionKey - generates a fresh key and signs over the VK of the user with it
-func (m *skManager) CreateSessionKey(user *common.GWUser) (*common.GWSessionKey, error) {
-	if user.SessionKey != nil {
-		return nil, fmt.Errorf("user already has a session key")
+func (m *skManager) CreateSessionKey(user *common.GWUser, params *common.SessionKeyParams) (*common.GWSessionKey, error) {
+	if len(user.SessionKeys) >= maxSessionKeysPerUser {
+		return nil, fmt.Errorf("user already has the maximum number of %d session keys", maxSessionKeysPerUser)
 	}
 	sk, err := m.createSK(user)
 	if err != nil {
 		return nil, err
 	}
+	if params != nil {
+		if err := applySessionKeyParams(sk, params); err != nil {
+			return nil, err
+		}
+	}
 	err = m.storage.AddSessionKey(user.ID, *sk)
 	if err != nil {
 		return nil, err
@@ -59,42 +84,70 @@ func (m *skManager) CreateSessionKey(user *common.GWUser) (*common.GWSessionKey,
 	return sk, nil
 }
 
-func (m *skManager) ActivateSessionKey(user *common.GWUser) (bool, error) {
-	if user.SessionKey == nil {
-		return false, fmt.Errorf("please create a session key")
+func (m *skManager) ListSessionKeys(user *common.GWUser) []common.SessionKeyInfo {
+	res := make([]common.SessionKeyInfo, 0, len(user.SessionKeys))
+	for _, sk := range user.SessionKeys {
+		res = append(res, sk.Info())
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return bytes.Compare(res[i].Address.Bytes(), res[j].Address.Bytes()) < 0
+	})
+	return res
+}
+
+func (m *skManager) ActivateSessionKey(user *common.GWUser, address *gethcommon.Address) (bool, error) {
+	sk, err := findSessionKey(user, address)
+	if err != nil {
+		return false, err
 	}
-	if user.ActiveSK {
+	if sk.Active {
 		return false, fmt.Errorf("session key already activated")
 	}
-	err := m.storage.ActivateSessionKey(user.ID, true)
+	if sk.IsExpired(time.Now()) {
+		return false, fmt.Errorf("session key expired")
+	}
+	err = m.storage.ActivateSessionKey(user.ID, *sk.Account.Address, true)
 	if err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
-func (m *skManager) DeactivateSessionKey(user *common.GWUser) (bool, error) {
-	if user.SessionKey == nil {
-		return false, fmt.Errorf("please create a session key")
+func (m *skManager) DeactivateSessionKey(user *common.GWUser, address *gethcommon.Address) (bool, error) {
+	sk, err := findSessionKey(user, address)
+	if err != nil {
+		return false, err
 	}
-	if !user.ActiveSK {
+	if !sk.Active {
 		return false, fmt.Errorf("session key is not activated")
 	}
-	err := m.storage.ActivateSessionKey(user.ID, false)
+	err = m.storage.ActivateSessionKey(user.ID, *sk.Account.Address, false)
 	if err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
-func (m *skManager) DeleteSessionKey(user *common.GWUser) (bool, error) {
-	if user.SessionKey == nil {
-		return false, fmt.Errorf("please create a session key")
+func (m *skManager) DeleteSessionKey(user *common.GWUser, address *gethcommon.Address) (bool, error) {
+	sk, err := findSessionKey(user, address)
+	if err != nil {
+		return false, err
 	}
-	if user.ActiveSK {
+	if sk.Active {
 		return false, fmt.Errorf("session key is active. Please deactivate first")
 	}
-	err := m.storage.RemoveSessionKey(user.ID)
+	err = m.storage.RemoveSessionKey(user.ID, *sk.Account.Address)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (m *skManager) RevokeSessionKey(user *common.GWUser, address gethcommon.Address) (bool, error) {
+	if _, found := user.SessionKeys[address]; !found {
+		return false, fmt.Errorf("session key %s not found", address.Hex())
+	}
+	err := m.storage.RemoveSessionKey(user.ID, address)
 	if err != nil {
 		return false, err
 	}
@@ -137,11 +190,28 @@ func (m *skManager) createSK(user *common.GWUser) (*common.GWSessionKey, error)
 			Signature:     sig,
 			SignatureType: viewingkey.EIP712Signature,
 		},
+		SpentValue: big.NewInt(0),
 	}, nil
 }
 
-func (m *skManager) SignTx(ctx context.Context, user *common.GWUser, tx *types.Transaction) (*types.Transaction, error) {
-	prvKey := user.SessionKey.PrivateKey.ExportECDSA()
+func (m *skManager) SignTx(ctx context.Context, user *common.GWUser, from *gethcommon.Address, tx *types.Transaction) (*types.Transaction, error) {
+	sk, err := activeSessionKey(user, from)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSessionKeyRestrictions(sk, tx, time.Now()); err != nil {
+		return nil, err
+	}
+
+	// the value is recorded before signing, so a transaction that fails to be submitted still counts towards the cap
+	if tx.Value() != nil && tx.Value().Sign() > 0 {
+		err = m.storage.SpendSessionKeyValue(user.ID, *sk.Account.Address, tx.Value())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	prvKey := sk.PrivateKey.ExportECDSA()
 	signer := types.NewCancunSigner(big.NewInt(int64(m.config.TenChainID)))
 
 	stx, err := types.SignTx(tx, signer, prvKey)
@@ -149,7 +219,104 @@ func (m *skManager) SignTx(ctx context.Context, user *common.GWUser, tx *types.T
 		return nil, err
 	}
 
-	m.logger.Debug("Signed transaction with session key", "stxHash", stx.Hash().Hex())
+	m.logger.Debug("Signed transaction with session key", "sk", sk.Account.Address.Hex(), "stxHash", stx.Hash().Hex())
 
 	return stx, nil
 }
+
+// findSessionKey - returns the session key with the given address, or the only session key of the user if the address is nil
+func findSessionKey(user *common.GWUser, address *gethcommon.Address) (*common.GWSessionKey, error) {
+	if address != nil {
+		sk, found := user.SessionKeys[*address]
+		if !found {
+			return nil, fmt.Errorf("session key %s not found", address.Hex())
+		}
+		return sk, nil
+	}
+	switch len(user.SessionKeys) {
+	case 0:
+		return nil, fmt.Errorf("please create a session key")
+	case 1:
+		for _, sk := range user.SessionKeys {
+			return sk, nil
+		}
+	}
+	return nil, fmt.Errorf("user has multiple session keys. Please specify the address of the session key")
+}
+
+// activeSessionKey - returns the active session key with the given address, or the only active session key of the user if the address is nil
+func activeSessionKey(user *common.GWUser, address *gethcommon.Address) (*common.GWSessionKey, error) {
+	if address != nil {
+		sk, found := user.SessionKeys[*address]
+		if !found || !sk.Active {
+			return nil, fmt.Errorf("%s is not an active session key", address.Hex())
+		}
+		return sk, nil
+	}
+	activeKeys := user.ActiveSessionKeys()
+	switch len(activeKeys) {
+	case 0:
+		return nil, fmt.Errorf("please activate session key")
+	case 1:
+		return activeKeys[0], nil
+	default:
+		return nil, fmt.Errorf("user has multiple active session keys. Please specify the session key in the \"from\" field")
+	}
+}
+
+// checkSessionKeyRestrictions - returns an error if the session key is not allowed to sign the transaction at the given time
+// The cap of the value transferred is checked when the value is recorded in the storage
+func checkSessionKeyRestrictions(sk *common.GWSessionKey, tx *types.Transaction, now time.Time) error {
+	if sk.IsExpired(now) {
+		return fmt.Errorf("session key %s expired", sk.Account.Address.Hex())
+	}
+	if len(sk.Targets) == 0 {
+		return nil
+	}
+	if tx.To() == nil {
+		return fmt.Errorf("session key %s is not allowed to deploy contracts", sk.Account.Address.Hex())
+	}
+	for _, target := range sk.Targets {
+		if target.Address != *tx.To() {
+			continue
+		}
+		if len(target.Selectors) == 0 {
+			return nil
+		}
+		if len(tx.Data()) < selectorLength {
+			return fmt.Errorf("session key %s is only allowed to call specific functions of %s", sk.Account.Address.Hex(), tx.To().Hex())
+		}
+		for _, selector := range target.Selectors {
+			if bytes.Equal(selector, tx.Data()[:selectorLength]) {
+				return nil
+			}
+		}
+		return fmt.Errorf("session key %s is not allowed to call function %s of %s", sk.Account.Address.Hex(), hexutil.Encode(tx.Data()[:selectorLength]), tx.To().Hex())
+	}
+	return fmt.Errorf("session key %s is not allowed to call %s", sk.Account.Address.Hex(), tx.To().Hex())
+}
+
+func applySessionKeyParams(sk *common.GWSessionKey, params *common.SessionKeyParams) error {
+	sk.Name = params.Name
+	if params.ExpiresAt != 0 {
+		sk.ExpiresAt = time.Unix(int64(params.ExpiresAt), 0)
+		if sk.IsExpired(time.Now()) {
+			return fmt.Errorf("session key expiry time is in the past")
+		}
+	}
+	for _, target := range params.Targets {
+		for _, selector := range target.Selectors {
+			if len(selector) != selectorLength {
+				return fmt.Errorf("invalid function selector %s. Selectors must be %d bytes", selector, selectorLength)
+			}
+		}
+	}
+	sk.Targets = params.Targets
+	if params.ValueCap != nil {
+		if params.ValueCap.ToInt().Sign() < 0 {
+			return fmt.Errorf("session key value cap can't be negative")
+		}
+		sk.ValueCap = params.ValueCap.ToInt()
+	}
+	return nil
+}
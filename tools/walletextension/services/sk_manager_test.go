@@ -0,0 +1,70 @@
+package services
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	"github.com/ten-protocol/go-ten/tools/walletextension/common"
+)
+
+func TestSessionKeyRestrictions(t *testing.T) {
+	game := gethcommon.HexToAddress("0x1")
+	token := gethcommon.HexToAddress("0x2")
+	move := hexutil.Bytes{1, 2, 3, 4}
+	now := time.Now()
+
+	sk := &common.GWSessionKey{
+		Account:   &common.GWAccount{Address: &gethcommon.Address{}},
+		ExpiresAt: now.Add(time.Hour),
+		Targets: []common.GWSessionKeyTarget{
+			{Address: game, Selectors: []hexutil.Bytes{move}},
+			{Address: token},
+		},
+	}
+
+	require.NoError(t, checkSessionKeyRestrictions(sk, newTx(&game, append(move, 5)), now))
+	require.NoError(t, checkSessionKeyRestrictions(sk, newTx(&token, []byte{9, 9, 9, 9}), now))
+	require.Error(t, checkSessionKeyRestrictions(sk, newTx(&game, []byte{9, 9, 9, 9}), now))
+	require.Error(t, checkSessionKeyRestrictions(sk, newTx(&game, nil), now))
+	require.Error(t, checkSessionKeyRestrictions(sk, newTx(&gethcommon.Address{}, nil), now))
+	require.Error(t, checkSessionKeyRestrictions(sk, newTx(nil, move), now))
+	require.Error(t, checkSessionKeyRestrictions(sk, newTx(&game, move), now.Add(time.Hour)))
+
+	// keys without targets can call any contract
+	sk.Targets = nil
+	require.NoError(t, checkSessionKeyRestrictions(sk, newTx(nil, move), now))
+}
+
+func TestSessionKeySelection(t *testing.T) {
+	addr1, addr2 := gethcommon.HexToAddress("0x1"), gethcommon.HexToAddress("0x2")
+	user := &common.GWUser{SessionKeys: map[gethcommon.Address]*common.GWSessionKey{
+		addr1: {Account: &common.GWAccount{Address: &addr1}, Active: true},
+	}}
+
+	sk, err := activeSessionKey(user, nil)
+	require.NoError(t, err)
+	require.Equal(t, addr1, *sk.Account.Address)
+
+	user.SessionKeys[addr2] = &common.GWSessionKey{Account: &common.GWAccount{Address: &addr2}}
+	_, err = activeSessionKey(user, &addr2)
+	require.Error(t, err)
+	_, err = findSessionKey(user, nil)
+	require.Error(t, err)
+
+	// with multiple active keys, the key must be specified
+	user.SessionKeys[addr2].Active = true
+	_, err = activeSessionKey(user, nil)
+	require.Error(t, err)
+	sk, err = activeSessionKey(user, &addr2)
+	require.NoError(t, err)
+	require.Equal(t, addr2, *sk.Account.Address)
+}
+
+func newTx(to *gethcommon.Address, data []byte) *types.Transaction {
+	return types.NewTx(&types.LegacyTx{To: to, Data: data, Value: big.NewInt(1), Gas: 21_000, GasPrice: big.NewInt(1)})
+}
@@ -36,8 +36,8 @@ import (
 
 // Services handles the various business logic for the api endpoints
 type Services struct {
-	HostAddrHTTP        string // The HTTP address on which the TEN host can be reached
-	HostAddrWS          string // The WS address on which the TEN host can be reached
+	HostAddrsHTTP       []string // The HTTP addresses on which the TEN hosts can be reached
+	HostAddrsWS         []string // The WS addresses on which the TEN hosts can be reached
 	Storage             storage.UserStorage
 	logger              gethlog.Logger
 	stopControl         *stopcontrol.StopControl
@@ -58,7 +58,7 @@ type NewHeadNotifier interface {
 // number of rpc responses to cache
 const rpcResponseCacheSize = 1_000_000
 
-func NewServices(hostAddrHTTP string, hostAddrWS string, storage storage.UserStorage, stopControl *stopcontrol.StopControl, version string, logger gethlog.Logger, config *common.Config) *Services {
+func NewServices(hostAddrsHTTP []string, hostAddrsWS []string, storage storage.UserStorage, stopControl *stopcontrol.StopControl, version string, logger gethlog.Logger, config *common.Config) *Services {
 	newGatewayCache, err := cache.NewCache(rpcResponseCacheSize, logger)
 	if err != nil {
 		logger.Error(fmt.Errorf("could not create cache. Cause: %w", err).Error())
@@ -68,14 +68,14 @@ func NewServices(hostAddrHTTP string, hostAddrWS string, storage storage.UserSto
 	rateLimiter := ratelimiter.NewRateLimiter(config.RateLimitUserComputeTime, config.RateLimitWindow, uint32(config.RateLimitMaxConcurrentRequests), logger)
 
 	services := Services{
-		HostAddrHTTP:        hostAddrHTTP,
-		HostAddrWS:          hostAddrWS,
+		HostAddrsHTTP:       hostAddrsHTTP,
+		HostAddrsWS:         hostAddrsWS,
 		Storage:             storage,
 		logger:              logger,
 		stopControl:         stopControl,
 		version:             version,
 		RPCResponsesCache:   newGatewayCache,
-		BackendRPC:          NewBackendRPC(hostAddrHTTP, hostAddrWS, logger),
+		BackendRPC:          NewBackendRPC(hostAddrsHTTP, hostAddrsWS, logger),
 		SKManager:           NewSKManager(storage, config, logger),
 		RateLimiter:         rateLimiter,
 		Config:              config,
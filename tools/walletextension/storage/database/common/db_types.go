@@ -1,22 +1,34 @@
 package common
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"math/big"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto/ecies"
+	"github.com/ten-protocol/go-ten/go/common/errutil"
 	"github.com/ten-protocol/go-ten/go/common/viewingkey"
 	wecommon "github.com/ten-protocol/go-ten/tools/walletextension/common"
 )
 
+// ErrValueCapExceeded - the transaction would take the value transferred with a session key over its cap
+var ErrValueCapExceeded = errors.New("session key value cap exceeded")
+
 type GWUserDB struct {
-	UserId     []byte          `json:"userId"`
-	PrivateKey []byte          `json:"privateKey"`
-	Accounts   []GWAccountDB   `json:"accounts"`
-	SessionKey *GWSessionKeyDB `json:"sessionKey"`
-	ActiveSK   bool            `json:"activeSK"`
+	UserId      []byte           `json:"userId"`
+	PrivateKey  []byte           `json:"privateKey"`
+	Accounts    []GWAccountDB    `json:"accounts"`
+	SessionKeys []GWSessionKeyDB `json:"sessionKeys"`
+	// the single session key of the users created before multiple session keys were supported. It is moved to
+	// SessionKeys the first time the session keys of the user are modified
+	SessionKey *GWSessionKeyDB `json:"sessionKey,omitempty"`
+	ActiveSK   bool            `json:"activeSK,omitempty"`
 }
 
 type GWAccountDB struct {
@@ -27,16 +39,103 @@ type GWAccountDB struct {
 
 // GWSessionKeyDB - an account key-pair registered for a user
 type GWSessionKeyDB struct {
-	PrivateKey []byte      `json:"privateKey"`
-	Account    GWAccountDB `json:"account"`
+	PrivateKey []byte                 `json:"privateKey"`
+	Account    GWAccountDB            `json:"account"`
+	Name       string                 `json:"name"`
+	Active     bool                   `json:"active"`
+	ExpiresAt  int64                  `json:"expiresAt"` // unix timestamp. 0 if the key does not expire
+	Targets    []GWSessionKeyTargetDB `json:"targets"`
+	ValueCap   *big.Int               `json:"valueCap"`
+	SpentValue *big.Int               `json:"spentValue"`
+}
+
+type GWSessionKeyTargetDB struct {
+	Address   []byte   `json:"address"`
+	Selectors [][]byte `json:"selectors"`
+}
+
+func NewGWSessionKeyDB(key wecommon.GWSessionKey) GWSessionKeyDB {
+	skDB := GWSessionKeyDB{
+		PrivateKey: crypto.FromECDSA(key.PrivateKey.ExportECDSA()),
+		Account: GWAccountDB{
+			AccountAddress: key.Account.Address.Bytes(),
+			Signature:      key.Account.Signature,
+			SignatureType:  int(key.Account.SignatureType),
+		},
+		Name:       key.Name,
+		Active:     key.Active,
+		ValueCap:   key.ValueCap,
+		SpentValue: key.SpentValue,
+	}
+	if !key.ExpiresAt.IsZero() {
+		skDB.ExpiresAt = key.ExpiresAt.Unix()
+	}
+	for _, target := range key.Targets {
+		targetDB := GWSessionKeyTargetDB{Address: target.Address.Bytes()}
+		for _, selector := range target.Selectors {
+			targetDB.Selectors = append(targetDB.Selectors, selector)
+		}
+		skDB.Targets = append(skDB.Targets, targetDB)
+	}
+	return skDB
+}
+
+// AddSessionKey - adds the key to the user, after moving the legacy session key to the list of keys
+func (userDB *GWUserDB) AddSessionKey(key wecommon.GWSessionKey) {
+	userDB.migrateLegacySessionKey()
+	userDB.SessionKeys = append(userDB.SessionKeys, NewGWSessionKeyDB(key))
+}
+
+// UpdateSessionKey - applies the update to the session key with the given address
+func (userDB *GWUserDB) UpdateSessionKey(address common.Address, update func(sk *GWSessionKeyDB) error) error {
+	userDB.migrateLegacySessionKey()
+	for i := range userDB.SessionKeys {
+		if bytes.Equal(userDB.SessionKeys[i].Account.AccountAddress, address.Bytes()) {
+			return update(&userDB.SessionKeys[i])
+		}
+	}
+	return fmt.Errorf("session key %s: %w", address.Hex(), errutil.ErrNotFound)
+}
+
+// RemoveSessionKey - removes the session key with the given address
+func (userDB *GWUserDB) RemoveSessionKey(address common.Address) error {
+	userDB.migrateLegacySessionKey()
+	for i := range userDB.SessionKeys {
+		if bytes.Equal(userDB.SessionKeys[i].Account.AccountAddress, address.Bytes()) {
+			userDB.SessionKeys = append(userDB.SessionKeys[:i], userDB.SessionKeys[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("session key %s: %w", address.Hex(), errutil.ErrNotFound)
+}
+
+// Spend - adds the value to the value spent with the session key, unless that would exceed its cap
+func (sk *GWSessionKeyDB) Spend(value *big.Int) error {
+	spent := new(big.Int).Add(value, spentOrZero(sk.SpentValue))
+	if sk.ValueCap != nil && spent.Cmp(sk.ValueCap) > 0 {
+		return fmt.Errorf("%w: spent %s, cap %s, transaction value %s", ErrValueCapExceeded, spentOrZero(sk.SpentValue), sk.ValueCap, value)
+	}
+	sk.SpentValue = spent
+	return nil
+}
+
+func (userDB *GWUserDB) migrateLegacySessionKey() {
+	if userDB.SessionKey == nil {
+		return
+	}
+	legacySK := *userDB.SessionKey
+	legacySK.Active = userDB.ActiveSK
+	userDB.SessionKeys = append(userDB.SessionKeys, legacySK)
+	userDB.SessionKey = nil
+	userDB.ActiveSK = false
 }
 
 func (userDB *GWUserDB) ToGWUser() (*wecommon.GWUser, error) {
 	user := &wecommon.GWUser{
-		ID:       userDB.UserId,
-		Accounts: make(map[common.Address]*wecommon.GWAccount),
-		UserKey:  userDB.PrivateKey,
-		ActiveSK: userDB.ActiveSK,
+		ID:          userDB.UserId,
+		Accounts:    make(map[common.Address]*wecommon.GWAccount),
+		UserKey:     userDB.PrivateKey,
+		SessionKeys: make(map[common.Address]*wecommon.GWSessionKey),
 	}
 
 	for _, accountDB := range userDB.Accounts {
@@ -50,25 +149,60 @@ func (userDB *GWUserDB) ToGWUser() (*wecommon.GWUser, error) {
 		user.Accounts[address] = &gwAccount
 	}
 
+	sessionKeys := userDB.SessionKeys
 	if userDB.SessionKey != nil {
-		ecdsaPrivateKey, err := crypto.ToECDSA(userDB.SessionKey.PrivateKey)
+		legacySK := *userDB.SessionKey
+		legacySK.Active = userDB.ActiveSK
+		sessionKeys = append([]GWSessionKeyDB{legacySK}, sessionKeys...)
+	}
+	for _, skDB := range sessionKeys {
+		sk, err := skDB.toGWSessionKey(user)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse ECDSA private key: %w", err)
+			return nil, err
 		}
+		user.SessionKeys[*sk.Account.Address] = sk
+	}
+
+	return user, nil
+}
 
+func (sk *GWSessionKeyDB) toGWSessionKey(user *wecommon.GWUser) (*wecommon.GWSessionKey, error) {
+	ecdsaPrivateKey, err := crypto.ToECDSA(sk.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ECDSA private key: %w", err)
+	}
+
+	address := common.BytesToAddress(sk.Account.AccountAddress)
+	gwSK := &wecommon.GWSessionKey{
+		Account: &wecommon.GWAccount{
+			User:          user,
+			Address:       &address,
+			Signature:     sk.Account.Signature,
+			SignatureType: viewingkey.SignatureType(sk.Account.SignatureType),
+		},
 		// Convert ECDSA private key to ECIES private key
-		eciesPrivateKey := ecies.ImportECDSA(ecdsaPrivateKey)
-		acc := userDB.SessionKey.Account
-		user.SessionKey = &wecommon.GWSessionKey{
-			Account: &wecommon.GWAccount{
-				User:          user,
-				Address:       (*common.Address)(acc.AccountAddress),
-				Signature:     acc.Signature,
-				SignatureType: viewingkey.SignatureType(acc.SignatureType),
-			},
-			PrivateKey: eciesPrivateKey,
+		PrivateKey: ecies.ImportECDSA(ecdsaPrivateKey),
+		Name:       sk.Name,
+		Active:     sk.Active,
+		ValueCap:   sk.ValueCap,
+		SpentValue: spentOrZero(sk.SpentValue),
+	}
+	if sk.ExpiresAt != 0 {
+		gwSK.ExpiresAt = time.Unix(sk.ExpiresAt, 0)
+	}
+	for _, targetDB := range sk.Targets {
+		target := wecommon.GWSessionKeyTarget{Address: common.BytesToAddress(targetDB.Address)}
+		for _, selector := range targetDB.Selectors {
+			target.Selectors = append(target.Selectors, hexutil.Bytes(selector))
 		}
+		gwSK.Targets = append(gwSK.Targets, target)
 	}
+	return gwSK, nil
+}
 
-	return user, nil
+func spentOrZero(spent *big.Int) *big.Int {
+	if spent == nil {
+		return big.NewInt(0)
+	}
+	return spent
 }
@@ -5,9 +5,10 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"strings"
 
-	"github.com/ethereum/go-ethereum/crypto"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 
 	dbcommon "github.com/ten-protocol/go-ten/tools/walletextension/storage/database/common"
 
@@ -134,37 +135,49 @@ func (c *CosmosDB) AddSessionKey(userID []byte, key common.GWSessionKey) error {
 	if err != nil {
 		return fmt.Errorf("failed to get user: %w", err)
 	}
-	user.user.SessionKey = &dbcommon.GWSessionKeyDB{
-		PrivateKey: crypto.FromECDSA(key.PrivateKey.ExportECDSA()),
-		Account: dbcommon.GWAccountDB{
-			AccountAddress: key.Account.Address.Bytes(),
-			Signature:      key.Account.Signature,
-			SignatureType:  int(key.Account.SignatureType),
-		},
-	}
+	user.user.AddSessionKey(key)
 	return c.updateUser(ctx, user.user)
 }
 
-func (c *CosmosDB) ActivateSessionKey(userID []byte, active bool) error {
+func (c *CosmosDB) ActivateSessionKey(userID []byte, address gethcommon.Address, active bool) error {
+	return c.updateSessionKey(userID, address, func(sk *dbcommon.GWSessionKeyDB) error {
+		sk.Active = active
+		return nil
+	})
+}
+
+func (c *CosmosDB) RemoveSessionKey(userID []byte, address gethcommon.Address) error {
 	ctx := context.Background()
 
 	user, err := c.getUserDB(userID)
 	if err != nil {
 		return fmt.Errorf("failed to get user: %w", err)
 	}
-	user.user.ActiveSK = active
+	if err := user.user.RemoveSessionKey(address); err != nil {
+		return err
+	}
 	return c.updateUser(ctx, user.user)
 }
 
-func (c *CosmosDB) RemoveSessionKey(userID []byte) error {
+func (c *CosmosDB) SpendSessionKeyValue(userID []byte, address gethcommon.Address, value *big.Int) error {
+	return c.updateSessionKey(userID, address, func(sk *dbcommon.GWSessionKeyDB) error {
+		return sk.Spend(value)
+	})
+}
+
+// updateSessionKey - the update is only written if the user document was not modified since it was read, so that
+// concurrent updates can't take the value spent with a key over its cap
+func (c *CosmosDB) updateSessionKey(userID []byte, address gethcommon.Address, update func(sk *dbcommon.GWSessionKeyDB) error) error {
 	ctx := context.Background()
 
 	user, err := c.getUserDB(userID)
 	if err != nil {
 		return fmt.Errorf("failed to get user: %w", err)
 	}
-	user.user.SessionKey = nil
-	return c.updateUser(ctx, user.user)
+	if err := user.user.UpdateSessionKey(address, update); err != nil {
+		return err
+	}
+	return c.replaceUser(ctx, user)
 }
 
 func (c *CosmosDB) AddAccount(userID []byte, accountAddress []byte, signature []byte, signatureType viewingkey.SignatureType) error {
@@ -229,7 +242,12 @@ func (c *CosmosDB) updateUser(ctx context.Context, user dbcommon.GWUserDB) error
 	if err != nil {
 		return fmt.Errorf("failed to get current user state: %w", err)
 	}
+	return c.replaceUser(ctx, userWithETag{user: user, etag: currentUser.etag})
+}
 
+// replaceUser - writes the user document if it was not modified since the ETag was read
+func (c *CosmosDB) replaceUser(ctx context.Context, userWithETag userWithETag) error {
+	user := userWithETag.user
 	keyString, partitionKey := c.dbKey(user.UserId)
 	encryptedDoc, err := c.createEncryptedDoc(user, keyString)
 	if err != nil {
@@ -237,7 +255,7 @@ func (c *CosmosDB) updateUser(ctx context.Context, user dbcommon.GWUserDB) error
 	}
 
 	options := &azcosmos.ItemOptions{
-		IfMatchEtag: &currentUser.etag,
+		IfMatchEtag: &userWithETag.etag,
 	}
 
 	_, err = c.usersContainer.ReplaceItem(ctx, partitionKey, keyString, encryptedDoc, options)
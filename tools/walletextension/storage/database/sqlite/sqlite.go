@@ -19,10 +19,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"os"
 	"path/filepath"
 
-	"github.com/ethereum/go-ethereum/crypto"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	_ "github.com/mattn/go-sqlite3" // sqlite driver for sql.Open()
 
 	dbcommon "github.com/ten-protocol/go-ten/tools/walletextension/storage/database/common"
@@ -142,36 +143,46 @@ func (s *SqliteDB) AddSessionKey(userID []byte, key common.GWSessionKey) error {
 		if err != nil {
 			return err
 		}
-		user.SessionKey = &dbcommon.GWSessionKeyDB{
-			PrivateKey: crypto.FromECDSA(key.PrivateKey.ExportECDSA()),
-			Account: dbcommon.GWAccountDB{
-				AccountAddress: key.Account.Address.Bytes(),
-				Signature:      key.Account.Signature,
-				SignatureType:  int(key.Account.SignatureType),
-			},
-		}
+		user.AddSessionKey(key)
 		return s.updateUser(dbTx, user)
 	})
 }
 
-func (s *SqliteDB) ActivateSessionKey(userID []byte, active bool) error {
+func (s *SqliteDB) ActivateSessionKey(userID []byte, address gethcommon.Address, active bool) error {
+	return s.updateSessionKey(userID, address, func(sk *dbcommon.GWSessionKeyDB) error {
+		sk.Active = active
+		return nil
+	})
+}
+
+func (s *SqliteDB) RemoveSessionKey(userID []byte, address gethcommon.Address) error {
 	return s.withTx(func(dbTx *sql.Tx) error {
 		user, err := s.readUser(dbTx, userID)
 		if err != nil {
 			return err
 		}
-		user.ActiveSK = active
+		if err := user.RemoveSessionKey(address); err != nil {
+			return err
+		}
 		return s.updateUser(dbTx, user)
 	})
 }
 
-func (s *SqliteDB) RemoveSessionKey(userID []byte) error {
+func (s *SqliteDB) SpendSessionKeyValue(userID []byte, address gethcommon.Address, value *big.Int) error {
+	return s.updateSessionKey(userID, address, func(sk *dbcommon.GWSessionKeyDB) error {
+		return sk.Spend(value)
+	})
+}
+
+func (s *SqliteDB) updateSessionKey(userID []byte, address gethcommon.Address, update func(sk *dbcommon.GWSessionKeyDB) error) error {
 	return s.withTx(func(dbTx *sql.Tx) error {
 		user, err := s.readUser(dbTx, userID)
 		if err != nil {
 			return err
 		}
-		user.SessionKey = nil
+		if err := user.UpdateSessionKey(address, update); err != nil {
+			return err
+		}
 		return s.updateUser(dbTx, user)
 	})
 }
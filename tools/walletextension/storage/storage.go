@@ -2,7 +2,9 @@ package storage
 
 import (
 	"fmt"
+	"math/big"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	gethlog "github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common/viewingkey"
 
@@ -17,8 +19,10 @@ type UserStorage interface {
 	DeleteUser(userID []byte) error
 	AddAccount(userID []byte, accountAddress []byte, signature []byte, signatureType viewingkey.SignatureType) error
 	AddSessionKey(userID []byte, key common.GWSessionKey) error
-	ActivateSessionKey(userID []byte, active bool) error
-	RemoveSessionKey(userID []byte) error
+	ActivateSessionKey(userID []byte, address gethcommon.Address, active bool) error
+	RemoveSessionKey(userID []byte, address gethcommon.Address) error
+	// SpendSessionKeyValue - records the value transferred with the session key, or fails if that would exceed its cap
+	SpendSessionKeyValue(userID []byte, address gethcommon.Address, value *big.Int) error
 	GetUser(userID []byte) (*common.GWUser, error)
 	GetEncryptionKey() []byte
 }
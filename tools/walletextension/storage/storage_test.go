@@ -4,8 +4,14 @@ import (
 	"bytes"
 	"crypto/rand"
 	"errors"
+	"math/big"
 	"testing"
 
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/ecies"
+	dbcommon "github.com/ten-protocol/go-ten/tools/walletextension/storage/database/common"
+
 	"github.com/ten-protocol/go-ten/integration/common/testlog"
 
 	"github.com/ten-protocol/go-ten/go/common/viewingkey"
@@ -20,6 +26,7 @@ var tests = map[string]func(storage UserStorage, t *testing.T){
 	"testAddAccounts":   testAddAccounts,
 	"testDeleteUser":    testDeleteUser,
 	"testGetUser":       testGetUser,
+	"testSessionKeys":   testSessionKeys,
 }
 
 func TestGatewayStorage(t *testing.T) {
@@ -201,3 +208,60 @@ func testGetUser(storage UserStorage, t *testing.T) {
 		t.Error("Expected error when getting non-existent user, but got none")
 	}
 }
+
+func testSessionKeys(storage UserStorage, t *testing.T) {
+	userID := make([]byte, 20)
+	rand.Read(userID)
+	privateKey := make([]byte, 32)
+	rand.Read(privateKey)
+	require.NoError(t, storage.AddUser(userID, privateKey))
+
+	// add two session keys, one of them with a value cap
+	sk1 := randomSessionKey(t, "phone", nil)
+	sk2 := randomSessionKey(t, "laptop", big.NewInt(100))
+	require.NoError(t, storage.AddSessionKey(userID, sk1))
+	require.NoError(t, storage.AddSessionKey(userID, sk2))
+	addr1, addr2 := *sk1.Account.Address, *sk2.Account.Address
+
+	require.NoError(t, storage.ActivateSessionKey(userID, addr2, true))
+	user, err := storage.GetUser(userID)
+	require.NoError(t, err)
+	require.Len(t, user.SessionKeys, 2)
+	require.Len(t, user.GetAllAddresses(), 2)
+	require.False(t, user.SessionKeys[addr1].Active)
+	require.True(t, user.SessionKeys[addr2].Active)
+	require.Equal(t, "laptop", user.SessionKeys[addr2].Name)
+	require.Equal(t, sk2.Targets, user.SessionKeys[addr2].Targets)
+	require.Equal(t, crypto.FromECDSA(sk2.PrivateKey.ExportECDSA()), crypto.FromECDSA(user.SessionKeys[addr2].PrivateKey.ExportECDSA()))
+
+	// the value spent is capped
+	require.NoError(t, storage.SpendSessionKeyValue(userID, addr2, big.NewInt(60)))
+	err = storage.SpendSessionKeyValue(userID, addr2, big.NewInt(60))
+	require.ErrorIs(t, err, dbcommon.ErrValueCapExceeded)
+	require.NoError(t, storage.SpendSessionKeyValue(userID, addr1, big.NewInt(1_000)))
+	user, err = storage.GetUser(userID)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(60), user.SessionKeys[addr2].SpentValue)
+	require.Equal(t, big.NewInt(1_000), user.SessionKeys[addr1].SpentValue)
+
+	// session keys are removed individually
+	require.NoError(t, storage.RemoveSessionKey(userID, addr1))
+	require.ErrorIs(t, storage.RemoveSessionKey(userID, addr1), errutil.ErrNotFound)
+	user, err = storage.GetUser(userID)
+	require.NoError(t, err)
+	require.Len(t, user.SessionKeys, 1)
+	require.Contains(t, user.SessionKeys, addr2)
+}
+
+func randomSessionKey(t *testing.T, name string, valueCap *big.Int) wecommon.GWSessionKey {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	return wecommon.GWSessionKey{
+		Account:    &wecommon.GWAccount{Address: &address, Signature: []byte{1}, SignatureType: viewingkey.EIP712Signature},
+		PrivateKey: ecies.ImportECDSA(key),
+		Name:       name,
+		Targets:    []wecommon.GWSessionKeyTarget{{Address: gethcommon.HexToAddress("0x1")}},
+		ValueCap:   valueCap,
+	}
+}
@@ -1,6 +1,9 @@
 package storage
 
 import (
+	"math/big"
+
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ten-protocol/go-ten/go/common/viewingkey"
 	"github.com/ten-protocol/go-ten/tools/walletextension/cache"
@@ -42,8 +45,8 @@ func (s *UserStorageWithCache) DeleteUser(userID []byte) error {
 	return nil
 }
 
-func (s *UserStorageWithCache) ActivateSessionKey(userID []byte, active bool) error {
-	err := s.storage.ActivateSessionKey(userID, active)
+func (s *UserStorageWithCache) ActivateSessionKey(userID []byte, address gethcommon.Address, active bool) error {
+	err := s.storage.ActivateSessionKey(userID, address, active)
 	if err != nil {
 		return err
 	}
@@ -60,8 +63,17 @@ func (s *UserStorageWithCache) AddSessionKey(userID []byte, key wecommon.GWSessi
 	return nil
 }
 
-func (s *UserStorageWithCache) RemoveSessionKey(userID []byte) error {
-	err := s.storage.RemoveSessionKey(userID)
+func (s *UserStorageWithCache) RemoveSessionKey(userID []byte, address gethcommon.Address) error {
+	err := s.storage.RemoveSessionKey(userID, address)
+	if err != nil {
+		return err
+	}
+	s.cache.Remove(userID)
+	return nil
+}
+
+func (s *UserStorageWithCache) SpendSessionKeyValue(userID []byte, address gethcommon.Address, value *big.Int) error {
+	err := s.storage.SpendSessionKeyValue(userID, address, value)
 	if err != nil {
 		return err
 	}
@@ -35,9 +35,14 @@ type Container struct {
 }
 
 func NewContainerFromConfig(config wecommon.Config, logger gethlog.Logger) *Container {
-	// create the account manager with a single unauthenticated connection
-	hostRPCBindAddrWS := wecommon.WSProtocol + config.NodeRPCWebsocketAddress
-	hostRPCBindAddrHTTP := wecommon.HTTPProtocol + config.NodeRPCHTTPAddress
+	hostRPCBindAddrsWS := make([]string, len(config.NodeRPCWebsocketAddresses))
+	for i, addr := range config.NodeRPCWebsocketAddresses {
+		hostRPCBindAddrsWS[i] = wecommon.WSProtocol + addr
+	}
+	hostRPCBindAddrsHTTP := make([]string, len(config.NodeRPCHTTPAddresses))
+	for i, addr := range config.NodeRPCHTTPAddresses {
+		hostRPCBindAddrsHTTP[i] = wecommon.HTTPProtocol + addr
+	}
 
 	// get the encryption key (method is determined by the config)
 	encryptionKey, err := keymanager.GetEncryptionKey(config, logger)
@@ -60,7 +65,7 @@ func NewContainerFromConfig(config wecommon.Config, logger gethlog.Logger) *Cont
 	}
 
 	stopControl := stopcontrol.New()
-	walletExt := services.NewServices(hostRPCBindAddrHTTP, hostRPCBindAddrWS, userStorage, stopControl, version, logger, &config)
+	walletExt := services.NewServices(hostRPCBindAddrsHTTP, hostRPCBindAddrsWS, userStorage, stopControl, version, logger, &config)
 	cfg := &node.RPCConfig{
 		EnableHTTP: true,
 		HTTPPort:   config.WalletExtensionPortHTTP,